@@ -1,8 +1,12 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/labstack/echo/v4"
+	tele "gopkg.in/telebot.v3"
+	"nlypage-final/internal/adapters/controller/telegram"
+	"nlypage-final/internal/domain/dto"
 	"nlypage-final/pkg/closer"
 	"sync"
 )
@@ -10,6 +14,7 @@ import (
 // App is an interface that represents the app
 type App interface {
 	Start()
+	Run(args []string) error
 }
 
 // app is a struct that represents the app
@@ -34,15 +39,20 @@ func (a *app) route() {
 			"status": "ok",
 		})
 	})
-	a.serviceProvider.ClientsHandler().Setup(e.Group("/clients"))
-	a.serviceProvider.AdvertisersHandler().Setup(e.Group("/advertisers"))
-	a.serviceProvider.MlScoreHandler().Setup(e.Group("/ml-scores"))
-	a.serviceProvider.CampaignsHandler().Setup(e.Group("/advertisers"))
-	a.serviceProvider.AdsHandler().Setup(e.Group("/ads"))
-	a.serviceProvider.StatsHandler().Setup(e.Group("/stats"))
-	a.serviceProvider.AiHandler().Setup(e.Group("/ai"))
-	a.serviceProvider.TimeHandler().Setup(e.Group("/time"))
-	a.serviceProvider.ModerationHandler().Setup(e.Group("/moderation"))
+
+	// Администратор проходит любую проверку, рекламодатель — только для своих ресурсов
+	authorize := a.serviceProvider.AuthMiddleware().Authorize
+	a.serviceProvider.ClientsHandler().Setup(e.Group("/clients", authorize()))
+	a.serviceProvider.AdvertisersHandler().Setup(e.Group("/advertisers", authorize(dto.RoleAdvertiser)))
+	a.serviceProvider.MlScoreHandler().Setup(e.Group("/ml-scores", authorize()))
+	a.serviceProvider.CampaignsHandler().Setup(e.Group("/advertisers", authorize(dto.RoleAdvertiser)))
+	a.serviceProvider.AdsHandler().Setup(e.Group("/ads", authorize(dto.RoleClient)))
+	a.serviceProvider.StatsHandler().Setup(e.Group("/stats", authorize(dto.RoleAdvertiser)))
+	a.serviceProvider.AiHandler().Setup(e.Group("/ai", authorize(dto.RoleAdvertiser)))
+	a.serviceProvider.TimeHandler().Setup(e.Group("/time", authorize()))
+	a.serviceProvider.ModerationHandler().Setup(e.Group("/moderation", authorize(dto.RoleModerator)))
+	a.serviceProvider.AdminHandler().Setup(e.Group("/admin", authorize()))
+	a.serviceProvider.BillingHandler().Setup(e.Group("/billing", authorize(dto.RoleAdvertiser)))
 
 	routes, err := json.MarshalIndent(e.Routes(), "", "  ")
 	if err == nil {
@@ -50,6 +60,28 @@ func (a *app) route() {
 	}
 }
 
+// routeBot is a function that sets up the bot handlers
+func (a *app) routeBot() {
+	b := a.serviceProvider.Bot()
+	lt := a.serviceProvider.Layout()
+
+	a.serviceProvider.Logger().Debug("Setting up bot handlers")
+	b.Use(lt.Middleware(telegram.DefaultLocale))
+
+	// Ответы на запросы ввода; кнопки с зарегистрированными обработчиками сюда не попадают
+	b.Handle(tele.OnText, a.serviceProvider.InputManager().MessageHandler())
+	b.Handle(tele.OnCallback, a.serviceProvider.InputManager().CallbackHandler())
+
+	a.serviceProvider.StartBotHandler().Setup(b.Group())
+	a.serviceProvider.AccountBotHandler().Setup(b.Group())
+	a.serviceProvider.ModerationBotHandler().Setup(b.Group())
+
+	// Кампании и статистика доступны только после привязки аккаунта рекламодателя
+	linked := b.Group()
+	linked.Use(telegram.AccountMiddleware(lt, a.serviceProvider.TelegramService()))
+	a.serviceProvider.CampaignsBotHandler().Setup(linked)
+}
+
 // Start is a function that starts the app
 func (a *app) Start() {
 	// Close all resources on stop
@@ -66,8 +98,12 @@ func (a *app) Start() {
 	// Migrate database trough DI
 	a.serviceProvider.DB()
 
-	// Migrate clickhouse database trough DI
-	a.serviceProvider.Clickhouse()
+	// Migrate clickhouse database
+	if a.serviceProvider.ClickhouseConfig().AutoMigrate() {
+		if err := a.migrateClickhouse(false); err != nil {
+			a.serviceProvider.Logger().Panicf("failed to run clickhouse migrations: %v", err)
+		}
+	}
 
 	// Start server listening
 	var wg sync.WaitGroup
@@ -93,10 +129,24 @@ func (a *app) Start() {
 	//	)
 	//}()
 
+	go func() {
+		defer wg.Done()
+		if !a.serviceProvider.AIModerationConfig().Enabled() {
+			return
+		}
+		closer.Add(a.serviceProvider.AIModerationService().Stop)
+
+		a.serviceProvider.Logger().Info("Starting ai moderation service")
+		a.serviceProvider.Logger().Error(
+			a.serviceProvider.AIModerationService().Start(context.Background()),
+		)
+	}()
+
 	go func() {
 		defer wg.Done()
 
 		a.serviceProvider.Logger().Info("Starting bot")
+		a.routeBot()
 		a.serviceProvider.Bot().Start()
 	}()
 
@@ -0,0 +1,218 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"nlypage-final/pkg/closer"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// clickhouseMigrationsLock is the redis lock held while clickhouse migrations are applied
+	clickhouseMigrationsLock = "clickhouse:migrations"
+	// clickhouseMigrationsLockTTL is how long the lock outlives a crashed process
+	clickhouseMigrationsLockTTL = time.Minute
+)
+
+// Run is a function that runs a CLI subcommand instead of starting the server
+func (a *app) Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no command specified")
+	}
+
+	// Close all resources opened by the command
+	defer func() {
+		closer.CloseAll()
+		closer.Wait()
+	}()
+
+	switch args[0] {
+	case "migrate":
+		return a.migrateCommand(args[1:])
+	case "clickhouse-migrate":
+		return a.clickhouseMigrateCommand(args[1:])
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// migrateCommand handles `migrate up [-dry-run]`, `migrate down [-steps n] [-dry-run]` and `migrate status`
+func (a *app) migrateCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate <up|down|status> [-steps n] [-dry-run]")
+	}
+
+	fs := flag.NewFlagSet("migrate "+args[0], flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "print migrations without applying them")
+	steps := fs.Int("steps", 1, "number of migrations to roll back")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		return a.migratePostgresUp(*dryRun)
+	case "down":
+		return a.migratePostgresDown(*steps, *dryRun)
+	case "status":
+		return a.postgresMigrationStatus()
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+}
+
+// migratePostgresUp applies pending postgres migrations, in dry-run mode only prints them
+func (a *app) migratePostgresUp(dryRun bool) error {
+	log := a.serviceProvider.Logger()
+
+	pending, err := a.serviceProvider.PGMigrator().Up(context.Background(), dryRun)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) == 0 {
+		log.Info("Postgres schema is up to date")
+		return nil
+	}
+
+	for _, m := range pending {
+		if dryRun {
+			log.Infof("Pending postgres migration %d_%s:", m.Version, m.Name)
+			fmt.Println(m.Up)
+			continue
+		}
+		log.Infof("Applied postgres migration %d_%s", m.Version, m.Name)
+	}
+
+	return nil
+}
+
+// migratePostgresDown rolls back the last applied postgres migrations, in dry-run mode only prints them
+func (a *app) migratePostgresDown(steps int, dryRun bool) error {
+	log := a.serviceProvider.Logger()
+
+	rolledBack, err := a.serviceProvider.PGMigrator().Down(context.Background(), steps, dryRun)
+	if err != nil {
+		return err
+	}
+
+	if len(rolledBack) == 0 {
+		log.Info("No postgres migrations to roll back")
+		return nil
+	}
+
+	for _, m := range rolledBack {
+		if dryRun {
+			log.Infof("Postgres migration %d_%s would be rolled back:", m.Version, m.Name)
+			fmt.Println(m.Down)
+			continue
+		}
+		log.Infof("Rolled back postgres migration %d_%s", m.Version, m.Name)
+	}
+
+	return nil
+}
+
+// postgresMigrationStatus prints the state of every known postgres migration
+func (a *app) postgresMigrationStatus() error {
+	statuses, err := a.serviceProvider.PGMigrator().Statuses(context.Background())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, st := range statuses {
+		status, appliedAt := "pending", "-"
+		if st.Applied {
+			status, appliedAt = "applied", st.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", st.Version, st.Name, status, appliedAt)
+	}
+
+	return w.Flush()
+}
+
+// clickhouseMigrateCommand handles `clickhouse-migrate up [-dry-run]` and `clickhouse-migrate status`
+func (a *app) clickhouseMigrateCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: clickhouse-migrate <up|status> [-dry-run]")
+	}
+
+	fs := flag.NewFlagSet("clickhouse-migrate "+args[0], flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "print pending migrations without applying them")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		return a.migrateClickhouse(*dryRun)
+	case "status":
+		return a.clickhouseMigrationStatus()
+	default:
+		return fmt.Errorf("unknown clickhouse-migrate command %q", args[0])
+	}
+}
+
+// migrateClickhouse applies pending clickhouse migrations, in dry-run mode only prints them
+func (a *app) migrateClickhouse(dryRun bool) error {
+	log := a.serviceProvider.Logger()
+	ctx := context.Background()
+
+	// Clickhouse DDL is not transactional, so only one process may apply migrations at a time.
+	// The second one waits for the first and then finds nothing left to apply
+	if !dryRun {
+		release, err := a.serviceProvider.Redis().Locks.Acquire(ctx, clickhouseMigrationsLock, clickhouseMigrationsLockTTL)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	pending, err := a.serviceProvider.Clickhouse().MigrateUp(ctx, dryRun)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) == 0 {
+		log.Info("Clickhouse schema is up to date")
+		return nil
+	}
+
+	for _, m := range pending {
+		if dryRun {
+			log.Infof("Pending clickhouse migration %d_%s:", m.Version, m.Name)
+			for _, query := range m.Queries {
+				fmt.Println(query)
+			}
+			continue
+		}
+		log.Infof("Applied clickhouse migration %d_%s", m.Version, m.Name)
+	}
+
+	return nil
+}
+
+// clickhouseMigrationStatus prints the state of every known clickhouse migration
+func (a *app) clickhouseMigrationStatus() error {
+	statuses, err := a.serviceProvider.Clickhouse().MigrationStatuses(context.Background())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, st := range statuses {
+		status, appliedAt := "pending", "-"
+		if st.Applied {
+			status, appliedAt = "applied", st.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", st.Version, st.Name, status, appliedAt)
+	}
+
+	return w.Flush()
+}
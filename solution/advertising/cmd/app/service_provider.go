@@ -14,10 +14,14 @@ import (
 	"gopkg.in/telebot.v3/layout"
 	"log"
 	"nlypage-final/internal/adapters/config"
+	"nlypage-final/internal/adapters/controller/api/auth"
+	"nlypage-final/internal/adapters/controller/api/ratelimit"
 	apiV1 "nlypage-final/internal/adapters/controller/api/v1"
+	"nlypage-final/internal/adapters/controller/api/v1/admin"
 	"nlypage-final/internal/adapters/controller/api/v1/ads"
 	"nlypage-final/internal/adapters/controller/api/v1/advertisers"
 	"nlypage-final/internal/adapters/controller/api/v1/ai"
+	"nlypage-final/internal/adapters/controller/api/v1/billing"
 	"nlypage-final/internal/adapters/controller/api/v1/campaigns"
 	"nlypage-final/internal/adapters/controller/api/v1/clients"
 	"nlypage-final/internal/adapters/controller/api/v1/ml_score"
@@ -25,16 +29,30 @@ import (
 	"nlypage-final/internal/adapters/controller/api/v1/stats"
 	timeHandler "nlypage-final/internal/adapters/controller/api/v1/time"
 	"nlypage-final/internal/adapters/controller/api/validator"
+	"nlypage-final/internal/adapters/controller/telegram"
+	"nlypage-final/internal/adapters/controller/telegram/alerts"
+	accountBotHandler "nlypage-final/internal/adapters/controller/telegram/handlers/account"
+	campaignsBotHandler "nlypage-final/internal/adapters/controller/telegram/handlers/campaigns"
+	moderationBotHandler "nlypage-final/internal/adapters/controller/telegram/handlers/moderation"
+	startBotHandler "nlypage-final/internal/adapters/controller/telegram/handlers/start"
 	"nlypage-final/internal/adapters/database/clickhouse"
 	"nlypage-final/internal/adapters/database/minio"
 	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/migrations"
 	"nlypage-final/internal/adapters/database/redis"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/internal/domain/prompts"
 	"nlypage-final/internal/domain/service"
+	"nlypage-final/internal/domain/utils"
 	"nlypage-final/pkg/ad_scoring"
+	"nlypage-final/pkg/ai_moderation"
 	"nlypage-final/pkg/closer"
 	"nlypage-final/pkg/gigachat"
+	"nlypage-final/pkg/image_validation"
+	"nlypage-final/pkg/invalid_traffic"
+	"nlypage-final/pkg/llm"
 	"nlypage-final/pkg/logger"
+	"nlypage-final/pkg/premoderation"
 	"os"
 	"time"
 
@@ -50,22 +68,40 @@ type ServiceProvider interface {
 	Location() *time.Location
 	Layout() *layout.Layout
 	InputManager() *intele.InputManager
+	TelegramNotifier() *alerts.Notifier
 
 	LoggerConfig() config.LoggerConfig
 	PGConfig() config.PGConfig
 	ClickhouseConfig() config.ClickHouseConfig
 	GigaChatConfig() config.GigachatConfig
+	LLMConfig() config.LLMConfig
 	AdScoringConfig() config.AdScoringConfig
+	RateLimitConfig() config.RateLimitConfig
+	AuthConfig() config.AuthConfig
+	ModerationConfig() config.ModerationConfig
+	AIModerationConfig() config.AIModerationConfig
+	ImageValidationConfig() config.ImageValidationConfig
+	InvalidTrafficConfig() config.InvalidTrafficConfig
+	ConversionsConfig() config.ConversionsConfig
+	BudgetsConfig() config.BudgetsConfig
 
 	Validator() *validator.Validator
+	AuthMiddleware() *auth.Middleware
+	RateLimiter() *ratelimit.Middleware
 	Logger() *logger.Logger
 	GigaChat() *gigachat.Client
+	LLM() llm.Provider
+	Prompts() *prompts.Templates
 
 	DB() *ent.Client
+	PGMigrator() *migrations.Migrator
 	Redis() *redis.Client
 	Clickhouse() *clickhouse.Repository
 	AdImagesRepository() minio.AdImagesRepository
 	AdScorer() ad_scoring.Scorer
+	PreModerator() premoderation.Checker
+	ImageValidator() image_validation.Validator
+	InvalidTrafficDetector() invalid_traffic.Detector
 
 	TimeService() service.TimeService
 	ClientService() service.ClientService
@@ -77,6 +113,12 @@ type ServiceProvider interface {
 	GenerateService() service.GenerateService
 	ModerationService() service.ModerationService
 	AdScoringService() service.AdScoringService
+	AIModerationService() service.AIModerationService
+	AuthService() service.AuthService
+	BudgetService() service.BudgetService
+	BillingService() service.BillingService
+	AuditService() service.AuditService
+	TelegramService() service.TelegramService
 
 	TimeHandler() apiV1.Handler
 	ClientsHandler() apiV1.Handler
@@ -87,6 +129,13 @@ type ServiceProvider interface {
 	StatsHandler() apiV1.Handler
 	AiHandler() apiV1.Handler
 	ModerationHandler() apiV1.Handler
+	AdminHandler() apiV1.Handler
+	BillingHandler() apiV1.Handler
+
+	StartBotHandler() telegram.Handler
+	AccountBotHandler() telegram.Handler
+	CampaignsBotHandler() telegram.Handler
+	ModerationBotHandler() telegram.Handler
 }
 
 type serviceProvider struct {
@@ -97,20 +146,39 @@ type serviceProvider struct {
 	location     *time.Location
 	layout       *layout.Layout
 	inputManager *intele.InputManager
-
-	pgConfig         config.PGConfig
-	loggerConfig     config.LoggerConfig
-	clickhouseConfig config.ClickHouseConfig
-	gigachatConfig   config.GigachatConfig
-	minioConfig      config.MinioConfig
-	adScoringConfig  config.AdScoringConfig
-
-	validator *validator.Validator
-	logger    *logger.Logger
-	gigachat  *gigachat.Client
-	adScorer  ad_scoring.Scorer
+	notifier     *alerts.Notifier
+
+	pgConfig              config.PGConfig
+	loggerConfig          config.LoggerConfig
+	clickhouseConfig      config.ClickHouseConfig
+	gigachatConfig        config.GigachatConfig
+	llmConfig             config.LLMConfig
+	minioConfig           config.MinioConfig
+	adScoringConfig       config.AdScoringConfig
+	rateLimitConfig       config.RateLimitConfig
+	authConfig            config.AuthConfig
+	moderationConfig      config.ModerationConfig
+	aiModerationConfig    config.AIModerationConfig
+	imageValidationConfig config.ImageValidationConfig
+	invalidTrafficConfig  config.InvalidTrafficConfig
+	conversionsConfig     config.ConversionsConfig
+	budgetsConfig         config.BudgetsConfig
+
+	validator      *validator.Validator
+	authMiddleware *auth.Middleware
+	rateLimiter    *ratelimit.Middleware
+	logger         *logger.Logger
+	gigachat       *gigachat.Client
+	llm            llm.Provider
+	prompts        *prompts.Templates
+	adScorer       ad_scoring.Scorer
+
+	preModerator   premoderation.Checker
+	imageValidator image_validation.Validator
+	ivtDetector    invalid_traffic.Detector
 
 	db                 *ent.Client
+	pgMigrator         *migrations.Migrator
 	clickhouse         *clickhouse.Repository
 	redis              *redis.Client
 	adImagesRepository minio.AdImagesRepository
@@ -126,6 +194,13 @@ type serviceProvider struct {
 	moderationService service.ModerationService
 	adScoringService  service.AdScoringService
 
+	aiModerationService service.AIModerationService
+	authService         service.AuthService
+	budgetService       service.BudgetService
+	billingService      service.BillingService
+	auditService        service.AuditService
+	telegramService     service.TelegramService
+
 	timeHandler        apiV1.Handler
 	clientsHandler     apiV1.Handler
 	advertisersHandler apiV1.Handler
@@ -135,6 +210,13 @@ type serviceProvider struct {
 	statsHandler       apiV1.Handler
 	aiHandler          apiV1.Handler
 	moderationHandler  apiV1.Handler
+	adminHandler       apiV1.Handler
+	billingHandler     apiV1.Handler
+
+	startBotHandler      telegram.Handler
+	accountBotHandler    telegram.Handler
+	campaignsBotHandler  telegram.Handler
+	moderationBotHandler telegram.Handler
 }
 
 func newServiceProvider() ServiceProvider {
@@ -187,6 +269,12 @@ func (s *serviceProvider) Echo() *echo.Echo {
 				return nil
 			},
 		}))
+		e.Use(s.AuthMiddleware().Authenticate(func(c echo.Context) bool {
+			return c.Path() == "/ping"
+		}))
+		if s.RateLimitConfig().Enabled() {
+			e.Use(s.RateLimiter().Limit())
+		}
 
 		s.echo = e
 	}
@@ -273,6 +361,19 @@ func (s *serviceProvider) InputManager() *intele.InputManager {
 	return s.inputManager
 }
 
+func (s *serviceProvider) TelegramNotifier() *alerts.Notifier {
+	if s.notifier == nil {
+		s.notifier = alerts.New(
+			s.Bot(),
+			s.Layout(),
+			s.TelegramService(),
+			s.ModerationConfig().ModeratorsChatID(),
+			s.Logger().Named("alerts"),
+		)
+	}
+	return s.notifier
+}
+
 func (s *serviceProvider) LoggerConfig() config.LoggerConfig {
 	if s.loggerConfig == nil {
 		cfg := config.NewLoggerConfig(s.Viper(), s.Location())
@@ -306,6 +407,14 @@ func (s *serviceProvider) GigaChatConfig() config.GigachatConfig {
 	return s.gigachatConfig
 }
 
+func (s *serviceProvider) LLMConfig() config.LLMConfig {
+	if s.llmConfig == nil {
+		s.llmConfig = config.NewLLMConfig(s.Viper())
+	}
+
+	return s.llmConfig
+}
+
 func (s *serviceProvider) AdScoringConfig() config.AdScoringConfig {
 	if s.adScoringConfig == nil {
 		s.adScoringConfig = config.NewAdScoringConfig(s.Viper())
@@ -314,6 +423,74 @@ func (s *serviceProvider) AdScoringConfig() config.AdScoringConfig {
 	return s.adScoringConfig
 }
 
+func (s *serviceProvider) RateLimitConfig() config.RateLimitConfig {
+	if s.rateLimitConfig == nil {
+		rateLimitConfig, err := config.NewRateLimitConfig(s.Viper())
+		if err != nil {
+			s.Logger().Panicf("failed to load rate limit config: %v", err)
+		}
+		s.rateLimitConfig = rateLimitConfig
+	}
+
+	return s.rateLimitConfig
+}
+
+func (s *serviceProvider) AuthConfig() config.AuthConfig {
+	if s.authConfig == nil {
+		s.authConfig = config.NewAuthConfig(s.Viper())
+	}
+
+	return s.authConfig
+}
+
+func (s *serviceProvider) ModerationConfig() config.ModerationConfig {
+	if s.moderationConfig == nil {
+		s.moderationConfig = config.NewModerationConfig(s.Viper())
+	}
+
+	return s.moderationConfig
+}
+
+func (s *serviceProvider) AIModerationConfig() config.AIModerationConfig {
+	if s.aiModerationConfig == nil {
+		s.aiModerationConfig = config.NewAIModerationConfig(s.Viper())
+	}
+
+	return s.aiModerationConfig
+}
+
+func (s *serviceProvider) ImageValidationConfig() config.ImageValidationConfig {
+	if s.imageValidationConfig == nil {
+		s.imageValidationConfig = config.NewImageValidationConfig(s.Viper())
+	}
+
+	return s.imageValidationConfig
+}
+
+func (s *serviceProvider) InvalidTrafficConfig() config.InvalidTrafficConfig {
+	if s.invalidTrafficConfig == nil {
+		s.invalidTrafficConfig = config.NewInvalidTrafficConfig(s.Viper())
+	}
+
+	return s.invalidTrafficConfig
+}
+
+func (s *serviceProvider) ConversionsConfig() config.ConversionsConfig {
+	if s.conversionsConfig == nil {
+		s.conversionsConfig = config.NewConversionsConfig(s.Viper())
+	}
+
+	return s.conversionsConfig
+}
+
+func (s *serviceProvider) BudgetsConfig() config.BudgetsConfig {
+	if s.budgetsConfig == nil {
+		s.budgetsConfig = config.NewBudgetsConfig(s.Viper())
+	}
+
+	return s.budgetsConfig
+}
+
 func (s *serviceProvider) MinioConfig() config.MinioConfig {
 	if s.minioConfig == nil {
 		s.minioConfig = config.NewMinioConfig(s.Viper())
@@ -364,8 +541,10 @@ func (s *serviceProvider) DB() *ent.Client {
 		if loggerCfg.Debug() {
 			client = client.Debug()
 		}
-		if errMigrate := client.Schema.Create(entcache.Skip(context.Background())); errMigrate != nil {
-			s.Logger().Panicf("Failed to run migrations: %v", errMigrate)
+		if s.PGConfig().AutoMigrate() {
+			if errMigrate := client.Schema.Create(entcache.Skip(context.Background())); errMigrate != nil {
+				s.Logger().Panicf("Failed to run migrations: %v", errMigrate)
+			}
 		}
 
 		closer.Add(client.Close)
@@ -375,6 +554,25 @@ func (s *serviceProvider) DB() *ent.Client {
 	return s.db
 }
 
+func (s *serviceProvider) PGMigrator() *migrations.Migrator {
+	if s.pgMigrator == nil {
+		db, err := sql.Open(dialect.Postgres, s.PGConfig().DSN())
+		if err != nil {
+			s.Logger().Panicf("failed to open database: %v", err)
+		}
+
+		m, err := migrations.NewMigrator(db.DB())
+		if err != nil {
+			s.Logger().Panicf("failed to load postgres migrations: %v", err)
+		}
+
+		closer.Add(db.Close)
+		s.pgMigrator = m
+	}
+
+	return s.pgMigrator
+}
+
 func (s *serviceProvider) Redis() *redis.Client {
 	if s.redis == nil {
 		r, err := redis.New(redis.Options{
@@ -442,6 +640,52 @@ func (s *serviceProvider) AdScorer() ad_scoring.Scorer {
 	return s.adScorer
 }
 
+// PreModerator возвращает проверку правилами премодерации или nil, если премодерация отключена
+func (s *serviceProvider) PreModerator() premoderation.Checker {
+	if s.preModerator == nil && s.ModerationConfig().PremoderationEnabled() {
+		rules, err := premoderation.LoadRules(s.ModerationConfig().PremoderationRules())
+		if err != nil {
+			s.Logger().Panicf("failed to load premoderation rules: %v", err)
+		}
+		checker, err := premoderation.NewChecker(rules, utils.DetectLanguage)
+		if err != nil {
+			s.Logger().Panicf("failed to init premoderation: %v", err)
+		}
+		s.preModerator = checker
+	}
+	return s.preModerator
+}
+
+func (s *serviceProvider) ImageValidator() image_validation.Validator {
+	if s.imageValidator == nil {
+		cfg := s.ImageValidationConfig()
+		s.imageValidator = image_validation.NewValidator(image_validation.Config{
+			Formats:        cfg.Formats(),
+			MaxBytes:       cfg.MaxBytes(),
+			MinWidth:       cfg.MinWidth(),
+			MinHeight:      cfg.MinHeight(),
+			MaxWidth:       cfg.MaxWidth(),
+			MaxHeight:      cfg.MaxHeight(),
+			MinAspectRatio: cfg.MinAspectRatio(),
+			MaxAspectRatio: cfg.MaxAspectRatio(),
+		})
+	}
+	return s.imageValidator
+}
+
+func (s *serviceProvider) InvalidTrafficDetector() invalid_traffic.Detector {
+	if cfg := s.InvalidTrafficConfig(); s.ivtDetector == nil && cfg.Enabled() {
+		s.ivtDetector = invalid_traffic.NewDetector(invalid_traffic.Config{
+			MinClickDelay:        cfg.MinClickDelay(),
+			MaxIPClicks:          cfg.MaxIPClicks(),
+			ClickEverythingMin:   cfg.ClickEverythingMin(),
+			MaxClientCTR:         cfg.MaxClientCTR(),
+			MinClientImpressions: cfg.MinClientImpressions(),
+		})
+	}
+	return s.ivtDetector
+}
+
 func (s *serviceProvider) Validator() *validator.Validator {
 	if s.validator == nil {
 		s.validator = validator.New()
@@ -450,6 +694,27 @@ func (s *serviceProvider) Validator() *validator.Validator {
 	return s.validator
 }
 
+func (s *serviceProvider) AuthMiddleware() *auth.Middleware {
+	if s.authMiddleware == nil {
+		s.authMiddleware = auth.NewMiddleware(
+			s.AuthService(),
+			s.AuthConfig().Enabled(),
+		)
+	}
+	return s.authMiddleware
+}
+
+func (s *serviceProvider) RateLimiter() *ratelimit.Middleware {
+	if s.rateLimiter == nil {
+		rateLimiter, err := ratelimit.NewMiddleware(s.Redis().Buckets, s.RateLimitConfig().Routes())
+		if err != nil {
+			s.Logger().Panicf("failed to init rate limiter: %v", err)
+		}
+		s.rateLimiter = rateLimiter
+	}
+	return s.rateLimiter
+}
+
 func (s *serviceProvider) GigaChat() *gigachat.Client {
 	if s.gigachat == nil {
 		giga, err := gigachat.NewInsecureClientWithAuthKey(s.GigaChatConfig().AuthKey())
@@ -461,11 +726,43 @@ func (s *serviceProvider) GigaChat() *gigachat.Client {
 	return s.gigachat
 }
 
+func (s *serviceProvider) LLM() llm.Provider {
+	if s.llm == nil {
+		cfg := s.LLMConfig()
+		switch cfg.Provider() {
+		case config.LLMProviderGigaChat, "":
+			s.llm = llm.NewGigaChatProvider(s.GigaChat(), cfg.Model())
+		case config.LLMProviderOpenAI:
+			s.llm = llm.NewOpenAIProvider(llm.OpenAIConfig{
+				BaseURL: cfg.OpenAIBaseURL(),
+				APIKey:  cfg.OpenAIAPIKey(),
+				Model:   cfg.Model(),
+			})
+		case config.LLMProviderStub:
+			s.llm = llm.NewStubProvider(nil)
+		default:
+			s.Logger().Panicf("unknown llm provider: %s", cfg.Provider())
+		}
+	}
+	return s.llm
+}
+
+func (s *serviceProvider) Prompts() *prompts.Templates {
+	if s.prompts == nil {
+		templates, err := prompts.Load(s.LLMConfig().PromptsDir())
+		if err != nil {
+			s.Logger().Panicf("failed to load prompts: %v", err)
+		}
+		s.prompts = templates
+	}
+	return s.prompts
+}
+
 // ----------------------------------Services----------------------------------start
 
 func (s *serviceProvider) TimeService() service.TimeService {
 	if s.timeService == nil {
-		timeSrvc, err := service.NewTimeService(s.Redis().Time)
+		timeSrvc, err := service.NewTimeService(s.Redis().Time, s.BillingService(), s.AuditService())
 		if err != nil {
 			s.Logger().Panicf("failed to init time service: %v", err)
 		}
@@ -477,21 +774,21 @@ func (s *serviceProvider) TimeService() service.TimeService {
 
 func (s *serviceProvider) ClientService() service.ClientService {
 	if s.clientService == nil {
-		s.clientService = service.NewClientService(s.DB())
+		s.clientService = service.NewClientService(s.DB(), s.Clickhouse(), s.Redis().Ads, s.AuditService())
 	}
 	return s.clientService
 }
 
 func (s *serviceProvider) AdvertiserService() service.AdvertiserService {
 	if s.advertiserService == nil {
-		s.advertiserService = service.NewAdvertiserService(s.DB())
+		s.advertiserService = service.NewAdvertiserService(s.DB(), s.AuditService())
 	}
 	return s.advertiserService
 }
 
 func (s *serviceProvider) MlScoreService() service.MlScoreService {
 	if s.mlScoreService == nil {
-		s.mlScoreService = service.NewMlScoreService(s.DB())
+		s.mlScoreService = service.NewMlScoreService(s.DB(), s.AuditService())
 	}
 	return s.mlScoreService
 }
@@ -503,7 +800,13 @@ func (s *serviceProvider) CampaignService() service.CampaignService {
 			s.TimeService(),
 			s.Clickhouse(),
 			s.AdImagesRepository(),
-			s.Viper().GetBool("service.backend.settings.campaign-moderation"),
+			s.ModerationConfig().CampaignModeration(),
+			s.ModerationConfig().ServeApprovedCreative(),
+			s.PreModerator(),
+			s.ImageValidator(),
+			s.ImageValidationConfig().DuplicateDistance(),
+			s.AuditService(),
+			s.TelegramNotifier(),
 		)
 	}
 	return s.campaignService
@@ -517,6 +820,13 @@ func (s *serviceProvider) AdService() service.AdService {
 			s.Redis().Ads,
 			s.Clickhouse(),
 			s.TimeService(),
+			s.BudgetService(),
+			s.ConversionsConfig().AttributionWindow(),
+			service.InvalidTrafficOptions{
+				Detector:   s.InvalidTrafficDetector(),
+				IPWindow:   s.InvalidTrafficConfig().IPWindow(),
+				ClientDays: s.InvalidTrafficConfig().ClientDays(),
+			},
 		)
 	}
 	return s.adService
@@ -531,14 +841,26 @@ func (s *serviceProvider) StatsService() service.StatsService {
 
 func (s *serviceProvider) GenerateService() service.GenerateService {
 	if s.generateService == nil {
-		s.generateService = service.NewGenerateService(s.GigaChat(), s.AdvertiserService())
+		s.generateService = service.NewGenerateService(
+			s.LLM(),
+			s.Prompts(),
+			s.AdvertiserService(),
+			s.CampaignService(),
+		)
 	}
 	return s.generateService
 }
 
 func (s *serviceProvider) ModerationService() service.ModerationService {
 	if s.moderationService == nil {
-		s.moderationService = service.NewModerationService(s.DB())
+		s.moderationService = service.NewModerationService(
+			s.DB(),
+			s.TimeService(),
+			s.Redis().Leases,
+			s.ModerationConfig().LeaseTTL(),
+			s.AuditService(),
+			s.TelegramNotifier(),
+		)
 	}
 	return s.moderationService
 }
@@ -558,6 +880,68 @@ func (s *serviceProvider) AdScoringService() service.AdScoringService {
 	return s.adScoringService
 }
 
+func (s *serviceProvider) AIModerationService() service.AIModerationService {
+	if s.aiModerationService == nil {
+		s.aiModerationService = service.NewAIModerationService(
+			s.DB(),
+			ai_moderation.NewReviewer(
+				s.LLM(),
+				s.AIModerationConfig().Model(),
+				s.Prompts(),
+				prompts.Moderation,
+			),
+			s.Logger(),
+			s.AIModerationConfig().Interval(),
+			s.AIModerationConfig().BatchSize(),
+		)
+	}
+	return s.aiModerationService
+}
+
+func (s *serviceProvider) AuthService() service.AuthService {
+	if s.authService == nil {
+		s.authService = service.NewAuthService(
+			s.DB(),
+			s.AuthConfig().AdminKey(),
+			s.AuditService(),
+		)
+	}
+	return s.authService
+}
+
+func (s *serviceProvider) BudgetService() service.BudgetService {
+	if s.budgetService == nil {
+		s.budgetService = service.NewBudgetService(
+			s.DB(),
+			s.TimeService(),
+			s.BudgetsConfig().RequireBalance(),
+			s.AuditService(),
+		)
+	}
+	return s.budgetService
+}
+
+func (s *serviceProvider) BillingService() service.BillingService {
+	if s.billingService == nil {
+		s.billingService = service.NewBillingService(s.DB(), s.Clickhouse())
+	}
+	return s.billingService
+}
+
+func (s *serviceProvider) AuditService() service.AuditService {
+	if s.auditService == nil {
+		s.auditService = service.NewAuditService(s.DB())
+	}
+	return s.auditService
+}
+
+func (s *serviceProvider) TelegramService() service.TelegramService {
+	if s.telegramService == nil {
+		s.telegramService = service.NewTelegramService(s.DB(), s.AuthService(), s.AuditService())
+	}
+	return s.telegramService
+}
+
 // ----------------------------------Services----------------------------------end
 
 // ----------------------------------Handlers----------------------------------start
@@ -578,7 +962,7 @@ func (s *serviceProvider) ClientsHandler() apiV1.Handler {
 
 func (s *serviceProvider) AdvertisersHandler() apiV1.Handler {
 	if s.advertisersHandler == nil {
-		s.advertisersHandler = advertisers.NewAdvertisersHandler(s.AdvertiserService(), s.Validator())
+		s.advertisersHandler = advertisers.NewAdvertisersHandler(s.AdvertiserService(), s.BudgetService(), s.Validator())
 	}
 	return s.advertisersHandler
 }
@@ -625,4 +1009,63 @@ func (s *serviceProvider) ModerationHandler() apiV1.Handler {
 	return s.moderationHandler
 }
 
+func (s *serviceProvider) AdminHandler() apiV1.Handler {
+	if s.adminHandler == nil {
+		s.adminHandler = admin.NewAdminHandler(s.AuthService(), s.BudgetService(), s.CampaignService(), s.AuditService(), s.Validator())
+	}
+	return s.adminHandler
+}
+
+func (s *serviceProvider) BillingHandler() apiV1.Handler {
+	if s.billingHandler == nil {
+		s.billingHandler = billing.NewBillingHandler(s.BillingService(), s.Validator())
+	}
+	return s.billingHandler
+}
+
+func (s *serviceProvider) StartBotHandler() telegram.Handler {
+	if s.startBotHandler == nil {
+		s.startBotHandler = startBotHandler.New(s.Layout(), s.Logger().Named("bot"), s.TelegramService())
+	}
+	return s.startBotHandler
+}
+
+func (s *serviceProvider) AccountBotHandler() telegram.Handler {
+	if s.accountBotHandler == nil {
+		s.accountBotHandler = accountBotHandler.New(
+			s.Layout(),
+			s.Logger().Named("bot"),
+			s.InputManager(),
+			s.TelegramService(),
+		)
+	}
+	return s.accountBotHandler
+}
+
+func (s *serviceProvider) CampaignsBotHandler() telegram.Handler {
+	if s.campaignsBotHandler == nil {
+		s.campaignsBotHandler = campaignsBotHandler.New(
+			s.Layout(),
+			s.Logger().Named("bot"),
+			s.CampaignService(),
+			s.StatsService(),
+			s.TimeService(),
+		)
+	}
+	return s.campaignsBotHandler
+}
+
+func (s *serviceProvider) ModerationBotHandler() telegram.Handler {
+	if s.moderationBotHandler == nil {
+		s.moderationBotHandler = moderationBotHandler.New(
+			s.Layout(),
+			s.Logger().Named("bot"),
+			s.InputManager(),
+			s.ModerationService(),
+			s.ModerationConfig().ModeratorsChatID(),
+		)
+	}
+	return s.moderationBotHandler
+}
+
 // ----------------------------------Handlers----------------------------------end
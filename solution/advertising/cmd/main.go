@@ -1,12 +1,23 @@
 package main
 
 import (
+	"log"
 	"nlypage-final/cmd/app"
+	"os"
 
 	_ "time/tzdata"
 )
 
 func main() {
 	a := app.New()
+
+	// Subcommands (e.g. `clickhouse-migrate up -dry-run`) run instead of the server
+	if len(os.Args) > 1 {
+		if err := a.Run(os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	a.Start()
 }
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type AIModerationConfig interface {
+	Enabled() bool
+	Model() string
+	Interval() time.Duration
+	BatchSize() int
+}
+
+type aiModerationConfig struct {
+	enabled   bool
+	model     string
+	interval  time.Duration
+	batchSize int
+}
+
+func NewAIModerationConfig(v *viper.Viper) AIModerationConfig {
+	return &aiModerationConfig{
+		enabled:   v.GetBool("service.backend.settings.ai-moderation.enabled"),
+		model:     v.GetString("service.backend.settings.ai-moderation.model"),
+		interval:  v.GetDuration("service.backend.settings.ai-moderation.interval"),
+		batchSize: v.GetInt("service.backend.settings.ai-moderation.batch-size"),
+	}
+}
+
+func (c *aiModerationConfig) Enabled() bool {
+	return c.enabled
+}
+
+func (c *aiModerationConfig) Model() string {
+	return c.model
+}
+
+func (c *aiModerationConfig) Interval() time.Duration {
+	return c.interval
+}
+
+func (c *aiModerationConfig) BatchSize() int {
+	return c.batchSize
+}
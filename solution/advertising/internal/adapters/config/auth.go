@@ -0,0 +1,28 @@
+package config
+
+import "github.com/spf13/viper"
+
+type AuthConfig interface {
+	Enabled() bool
+	AdminKey() string
+}
+
+type authConfig struct {
+	enabled  bool
+	adminKey string
+}
+
+func NewAuthConfig(v *viper.Viper) AuthConfig {
+	return &authConfig{
+		enabled:  v.GetBool("service.backend.settings.auth.enabled"),
+		adminKey: v.GetString("service.backend.settings.auth.admin-key"),
+	}
+}
+
+func (c *authConfig) Enabled() bool {
+	return c.enabled
+}
+
+func (c *authConfig) AdminKey() string {
+	return c.adminKey
+}
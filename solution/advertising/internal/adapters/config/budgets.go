@@ -0,0 +1,21 @@
+package config
+
+import "github.com/spf13/viper"
+
+type BudgetsConfig interface {
+	RequireBalance() bool
+}
+
+type budgetsConfig struct {
+	requireBalance bool
+}
+
+func NewBudgetsConfig(v *viper.Viper) BudgetsConfig {
+	return &budgetsConfig{
+		requireBalance: v.GetBool("service.backend.settings.budgets.require-balance"),
+	}
+}
+
+func (c *budgetsConfig) RequireBalance() bool {
+	return c.requireBalance
+}
@@ -9,25 +9,28 @@ type ClickHouseConfig interface {
 	Username() string
 	Password() string
 	Debug() bool
+	AutoMigrate() bool
 }
 
 type clickHouseConfig struct {
-	host     string
-	port     string
-	database string
-	username string
-	password string
-	debug    bool
+	host        string
+	port        string
+	database    string
+	username    string
+	password    string
+	debug       bool
+	autoMigrate bool
 }
 
 func NewClickHouseConfig(v *viper.Viper) ClickHouseConfig {
 	return &clickHouseConfig{
-		host:     v.GetString("service.clickhouse.host"),
-		port:     v.GetString("service.clickhouse.port"),
-		database: v.GetString("service.clickhouse.database"),
-		username: v.GetString("service.clickhouse.username"),
-		password: v.GetString("service.clickhouse.password"),
-		debug:    v.GetBool("service.debug"),
+		host:        v.GetString("service.clickhouse.host"),
+		port:        v.GetString("service.clickhouse.port"),
+		database:    v.GetString("service.clickhouse.database"),
+		username:    v.GetString("service.clickhouse.username"),
+		password:    v.GetString("service.clickhouse.password"),
+		debug:       v.GetBool("service.debug"),
+		autoMigrate: v.GetBool("service.clickhouse.auto-migrate"),
 	}
 }
 
@@ -54,3 +57,7 @@ func (c *clickHouseConfig) Password() string {
 func (c *clickHouseConfig) Debug() bool {
 	return c.debug
 }
+
+func (c *clickHouseConfig) AutoMigrate() bool {
+	return c.autoMigrate
+}
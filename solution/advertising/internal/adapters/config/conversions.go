@@ -0,0 +1,22 @@
+package config
+
+import "github.com/spf13/viper"
+
+type ConversionsConfig interface {
+	// AttributionWindow — сколько дней после клика засчитывается конверсия
+	AttributionWindow() int
+}
+
+type conversionsConfig struct {
+	attributionWindow int
+}
+
+func NewConversionsConfig(v *viper.Viper) ConversionsConfig {
+	return &conversionsConfig{
+		attributionWindow: v.GetInt("service.backend.settings.conversion-attribution-window"),
+	}
+}
+
+func (c *conversionsConfig) AttributionWindow() int {
+	return c.attributionWindow
+}
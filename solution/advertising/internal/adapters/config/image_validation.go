@@ -0,0 +1,77 @@
+package config
+
+import "github.com/spf13/viper"
+
+type ImageValidationConfig interface {
+	Formats() []string
+	MaxBytes() int64
+	MinWidth() int
+	MinHeight() int
+	MaxWidth() int
+	MaxHeight() int
+	MinAspectRatio() float64
+	MaxAspectRatio() float64
+	DuplicateDistance() int
+}
+
+type imageValidationConfig struct {
+	formats           []string
+	maxBytes          int64
+	minWidth          int
+	minHeight         int
+	maxWidth          int
+	maxHeight         int
+	minAspectRatio    float64
+	maxAspectRatio    float64
+	duplicateDistance int
+}
+
+func NewImageValidationConfig(v *viper.Viper) ImageValidationConfig {
+	return &imageValidationConfig{
+		formats:           v.GetStringSlice("service.backend.settings.image-validation.formats"),
+		maxBytes:          v.GetInt64("service.backend.settings.image-validation.max-bytes"),
+		minWidth:          v.GetInt("service.backend.settings.image-validation.min-width"),
+		minHeight:         v.GetInt("service.backend.settings.image-validation.min-height"),
+		maxWidth:          v.GetInt("service.backend.settings.image-validation.max-width"),
+		maxHeight:         v.GetInt("service.backend.settings.image-validation.max-height"),
+		minAspectRatio:    v.GetFloat64("service.backend.settings.image-validation.min-aspect-ratio"),
+		maxAspectRatio:    v.GetFloat64("service.backend.settings.image-validation.max-aspect-ratio"),
+		duplicateDistance: v.GetInt("service.backend.settings.image-validation.duplicate-distance"),
+	}
+}
+
+func (c *imageValidationConfig) Formats() []string {
+	return c.formats
+}
+
+func (c *imageValidationConfig) MaxBytes() int64 {
+	return c.maxBytes
+}
+
+func (c *imageValidationConfig) MinWidth() int {
+	return c.minWidth
+}
+
+func (c *imageValidationConfig) MinHeight() int {
+	return c.minHeight
+}
+
+func (c *imageValidationConfig) MaxWidth() int {
+	return c.maxWidth
+}
+
+func (c *imageValidationConfig) MaxHeight() int {
+	return c.maxHeight
+}
+
+func (c *imageValidationConfig) MinAspectRatio() float64 {
+	return c.minAspectRatio
+}
+
+func (c *imageValidationConfig) MaxAspectRatio() float64 {
+	return c.maxAspectRatio
+}
+
+func (c *imageValidationConfig) DuplicateDistance() int {
+	return c.duplicateDistance
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type InvalidTrafficConfig interface {
+	Enabled() bool
+	MinClickDelay() time.Duration
+	IPWindow() time.Duration
+	MaxIPClicks() int
+	ClientDays() int
+	ClickEverythingMin() int
+	MaxClientCTR() float64
+	MinClientImpressions() int
+}
+
+type invalidTrafficConfig struct {
+	enabled              bool
+	minClickDelay        time.Duration
+	ipWindow             time.Duration
+	maxIPClicks          int
+	clientDays           int
+	clickEverythingMin   int
+	maxClientCTR         float64
+	minClientImpressions int
+}
+
+func NewInvalidTrafficConfig(v *viper.Viper) InvalidTrafficConfig {
+	return &invalidTrafficConfig{
+		enabled:              v.GetBool("service.backend.settings.invalid-traffic.enabled"),
+		minClickDelay:        v.GetDuration("service.backend.settings.invalid-traffic.min-click-delay"),
+		ipWindow:             v.GetDuration("service.backend.settings.invalid-traffic.ip-window"),
+		maxIPClicks:          v.GetInt("service.backend.settings.invalid-traffic.max-ip-clicks"),
+		clientDays:           v.GetInt("service.backend.settings.invalid-traffic.client-days"),
+		clickEverythingMin:   v.GetInt("service.backend.settings.invalid-traffic.click-everything-min"),
+		maxClientCTR:         v.GetFloat64("service.backend.settings.invalid-traffic.max-client-ctr"),
+		minClientImpressions: v.GetInt("service.backend.settings.invalid-traffic.min-client-impressions"),
+	}
+}
+
+func (c *invalidTrafficConfig) Enabled() bool {
+	return c.enabled
+}
+
+func (c *invalidTrafficConfig) MinClickDelay() time.Duration {
+	return c.minClickDelay
+}
+
+func (c *invalidTrafficConfig) IPWindow() time.Duration {
+	return c.ipWindow
+}
+
+func (c *invalidTrafficConfig) MaxIPClicks() int {
+	return c.maxIPClicks
+}
+
+func (c *invalidTrafficConfig) ClientDays() int {
+	return c.clientDays
+}
+
+func (c *invalidTrafficConfig) ClickEverythingMin() int {
+	return c.clickEverythingMin
+}
+
+func (c *invalidTrafficConfig) MaxClientCTR() float64 {
+	return c.maxClientCTR
+}
+
+func (c *invalidTrafficConfig) MinClientImpressions() int {
+	return c.minClientImpressions
+}
@@ -0,0 +1,56 @@
+package config
+
+import "github.com/spf13/viper"
+
+// Провайдеры языковых моделей
+const (
+	LLMProviderGigaChat = "gigachat"
+	LLMProviderOpenAI   = "openai"
+	LLMProviderStub     = "stub"
+)
+
+type LLMConfig interface {
+	Provider() string
+	Model() string
+	PromptsDir() string
+	OpenAIBaseURL() string
+	OpenAIAPIKey() string
+}
+
+type llmConfig struct {
+	provider      string
+	model         string
+	promptsDir    string
+	openAIBaseURL string
+	openAIAPIKey  string
+}
+
+func NewLLMConfig(v *viper.Viper) LLMConfig {
+	return &llmConfig{
+		provider:      v.GetString("service.llm.provider"),
+		model:         v.GetString("service.llm.model"),
+		promptsDir:    v.GetString("service.llm.prompts-dir"),
+		openAIBaseURL: v.GetString("service.llm.openai.base-url"),
+		openAIAPIKey:  v.GetString("service.llm.openai.api-key"),
+	}
+}
+
+func (c *llmConfig) Provider() string {
+	return c.provider
+}
+
+func (c *llmConfig) Model() string {
+	return c.model
+}
+
+func (c *llmConfig) PromptsDir() string {
+	return c.promptsDir
+}
+
+func (c *llmConfig) OpenAIBaseURL() string {
+	return c.openAIBaseURL
+}
+
+func (c *llmConfig) OpenAIAPIKey() string {
+	return c.openAIAPIKey
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type ModerationConfig interface {
+	CampaignModeration() bool
+	ServeApprovedCreative() bool
+	LeaseTTL() time.Duration
+	PremoderationEnabled() bool
+	PremoderationRules() string
+	// ModeratorsChatID — чат модераторов в Telegram, 0 — отключено
+	ModeratorsChatID() int64
+}
+
+type moderationConfig struct {
+	campaignModeration    bool
+	serveApprovedCreative bool
+	leaseTTL              time.Duration
+	premoderationEnabled  bool
+	premoderationRules    string
+	moderatorsChatID      int64
+}
+
+func NewModerationConfig(v *viper.Viper) ModerationConfig {
+	return &moderationConfig{
+		campaignModeration:    v.GetBool("service.backend.settings.campaign-moderation"),
+		serveApprovedCreative: v.GetBool("service.backend.settings.serve-approved-creative"),
+		leaseTTL:              v.GetDuration("service.backend.settings.moderation-lease-ttl"),
+		premoderationEnabled:  v.GetBool("service.backend.settings.premoderation.enabled"),
+		premoderationRules:    v.GetString("service.backend.settings.premoderation.rules"),
+		moderatorsChatID:      v.GetInt64("service.bot.moderators-chat-id"),
+	}
+}
+
+func (c *moderationConfig) CampaignModeration() bool {
+	return c.campaignModeration
+}
+
+func (c *moderationConfig) ServeApprovedCreative() bool {
+	return c.serveApprovedCreative
+}
+
+func (c *moderationConfig) LeaseTTL() time.Duration {
+	return c.leaseTTL
+}
+
+func (c *moderationConfig) PremoderationEnabled() bool {
+	return c.premoderationEnabled
+}
+
+func (c *moderationConfig) PremoderationRules() string {
+	return c.premoderationRules
+}
+
+func (c *moderationConfig) ModeratorsChatID() int64 {
+	return c.moderatorsChatID
+}
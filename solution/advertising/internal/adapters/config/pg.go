@@ -7,6 +7,7 @@ import (
 
 type PGConfig interface {
 	DSN() string
+	AutoMigrate() bool
 }
 
 type pgConfig struct {
@@ -17,6 +18,8 @@ type pgConfig struct {
 	dbName   string
 	sslMode  string
 	timeZone string
+
+	autoMigrate bool
 }
 
 func NewPGConfig(v *viper.Viper) PGConfig {
@@ -28,6 +31,8 @@ func NewPGConfig(v *viper.Viper) PGConfig {
 		dbName:   v.GetString("service.database.name"),
 		sslMode:  v.GetString("service.database.ssl-mode"),
 		timeZone: v.GetString("settings.timezone"),
+
+		autoMigrate: v.GetBool("service.database.auto-migrate"),
 	}
 }
 
@@ -42,3 +47,7 @@ func (c *pgConfig) DSN() string {
 		c.timeZone,
 	)
 }
+
+func (c *pgConfig) AutoMigrate() bool {
+	return c.autoMigrate
+}
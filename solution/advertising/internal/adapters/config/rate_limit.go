@@ -0,0 +1,54 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+// Ключи, по которым считаются лимиты запросов
+const (
+	RateLimitKeyClient = "client"
+	RateLimitKeyIP     = "ip"
+	RateLimitKeyAPIKey = "api-key"
+)
+
+// RateLimit — корзина токенов: rate токенов в секунду, не больше burst накопленных
+type RateLimit struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
+}
+
+// RateLimitRoute задает лимиты маршрута, например "POST /ads/:adID/click", отдельно для каждого ключа
+type RateLimitRoute struct {
+	Route  string               `mapstructure:"route"`
+	Limits map[string]RateLimit `mapstructure:"limits"`
+}
+
+type RateLimitConfig interface {
+	Enabled() bool
+	Routes() []RateLimitRoute
+}
+
+type rateLimitConfig struct {
+	enabled bool
+	routes  []RateLimitRoute
+}
+
+func NewRateLimitConfig(v *viper.Viper) (RateLimitConfig, error) {
+	var routes []RateLimitRoute
+	if err := v.UnmarshalKey("service.backend.settings.rate-limit.routes", &routes); err != nil {
+		return nil, err
+	}
+
+	return &rateLimitConfig{
+		enabled: v.GetBool("service.backend.settings.rate-limit.enabled"),
+		routes:  routes,
+	}, nil
+}
+
+func (c *rateLimitConfig) Enabled() bool {
+	return c.enabled
+}
+
+func (c *rateLimitConfig) Routes() []RateLimitRoute {
+	return c.routes
+}
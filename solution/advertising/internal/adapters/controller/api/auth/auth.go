@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+)
+
+const (
+	principalKey = "principal"
+	apiKeyHeader = "X-API-Key"
+)
+
+type authService interface {
+	Authenticate(ctx context.Context, key string) (*dto.Principal, error)
+	CampaignAdvertiser(ctx context.Context, campaignID uuid.UUID) (uuid.UUID, error)
+}
+
+// Middleware проверяет ключ доступа и роль владельца ключа.
+// Если аутентификация выключена, все запросы пропускаются без проверки
+type Middleware struct {
+	service authService
+	enabled bool
+}
+
+func NewMiddleware(service authService, enabled bool) *Middleware {
+	return &Middleware{
+		service: service,
+		enabled: enabled,
+	}
+}
+
+// Authenticate определяет владельца ключа из заголовка Authorization: Bearer или X-API-Key
+func (m *Middleware) Authenticate(skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !m.enabled || (skipper != nil && skipper(c)) {
+				return next(c)
+			}
+
+			principal, err := m.service.Authenticate(c.Request().Context(), requestKey(c))
+			if err != nil {
+				return err
+			}
+			c.Set(principalKey, principal)
+			c.SetRequest(c.Request().WithContext(dto.ContextWithPrincipal(c.Request().Context(), principal)))
+
+			return next(c)
+		}
+	}
+}
+
+// Authorize пропускает администратора и перечисленные роли.
+// Рекламодатель получает доступ только к своим ресурсам: advertiserId в пути
+// должен совпадать с его идентификатором, а campaignId — принадлежать ему
+func (m *Middleware) Authorize(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !m.enabled {
+				return next(c)
+			}
+
+			principal := PrincipalFromContext(c)
+			if principal == nil {
+				return errorz.ErrUnauthorized
+			}
+			if principal.Role == dto.RoleAdmin {
+				return next(c)
+			}
+			if !hasRole(roles, principal.Role) {
+				return errorz.ErrForbidden
+			}
+			if principal.Role == dto.RoleAdvertiser {
+				if err := m.checkAdvertiser(c, principal); err != nil {
+					return err
+				}
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func (m *Middleware) checkAdvertiser(c echo.Context, principal *dto.Principal) error {
+	if principal.AdvertiserID == nil {
+		return errorz.ErrForbidden
+	}
+
+	if param := c.Param("advertiserId"); param != "" {
+		advertiserID, err := uuid.Parse(param)
+		if err != nil || advertiserID != *principal.AdvertiserID {
+			return errorz.ErrForbidden
+		}
+		return nil
+	}
+
+	if param := c.Param("campaignId"); param != "" {
+		campaignID, err := uuid.Parse(param)
+		if err != nil {
+			return errorz.ErrForbidden
+		}
+		advertiserID, err := m.service.CampaignAdvertiser(c.Request().Context(), campaignID)
+		if err != nil {
+			return err
+		}
+		if advertiserID != *principal.AdvertiserID {
+			return errorz.ErrForbidden
+		}
+		return nil
+	}
+
+	// Маршруты без идентификатора рекламодателя затрагивают чужие ресурсы
+	return errorz.ErrForbidden
+}
+
+// PrincipalFromContext возвращает владельца ключа, nil если аутентификация выключена
+func PrincipalFromContext(c echo.Context) *dto.Principal {
+	principal, _ := c.Get(principalKey).(*dto.Principal)
+	return principal
+}
+
+func requestKey(c echo.Context) string {
+	header := c.Request().Header
+	if authorization := header.Get(echo.HeaderAuthorization); authorization != "" {
+		scheme, key, found := strings.Cut(authorization, " ")
+		if found && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(key)
+		}
+	}
+	return header.Get(apiKeyHeader)
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
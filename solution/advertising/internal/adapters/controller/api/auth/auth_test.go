@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+)
+
+var (
+	advertiserID      = uuid.New()
+	otherAdvertiserID = uuid.New()
+	campaignID        = uuid.New()
+	otherCampaignID   = uuid.New()
+)
+
+// fakeAuthService знает несколько ключей; отозванный ключ не проходит аутентификацию, как в AuthService
+type fakeAuthService struct{}
+
+func (fakeAuthService) Authenticate(_ context.Context, key string) (*dto.Principal, error) {
+	switch key {
+	case "admin":
+		return &dto.Principal{Name: "admin", Role: dto.RoleAdmin}, nil
+	case "moderator":
+		return &dto.Principal{KeyID: uuid.New(), Name: "moderator", Role: dto.RoleModerator}, nil
+	case "advertiser":
+		return &dto.Principal{KeyID: uuid.New(), Name: "advertiser", Role: dto.RoleAdvertiser, AdvertiserID: &advertiserID}, nil
+	case "advertiser-without-id":
+		return &dto.Principal{KeyID: uuid.New(), Name: "broken", Role: dto.RoleAdvertiser}, nil
+	}
+	return nil, errorz.ErrUnauthorized
+}
+
+func (fakeAuthService) CampaignAdvertiser(_ context.Context, id uuid.UUID) (uuid.UUID, error) {
+	switch id {
+	case campaignID:
+		return advertiserID, nil
+	case otherCampaignID:
+		return otherAdvertiserID, nil
+	}
+	return uuid.Nil, errorz.ErrNotFound
+}
+
+// serve прогоняет запрос через Authenticate и Authorize и возвращает ошибку цепочки
+func serve(t *testing.T, m *Middleware, roles []string, setup func(req *http.Request), params map[string]string) error {
+	t.Helper()
+
+	e := echo.New()
+	// Контекст хранит не больше параметров пути, чем есть в маршрутах echo
+	e.GET("/:advertiserId/:campaignId", nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if setup != nil {
+		setup(req)
+	}
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	var names, values []string
+	for name, value := range params {
+		names = append(names, name)
+		values = append(values, value)
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	handler := m.Authenticate(nil)(m.Authorize(roles...)(func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	}))
+	return handler(c)
+}
+
+func withKey(key string) func(req *http.Request) {
+	return func(req *http.Request) {
+		req.Header.Set(apiKeyHeader, key)
+	}
+}
+
+func assertCode(t *testing.T, err error, code int) {
+	t.Helper()
+
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, code, httpErr.Code)
+}
+
+func TestAuthenticate(t *testing.T) {
+	m := NewMiddleware(fakeAuthService{}, true)
+
+	t.Run("no key", func(t *testing.T) {
+		assertCode(t, serve(t, m, nil, nil, nil), http.StatusUnauthorized)
+	})
+	t.Run("unknown or revoked key", func(t *testing.T) {
+		assertCode(t, serve(t, m, nil, withKey("revoked"), nil), http.StatusUnauthorized)
+	})
+	t.Run("bearer token", func(t *testing.T) {
+		err := serve(t, m, nil, func(req *http.Request) {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer admin")
+		}, nil)
+		assert.NoError(t, err)
+	})
+	t.Run("principal in request context", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		withKey("moderator")(req)
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		var principal *dto.Principal
+		err := m.Authenticate(nil)(func(c echo.Context) error {
+			principal = dto.PrincipalFromContext(c.Request().Context())
+			return nil
+		})(c)
+		require.NoError(t, err)
+		require.NotNil(t, principal)
+		assert.Equal(t, "moderator", principal.Name)
+		assert.Equal(t, principal, PrincipalFromContext(c))
+	})
+	t.Run("skipper", func(t *testing.T) {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/ping", nil), httptest.NewRecorder())
+		err := m.Authenticate(func(echo.Context) bool { return true })(func(echo.Context) error { return nil })(c)
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	m := NewMiddleware(fakeAuthService{}, true)
+
+	tests := []struct {
+		name   string
+		key    string
+		roles  []string
+		params map[string]string
+		code   int
+	}{
+		{
+			name:  "admin passes any role check",
+			key:   "admin",
+			roles: []string{dto.RoleModerator},
+		},
+		{
+			name:   "admin is not scoped to an advertiser",
+			key:    "admin",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"advertiserId": otherAdvertiserID.String()},
+		},
+		{
+			name:  "role not allowed",
+			key:   "moderator",
+			roles: []string{dto.RoleAdvertiser},
+			code:  http.StatusForbidden,
+		},
+		{
+			name:  "allowed role",
+			key:   "moderator",
+			roles: []string{dto.RoleModerator},
+		},
+		{
+			name:   "own advertiser",
+			key:    "advertiser",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"advertiserId": advertiserID.String()},
+		},
+		{
+			name:   "other advertiser",
+			key:    "advertiser",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"advertiserId": otherAdvertiserID.String()},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "advertiser id checked before campaign id",
+			key:    "advertiser",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"advertiserId": otherAdvertiserID.String(), "campaignId": campaignID.String()},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "malformed advertiser id",
+			key:    "advertiser",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"advertiserId": "not-a-uuid"},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "own campaign",
+			key:    "advertiser",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"campaignId": campaignID.String()},
+		},
+		{
+			name:   "other advertiser's campaign",
+			key:    "advertiser",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"campaignId": otherCampaignID.String()},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "unknown campaign",
+			key:    "advertiser",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"campaignId": uuid.NewString()},
+			code:   http.StatusNotFound,
+		},
+		{
+			name:  "advertiser route without ids",
+			key:   "advertiser",
+			roles: []string{dto.RoleAdvertiser},
+			code:  http.StatusForbidden,
+		},
+		{
+			name:   "advertiser key without advertiser",
+			key:    "advertiser-without-id",
+			roles:  []string{dto.RoleAdvertiser},
+			params: map[string]string{"advertiserId": advertiserID.String()},
+			code:   http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := serve(t, m, tt.roles, withKey(tt.key), tt.params)
+			if tt.code == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assertCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	m := NewMiddleware(fakeAuthService{}, false)
+
+	// Без аутентификации проверки ролей и владельца не выполняются
+	err := serve(t, m, []string{dto.RoleAdvertiser}, nil, map[string]string{"advertiserId": otherAdvertiserID.String()})
+	assert.NoError(t, err)
+}
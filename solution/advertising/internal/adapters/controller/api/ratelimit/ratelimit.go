@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"nlypage-final/internal/adapters/config"
+	"nlypage-final/internal/adapters/controller/api/auth"
+	"nlypage-final/internal/adapters/database/redis/buckets"
+	"nlypage-final/pkg/logger"
+)
+
+type bucketStorage interface {
+	Take(ctx context.Context, key string, limit buckets.Limit) (*buckets.Result, error)
+}
+
+type keyLimit struct {
+	key   string
+	limit buckets.Limit
+}
+
+// Middleware ограничивает частоту запросов к маршрутам из конфигурации.
+// Запрос проходит, только если в корзине каждого его ключа есть токен
+type Middleware struct {
+	storage bucketStorage
+	// routes — лимиты по маршруту в виде "METHOD /path" из echo
+	routes map[string][]keyLimit
+}
+
+func NewMiddleware(storage bucketStorage, routes []config.RateLimitRoute) (*Middleware, error) {
+	m := &Middleware{
+		storage: storage,
+		routes:  make(map[string][]keyLimit, len(routes)),
+	}
+
+	for _, route := range routes {
+		method, path, found := strings.Cut(strings.TrimSpace(route.Route), " ")
+		if !found {
+			return nil, fmt.Errorf("rate limit route %q must be \"METHOD /path\"", route.Route)
+		}
+		name := routeName(strings.ToUpper(method), strings.TrimSpace(path))
+
+		keys := make([]string, 0, len(route.Limits))
+		for key := range route.Limits {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			limit := route.Limits[key]
+			switch key {
+			case config.RateLimitKeyClient, config.RateLimitKeyIP, config.RateLimitKeyAPIKey:
+			default:
+				return nil, fmt.Errorf("rate limit route %q: unknown key %q", route.Route, key)
+			}
+			if limit.Rate <= 0 || limit.Burst < 1 {
+				return nil, fmt.Errorf("rate limit route %q: rate and burst must be positive", route.Route)
+			}
+			m.routes[name] = append(m.routes[name], keyLimit{
+				key:   key,
+				limit: buckets.Limit{Rate: limit.Rate, Burst: limit.Burst},
+			})
+		}
+	}
+
+	return m, nil
+}
+
+// Limit возвращает middleware. Должен стоять после аутентификации, чтобы учитывать ключ API
+func (m *Middleware) Limit() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			name := routeName(c.Request().Method, c.Path())
+			limits, ok := m.routes[name]
+			if !ok {
+				return next(c)
+			}
+
+			for _, limit := range limits {
+				value := keyValue(c, limit.key)
+				if value == "" {
+					continue
+				}
+
+				result, err := m.storage.Take(
+					c.Request().Context(),
+					fmt.Sprintf("%s:%s:%s", name, limit.key, value),
+					limit.limit,
+				)
+				if err != nil {
+					// Недоступность Redis не должна останавливать показ рекламы
+					logger.Log.Warnw("rate limit check failed", "route", name, "error", err)
+					return next(c)
+				}
+				if !result.Allowed {
+					seconds := int(math.Ceil(result.RetryAfter.Seconds()))
+					if seconds < 1 {
+						seconds = 1
+					}
+					c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(seconds))
+					return &echo.HTTPError{
+						Message: fmt.Sprintf("too many requests, retry after %s", time.Duration(seconds)*time.Second),
+						Code:    echo.ErrTooManyRequests.Code,
+					}
+				}
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func routeName(method, path string) string {
+	return method + " " + path
+}
+
+func keyValue(c echo.Context, key string) string {
+	switch key {
+	case config.RateLimitKeyIP:
+		return c.RealIP()
+	case config.RateLimitKeyAPIKey:
+		if principal := auth.PrincipalFromContext(c); principal != nil {
+			return principal.Name + ":" + principal.KeyID.String()
+		}
+	case config.RateLimitKeyClient:
+		return clientID(c)
+	}
+	return ""
+}
+
+// clientID берется из query (GET /ads) или из тела запроса (клики и конверсии).
+// Тело возвращается в запрос, чтобы его прочитал обработчик
+func clientID(c echo.Context) string {
+	if id := c.QueryParam("client_id"); id != "" {
+		return normalizeClientID(id)
+	}
+
+	req := c.Request()
+	if req.Body == nil || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return ""
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return ""
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	var payload struct {
+		ClientID string `json:"client_id"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ""
+	}
+	return normalizeClientID(payload.ClientID)
+}
+
+// normalizeClientID отбрасывает значения, которые не являются UUID, чтобы не плодить ключи в Redis.
+// Такие запросы все равно отклонит валидация, а лимит по IP продолжит действовать
+func normalizeClientID(id string) string {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return ""
+	}
+	return parsed.String()
+}
@@ -0,0 +1,162 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"nlypage-final/internal/adapters/config"
+	"nlypage-final/internal/adapters/database/redis/buckets"
+	"nlypage-final/pkg/logger"
+)
+
+// newTestServer собирает echo с лимитами на GET /ads и POST /ads/:adID/click.
+// Обработчик клика возвращает прочитанное тело, чтобы проверить, что middleware его не съел
+func newTestServer(t *testing.T, storage bucketStorage) *echo.Echo {
+	t.Helper()
+
+	m, err := NewMiddleware(storage, []config.RateLimitRoute{
+		{
+			Route:  "GET /ads",
+			Limits: map[string]config.RateLimit{config.RateLimitKeyClient: {Rate: 0.1, Burst: 2}},
+		},
+		{
+			Route:  "post /ads/:adID/click",
+			Limits: map[string]config.RateLimit{config.RateLimitKeyClient: {Rate: 0.1, Burst: 1}},
+		},
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.Use(m.Limit())
+	e.GET("/ads", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	e.POST("/ads/:adID/click", func(c echo.Context) error {
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, string(body))
+	})
+	e.GET("/ping", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	return e
+}
+
+func newRedisStorage(t *testing.T) buckets.Storage {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	s := buckets.NewStorage(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func get(e *echo.Echo, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestLimitDeniesWithRetryAfter(t *testing.T) {
+	e := newTestServer(t, newRedisStorage(t))
+	client := uuid.NewString()
+
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, http.StatusOK, get(e, "/ads?client_id="+client).Code)
+	}
+
+	rec := get(e, "/ads?client_id="+client)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	// Токен появляется раз в 10 секунд
+	assert.Equal(t, "10", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// У другого клиента своя корзина
+	assert.Equal(t, http.StatusOK, get(e, "/ads?client_id="+uuid.NewString()).Code)
+	// Маршрут без лимитов не ограничивается
+	assert.Equal(t, http.StatusOK, get(e, "/ping").Code)
+}
+
+func TestLimitClientFromBody(t *testing.T) {
+	e := newTestServer(t, newRedisStorage(t))
+	body := `{"client_id":"` + uuid.NewString() + `"}`
+
+	click := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/ads/"+uuid.NewString()+"/click", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := click()
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, body, rec.Body.String())
+
+	rec = click()
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+}
+
+func TestLimitSkipsInvalidClientID(t *testing.T) {
+	e := newTestServer(t, newRedisStorage(t))
+
+	// Без ключа ограничивать нечего: такой запрос отклонит валидация
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, get(e, "/ads?client_id=not-a-uuid").Code)
+	}
+}
+
+type failingStorage struct{}
+
+func (failingStorage) Take(context.Context, string, buckets.Limit) (*buckets.Result, error) {
+	return nil, errors.New("redis is down")
+}
+
+func TestLimitFailsOpen(t *testing.T) {
+	logger.Log = &logger.Logger{SugaredLogger: zap.NewNop().Sugar()}
+	e := newTestServer(t, failingStorage{})
+
+	assert.Equal(t, http.StatusOK, get(e, "/ads?client_id="+uuid.NewString()).Code)
+}
+
+func TestNewMiddlewareValidatesRoutes(t *testing.T) {
+	tests := map[string]config.RateLimitRoute{
+		"no method": {
+			Route:  "/ads",
+			Limits: map[string]config.RateLimit{config.RateLimitKeyIP: {Rate: 1, Burst: 1}},
+		},
+		"unknown key": {
+			Route:  "GET /ads",
+			Limits: map[string]config.RateLimit{"user": {Rate: 1, Burst: 1}},
+		},
+		"zero rate": {
+			Route:  "GET /ads",
+			Limits: map[string]config.RateLimit{config.RateLimitKeyIP: {Rate: 0, Burst: 1}},
+		},
+		"zero burst": {
+			Route:  "GET /ads",
+			Limits: map[string]config.RateLimit{config.RateLimitKeyIP: {Rate: 1, Burst: 0}},
+		},
+	}
+
+	for name, route := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewMiddleware(nil, []config.RateLimitRoute{route})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package admin
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	v1 "nlypage-final/internal/adapters/controller/api/v1"
+	"nlypage-final/internal/adapters/controller/api/validator"
+	"nlypage-final/internal/domain/dto"
+)
+
+type authService interface {
+	CreateKey(ctx context.Context, create dto.APIKeyCreate) (*dto.APIKeyCreated, error)
+	ListKeys(ctx context.Context) ([]*dto.APIKey, error)
+	RevokeKey(ctx context.Context, revoke dto.APIKeyRevoke) error
+}
+
+type budgetService interface {
+	TopUp(ctx context.Context, topUp dto.AdvertiserTopUp) (*dto.AdvertiserBalance, error)
+}
+
+type campaignService interface {
+	Purge(ctx context.Context, campaignID uuid.UUID) error
+}
+
+type auditService interface {
+	List(ctx context.Context, get dto.AuditGet) ([]*dto.AuditEntry, error)
+}
+
+type adminHandler struct {
+	service         authService
+	budgetService   budgetService
+	campaignService campaignService
+	auditService    auditService
+	validator       *validator.Validator
+}
+
+func NewAdminHandler(
+	service authService,
+	budgetService budgetService,
+	campaignService campaignService,
+	auditService auditService,
+	validator *validator.Validator,
+) v1.Handler {
+	return &adminHandler{
+		service:         service,
+		budgetService:   budgetService,
+		campaignService: campaignService,
+		auditService:    auditService,
+		validator:       validator,
+	}
+}
+
+func (h adminHandler) createKey(c echo.Context) error {
+	var keyCreate dto.APIKeyCreate
+	if err := c.Bind(&keyCreate); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(keyCreate); err != nil {
+		return err
+	}
+
+	key, err := h.service.CreateKey(c.Request().Context(), keyCreate)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, key)
+}
+
+func (h adminHandler) listKeys(c echo.Context) error {
+	keys, err := h.service.ListKeys(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, keys)
+}
+
+func (h adminHandler) revokeKey(c echo.Context) error {
+	var keyRevoke dto.APIKeyRevoke
+	if err := c.Bind(&keyRevoke); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(keyRevoke); err != nil {
+		return err
+	}
+
+	if err := h.service.RevokeKey(c.Request().Context(), keyRevoke); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (h adminHandler) topUp(c echo.Context) error {
+	var topUp dto.AdvertiserTopUp
+	if err := c.Bind(&topUp); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(topUp); err != nil {
+		return err
+	}
+
+	balance, err := h.budgetService.TopUp(c.Request().Context(), topUp)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, balance)
+}
+
+func (h adminHandler) purgeCampaign(c echo.Context) error {
+	var campaignPurge dto.CampaignPurge
+	if err := c.Bind(&campaignPurge); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(campaignPurge); err != nil {
+		return err
+	}
+
+	if err := h.campaignService.Purge(c.Request().Context(), campaignPurge.CampaignID); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (h adminHandler) audit(c echo.Context) error {
+	var auditGet dto.AuditGet
+	if err := c.Bind(&auditGet); err != nil {
+		return err
+	}
+	if auditGet.Size == 0 {
+		auditGet.Size = 50
+	}
+	if auditGet.Page == 0 {
+		auditGet.Page = 1
+	}
+	if err := h.validator.ValidateData(auditGet); err != nil {
+		return err
+	}
+
+	entries, err := h.auditService.List(c.Request().Context(), auditGet)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, entries)
+}
+
+func (h adminHandler) Setup(group *echo.Group) {
+	group.POST("/api-keys", h.createKey)
+	group.GET("/api-keys", h.listKeys)
+	group.DELETE("/api-keys/:keyId", h.revokeKey)
+	group.POST("/advertisers/:advertiserId/top-up", h.topUp)
+	group.DELETE("/campaigns/:campaignId", h.purgeCampaign)
+	group.GET("/audit", h.audit)
+}
@@ -11,6 +11,7 @@ import (
 type adService interface {
 	SelectAd(ctx context.Context, clientID dto.ClientAdGet) (*dto.Ad, error)
 	RecordClick(ctx context.Context, click dto.ClientAdClick) error
+	RecordConversion(ctx context.Context, conversion dto.ClientAdConversion) error
 }
 
 type adsHandler struct {
@@ -47,6 +48,7 @@ func (a adsHandler) clickAd(c echo.Context) error {
 	if err := c.Bind(&request); err != nil {
 		return err
 	}
+	request.IP = c.RealIP()
 	if err := a.validator.ValidateData(request); err != nil {
 		return err
 	}
@@ -58,7 +60,24 @@ func (a adsHandler) clickAd(c echo.Context) error {
 	return c.NoContent(204)
 }
 
+func (a adsHandler) conversionAd(c echo.Context) error {
+	var request dto.ClientAdConversion
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+	if err := a.validator.ValidateData(request); err != nil {
+		return err
+	}
+
+	if err := a.adService.RecordConversion(c.Request().Context(), request); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
 func (a adsHandler) Setup(group *echo.Group) {
 	group.GET("", a.getAd)
 	group.POST("/:adID/click", a.clickAd)
+	group.POST("/:adID/conversion", a.conversionAd)
 }
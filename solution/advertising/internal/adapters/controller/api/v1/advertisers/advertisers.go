@@ -14,14 +14,20 @@ type advertiserService interface {
 	UpsertBulk(ctx context.Context, upsertAdvertisers []dto.AdvertiserUpsert) error
 }
 
+type budgetService interface {
+	Balance(ctx context.Context, advertiserID uuid.UUID) (*dto.AdvertiserBalance, error)
+}
+
 type advertisersHandler struct {
 	advertiserService advertiserService
+	budgetService     budgetService
 	validator         *validator.Validator
 }
 
-func NewAdvertisersHandler(advertiserService advertiserService, validator *validator.Validator) v1.Handler {
+func NewAdvertisersHandler(advertiserService advertiserService, budgetService budgetService, validator *validator.Validator) v1.Handler {
 	return &advertisersHandler{
 		advertiserService: advertiserService,
+		budgetService:     budgetService,
 		validator:         validator,
 	}
 }
@@ -65,7 +71,26 @@ func (h advertisersHandler) GetByID(c echo.Context) error {
 	return c.JSON(200, client)
 }
 
+func (h advertisersHandler) balance(c echo.Context) error {
+	var advertiserID dto.AdvertiserGet
+	if err := c.Bind(&advertiserID); err != nil {
+		return err
+	}
+
+	if err := h.validator.ValidateData(advertiserID); err != nil {
+		return err
+	}
+
+	balance, err := h.budgetService.Balance(c.Request().Context(), advertiserID.AdvertiserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, balance)
+}
+
 func (h advertisersHandler) Setup(group *echo.Group) {
 	group.GET("/:advertiserId", h.GetByID)
+	group.GET("/:advertiserId/balance", h.balance)
 	group.POST("/bulk", h.upsertBulk)
 }
@@ -2,14 +2,34 @@ package ai
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/labstack/echo/v4"
 	v1 "nlypage-final/internal/adapters/controller/api/v1"
 	"nlypage-final/internal/adapters/controller/api/validator"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/llm"
+	"nlypage-final/pkg/sse"
 )
 
 type generateService interface {
 	GenerateAdText(ctx context.Context, generateAdText *dto.GenerateAdTextRequest) (*dto.GenerateAdTextResponse, error)
+	StreamAdText(ctx context.Context, generateAdText *dto.GenerateAdTextRequest, onDelta llm.DeltaFunc) (*dto.GenerateAdTextResponse, error)
+	GenerateCreative(ctx context.Context, generateCreative *dto.GenerateCreativeRequest) (*dto.GenerateCreativeResponse, error)
+}
+
+// События потока генерации
+const (
+	streamEventDelta = "delta"
+	streamEventDone  = "done"
+	streamEventError = "error"
+)
+
+type streamDelta struct {
+	Text string `json:"text"`
 }
 
 type aiHandler struct {
@@ -34,6 +54,10 @@ func (h aiHandler) generateAdText(c echo.Context) error {
 		return err
 	}
 
+	if wantsStream(c) {
+		return h.streamAdText(c, &generateAdTextRequest)
+	}
+
 	generateAdTextResponse, err := h.generateService.GenerateAdText(c.Request().Context(), &generateAdTextRequest)
 	if err != nil {
 		return err
@@ -42,6 +66,72 @@ func (h aiHandler) generateAdText(c echo.Context) error {
 	return c.JSON(200, generateAdTextResponse)
 }
 
+// streamAdText отправляет текст событиями SSE: delta с очередной частью текста и done с полным ответом.
+// Ошибка до первой части возвращается обычным ответом, после — событием error
+func (h aiHandler) streamAdText(c echo.Context, generateAdTextRequest *dto.GenerateAdTextRequest) error {
+	if generateAdTextRequest.N > 1 {
+		return &echo.HTTPError{
+			Message: "streaming supports a single variant",
+			Code:    echo.ErrBadRequest.Code,
+		}
+	}
+
+	var writer *sse.Writer
+	generateAdTextResponse, err := h.generateService.StreamAdText(
+		c.Request().Context(),
+		generateAdTextRequest,
+		func(delta string) error {
+			if writer == nil {
+				writer = sse.NewWriter(c.Response())
+			}
+			return writer.Send(streamEventDelta, streamDelta{Text: delta})
+		},
+	)
+	if err != nil {
+		if writer == nil {
+			return err
+		}
+		message := err.Error()
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			message = fmt.Sprint(httpErr.Message)
+		}
+		return writer.Send(streamEventError, map[string]string{"message": message})
+	}
+
+	if writer == nil {
+		writer = sse.NewWriter(c.Response())
+	}
+	return writer.Send(streamEventDone, generateAdTextResponse)
+}
+
+func (h aiHandler) generateCreative(c echo.Context) error {
+	var generateCreativeRequest dto.GenerateCreativeRequest
+	if err := c.Bind(&generateCreativeRequest); err != nil {
+		return err
+	}
+
+	if err := h.validator.ValidateData(&generateCreativeRequest); err != nil {
+		return err
+	}
+
+	generateCreativeResponse, err := h.generateService.GenerateCreative(c.Request().Context(), &generateCreativeRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, generateCreativeResponse)
+}
+
+// wantsStream определяет, запрошен ли ответ потоком: параметром stream или заголовком Accept
+func wantsStream(c echo.Context) bool {
+	if stream, err := strconv.ParseBool(c.QueryParam("stream")); err == nil {
+		return stream
+	}
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), sse.ContentType)
+}
+
 func (h aiHandler) Setup(group *echo.Group) {
 	group.POST("/advertisers/:advertiserId/generate/ad-text", h.generateAdText)
+	group.POST("/advertisers/:advertiserId/generate/creative", h.generateCreative)
 }
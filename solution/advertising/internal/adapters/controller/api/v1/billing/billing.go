@@ -0,0 +1,62 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	v1 "nlypage-final/internal/adapters/controller/api/v1"
+	"nlypage-final/internal/adapters/controller/api/validator"
+	"nlypage-final/internal/domain/dto"
+)
+
+const formatPDF = "pdf"
+
+type billingService interface {
+	Invoice(ctx context.Context, get dto.InvoiceGet) (*dto.Invoice, error)
+}
+
+type billingHandler struct {
+	billingService billingService
+	validator      *validator.Validator
+}
+
+func NewBillingHandler(billingService billingService, validator *validator.Validator) v1.Handler {
+	return &billingHandler{
+		billingService: billingService,
+		validator:      validator,
+	}
+}
+
+func (h billingHandler) invoice(c echo.Context) error {
+	var invoiceGet dto.InvoiceGet
+	if err := c.Bind(&invoiceGet); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(invoiceGet); err != nil {
+		return err
+	}
+
+	invoice, err := h.billingService.Invoice(c.Request().Context(), invoiceGet)
+	if err != nil {
+		return err
+	}
+
+	if invoiceGet.Format != formatPDF {
+		return c.JSON(200, invoice)
+	}
+
+	var buf bytes.Buffer
+	if _, err := renderInvoicePDF(invoice).WriteTo(&buf); err != nil {
+		return err
+	}
+	filename := fmt.Sprintf("invoice-%s-%d-%d.pdf", invoice.AdvertiserID, invoice.From, invoice.To)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	return c.Blob(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+func (h billingHandler) Setup(group *echo.Group) {
+	group.GET("/advertisers/:advertiserId/invoice", h.invoice)
+}
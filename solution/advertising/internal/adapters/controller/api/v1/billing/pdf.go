@@ -0,0 +1,57 @@
+package billing
+
+import (
+	"strings"
+
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/pdf"
+)
+
+const (
+	invoiceFontSize = 7
+	// invoiceTitleWidth — ширина колонки с заголовком кампании в символах, длинные заголовки обрезаются
+	invoiceTitleWidth = 30
+	// invoiceRuleWidth — ширина таблицы в символах моноширинного шрифта
+	invoiceRuleWidth = 111
+)
+
+// renderInvoicePDF набирает счет таблицей с теми же строками, что и JSON-версия:
+// заголовок кампании, под ним ее идентификатор, количество и стоимость событий и итог по строке
+func renderInvoicePDF(invoice *dto.Invoice) *pdf.Document {
+	doc := pdf.NewDocument(invoiceFontSize)
+	rule := strings.Repeat("-", invoiceRuleWidth)
+
+	doc.Line("СЧЕТ")
+	doc.Line("")
+	doc.Linef("Рекламодатель: %s", invoice.AdvertiserName)
+	doc.Linef("ID:            %s", invoice.AdvertiserID)
+	doc.Linef("Дни:           %d - %d", invoice.From, invoice.To)
+	doc.Line("")
+	doc.Linef("%-30s %10s %12s %8s %12s %8s %12s %12s",
+		"Кампания", "Показы", "Сумма", "Клики", "Сумма", "Конв.", "Сумма", "Итого",
+	)
+	doc.Line(rule)
+	for _, line := range invoice.Lines {
+		doc.Linef("%-30s %10d %12.2f %8d %12.2f %8d %12.2f %12.2f",
+			truncate(line.CampaignTitle, invoiceTitleWidth),
+			line.Impressions, line.ImpressionsAmount,
+			line.Clicks, line.ClicksAmount,
+			line.Conversions, line.ConversionsAmount,
+			line.Total,
+		)
+		doc.Linef("  %s", line.CampaignID)
+	}
+	doc.Line(rule)
+	doc.Linef("%-30s %80.2f", "Итого", invoice.Total)
+
+	return doc
+}
+
+// truncate обрезает строку до width символов. Ширина считается в рунах: шрифт моноширинный
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
@@ -18,6 +18,9 @@ type campaignService interface {
 	Update(ctx context.Context, campaignUpdate *dto.CampaignUpdate) (*dto.Campaign, error)
 	UploadImage(ctx context.Context, uploadImageRequest *dto.CampaignUploadImageRequest, imageData io.Reader) (*dto.CampaignImageURL, error)
 	RemoveImage(ctx context.Context, removeImageRequest *dto.CampaignRemoveImageRequest) error
+	Resubmit(ctx context.Context, resubmit *dto.CampaignResubmit) (*dto.Campaign, error)
+	Pause(ctx context.Context, pause *dto.CampaignPause) (*dto.Campaign, error)
+	Resume(ctx context.Context, resume *dto.CampaignPause) (*dto.Campaign, error)
 }
 
 type campaignsHandler struct {
@@ -191,6 +194,57 @@ func (h campaignsHandler) removeImage(c echo.Context) error {
 	return c.NoContent(204)
 }
 
+func (h campaignsHandler) resubmit(c echo.Context) error {
+	var resubmit dto.CampaignResubmit
+	if err := c.Bind(&resubmit); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(resubmit); err != nil {
+		return err
+	}
+
+	campaign, err := h.service.Resubmit(c.Request().Context(), &resubmit)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, campaign)
+}
+
+func (h campaignsHandler) pause(c echo.Context) error {
+	var pause dto.CampaignPause
+	if err := c.Bind(&pause); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(pause); err != nil {
+		return err
+	}
+
+	campaign, err := h.service.Pause(c.Request().Context(), &pause)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, campaign)
+}
+
+func (h campaignsHandler) resume(c echo.Context) error {
+	var resume dto.CampaignPause
+	if err := c.Bind(&resume); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(resume); err != nil {
+		return err
+	}
+
+	campaign, err := h.service.Resume(c.Request().Context(), &resume)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, campaign)
+}
+
 func (h campaignsHandler) Setup(group *echo.Group) {
 	group.POST("/:advertiserId/campaigns", h.create)
 	group.GET("/:advertiserId/campaigns", h.get)
@@ -199,4 +253,7 @@ func (h campaignsHandler) Setup(group *echo.Group) {
 	group.PUT("/:advertiserId/campaigns/:campaignId", h.update)
 	group.POST("/:advertiserId/campaigns/:campaignId/image", h.uploadImage)
 	group.DELETE("/:advertiserId/campaigns/:campaignId/image", h.removeImage)
+	group.POST("/:advertiserId/campaigns/:campaignId/resubmit", h.resubmit)
+	group.POST("/:advertiserId/campaigns/:campaignId/pause", h.pause)
+	group.POST("/:advertiserId/campaigns/:campaignId/resume", h.resume)
 }
@@ -12,6 +12,8 @@ import (
 type clientService interface {
 	UpsertBulk(ctx context.Context, upsertClients []dto.ClientUpsert) error
 	GetByID(ctx context.Context, clientID uuid.UUID) (*dto.Client, error)
+	Delete(ctx context.Context, clientID uuid.UUID) (*dto.ClientErasure, error)
+	Export(ctx context.Context, clientID uuid.UUID) (*dto.ClientExport, error)
 }
 
 type clientsHandler struct {
@@ -65,7 +67,45 @@ func (h clientsHandler) GetByID(c echo.Context) error {
 	return c.JSON(200, client)
 }
 
+func (h clientsHandler) delete(c echo.Context) error {
+	var clientID dto.ClientGet
+	if err := c.Bind(&clientID); err != nil {
+		return err
+	}
+
+	if err := h.validator.ValidateData(clientID); err != nil {
+		return err
+	}
+
+	erasure, err := h.clientService.Delete(c.Request().Context(), clientID.ClientID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, erasure)
+}
+
+func (h clientsHandler) export(c echo.Context) error {
+	var clientID dto.ClientGet
+	if err := c.Bind(&clientID); err != nil {
+		return err
+	}
+
+	if err := h.validator.ValidateData(clientID); err != nil {
+		return err
+	}
+
+	export, err := h.clientService.Export(c.Request().Context(), clientID.ClientID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, export)
+}
+
 func (h clientsHandler) Setup(group *echo.Group) {
 	group.GET("/:clientId", h.GetByID)
+	group.DELETE("/:clientId", h.delete)
+	group.GET("/:clientId/export", h.export)
 	group.POST("/bulk", h.upsertBulk)
 }
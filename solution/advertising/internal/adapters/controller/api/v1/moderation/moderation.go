@@ -10,8 +10,12 @@ import (
 )
 
 type moderationService interface {
-	GetNotModeratedCampaigns(ctx context.Context) ([]*dto.Campaign, error)
-	ApproveCampaign(ctx context.Context, campaignID uuid.UUID) error
+	GetNotModeratedCampaigns(ctx context.Context, filter dto.ModerationCampaignsGet) ([]*dto.ModerationCampaign, error)
+	ClaimCampaign(ctx context.Context, claim dto.CampaignClaim) (*dto.ModerationLease, error)
+	ReleaseCampaign(ctx context.Context, release dto.CampaignRelease) error
+	ApproveCampaign(ctx context.Context, approve dto.CampaignApprove) error
+	RejectCampaign(ctx context.Context, reject dto.CampaignReject) error
+	History(ctx context.Context, campaignID uuid.UUID) ([]*dto.ModerationDecision, error)
 }
 
 type moderationHandler struct {
@@ -27,34 +31,165 @@ func NewModerationHandler(service moderationService, validator *validator.Valida
 }
 
 func (h moderationHandler) list(c echo.Context) error {
-	campaigns, err := h.service.GetNotModeratedCampaigns(c.Request().Context())
-	if err != nil {
+	var campaignsGet dto.ModerationCampaignsGet
+	if err := c.Bind(&campaignsGet); err != nil {
+		return err
+	}
+	if campaignsGet.Size == 0 {
+		campaignsGet.Size = 10
+	}
+	if campaignsGet.Page == 0 {
+		campaignsGet.Page = 1
+	}
+	if err := h.validator.ValidateData(campaignsGet); err != nil {
 		return err
 	}
 
-	if len(campaigns) == 0 {
-		return c.JSON(200, []dto.Campaign{})
+	campaigns, err := h.service.GetNotModeratedCampaigns(c.Request().Context(), campaignsGet)
+	if err != nil {
+		return err
 	}
+
 	return c.JSON(200, campaigns)
 }
 
+func (h moderationHandler) claim(c echo.Context) error {
+	var campaignClaim dto.CampaignClaim
+	if err := c.Bind(&campaignClaim); err != nil {
+		return err
+	}
+	moderator, err := authenticatedModerator(c, campaignClaim.Moderator)
+	if err != nil {
+		return err
+	}
+	campaignClaim.Moderator = moderator
+	if err := h.validator.ValidateData(campaignClaim); err != nil {
+		return err
+	}
+
+	lease, err := h.service.ClaimCampaign(c.Request().Context(), campaignClaim)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, lease)
+}
+
+func (h moderationHandler) release(c echo.Context) error {
+	var campaignRelease dto.CampaignRelease
+	if err := c.Bind(&campaignRelease); err != nil {
+		return err
+	}
+	moderator, err := authenticatedModerator(c, campaignRelease.Moderator)
+	if err != nil {
+		return err
+	}
+	campaignRelease.Moderator = moderator
+	if err := h.validator.ValidateData(campaignRelease); err != nil {
+		return err
+	}
+
+	if err := h.service.ReleaseCampaign(c.Request().Context(), campaignRelease); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
 func (h moderationHandler) approve(c echo.Context) error {
 	var campaignApprove dto.CampaignApprove
 	if err := c.Bind(&campaignApprove); err != nil {
 		return err
 	}
+	if err := moderatorFromKey(c, &campaignApprove.Moderator); err != nil {
+		return err
+	}
 	if err := h.validator.ValidateData(campaignApprove); err != nil {
 		return err
 	}
 
-	if err := h.service.ApproveCampaign(c.Request().Context(), campaignApprove.CampaignID); err != nil {
+	if err := h.service.ApproveCampaign(c.Request().Context(), campaignApprove); err != nil {
 		return err
 	}
 
 	return c.NoContent(204)
 }
 
+func (h moderationHandler) reject(c echo.Context) error {
+	var campaignReject dto.CampaignReject
+	if err := c.Bind(&campaignReject); err != nil {
+		return err
+	}
+	if err := moderatorFromKey(c, &campaignReject.Moderator); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(campaignReject); err != nil {
+		return err
+	}
+
+	if err := h.service.RejectCampaign(c.Request().Context(), campaignReject); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (h moderationHandler) history(c echo.Context) error {
+	var historyGet dto.CampaignModerationHistoryGet
+	if err := c.Bind(&historyGet); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(historyGet); err != nil {
+		return err
+	}
+
+	decisions, err := h.service.History(c.Request().Context(), historyGet.CampaignID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, decisions)
+}
+
+// authenticatedModerator возвращает имя модератора. При включенной аутентификации это имя ключа API:
+// модератор не может действовать от чужого имени, поэтому другое имя в теле запроса отклоняется.
+// Без аутентификации имя берется из тела запроса
+func authenticatedModerator(c echo.Context, requested string) (string, error) {
+	principal := dto.PrincipalFromContext(c.Request().Context())
+	if principal == nil {
+		return requested, nil
+	}
+	if requested != "" && requested != principal.Name {
+		return "", &echo.HTTPError{
+			Message: "moderator does not match the API key",
+			Code:    echo.ErrForbidden.Code,
+		}
+	}
+	return principal.Name, nil
+}
+
+// moderatorFromKey — authenticatedModerator для необязательного имени модератора
+func moderatorFromKey(c echo.Context, moderator **string) error {
+	var requested string
+	if *moderator != nil {
+		requested = **moderator
+	}
+
+	name, err := authenticatedModerator(c, requested)
+	if err != nil {
+		return err
+	}
+	if name != "" {
+		*moderator = &name
+	}
+	return nil
+}
+
 func (h moderationHandler) Setup(group *echo.Group) {
 	group.GET("/campaigns", h.list)
+	group.POST("/campaigns/:campaignId/claim", h.claim)
+	group.POST("/campaigns/:campaignId/release", h.release)
 	group.POST("/campaigns/:campaignId/approve", h.approve)
+	group.POST("/campaigns/:campaignId/reject", h.reject)
+	group.GET("/campaigns/:campaignId/history", h.history)
 }
@@ -0,0 +1,169 @@
+package stats
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/parquet-go/parquet-go"
+	"nlypage-final/internal/domain/dto"
+)
+
+const (
+	formatCSV     = "csv"
+	formatParquet = "parquet"
+
+	// parquetRowGroupSize ограничивает количество строк, которые parquet-писатель держит в памяти
+	parquetRowGroupSize = 1024
+)
+
+// statsRowWriter кодирует строки ежедневной статистики в формат выгрузки
+type statsRowWriter interface {
+	Write(stat *dto.StatsDaily) error
+	// Close дописывает оставшиеся данные (заголовок пустого CSV, футер parquet)
+	Close() error
+}
+
+func newStatsRowWriter(format string, w io.Writer) statsRowWriter {
+	if format == formatParquet {
+		return &parquetStatsWriter{
+			writer: parquet.NewGenericWriter[parquetStatsRow](w, parquet.MaxRowsPerRowGroup(parquetRowGroupSize)),
+		}
+	}
+	return &csvStatsWriter{writer: csv.NewWriter(w)}
+}
+
+var csvHeader = []string{
+	"date",
+	"impressions_count",
+	"clicks_count",
+	"conversion",
+	"conversions_count",
+	"conversions_value",
+	"cvr",
+	"cpa",
+	"roas",
+	"spent_impressions",
+	"spent_clicks",
+	"spent_actions",
+	"spent_total",
+}
+
+type csvStatsWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvStatsWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.writer.Write(csvHeader)
+}
+
+func (w *csvStatsWriter) Write(stat *dto.StatsDaily) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return w.writer.Write([]string{
+		strconv.Itoa(stat.Date),
+		strconv.Itoa(stat.ImpressionsCount),
+		strconv.Itoa(stat.ClicksCount),
+		formatFloat(stat.Conversion),
+		strconv.Itoa(stat.ConversionsCount),
+		formatFloat(stat.ConversionsValue),
+		formatFloat(stat.CVR),
+		formatFloat(stat.CPA),
+		formatFloat(stat.ROAS),
+		formatFloat(stat.SpentImpressions),
+		formatFloat(stat.SpentClicks),
+		formatFloat(stat.SpentActions),
+		formatFloat(stat.SpentTotal),
+	})
+}
+
+func (w *csvStatsWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// parquetStatsRow — строка parquet-файла, поля совпадают с dto.StatsDaily
+type parquetStatsRow struct {
+	Date             int32   `parquet:"date"`
+	ImpressionsCount int64   `parquet:"impressions_count"`
+	ClicksCount      int64   `parquet:"clicks_count"`
+	Conversion       float64 `parquet:"conversion"`
+	ConversionsCount int64   `parquet:"conversions_count"`
+	ConversionsValue float64 `parquet:"conversions_value"`
+	CVR              float64 `parquet:"cvr"`
+	CPA              float64 `parquet:"cpa"`
+	ROAS             float64 `parquet:"roas"`
+	SpentImpressions float64 `parquet:"spent_impressions"`
+	SpentClicks      float64 `parquet:"spent_clicks"`
+	SpentActions     float64 `parquet:"spent_actions"`
+	SpentTotal       float64 `parquet:"spent_total"`
+}
+
+type parquetStatsWriter struct {
+	writer *parquet.GenericWriter[parquetStatsRow]
+}
+
+func (w *parquetStatsWriter) Write(stat *dto.StatsDaily) error {
+	_, err := w.writer.Write([]parquetStatsRow{{
+		Date:             int32(stat.Date),
+		ImpressionsCount: int64(stat.ImpressionsCount),
+		ClicksCount:      int64(stat.ClicksCount),
+		Conversion:       stat.Conversion,
+		ConversionsCount: int64(stat.ConversionsCount),
+		ConversionsValue: stat.ConversionsValue,
+		CVR:              stat.CVR,
+		CPA:              stat.CPA,
+		ROAS:             stat.ROAS,
+		SpentImpressions: stat.SpentImpressions,
+		SpentClicks:      stat.SpentClicks,
+		SpentActions:     stat.SpentActions,
+		SpentTotal:       stat.SpentTotal,
+	}})
+	return err
+}
+
+func (w *parquetStatsWriter) Close() error {
+	return w.writer.Close()
+}
+
+// exportResponse откладывает отправку заголовков ответа до первой записи,
+// чтобы ошибки, возникшие до начала выгрузки, вернулись клиенту обычным ответом с ошибкой
+type exportResponse struct {
+	response *echo.Response
+	format   string
+	filename string
+}
+
+func (r *exportResponse) Write(p []byte) (int, error) {
+	if !r.response.Committed {
+		contentType := "text/csv; charset=utf-8"
+		if r.format == formatParquet {
+			contentType = "application/vnd.apache.parquet"
+		}
+		r.response.Header().Set(echo.HeaderContentType, contentType)
+		r.response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.%s"`, r.filename, r.format))
+		r.response.WriteHeader(http.StatusOK)
+	}
+
+	n, err := r.response.Write(p)
+	if err == nil {
+		r.response.Flush()
+	}
+	return n, err
+}
@@ -14,6 +14,10 @@ type statsService interface {
 	CampaignDaily(ctx context.Context, campaignID uuid.UUID) ([]*dto.StatsDaily, error)
 	Advertiser(ctx context.Context, advertiserID uuid.UUID) (*dto.Stats, error)
 	AdvertiserDaily(ctx context.Context, advertiserID uuid.UUID) ([]*dto.StatsDaily, error)
+	ExportCampaignDaily(ctx context.Context, campaignID uuid.UUID, export dto.StatsExport, fn func(*dto.StatsDaily) error) error
+	ExportAdvertiserDaily(ctx context.Context, advertiserID uuid.UUID, export dto.StatsExport, fn func(*dto.StatsDaily) error) error
+	CampaignInvalidTraffic(ctx context.Context, campaignID uuid.UUID) (*dto.InvalidTrafficReport, error)
+	AdvertiserInvalidTraffic(ctx context.Context, advertiserID uuid.UUID) (*dto.InvalidTrafficReport, error)
 }
 
 type statsHandler struct {
@@ -104,9 +108,98 @@ func (h statsHandler) advertiserDaily(c echo.Context) error {
 	return c.JSON(200, stats)
 }
 
+func (h statsHandler) campaignExport(c echo.Context) error {
+	var campaignStatsExport dto.CampaignStatsExport
+	if err := c.Bind(&campaignStatsExport); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(campaignStatsExport); err != nil {
+		return err
+	}
+
+	return h.export(c, campaignStatsExport.StatsExport, "campaign-"+campaignStatsExport.CampaignID.String(),
+		func(fn func(*dto.StatsDaily) error) error {
+			return h.statsService.ExportCampaignDaily(c.Request().Context(), campaignStatsExport.CampaignID, campaignStatsExport.StatsExport, fn)
+		},
+	)
+}
+
+func (h statsHandler) advertiserExport(c echo.Context) error {
+	var advertiserStatsExport dto.AdvertiserStatsExport
+	if err := c.Bind(&advertiserStatsExport); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(advertiserStatsExport); err != nil {
+		return err
+	}
+
+	return h.export(c, advertiserStatsExport.StatsExport, "advertiser-"+advertiserStatsExport.AdvertiserID.String(),
+		func(fn func(*dto.StatsDaily) error) error {
+			return h.statsService.ExportAdvertiserDaily(c.Request().Context(), advertiserStatsExport.AdvertiserID, advertiserStatsExport.StatsExport, fn)
+		},
+	)
+}
+
+func (h statsHandler) campaignInvalidTraffic(c echo.Context) error {
+	var campaignStatsGet dto.CampaignStatsGet
+	if err := c.Bind(&campaignStatsGet); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(campaignStatsGet); err != nil {
+		return err
+	}
+
+	report, err := h.statsService.CampaignInvalidTraffic(c.Request().Context(), campaignStatsGet.CampaignID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, report)
+}
+
+func (h statsHandler) advertiserInvalidTraffic(c echo.Context) error {
+	var advertiserStatsGet dto.AdvertiserStatsGet
+	if err := c.Bind(&advertiserStatsGet); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateData(advertiserStatsGet); err != nil {
+		return err
+	}
+
+	report, err := h.statsService.AdvertiserInvalidTraffic(c.Request().Context(), advertiserStatsGet.AdvertiserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, report)
+}
+
+// export пишет строки, полученные из stream, в ответ в выбранном формате по мере их чтения
+func (h statsHandler) export(c echo.Context, export dto.StatsExport, filename string, stream func(fn func(*dto.StatsDaily) error) error) error {
+	format := export.Format
+	if format == "" {
+		format = formatCSV
+	}
+
+	writer := newStatsRowWriter(format, &exportResponse{
+		response: c.Response(),
+		format:   format,
+		filename: filename,
+	})
+	if err := stream(writer.Write); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
 func (h statsHandler) Setup(group *echo.Group) {
 	group.GET("/campaigns/:campaignId", h.campaign)
 	group.GET("/campaigns/:campaignId/daily", h.campaignDaily)
 	group.GET("/advertisers/:advertiserId/campaigns", h.advertiser)
 	group.GET("/advertisers/:advertiserId/campaigns/daily", h.advertiserDaily)
+	group.GET("/campaigns/:campaignId/daily/export", h.campaignExport)
+	group.GET("/advertisers/:advertiserId/campaigns/daily/export", h.advertiserExport)
+	group.GET("/campaigns/:campaignId/invalid-traffic", h.campaignInvalidTraffic)
+	group.GET("/advertisers/:advertiserId/invalid-traffic", h.advertiserInvalidTraffic)
 }
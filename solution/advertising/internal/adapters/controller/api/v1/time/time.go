@@ -10,7 +10,7 @@ import (
 
 type timeService interface {
 	Now() *dto.CurrentDate
-	Set(date int) (*dto.CurrentDate, error)
+	Set(ctx context.Context, date int) (*dto.CurrentDate, error)
 }
 
 type timeAdScoringService interface {
@@ -41,7 +41,7 @@ func (h timeHandler) advance(c echo.Context) error {
 		return err
 	}
 
-	date, err := h.timeService.Set(t.CurrentDate)
+	date, err := h.timeService.Set(c.Request().Context(), t.CurrentDate)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,71 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+
+	tele "gopkg.in/telebot.v3"
+	"gopkg.in/telebot.v3/layout"
+)
+
+// DefaultLocale — язык текстов бота, пока пользователи не выбирают его сами
+const DefaultLocale = "ru"
+
+// accountKey — ключ привязанного аккаунта в контексте бота
+const accountKey = "account"
+
+type accountService interface {
+	Account(ctx context.Context, telegramID int64) (*dto.TelegramAccount, error)
+}
+
+// AccountMiddleware пропускает только пользователей с привязанным аккаунтом рекламодателя.
+// Остальным бот предлагает привязать аккаунт
+func AccountMiddleware(lt *layout.Layout, accounts accountService) tele.MiddlewareFunc {
+	return func(next tele.HandlerFunc) tele.HandlerFunc {
+		return func(c tele.Context) error {
+			account, err := accounts.Account(context.Background(), c.Sender().ID)
+			if err != nil {
+				if errors.Is(err, errorz.ErrNotFound) {
+					return Show(c, lt.Text(c, "link_required"), lt.Markup(c, "link"))
+				}
+				return err
+			}
+
+			c.Set(accountKey, account)
+			return next(c)
+		}
+	}
+}
+
+// Account возвращает аккаунт, загруженный AccountMiddleware
+func Account(c tele.Context) *dto.TelegramAccount {
+	account, _ := c.Get(accountKey).(*dto.TelegramAccount)
+	return account
+}
+
+// Context возвращает контекст для вызова сервисов от имени рекламодателя,
+// чтобы операции из бота попадали в журнал аудита с его ключом
+func Context(c tele.Context) context.Context {
+	ctx := context.Background()
+	if account := Account(c); account != nil {
+		ctx = dto.ContextWithPrincipal(ctx, account.Principal())
+	}
+	return ctx
+}
+
+// Show редактирует сообщение с нажатой кнопкой или отправляет новое, если обработчик вызван командой
+func Show(c tele.Context, text string, markup *tele.ReplyMarkup) error {
+	if c.Callback() == nil {
+		return c.Send(text, markup)
+	}
+
+	_ = c.Respond()
+	err := c.Edit(text, markup)
+	if errors.Is(err, tele.ErrSameMessageContent) || errors.Is(err, tele.ErrMessageNotModified) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,125 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"nlypage-final/internal/adapters/controller/telegram"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+
+	tele "gopkg.in/telebot.v3"
+	"gopkg.in/telebot.v3/layout"
+)
+
+// imageTimeout ограничивает загрузку изображения кампании для уведомления модераторов
+const imageTimeout = 10 * time.Second
+
+type chatService interface {
+	ChatIDs(ctx context.Context, advertiserID uuid.UUID) ([]int64, error)
+}
+
+// Notifier отправляет уведомления рекламодателям в привязанные Telegram-чаты и модераторам в их общий чат
+type Notifier struct {
+	bot    *tele.Bot
+	layout *layout.Layout
+	chats  chatService
+	// moderatorsChatID — чат модераторов; 0, если уведомления модераторов отключены
+	moderatorsChatID int64
+	httpClient       *http.Client
+	logger           *logger.Logger
+}
+
+func New(bot *tele.Bot, lt *layout.Layout, chats chatService, moderatorsChatID int64, logger *logger.Logger) *Notifier {
+	return &Notifier{
+		bot:              bot,
+		layout:           lt,
+		chats:            chats,
+		moderatorsChatID: moderatorsChatID,
+		httpClient:       &http.Client{Timeout: imageTimeout},
+		logger:           logger,
+	}
+}
+
+// CampaignModerated сообщает о решении модератора по кампании. Отправка идет в фоне,
+// чтобы ответ API модератору не зависел от Telegram
+func (n *Notifier) CampaignModerated(ctx context.Context, campaign *dto.Campaign) {
+	text := "alert_campaign_approved"
+	if campaign.ModerationStatus == dto.ModerationStatusRejected {
+		text = "alert_campaign_rejected"
+	}
+	// Шаблону нужен код причины строкой, а не указателем
+	view := struct {
+		*dto.Campaign
+		Reason string
+	}{Campaign: campaign}
+	if campaign.RejectionReason != nil {
+		view.Reason = *campaign.RejectionReason
+	}
+
+	go n.send(context.WithoutCancel(ctx), campaign.AdvertiserID, n.layout.TextLocale(telegram.DefaultLocale, text, view), campaign)
+}
+
+func (n *Notifier) send(ctx context.Context, advertiserID uuid.UUID, text string, campaign *dto.Campaign) {
+	chatIDs, err := n.chats.ChatIDs(ctx, advertiserID)
+	if err != nil {
+		n.logger.Errorf("failed to get chats of advertiser %s: %v", advertiserID, err)
+		return
+	}
+
+	btn := n.layout.ButtonLocale(telegram.DefaultLocale, "campaigns:open", campaign)
+	btn.Text = n.layout.TextLocale(telegram.DefaultLocale, "open_campaign")
+	markup := &tele.ReplyMarkup{}
+	markup.Inline(markup.Row(*btn))
+
+	for _, chatID := range chatIDs {
+		if _, err := n.bot.Send(tele.ChatID(chatID), text, markup); err != nil {
+			n.logger.Warnf("failed to send alert to chat %d: %v", chatID, err)
+		}
+	}
+}
+
+// CampaignPending отправляет в чат модераторов объявление, которое ждет проверки, с кнопками решения
+func (n *Notifier) CampaignPending(_ context.Context, campaign *dto.Campaign) {
+	if n.moderatorsChatID == 0 {
+		return
+	}
+
+	go n.sendPending(campaign)
+}
+
+func (n *Notifier) sendPending(campaign *dto.Campaign) {
+	chat := tele.ChatID(n.moderatorsChatID)
+	markup := n.layout.MarkupLocale(telegram.DefaultLocale, "moderation", campaign)
+
+	var replyTo *tele.Message
+	if campaign.ImageURL != "" {
+		photo, err := n.sendImage(chat, campaign.ImageURL)
+		if err != nil {
+			n.logger.Warnf("failed to send image of campaign %s to moderators: %v", campaign.CampaignID, err)
+		}
+		replyTo = photo
+	}
+
+	text := n.layout.TextLocale(telegram.DefaultLocale, "moderation_campaign", campaign)
+	if _, err := n.bot.Send(chat, text, &tele.SendOptions{ReplyTo: replyTo, ReplyMarkup: markup}); err != nil {
+		n.logger.Errorf("failed to send campaign %s to moderators: %v", campaign.CampaignID, err)
+	}
+}
+
+// sendImage загружает изображение сама: хранилище изображений может быть недоступно серверам Telegram
+func (n *Notifier) sendImage(chat tele.ChatID, url string) (*tele.Message, error) {
+	resp, err := n.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return n.bot.Send(chat, &tele.Photo{File: tele.FromReader(resp.Body)})
+}
@@ -0,0 +1,103 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nlypage/intele"
+	"nlypage-final/internal/adapters/controller/telegram"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+
+	tele "gopkg.in/telebot.v3"
+	"gopkg.in/telebot.v3/layout"
+)
+
+// inputTimeout — сколько бот ждет ключ API, прежде чем отменить привязку
+const inputTimeout = 5 * time.Minute
+
+type telegramService interface {
+	Link(ctx context.Context, link dto.TelegramLink) (*dto.TelegramAccount, error)
+	Account(ctx context.Context, telegramID int64) (*dto.TelegramAccount, error)
+	Unlink(ctx context.Context, telegramID int64) error
+}
+
+type handler struct {
+	layout       *layout.Layout
+	logger       *logger.Logger
+	inputManager *intele.InputManager
+	service      telegramService
+}
+
+func New(lt *layout.Layout, logger *logger.Logger, inputManager *intele.InputManager, service telegramService) telegram.Handler {
+	return &handler{
+		layout:       lt,
+		logger:       logger,
+		inputManager: inputManager,
+		service:      service,
+	}
+}
+
+// link ждет ключ API рекламодателя и привязывает к нему Telegram-аккаунт.
+// Состояние ожидания ввода хранится в states.Storage
+func (h handler) link(c tele.Context) error {
+	if err := telegram.Show(c, h.layout.Text(c, "link_input"), h.layout.Markup(c, "core:cancel")); err != nil {
+		return err
+	}
+
+	response, err := h.inputManager.Get(context.Background(), c.Sender().ID, inputTimeout, h.layout.Callback("core:cancel"))
+	switch {
+	case errors.Is(err, intele.ErrTimeout):
+		return c.Send(h.layout.Text(c, "link_timeout"), h.layout.Markup(c, "link"))
+	case err != nil:
+		return err
+	case response.Canceled || response.Callback != nil:
+		return c.Send(h.layout.Text(c, "link_canceled"), h.layout.Markup(c, "link"))
+	}
+
+	// Ключ — секрет, поэтому сообщение удаляется до проверки
+	if err := c.Bot().Delete(response.Message); err != nil {
+		h.logger.Warnf("(user: %d) failed to delete api key message: %v", c.Sender().ID, err)
+	}
+
+	account, err := h.service.Link(context.Background(), dto.TelegramLink{
+		TelegramID: c.Sender().ID,
+		ChatID:     c.Chat().ID,
+		Key:        strings.TrimSpace(response.Message.Text),
+	})
+	if err != nil {
+		if errors.Is(err, errorz.ErrUnauthorized) || errors.Is(err, errorz.ErrForbidden) {
+			return c.Send(h.layout.Text(c, "link_failed"), h.layout.Markup(c, "link"))
+		}
+		return err
+	}
+
+	h.logger.Infof("(user: %d) linked to advertiser %s", c.Sender().ID, account.AdvertiserID)
+	return c.Send(h.layout.Text(c, "link_success", account), h.layout.Markup(c, "menu"))
+}
+
+func (h handler) unlink(c tele.Context) error {
+	account, err := h.service.Account(context.Background(), c.Sender().ID)
+	if err != nil && !errors.Is(err, errorz.ErrNotFound) {
+		return err
+	}
+
+	ctx := context.Background()
+	if account != nil {
+		ctx = dto.ContextWithPrincipal(ctx, account.Principal())
+	}
+	if err := h.service.Unlink(ctx, c.Sender().ID); err != nil && !errors.Is(err, errorz.ErrNotFound) {
+		return err
+	}
+
+	return telegram.Show(c, h.layout.Text(c, "unlinked"), h.layout.Markup(c, "link"))
+}
+
+func (h handler) Setup(g *tele.Group) {
+	g.Handle("/link", h.link)
+	g.Handle(h.layout.Callback("account:link"), h.link)
+	g.Handle(h.layout.Callback("account:unlink"), h.unlink)
+}
@@ -0,0 +1,256 @@
+package campaigns
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"nlypage-final/internal/adapters/controller/telegram"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+
+	tele "gopkg.in/telebot.v3"
+	"gopkg.in/telebot.v3/layout"
+)
+
+// pageSize — сколько кампаний помещается на одну страницу списка
+const pageSize = 8
+
+type campaignService interface {
+	GetByID(ctx context.Context, campaignID uuid.UUID, advertiserID uuid.UUID) (*dto.Campaign, error)
+	Get(ctx context.Context, advertiserID uuid.UUID, size, page int) ([]*dto.Campaign, error)
+	Pause(ctx context.Context, pause *dto.CampaignPause) (*dto.Campaign, error)
+	Resume(ctx context.Context, resume *dto.CampaignPause) (*dto.Campaign, error)
+}
+
+type statsService interface {
+	Campaign(ctx context.Context, campaignID uuid.UUID) (*dto.Stats, error)
+	CampaignDaily(ctx context.Context, campaignID uuid.UUID) ([]*dto.StatsDaily, error)
+	Advertiser(ctx context.Context, advertiserID uuid.UUID) (*dto.Stats, error)
+	AdvertiserDaily(ctx context.Context, advertiserID uuid.UUID) ([]*dto.StatsDaily, error)
+}
+
+type timeService interface {
+	Now() *dto.CurrentDate
+}
+
+// statsView — статистика за текущий день и за все время для карточек бота
+type statsView struct {
+	Day   int
+	Today dto.Stats
+	Total dto.Stats
+}
+
+type campaignView struct {
+	statsView
+	Campaign *dto.Campaign
+	// TotalBudget равен нулю, если общий бюджет не ограничен
+	TotalBudget float64
+}
+
+type handler struct {
+	layout          *layout.Layout
+	logger          *logger.Logger
+	campaignService campaignService
+	statsService    statsService
+	timeService     timeService
+}
+
+func New(
+	lt *layout.Layout,
+	logger *logger.Logger,
+	campaignService campaignService,
+	statsService statsService,
+	timeService timeService,
+) telegram.Handler {
+	return &handler{
+		layout:          lt,
+		logger:          logger,
+		campaignService: campaignService,
+		statsService:    statsService,
+		timeService:     timeService,
+	}
+}
+
+// list показывает страницу кампаний рекламодателя. Номер страницы передается в данных кнопки
+func (h handler) list(c tele.Context) error {
+	page := 1
+	if c.Callback() != nil {
+		if p, err := strconv.Atoi(c.Callback().Data); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	campaigns, err := h.page(c, telegram.Account(c).AdvertiserID, page)
+	if err != nil {
+		return err
+	}
+	if len(campaigns) == 0 {
+		return telegram.Show(c, h.layout.Text(c, "campaigns_empty"), h.layout.Markup(c, "core:back"))
+	}
+
+	markup := &tele.ReplyMarkup{}
+	var rows []tele.Row
+	for i, camp := range campaigns {
+		if i == pageSize {
+			break
+		}
+		btn := h.layout.Button(c, "campaigns:open", camp)
+		btn.Text = h.layout.Text(c, "campaign_button", camp)
+		rows = append(rows, markup.Row(*btn))
+	}
+
+	var navigation tele.Row
+	if page > 1 {
+		navigation = append(navigation, *h.layout.Button(c, "campaigns:prev", page-1))
+	}
+	if len(campaigns) > pageSize {
+		navigation = append(navigation, *h.layout.Button(c, "campaigns:next", page+1))
+	}
+	if len(navigation) > 0 {
+		rows = append(rows, navigation)
+	}
+	rows = append(rows, markup.Row(*h.layout.Button(c, "core:back")))
+	markup.Inline(rows...)
+
+	return telegram.Show(c, h.layout.Text(c, "campaigns", page), markup)
+}
+
+// page загружает кампании страницы и первую кампанию следующей, чтобы понять, нужна ли кнопка «Далее»
+func (h handler) page(c tele.Context, advertiserID uuid.UUID, page int) ([]*dto.Campaign, error) {
+	campaigns, err := h.campaignService.Get(telegram.Context(c), advertiserID, pageSize, page)
+	if err != nil {
+		return nil, err
+	}
+	next, err := h.campaignService.Get(telegram.Context(c), advertiserID, 1, page*pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	return append(campaigns, next...), nil
+}
+
+// open показывает карточку кампании со статистикой
+func (h handler) open(c tele.Context) error {
+	campaignID, err := uuid.Parse(c.Callback().Data)
+	if err != nil {
+		return c.Respond()
+	}
+
+	camp, err := h.campaignService.GetByID(telegram.Context(c), campaignID, telegram.Account(c).AdvertiserID)
+	if err != nil {
+		return h.campaignError(c, err)
+	}
+	return h.showCampaign(c, camp)
+}
+
+func (h handler) pause(c tele.Context) error {
+	return h.setPaused(c, h.campaignService.Pause, "campaign_paused")
+}
+
+func (h handler) resume(c tele.Context) error {
+	return h.setPaused(c, h.campaignService.Resume, "campaign_resumed")
+}
+
+func (h handler) setPaused(
+	c tele.Context,
+	fn func(ctx context.Context, pause *dto.CampaignPause) (*dto.Campaign, error),
+	text string,
+) error {
+	campaignID, err := uuid.Parse(c.Callback().Data)
+	if err != nil {
+		return c.Respond()
+	}
+
+	camp, err := fn(telegram.Context(c), &dto.CampaignPause{
+		AdvertiserID: telegram.Account(c).AdvertiserID,
+		CampaignID:   campaignID,
+	})
+	if err != nil {
+		return h.campaignError(c, err)
+	}
+
+	h.logger.Infof("(user: %d) %s campaign %s", c.Sender().ID, text, campaignID)
+	_ = c.Respond(&tele.CallbackResponse{Text: h.layout.Text(c, text)})
+	return h.showCampaign(c, camp)
+}
+
+func (h handler) showCampaign(c tele.Context, camp *dto.Campaign) error {
+	ctx := telegram.Context(c)
+	total, err := h.statsService.Campaign(ctx, camp.CampaignID)
+	if err != nil {
+		return err
+	}
+	daily, err := h.statsService.CampaignDaily(ctx, camp.CampaignID)
+	if err != nil {
+		return err
+	}
+
+	markup := &tele.ReplyMarkup{}
+	toggle := h.layout.Button(c, "campaign:pause", camp)
+	if camp.Paused {
+		toggle = h.layout.Button(c, "campaign:resume", camp)
+	}
+	markup.Inline(
+		markup.Row(*toggle, *h.layout.Button(c, "campaign:refresh", camp)),
+		markup.Row(*h.layout.Button(c, "campaigns:back")),
+	)
+
+	view := campaignView{
+		statsView: h.statsView(total, daily),
+		Campaign:  camp,
+	}
+	if camp.TotalBudget != nil {
+		view.TotalBudget = *camp.TotalBudget
+	}
+	return telegram.Show(c, h.layout.Text(c, "campaign", view), markup)
+}
+
+// stats показывает общую статистику рекламодателя
+func (h handler) stats(c tele.Context) error {
+	ctx := telegram.Context(c)
+	advertiserID := telegram.Account(c).AdvertiserID
+	total, err := h.statsService.Advertiser(ctx, advertiserID)
+	if err != nil {
+		return err
+	}
+	daily, err := h.statsService.AdvertiserDaily(ctx, advertiserID)
+	if err != nil {
+		return err
+	}
+
+	return telegram.Show(c, h.layout.Text(c, "advertiser_stats", h.statsView(total, daily)), h.layout.Markup(c, "core:back"))
+}
+
+// statsView выбирает из ежедневной статистики текущий день. Если событий за день нет, статистика нулевая
+func (h handler) statsView(total *dto.Stats, daily []*dto.StatsDaily) statsView {
+	view := statsView{
+		Day:   h.timeService.Now().CurrentDate,
+		Total: *total,
+	}
+	for _, day := range daily {
+		if day.Date == view.Day {
+			view.Today = day.Stats
+			break
+		}
+	}
+	return view
+}
+
+func (h handler) campaignError(c tele.Context, err error) error {
+	if errors.Is(err, errorz.ErrNotFound) {
+		return c.Respond(&tele.CallbackResponse{Text: h.layout.Text(c, "campaign_not_found")})
+	}
+	return err
+}
+
+func (h handler) Setup(g *tele.Group) {
+	g.Handle("/campaigns", h.list)
+	g.Handle(h.layout.Callback("menu:campaigns"), h.list)
+	g.Handle(h.layout.Callback("campaigns:open"), h.open)
+	g.Handle(h.layout.Callback("campaign:pause"), h.pause)
+	g.Handle(h.layout.Callback("campaign:resume"), h.resume)
+	g.Handle("/stats", h.stats)
+	g.Handle(h.layout.Callback("menu:stats"), h.stats)
+}
@@ -0,0 +1,197 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/nlypage/intele"
+	"nlypage-final/internal/adapters/controller/telegram"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+
+	tele "gopkg.in/telebot.v3"
+	"gopkg.in/telebot.v3/layout"
+)
+
+// reasonTimeout — сколько бот ждет выбора причины отклонения
+const reasonTimeout = 5 * time.Minute
+
+// reasonButtons — кнопки причин отклонения из разметки moderation:reasons
+var reasonButtons = []string{
+	"moderation:reason:prohibited_content",
+	"moderation:reason:misleading_claims",
+	"moderation:reason:inappropriate_language",
+	"moderation:reason:low_quality_creative",
+	"moderation:reason:targeting_violation",
+	"moderation:reason:other",
+}
+
+type moderationService interface {
+	ApproveCampaign(ctx context.Context, approve dto.CampaignApprove) error
+	RejectCampaign(ctx context.Context, reject dto.CampaignReject) error
+}
+
+type handler struct {
+	layout       *layout.Layout
+	logger       *logger.Logger
+	inputManager *intele.InputManager
+	service      moderationService
+	// chatID — чат модераторов: решения из других чатов не принимаются
+	chatID int64
+}
+
+func New(
+	lt *layout.Layout,
+	logger *logger.Logger,
+	inputManager *intele.InputManager,
+	service moderationService,
+	chatID int64,
+) telegram.Handler {
+	return &handler{
+		layout:       lt,
+		logger:       logger,
+		inputManager: inputManager,
+		service:      service,
+		chatID:       chatID,
+	}
+}
+
+func (h handler) approve(c tele.Context) error {
+	campaignID, err := uuid.Parse(c.Callback().Data)
+	if err != nil {
+		return c.Respond()
+	}
+
+	moderator := moderatorName(c.Sender())
+	if err := h.service.ApproveCampaign(moderatorContext(c), dto.CampaignApprove{
+		CampaignID: campaignID,
+		Moderator:  &moderator,
+	}); err != nil {
+		return h.decisionError(c, err)
+	}
+
+	h.logger.Infof("(user: %d) approved campaign %s", c.Sender().ID, campaignID)
+	return h.decided(c, h.layout.Text(c, "moderation_approved", displayName(c.Sender())))
+}
+
+// reject предлагает выбрать причину и отклоняет кампанию. Выбор ждет InputManager, поэтому
+// нажать кнопку причины может только модератор, который начал отклонение
+func (h handler) reject(c tele.Context) error {
+	campaignID, err := uuid.Parse(c.Callback().Data)
+	if err != nil {
+		return c.Respond()
+	}
+
+	_ = c.Respond()
+	if _, err := c.Bot().EditReplyMarkup(c.Message(), h.layout.Markup(c, "moderation:reasons")); err != nil {
+		return err
+	}
+
+	callbacks := []tele.CallbackEndpoint{h.layout.Callback("core:cancel")}
+	for _, button := range reasonButtons {
+		callbacks = append(callbacks, h.layout.Callback(button))
+	}
+	response, err := h.inputManager.Get(context.Background(), c.Sender().ID, reasonTimeout, callbacks...)
+	if err != nil && !errors.Is(err, intele.ErrTimeout) {
+		return err
+	}
+	reason, ok := reasonFromCallback(response.Callback)
+	if err != nil || response.Canceled || !ok {
+		// Возвращаем кнопки решения, чтобы кампанию можно было проверить позже
+		_, err := c.Bot().EditReplyMarkup(c.Message(), h.layout.Markup(c, "moderation", dto.Campaign{CampaignID: campaignID}))
+		return err
+	}
+
+	moderator := moderatorName(c.Sender())
+	if err := h.service.RejectCampaign(moderatorContext(c), dto.CampaignReject{
+		CampaignID: campaignID,
+		Reason:     reason,
+		Moderator:  &moderator,
+	}); err != nil {
+		_, _ = c.Bot().EditReplyMarkup(c.Message(), h.layout.Markup(c, "moderation", dto.Campaign{CampaignID: campaignID}))
+		return h.decisionError(c, err)
+	}
+
+	h.logger.Infof("(user: %d) rejected campaign %s: %s", c.Sender().ID, campaignID, reason)
+	return h.decided(c, h.layout.Text(c, "moderation_rejected", struct {
+		Reason    string
+		Moderator string
+	}{
+		Reason:    reason,
+		Moderator: displayName(c.Sender()),
+	}))
+}
+
+// decided убирает кнопки решения и отвечает на карточку кампании, кто и как ее проверил
+func (h handler) decided(c tele.Context, text string) error {
+	_ = c.Respond()
+	if _, err := c.Bot().EditReplyMarkup(c.Message(), nil); err != nil {
+		h.logger.Warnf("failed to remove moderation buttons: %v", err)
+	}
+	return c.Reply(text)
+}
+
+func (h handler) decisionError(c tele.Context, err error) error {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) && httpErr.Code < echo.ErrInternalServerError.Code {
+		return c.Respond(&tele.CallbackResponse{
+			Text:      fmt.Sprint(httpErr.Message),
+			ShowAlert: true,
+		})
+	}
+	return err
+}
+
+// onlyModerators пропускает нажатия только из чата модераторов
+func (h handler) onlyModerators(next tele.HandlerFunc) tele.HandlerFunc {
+	return func(c tele.Context) error {
+		if c.Chat() == nil || c.Chat().ID != h.chatID {
+			return c.Respond(&tele.CallbackResponse{Text: h.layout.Text(c, "moderation_forbidden")})
+		}
+		return next(c)
+	}
+}
+
+func (h handler) Setup(g *tele.Group) {
+	g.Use(h.onlyModerators)
+	g.Handle(h.layout.Callback("moderation:approve"), h.approve)
+	g.Handle(h.layout.Callback("moderation:reject"), h.reject)
+}
+
+// reasonFromCallback достает код причины из нажатой кнопки. Кнопки без обработчика приходят
+// с данными в виде "\f<unique>|<data>"
+func reasonFromCallback(callback *tele.Callback) (string, bool) {
+	if callback == nil {
+		return "", false
+	}
+	unique, reason, found := strings.Cut(callback.Data, "|")
+	if !found || strings.TrimSpace(unique) != "moderation_reason" {
+		return "", false
+	}
+	return reason, true
+}
+
+// moderatorName — имя модератора в истории модерации и журнале аудита
+func moderatorName(user *tele.User) string {
+	return fmt.Sprintf("telegram:%d", user.ID)
+}
+
+// moderatorContext добавляет модератора из Telegram в контекст, чтобы решение попало в журнал аудита с его именем
+func moderatorContext(c tele.Context) context.Context {
+	return dto.ContextWithPrincipal(context.Background(), &dto.Principal{
+		Name: moderatorName(c.Sender()),
+		Role: dto.RoleModerator,
+	})
+}
+
+func displayName(user *tele.User) string {
+	if user.Username != "" {
+		return "@" + user.Username
+	}
+	return strings.TrimSpace(user.FirstName + " " + user.LastName)
+}
@@ -1,30 +1,58 @@
 package start
 
 import (
+	"context"
+	"errors"
+
 	"nlypage-final/internal/adapters/controller/telegram"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
 	"nlypage-final/pkg/logger"
 
 	tele "gopkg.in/telebot.v3"
 	"gopkg.in/telebot.v3/layout"
 )
 
+type accountService interface {
+	Account(ctx context.Context, telegramID int64) (*dto.TelegramAccount, error)
+}
+
 type handler struct {
-	layout *layout.Layout
-	logger *logger.Logger
+	layout   *layout.Layout
+	logger   *logger.Logger
+	accounts accountService
 }
 
-func New(lt *layout.Layout, logger *logger.Logger) telegram.Handler {
+func New(lt *layout.Layout, logger *logger.Logger, accounts accountService) telegram.Handler {
 	return &handler{
-		layout: lt,
-		logger: logger,
+		layout:   lt,
+		logger:   logger,
+		accounts: accounts,
 	}
 }
 
+// Start показывает главное меню или предлагает привязать аккаунт рекламодателя
 func (h handler) Start(c tele.Context) error {
 	h.logger.Infof("(user: %d) enter /start", c.Sender().ID)
-	return nil
+
+	account, err := h.accounts.Account(context.Background(), c.Sender().ID)
+	if err != nil {
+		if errors.Is(err, errorz.ErrNotFound) {
+			return telegram.Show(c, h.layout.Text(c, "link_required"), h.layout.Markup(c, "link"))
+		}
+		return err
+	}
+
+	return telegram.Show(c, h.layout.Text(c, "menu", account), h.layout.Markup(c, "menu"))
+}
+
+func (h handler) hide(c tele.Context) error {
+	_ = c.Respond()
+	return c.Delete()
 }
 
 func (h handler) Setup(g *tele.Group) {
 	g.Handle("/start", h.Start)
+	g.Handle(h.layout.Callback("core:back"), h.Start)
+	g.Handle(h.layout.Callback("core:hide"), h.hide)
 }
@@ -6,4 +6,6 @@ var (
 	ErrClickAlreadyExists      = errors.New("click already exists for this ad and client")
 	ErrImpressionAlreadyExists = errors.New("impression already exists for this ad and client")
 	ErrClickAdNotShown         = errors.New("cannot record click: ad was not shown to this client")
+	ErrClickNotFound           = errors.New("ad was not clicked by this client")
+	ErrConversionAlreadyExists = errors.New("conversion already recorded for this click")
 )
@@ -0,0 +1,283 @@
+package clickhouse
+
+// Migration описывает версионированную миграцию схемы ClickHouse.
+// ClickHouse не поддерживает транзакции для DDL, поэтому запросы миграции должны быть идемпотентными:
+// если миграция упала на середине, ее повторный запуск должен довести схему до нужного состояния
+type Migration struct {
+	Version uint64
+	Name    string
+	Queries []string
+}
+
+// migrations содержит все миграции в порядке применения.
+// Уже примененные миграции изменять нельзя, любые изменения схемы добавляются новой миграцией в конец списка
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "create_events_tables",
+		Queries: []string{
+			`
+        CREATE TABLE IF NOT EXISTS ad_impressions (
+            campaign_id UUID,
+            advertiser_id UUID,
+            client_id UUID,
+            income Float64,
+            day Int32,
+            view_count UInt64,
+            PRIMARY KEY (day, campaign_id, client_id)
+        ) ENGINE = ReplacingMergeTree()
+        ORDER BY (day, campaign_id, client_id)
+        `,
+			`
+        CREATE TABLE IF NOT EXISTS ad_clicks (
+            campaign_id UUID,
+            advertiser_id UUID,
+            client_id UUID,
+            income Float64,
+            day Int32,
+            PRIMARY KEY (day, campaign_id, client_id)
+        ) ENGINE = ReplacingMergeTree()
+        ORDER BY (day, campaign_id, client_id)
+        `,
+		},
+	},
+	{
+		Version: 2,
+		Name:    "create_daily_rollups",
+		Queries: []string{
+			// Предагрегированная статистика по кампаниям за день
+			`
+        CREATE TABLE IF NOT EXISTS campaign_daily_stats (
+            campaign_id UUID,
+            advertiser_id UUID,
+            day Int32,
+            impressions UInt64,
+            clicks UInt64,
+            impressions_income Float64,
+            clicks_income Float64
+        ) ENGINE = SummingMergeTree()
+        ORDER BY (campaign_id, day)
+        `,
+			// Предагрегированная статистика по рекламодателям за день.
+			// campaign_id входит в ключ, чтобы можно было удалить статистику отдельной кампании
+			`
+        CREATE TABLE IF NOT EXISTS advertiser_daily_stats (
+            advertiser_id UUID,
+            campaign_id UUID,
+            day Int32,
+            impressions UInt64,
+            clicks UInt64,
+            impressions_income Float64,
+            clicks_income Float64
+        ) ENGINE = SummingMergeTree()
+        ORDER BY (advertiser_id, day, campaign_id)
+        `,
+			// Повторные просмотры (view_count > 1) не учитываются: после слияния ReplacingMergeTree
+			// от них остается одна строка, поэтому в статистику попадает только первый показ за день
+			`
+        CREATE MATERIALIZED VIEW IF NOT EXISTS campaign_daily_impressions_mv TO campaign_daily_stats AS
+        SELECT
+            campaign_id,
+            advertiser_id,
+            day,
+            toUInt64(count()) AS impressions,
+            toUInt64(0) AS clicks,
+            sum(income) AS impressions_income,
+            toFloat64(0) AS clicks_income
+        FROM ad_impressions
+        WHERE view_count = 1
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        CREATE MATERIALIZED VIEW IF NOT EXISTS campaign_daily_clicks_mv TO campaign_daily_stats AS
+        SELECT
+            campaign_id,
+            advertiser_id,
+            day,
+            toUInt64(0) AS impressions,
+            toUInt64(count()) AS clicks,
+            toFloat64(0) AS impressions_income,
+            sum(income) AS clicks_income
+        FROM ad_clicks
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        CREATE MATERIALIZED VIEW IF NOT EXISTS advertiser_daily_impressions_mv TO advertiser_daily_stats AS
+        SELECT
+            advertiser_id,
+            campaign_id,
+            day,
+            toUInt64(count()) AS impressions,
+            toUInt64(0) AS clicks,
+            sum(income) AS impressions_income,
+            toFloat64(0) AS clicks_income
+        FROM ad_impressions
+        WHERE view_count = 1
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+			`
+        CREATE MATERIALIZED VIEW IF NOT EXISTS advertiser_daily_clicks_mv TO advertiser_daily_stats AS
+        SELECT
+            advertiser_id,
+            campaign_id,
+            day,
+            toUInt64(0) AS impressions,
+            toUInt64(count()) AS clicks,
+            toFloat64(0) AS impressions_income,
+            sum(income) AS clicks_income
+        FROM ad_clicks
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+			// Заполнение rollup-таблиц событиями, записанными до их появления.
+			// Таблицы очищаются перед заполнением, поэтому повторный запуск не задваивает статистику
+			`TRUNCATE TABLE campaign_daily_stats`,
+			`TRUNCATE TABLE advertiser_daily_stats`,
+			`
+        INSERT INTO campaign_daily_stats (campaign_id, advertiser_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT campaign_id, advertiser_id, day, count(), 0, sum(income), 0
+        FROM ad_impressions FINAL
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        INSERT INTO campaign_daily_stats (campaign_id, advertiser_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT campaign_id, advertiser_id, day, 0, count(), 0, sum(income)
+        FROM ad_clicks FINAL
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        INSERT INTO advertiser_daily_stats (advertiser_id, campaign_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT advertiser_id, campaign_id, day, count(), 0, sum(income), 0
+        FROM ad_impressions FINAL
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+			`
+        INSERT INTO advertiser_daily_stats (advertiser_id, campaign_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT advertiser_id, campaign_id, day, 0, count(), 0, sum(income)
+        FROM ad_clicks FINAL
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+		},
+	},
+	{
+		Version: 3,
+		Name:    "create_conversions",
+		Queries: []string{
+			// Конверсии (целевые действия после клика). click_day — день клика, к которому отнесена конверсия
+			`
+        CREATE TABLE IF NOT EXISTS ad_conversions (
+            campaign_id UUID,
+            advertiser_id UUID,
+            client_id UUID,
+            value Float64,
+            income Float64,
+            click_day Int32,
+            day Int32
+        ) ENGINE = MergeTree()
+        ORDER BY (day, campaign_id, client_id)
+        `,
+			`ALTER TABLE campaign_daily_stats ADD COLUMN IF NOT EXISTS conversions UInt64 DEFAULT 0`,
+			`ALTER TABLE campaign_daily_stats ADD COLUMN IF NOT EXISTS conversions_value Float64 DEFAULT 0`,
+			`ALTER TABLE campaign_daily_stats ADD COLUMN IF NOT EXISTS conversions_income Float64 DEFAULT 0`,
+			`ALTER TABLE advertiser_daily_stats ADD COLUMN IF NOT EXISTS conversions UInt64 DEFAULT 0`,
+			`ALTER TABLE advertiser_daily_stats ADD COLUMN IF NOT EXISTS conversions_value Float64 DEFAULT 0`,
+			`ALTER TABLE advertiser_daily_stats ADD COLUMN IF NOT EXISTS conversions_income Float64 DEFAULT 0`,
+			`
+        CREATE MATERIALIZED VIEW IF NOT EXISTS campaign_daily_conversions_mv TO campaign_daily_stats AS
+        SELECT
+            campaign_id,
+            advertiser_id,
+            day,
+            toUInt64(count()) AS conversions,
+            sum(value) AS conversions_value,
+            sum(income) AS conversions_income
+        FROM ad_conversions
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        CREATE MATERIALIZED VIEW IF NOT EXISTS advertiser_daily_conversions_mv TO advertiser_daily_stats AS
+        SELECT
+            advertiser_id,
+            campaign_id,
+            day,
+            toUInt64(count()) AS conversions,
+            sum(value) AS conversions_value,
+            sum(income) AS conversions_income
+        FROM ad_conversions
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+		},
+	},
+	{
+		Version: 4,
+		Name:    "create_invalid_clicks",
+		Queries: []string{
+			// Время показа и клика нужно для проверки скорости клика и всплесков с одного IP.
+			// Старым строкам проставляется начало эпохи, чтобы они не считались свежими
+			`ALTER TABLE ad_impressions ADD COLUMN IF NOT EXISTS shown_at DateTime64(3) DEFAULT toDateTime64(0, 3)`,
+			`ALTER TABLE ad_clicks ADD COLUMN IF NOT EXISTS ip String DEFAULT ''`,
+			`ALTER TABLE ad_clicks ADD COLUMN IF NOT EXISTS clicked_at DateTime64(3) DEFAULT toDateTime64(0, 3)`,
+			// Недействительные клики хранятся отдельно и не попадают в rollup-таблицы, статистику и оплату
+			`
+        CREATE TABLE IF NOT EXISTS invalid_clicks (
+            campaign_id UUID,
+            advertiser_id UUID,
+            client_id UUID,
+            ip String,
+            income Float64,
+            day Int32,
+            reason LowCardinality(String),
+            clicked_at DateTime64(3)
+        ) ENGINE = MergeTree()
+        ORDER BY (day, campaign_id, client_id)
+        `,
+		},
+	},
+	{
+		Version: 5,
+		Name:    "rebuild_daily_rollups",
+		Queries: []string{
+			// Пересборка rollup-таблиц из сырых событий. Заполнение в create_daily_rollups шло после
+			// создания представлений, поэтому события, записанные во время миграции, могли посчитаться
+			// дважды. Миграция выполняется при остановленной записи событий: пока таблицы очищаются и
+			// заполняются, представления продолжают писать в них новые показы, клики и конверсии
+			`TRUNCATE TABLE campaign_daily_stats`,
+			`TRUNCATE TABLE advertiser_daily_stats`,
+			`
+        INSERT INTO campaign_daily_stats (campaign_id, advertiser_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT campaign_id, advertiser_id, day, count(), 0, sum(income), 0
+        FROM ad_impressions FINAL
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        INSERT INTO campaign_daily_stats (campaign_id, advertiser_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT campaign_id, advertiser_id, day, 0, count(), 0, sum(income)
+        FROM ad_clicks FINAL
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        INSERT INTO campaign_daily_stats (campaign_id, advertiser_id, day, conversions, conversions_value, conversions_income)
+        SELECT campaign_id, advertiser_id, day, toUInt64(count()), sum(value), sum(income)
+        FROM ad_conversions
+        GROUP BY campaign_id, advertiser_id, day
+        `,
+			`
+        INSERT INTO advertiser_daily_stats (advertiser_id, campaign_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT advertiser_id, campaign_id, day, count(), 0, sum(income), 0
+        FROM ad_impressions FINAL
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+			`
+        INSERT INTO advertiser_daily_stats (advertiser_id, campaign_id, day, impressions, clicks, impressions_income, clicks_income)
+        SELECT advertiser_id, campaign_id, day, 0, count(), 0, sum(income)
+        FROM ad_clicks FINAL
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+			`
+        INSERT INTO advertiser_daily_stats (advertiser_id, campaign_id, day, conversions, conversions_value, conversions_income)
+        SELECT advertiser_id, campaign_id, day, toUInt64(count()), sum(value), sum(income)
+        FROM ad_conversions
+        GROUP BY advertiser_id, campaign_id, day
+        `,
+		},
+	},
+}
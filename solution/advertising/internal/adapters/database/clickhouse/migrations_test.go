@@ -0,0 +1,38 @@
+package clickhouse
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrationsOrder(t *testing.T) {
+	var prev uint64
+	for _, m := range migrations {
+		assert.Greater(t, m.Version, prev, "migration %s must have version greater than %d", m.Name, prev)
+		assert.NotEmpty(t, m.Name)
+		assert.NotEmpty(t, m.Queries, "migration %d_%s has no queries", m.Version, m.Name)
+		prev = m.Version
+	}
+}
+
+func TestPendingMigrations(t *testing.T) {
+	all := []Migration{
+		{Version: 1, Name: "first"},
+		{Version: 2, Name: "second"},
+		{Version: 3, Name: "third"},
+	}
+
+	t.Run("nothing applied", func(t *testing.T) {
+		assert.Equal(t, all, pendingMigrations(all, map[uint64]time.Time{}))
+	})
+	t.Run("partially applied", func(t *testing.T) {
+		pending := pendingMigrations(all, map[uint64]time.Time{1: time.Now()})
+		assert.Equal(t, []Migration{all[1], all[2]}, pending)
+	})
+	t.Run("all applied", func(t *testing.T) {
+		pending := pendingMigrations(all, map[uint64]time.Time{1: time.Now(), 2: time.Now(), 3: time.Now()})
+		assert.Empty(t, pending)
+	})
+}
@@ -0,0 +1,116 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MigrationStatus описывает состояние миграции на текущей базе
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+const createSchemaMigrationsTable = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version UInt64,
+		name String,
+		applied_at DateTime DEFAULT now()
+	) ENGINE = ReplacingMergeTree()
+	ORDER BY version
+`
+
+// MigrationStatuses возвращает состояние всех известных миграций
+func (r *Repository) MigrationStatuses(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := r.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(migrations))
+	for _, m := range migrations {
+		appliedAt, ok := applied[m.Version]
+		statuses = append(statuses, MigrationStatus{
+			Migration: m,
+			Applied:   ok,
+			AppliedAt: appliedAt,
+		})
+	}
+
+	return statuses, nil
+}
+
+// MigrateUp применяет все неприменённые миграции по порядку и возвращает их список.
+// В режиме dryRun запросы не выполняются, а только возвращается список миграций, которые были бы применены
+func (r *Repository) MigrateUp(ctx context.Context, dryRun bool) ([]Migration, error) {
+	applied, err := r.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := pendingMigrations(migrations, applied)
+	if dryRun {
+		return pending, nil
+	}
+
+	for _, m := range pending {
+		for i, query := range m.Queries {
+			if err := r.conn.Exec(ctx, query); err != nil {
+				return nil, fmt.Errorf("failed to apply migration %d_%s (query #%d): %w", m.Version, m.Name, i+1, err)
+			}
+		}
+
+		if err := r.conn.Exec(ctx,
+			`INSERT INTO schema_migrations (version, name) VALUES (?, ?)`,
+			m.Version, m.Name,
+		); err != nil {
+			return nil, fmt.Errorf("failed to record migration %d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// appliedMigrations возвращает версии примененных миграций и время их применения
+func (r *Repository) appliedMigrations(ctx context.Context) (map[uint64]time.Time, error) {
+	if err := r.conn.Exec(ctx, createSchemaMigrationsTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	rows, err := r.conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations FINAL`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[uint64]time.Time)
+	for rows.Next() {
+		var (
+			version   uint64
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan applied migration: %w", err)
+		}
+		applied[version] = appliedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating applied migrations: %w", err)
+	}
+
+	return applied, nil
+}
+
+// pendingMigrations возвращает миграции, которые еще не были применены, в порядке применения
+func pendingMigrations(all []Migration, applied map[uint64]time.Time) []Migration {
+	var pending []Migration
+	for _, m := range all {
+		if _, ok := applied[m.Version]; !ok {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
@@ -1,6 +1,8 @@
 package clickhouse
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -17,16 +19,104 @@ type AdClick struct {
 	CampaignID   uuid.UUID
 	AdvertiserID uuid.UUID
 	ClientID     uuid.UUID
+	IP           string
+	Income       float64
+	Day          int
+}
+
+// InvalidClick — клик, признанный недействительным. Не попадает в статистику и не оплачивается
+type InvalidClick struct {
+	AdClick
+	Reason string
+}
+
+// ClickSignals описывает поведение клиента на момент клика, без учета самого клика
+type ClickSignals struct {
+	// SinceImpression — время от последнего показа объявления клиенту
+	SinceImpression time.Duration
+	// ClientImpressions — объявления, показанные клиенту начиная с указанного дня
+	ClientImpressions uint64
+	// ClientClicks — клики клиента начиная с указанного дня, включая недействительные
+	ClientClicks uint64
+	// IPClicks — клики с IP-адреса за указанное время, включая недействительные
+	IPClicks uint64
+}
+
+// InvalidClicksStats — недействительные клики за день по одной причине
+type InvalidClicksStats struct {
+	Day         int32
+	Reason      string
+	ClicksCount uint64
+	Income      float64
+}
+
+// AdConversion описывает целевое действие клиента, отнесенное к его клику по рекламе
+type AdConversion struct {
+	CampaignID   uuid.UUID
+	AdvertiserID uuid.UUID
+	ClientID     uuid.UUID
+	Value        float64
 	Income       float64
+	ClickDay     int
 	Day          int
 }
 
+// ClientEvents — события клиента из всех таблиц, где хранится его идентификатор
+type ClientEvents struct {
+	Impressions   []*ClientImpression
+	Clicks        []*ClientClick
+	InvalidClicks []*ClientClick
+	Conversions   []*ClientConversion
+}
+
+type ClientImpression struct {
+	CampaignID   uuid.UUID
+	AdvertiserID uuid.UUID
+	Day          int32
+	ViewCount    uint64
+	ShownAt      time.Time
+}
+
+// ClientClick — клик клиента. Reason заполнен только у недействительных кликов
+type ClientClick struct {
+	CampaignID   uuid.UUID
+	AdvertiserID uuid.UUID
+	Day          int32
+	IP           string
+	Reason       string
+	ClickedAt    time.Time
+}
+
+type ClientConversion struct {
+	CampaignID   uuid.UUID
+	AdvertiserID uuid.UUID
+	Value        float64
+	ClickDay     int32
+	Day          int32
+}
+
+// CampaignDayIncome — оплачиваемые события кампании за день, из них формируется биллинговый журнал
+type CampaignDayIncome struct {
+	CampaignID        uuid.UUID
+	AdvertiserID      uuid.UUID
+	Day               int32
+	Impressions       uint64
+	Clicks            uint64
+	Conversions       uint64
+	ImpressionsIncome float64
+	ClicksIncome      float64
+	ConversionsIncome float64
+}
+
 type Stats struct {
 	ImpressionsCount uint64
 	ClicksCount      uint64
 	Conversion       float64
+	ConversionsCount uint64
+	ConversionsValue float64
 	SpentImpressions float64
 	SpentClicks      float64
+	SpentActions     float64
 	SpentTotal       float64
 }
 
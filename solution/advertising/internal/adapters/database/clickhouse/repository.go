@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
@@ -41,49 +42,9 @@ func New(cfg Config) (*Repository, error) {
 		return nil, fmt.Errorf("failed to connect to clickhouse: %w", err)
 	}
 
-	if err := createTables(conn); err != nil {
-		return nil, fmt.Errorf("failed to create tables: %w", err)
-	}
-
 	return &Repository{conn: conn}, nil
 }
 
-func createTables(conn driver.Conn) error {
-	queries := []string{
-		`
-        CREATE TABLE IF NOT EXISTS ad_impressions (
-            campaign_id UUID,
-            advertiser_id UUID,
-            client_id UUID,
-            income Float64,
-            day Int32,
-            view_count UInt64,
-            PRIMARY KEY (day, campaign_id, client_id)
-        ) ENGINE = ReplacingMergeTree()
-        ORDER BY (day, campaign_id, client_id)
-        `,
-		`
-        CREATE TABLE IF NOT EXISTS ad_clicks (
-            campaign_id UUID,
-            advertiser_id UUID,
-            client_id UUID,
-            income Float64,
-            day Int32,
-            PRIMARY KEY (day, campaign_id, client_id)
-        ) ENGINE = ReplacingMergeTree() 
-        ORDER BY (day, campaign_id, client_id)
-        `,
-	}
-
-	for _, query := range queries {
-		if err := conn.Exec(context.Background(), query); err != nil {
-			return fmt.Errorf("failed to execute query: %w", err)
-		}
-	}
-
-	return nil
-}
-
 // RecordImpression записывает показ рекламы или инкрементирует счетчик просмотров если показ уже существует
 func (r *Repository) RecordImpression(ctx context.Context, show *AdImpression) error {
 	// Используем INSERT ... SELECT для атомарного инкремента
@@ -94,7 +55,8 @@ func (r *Repository) RecordImpression(ctx context.Context, show *AdImpression) e
 			client_id,
 			income,
 			day,
-			view_count
+			view_count,
+			shown_at
 		)
 		SELECT 
 			campaign_id,
@@ -102,7 +64,8 @@ func (r *Repository) RecordImpression(ctx context.Context, show *AdImpression) e
 			client_id,
 			income,
 			day,
-			view_count + 1
+			view_count + 1,
+			now64(3)
 		FROM 
 		(
 			SELECT 
@@ -135,6 +98,73 @@ func (r *Repository) RecordImpression(ctx context.Context, show *AdImpression) e
 
 // RecordClick записывает клик по рекламе, возвращая ошибку если клик уже существует
 func (r *Repository) RecordClick(ctx context.Context, click *AdClick) error {
+	if err := r.checkClick(ctx, click); err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO ad_clicks (
+            campaign_id,
+            advertiser_id,
+            client_id,
+            ip,
+            income,
+            day,
+            clicked_at
+        ) VALUES (?, ?, ?, ?, ?, ?, now64(3))
+    `
+
+	if err := r.conn.Exec(ctx, query,
+		click.CampaignID,
+		click.AdvertiserID,
+		click.ClientID,
+		click.IP,
+		click.Income,
+		click.Day,
+	); err != nil {
+		return fmt.Errorf("failed to record click: %w", err)
+	}
+
+	return nil
+}
+
+// RecordInvalidClick записывает недействительный клик. Проверки те же, что в RecordClick:
+// повторный клик клиента по объявлению не записывается, даже если первый был недействительным
+func (r *Repository) RecordInvalidClick(ctx context.Context, click *InvalidClick) error {
+	if err := r.checkClick(ctx, &click.AdClick); err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO invalid_clicks (
+            campaign_id,
+            advertiser_id,
+            client_id,
+            ip,
+            income,
+            day,
+            reason,
+            clicked_at
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, now64(3))
+    `
+
+	if err := r.conn.Exec(ctx, query,
+		click.CampaignID,
+		click.AdvertiserID,
+		click.ClientID,
+		click.IP,
+		click.Income,
+		click.Day,
+		click.Reason,
+	); err != nil {
+		return fmt.Errorf("failed to record invalid click: %w", err)
+	}
+
+	return nil
+}
+
+// checkClick проверяет, что реклама была показана клиенту и клиент еще не кликал по ней
+func (r *Repository) checkClick(ctx context.Context, click *AdClick) error {
 	// Проверяем показана ли реклама
 	checkImpressionQuery := `
         SELECT count(*)
@@ -152,15 +182,19 @@ func (r *Repository) RecordClick(ctx context.Context, click *AdClick) error {
 		return ErrClickAdNotShown
 	}
 
-	// Проверяем существование клика
+	// Проверяем существование клика, в том числе недействительного
 	checkClickQuery := `
         SELECT count(*)
-        FROM ad_clicks
-        WHERE campaign_id = ? AND client_id = ?
+        FROM
+        (
+            SELECT campaign_id FROM ad_clicks WHERE campaign_id = ? AND client_id = ?
+            UNION ALL
+            SELECT campaign_id FROM invalid_clicks WHERE campaign_id = ? AND client_id = ?
+        )
     `
 
 	var clickCount uint64
-	row = r.conn.QueryRow(ctx, checkClickQuery, click.CampaignID, click.ClientID)
+	row = r.conn.QueryRow(ctx, checkClickQuery, click.CampaignID, click.ClientID, click.CampaignID, click.ClientID)
 	if err := row.Scan(&clickCount); err != nil {
 		return fmt.Errorf("failed to check existing click: %w", err)
 	}
@@ -169,25 +203,191 @@ func (r *Repository) RecordClick(ctx context.Context, click *AdClick) error {
 		return ErrClickAlreadyExists
 	}
 
-	// Если клика нет, записываем его
+	return nil
+}
+
+// ClickSignals собирает поведение клиента для проверки клика: время с показа объявления,
+// показы и клики клиента начиная с дня fromDay и клики с IP-адреса за последние ipWindow
+func (r *Repository) ClickSignals(ctx context.Context, click *AdClick, fromDay int, ipWindow time.Duration) (*ClickSignals, error) {
 	query := `
-        INSERT INTO ad_clicks (
+        SELECT
+            (
+                SELECT toUnixTimestamp64Milli(now64(3)) - toUnixTimestamp64Milli(max(shown_at))
+                FROM ad_impressions
+                WHERE campaign_id = ? AND client_id = ?
+            ) AS since_impression,
+            (
+                SELECT uniqExact(campaign_id)
+                FROM ad_impressions
+                WHERE client_id = ? AND day >= ?
+            ) AS client_impressions,
+            (
+                SELECT count(*)
+                FROM
+                (
+                    SELECT campaign_id FROM ad_clicks WHERE client_id = ? AND day >= ?
+                    UNION ALL
+                    SELECT campaign_id FROM invalid_clicks WHERE client_id = ? AND day >= ?
+                )
+            ) AS client_clicks,
+            (
+                SELECT count(*)
+                FROM
+                (
+                    SELECT campaign_id FROM ad_clicks WHERE ip = ? AND clicked_at >= fromUnixTimestamp64Milli(toUnixTimestamp64Milli(now64(3)) - ?)
+                    UNION ALL
+                    SELECT campaign_id FROM invalid_clicks WHERE ip = ? AND clicked_at >= fromUnixTimestamp64Milli(toUnixTimestamp64Milli(now64(3)) - ?)
+                )
+            ) AS ip_clicks
+    `
+
+	var (
+		sinceImpression int64
+		signals         ClickSignals
+	)
+	row := r.conn.QueryRow(ctx, query,
+		click.CampaignID, click.ClientID,
+		click.ClientID, fromDay,
+		click.ClientID, fromDay, click.ClientID, fromDay,
+		click.IP, ipWindow.Milliseconds(), click.IP, ipWindow.Milliseconds(),
+	)
+	if err := row.Scan(&sinceImpression, &signals.ClientImpressions, &signals.ClientClicks, &signals.IPClicks); err != nil {
+		return nil, fmt.Errorf("failed to get click signals: %w", err)
+	}
+	signals.SinceImpression = time.Duration(sinceImpression) * time.Millisecond
+
+	return &signals, nil
+}
+
+// CampaignInvalidClicks возвращает недействительные клики по кампании по дням и причинам
+func (r *Repository) CampaignInvalidClicks(ctx context.Context, campaignID uuid.UUID) ([]*InvalidClicksStats, error) {
+	return r.invalidClicks(ctx, "campaign_id", campaignID)
+}
+
+// AdvertiserInvalidClicks возвращает недействительные клики по рекламодателю по дням и причинам
+func (r *Repository) AdvertiserInvalidClicks(ctx context.Context, advertiserID uuid.UUID) ([]*InvalidClicksStats, error) {
+	return r.invalidClicks(ctx, "advertiser_id", advertiserID)
+}
+
+func (r *Repository) invalidClicks(ctx context.Context, keyColumn string, id uuid.UUID) ([]*InvalidClicksStats, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			day,
+			reason,
+			count(*) as clicks_count,
+			sum(income) as income
+		FROM invalid_clicks
+		WHERE %s = ?
+		GROUP BY day, reason
+		ORDER BY day, reason
+	`, keyColumn)
+
+	rows, err := r.conn.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invalid clicks: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*InvalidClicksStats
+	for rows.Next() {
+		var stat InvalidClicksStats
+		if err := rows.Scan(&stat.Day, &stat.Reason, &stat.ClicksCount, &stat.Income); err != nil {
+			return nil, fmt.Errorf("failed to scan invalid clicks: %w", err)
+		}
+		stats = append(stats, &stat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating invalid clicks: %w", err)
+	}
+
+	return stats, nil
+}
+
+// ViewedOnDay сообщает, был ли показ рекламы клиенту в указанный день.
+// Оплачивается только первый показ за день, поэтому так определяется, нужно ли списывать стоимость показа
+func (r *Repository) ViewedOnDay(ctx context.Context, campaignID, clientID uuid.UUID, day int) (bool, error) {
+	query := `
+        SELECT count(*)
+        FROM ad_impressions
+        WHERE campaign_id = ? AND client_id = ? AND day = ?
+    `
+
+	var viewCount uint64
+	row := r.conn.QueryRow(ctx, query, campaignID, clientID, day)
+	if err := row.Scan(&viewCount); err != nil {
+		return false, fmt.Errorf("failed to get impressions: %w", err)
+	}
+
+	return viewCount > 0, nil
+}
+
+// LastClickDay возвращает день последнего клика клиента по рекламе, ErrClickNotFound если клика не было
+func (r *Repository) LastClickDay(ctx context.Context, campaignID, clientID uuid.UUID) (int, error) {
+	query := `
+        SELECT max(day), count(*)
+        FROM ad_clicks
+        WHERE campaign_id = ? AND client_id = ?
+    `
+
+	var (
+		day        int32
+		clickCount uint64
+	)
+	row := r.conn.QueryRow(ctx, query, campaignID, clientID)
+	if err := row.Scan(&day, &clickCount); err != nil {
+		return 0, fmt.Errorf("failed to get last click: %w", err)
+	}
+
+	if clickCount == 0 {
+		return 0, ErrClickNotFound
+	}
+
+	return int(day), nil
+}
+
+// RecordConversion записывает конверсию, возвращая ErrConversionAlreadyExists, если по этому клику она уже есть.
+// Проверка атрибуции к клику выполняется вызывающей стороной
+func (r *Repository) RecordConversion(ctx context.Context, conversion *AdConversion) error {
+	// За один клик оплачивается не больше одной конверсии
+	checkQuery := `
+        SELECT count(*)
+        FROM ad_conversions
+        WHERE campaign_id = ? AND client_id = ? AND click_day = ?
+    `
+
+	var conversionCount uint64
+	row := r.conn.QueryRow(ctx, checkQuery, conversion.CampaignID, conversion.ClientID, conversion.ClickDay)
+	if err := row.Scan(&conversionCount); err != nil {
+		return fmt.Errorf("failed to check existing conversion: %w", err)
+	}
+
+	if conversionCount > 0 {
+		return ErrConversionAlreadyExists
+	}
+
+	query := `
+        INSERT INTO ad_conversions (
             campaign_id,
             advertiser_id,
             client_id,
+            value,
             income,
+            click_day,
             day
-        ) VALUES (?, ?, ?, ?, ?)
+        ) VALUES (?, ?, ?, ?, ?, ?, ?)
     `
 
 	if err := r.conn.Exec(ctx, query,
-		click.CampaignID,
-		click.AdvertiserID,
-		click.ClientID,
-		click.Income,
-		click.Day,
+		conversion.CampaignID,
+		conversion.AdvertiserID,
+		conversion.ClientID,
+		conversion.Value,
+		conversion.Income,
+		conversion.ClickDay,
+		conversion.Day,
 	); err != nil {
-		return fmt.Errorf("failed to record click: %w", err)
+		return fmt.Errorf("failed to record conversion: %w", err)
 	}
 
 	return nil
@@ -212,41 +412,237 @@ func (r *Repository) DeleteStatsByCampaignID(ctx context.Context, campaignID uui
 		return fmt.Errorf("failed to delete clicks: %w", err)
 	}
 
+	// Удаляем недействительные клики
+	deleteInvalidClicksQuery := `
+        ALTER TABLE invalid_clicks 
+        DELETE WHERE campaign_id = ?
+    `
+	if err := r.conn.Exec(ctx, deleteInvalidClicksQuery, campaignID); err != nil {
+		return fmt.Errorf("failed to delete invalid clicks: %w", err)
+	}
+
+	// Удаляем конверсии
+	deleteConversionsQuery := `
+        ALTER TABLE ad_conversions 
+        DELETE WHERE campaign_id = ?
+    `
+	if err := r.conn.Exec(ctx, deleteConversionsQuery, campaignID); err != nil {
+		return fmt.Errorf("failed to delete conversions: %w", err)
+	}
+
+	// Удаляем предагрегированную статистику
+	for _, table := range []string{"campaign_daily_stats", "advertiser_daily_stats"} {
+		deleteRollupQuery := fmt.Sprintf(`
+        ALTER TABLE %s 
+        DELETE WHERE campaign_id = ?
+    `, table)
+		if err := r.conn.Exec(ctx, deleteRollupQuery, campaignID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", table, err)
+		}
+	}
+
 	return nil
 }
 
+// ClientEvents возвращает все события клиента для выгрузки его данных
+func (r *Repository) ClientEvents(ctx context.Context, clientID uuid.UUID) (*ClientEvents, error) {
+	impressions, err := r.clientImpressions(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	clicks, err := r.clientClicks(ctx, `
+        SELECT campaign_id, advertiser_id, day, ip, '', clicked_at
+        FROM ad_clicks
+        WHERE client_id = ?
+        ORDER BY day, clicked_at
+    `, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clicks: %w", err)
+	}
+
+	invalidClicks, err := r.clientClicks(ctx, `
+        SELECT campaign_id, advertiser_id, day, ip, reason, clicked_at
+        FROM invalid_clicks
+        WHERE client_id = ?
+        ORDER BY day, clicked_at
+    `, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invalid clicks: %w", err)
+	}
+
+	conversions, err := r.clientConversions(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientEvents{
+		Impressions:   impressions,
+		Clicks:        clicks,
+		InvalidClicks: invalidClicks,
+		Conversions:   conversions,
+	}, nil
+}
+
+func (r *Repository) clientImpressions(ctx context.Context, clientID uuid.UUID) ([]*ClientImpression, error) {
+	// Каждый просмотр добавляет строку, поэтому берем последнее значение счетчика за день
+	query := `
+        SELECT campaign_id, any(advertiser_id), day, max(view_count), max(shown_at)
+        FROM ad_impressions
+        WHERE client_id = ?
+        GROUP BY campaign_id, day
+        ORDER BY day, campaign_id
+    `
+
+	rows, err := r.conn.Query(ctx, query, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query impressions: %w", err)
+	}
+	defer rows.Close()
+
+	var impressions []*ClientImpression
+	for rows.Next() {
+		var impression ClientImpression
+		if err := rows.Scan(&impression.CampaignID, &impression.AdvertiserID, &impression.Day, &impression.ViewCount, &impression.ShownAt); err != nil {
+			return nil, fmt.Errorf("failed to scan impression: %w", err)
+		}
+		impressions = append(impressions, &impression)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating impressions: %w", err)
+	}
+
+	return impressions, nil
+}
+
+func (r *Repository) clientClicks(ctx context.Context, query string, clientID uuid.UUID) ([]*ClientClick, error) {
+	rows, err := r.conn.Query(ctx, query, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var clicks []*ClientClick
+	for rows.Next() {
+		var click ClientClick
+		if err := rows.Scan(&click.CampaignID, &click.AdvertiserID, &click.Day, &click.IP, &click.Reason, &click.ClickedAt); err != nil {
+			return nil, err
+		}
+		clicks = append(clicks, &click)
+	}
+
+	return clicks, rows.Err()
+}
+
+func (r *Repository) clientConversions(ctx context.Context, clientID uuid.UUID) ([]*ClientConversion, error) {
+	query := `
+        SELECT campaign_id, advertiser_id, value, click_day, day
+        FROM ad_conversions
+        WHERE client_id = ?
+        ORDER BY day, campaign_id
+    `
+
+	rows, err := r.conn.Query(ctx, query, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query conversions: %w", err)
+	}
+	defer rows.Close()
+
+	var conversions []*ClientConversion
+	for rows.Next() {
+		var conversion ClientConversion
+		if err := rows.Scan(&conversion.CampaignID, &conversion.AdvertiserID, &conversion.Value, &conversion.ClickDay, &conversion.Day); err != nil {
+			return nil, fmt.Errorf("failed to scan conversion: %w", err)
+		}
+		conversions = append(conversions, &conversion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating conversions: %w", err)
+	}
+
+	return conversions, nil
+}
+
+// DeleteClientEvents удаляет все события клиента. Используется легковесный DELETE, а не мутация:
+// строки перестают читаться сразу после запроса, а не после фонового переписывания партов.
+// Предагрегированная статистика не содержит client_id и не меняется, поэтому статистика кампаний и биллинг сохраняются
+func (r *Repository) DeleteClientEvents(ctx context.Context, clientID uuid.UUID) error {
+	for _, table := range []string{"ad_impressions", "ad_clicks", "invalid_clicks", "ad_conversions"} {
+		query := fmt.Sprintf(`
+        DELETE FROM %s
+        WHERE client_id = ?
+    `, table)
+		if err := r.conn.Exec(ctx, query, clientID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// DailyIncome возвращает оплачиваемые события по кампаниям за дни from..to-1
+func (r *Repository) DailyIncome(ctx context.Context, from, to int) ([]*CampaignDayIncome, error) {
+	query := `
+		SELECT
+			campaign_id,
+			any(advertiser_id),
+			day,
+			sum(impressions),
+			sum(clicks),
+			sum(conversions),
+			sum(impressions_income),
+			sum(clicks_income),
+			sum(conversions_income)
+		FROM campaign_daily_stats
+		WHERE day >= ? AND day < ?
+		GROUP BY campaign_id, day
+		ORDER BY day, campaign_id
+	`
+
+	rows, err := r.conn.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query daily income: %w", err)
+	}
+	defer rows.Close()
+
+	var incomes []*CampaignDayIncome
+	for rows.Next() {
+		var income CampaignDayIncome
+		if err := rows.Scan(&income.CampaignID, &income.AdvertiserID, &income.Day, &income.Impressions, &income.Clicks, &income.Conversions, &income.ImpressionsIncome, &income.ClicksIncome, &income.ConversionsIncome); err != nil {
+			return nil, fmt.Errorf("failed to scan daily income: %w", err)
+		}
+		incomes = append(incomes, &income)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating daily income: %w", err)
+	}
+
+	return incomes, nil
+}
+
 // CampaignStats возвращает статистику по кампании
 func (r *Repository) CampaignStats(ctx context.Context, campaignID uuid.UUID) (*Stats, error) {
 	query := `
-		WITH 
-			impressions AS (
-				SELECT 
-					count(*) as imp_count,
-					sum(income) as imp_income
-				FROM ad_impressions 
-				WHERE campaign_id = ?
-			),
-			clicks AS (
-				SELECT 
-					count(*) as click_count,
-					sum(income) as click_income
-				FROM ad_clicks 
-				WHERE campaign_id = ?
-			)
 		SELECT 
-			imp_count as impressions,
-			click_count as clicks,
-			if(imp_count > 0, click_count/imp_count * 100, 0) as conversion,
-			coalesce(imp_income, 0) as impression_income,
-			coalesce(click_income, 0) as click_income,
-			coalesce(imp_income, 0) + coalesce(click_income, 0) as total_income
-		FROM impressions
-		CROSS JOIN clicks
+			sum(impressions) as impressions_count,
+			sum(clicks) as clicks_count,
+			if(impressions_count > 0, clicks_count/impressions_count * 100, 0) as conversion,
+			sum(conversions) as conversions_count,
+			sum(conversions_value) as conversions_value_sum,
+			sum(impressions_income) as impression_income,
+			sum(clicks_income) as click_income,
+			sum(conversions_income) as action_income,
+			impression_income + click_income + action_income as total_income
+		FROM campaign_daily_stats
+		WHERE campaign_id = ?
 	`
 
 	var stats Stats
-	row := r.conn.QueryRow(ctx, query, campaignID, campaignID)
-	if err := row.Scan(&stats.ImpressionsCount, &stats.ClicksCount, &stats.Conversion, &stats.SpentImpressions, &stats.SpentClicks, &stats.SpentTotal); err != nil {
+	row := r.conn.QueryRow(ctx, query, campaignID)
+	if err := row.Scan(&stats.ImpressionsCount, &stats.ClicksCount, &stats.Conversion, &stats.ConversionsCount, &stats.ConversionsValue, &stats.SpentImpressions, &stats.SpentClicks, &stats.SpentActions, &stats.SpentTotal); err != nil {
 		return nil, fmt.Errorf("failed to get campaign stats: %w", err)
 	}
 
@@ -256,39 +652,24 @@ func (r *Repository) CampaignStats(ctx context.Context, campaignID uuid.UUID) (*
 // CampaignDailyStats возвращает ежедневную статистику по кампании
 func (r *Repository) CampaignDailyStats(ctx context.Context, campaignID uuid.UUID) ([]*StatsDaily, error) {
 	query := `
-		WITH 
-			daily_impressions AS (
-				SELECT 
-					day,
-					count(*) as imp_count,
-					sum(income) as imp_income
-				FROM ad_impressions 
-				WHERE campaign_id = ?
-				GROUP BY day
-			),
-			daily_clicks AS (
-				SELECT 
-					day,
-					count(*) as click_count,
-					sum(income) as click_income
-				FROM ad_clicks 
-				WHERE campaign_id = ?
-				GROUP BY day
-			)
 		SELECT 
-			di.day,
-			di.imp_count as impressions,
-			COALESCE(dc.click_count, 0) as clicks,
-			if(di.imp_count > 0, COALESCE(dc.click_count, 0)/di.imp_count * 100, 0) as conversion,
-			COALESCE(di.imp_income, 0) as impression_income,
-			COALESCE(dc.click_income, 0) as click_income,
-			COALESCE(di.imp_income, 0) + COALESCE(dc.click_income, 0) as total_income
-		FROM daily_impressions di
-		LEFT JOIN daily_clicks dc ON dc.day = di.day
-		ORDER BY di.day
+			day,
+			sum(impressions) as impressions_count,
+			sum(clicks) as clicks_count,
+			if(impressions_count > 0, clicks_count/impressions_count * 100, 0) as conversion,
+			sum(conversions) as conversions_count,
+			sum(conversions_value) as conversions_value_sum,
+			sum(impressions_income) as impression_income,
+			sum(clicks_income) as click_income,
+			sum(conversions_income) as action_income,
+			impression_income + click_income + action_income as total_income
+		FROM campaign_daily_stats
+		WHERE campaign_id = ?
+		GROUP BY day
+		ORDER BY day
 	`
 
-	rows, err := r.conn.Query(ctx, query, campaignID, campaignID)
+	rows, err := r.conn.Query(ctx, query, campaignID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query daily campaign stats: %w", err)
 	}
@@ -297,7 +678,7 @@ func (r *Repository) CampaignDailyStats(ctx context.Context, campaignID uuid.UUI
 	var stats []*StatsDaily
 	for rows.Next() {
 		var stat StatsDaily
-		if err := rows.Scan(&stat.Date, &stat.ImpressionsCount, &stat.ClicksCount, &stat.Conversion, &stat.SpentImpressions, &stat.SpentClicks, &stat.SpentTotal); err != nil {
+		if err := rows.Scan(&stat.Date, &stat.ImpressionsCount, &stat.ClicksCount, &stat.Conversion, &stat.ConversionsCount, &stat.ConversionsValue, &stat.SpentImpressions, &stat.SpentClicks, &stat.SpentActions, &stat.SpentTotal); err != nil {
 			return nil, fmt.Errorf("failed to scan daily campaign stats: %w", err)
 		}
 		stats = append(stats, &stat)
@@ -313,35 +694,23 @@ func (r *Repository) CampaignDailyStats(ctx context.Context, campaignID uuid.UUI
 // AdvertiserStats возвращает статистику по рекламодателю
 func (r *Repository) AdvertiserStats(ctx context.Context, advertiserID uuid.UUID) (*Stats, error) {
 	query := `
-		WITH 
-			impressions AS (
-				SELECT 
-					count(*) as imp_count,
-					sum(income) as imp_income
-				FROM ad_impressions 
-				WHERE advertiser_id = ?
-			),
-			clicks AS (
-				SELECT 
-					count(*) as click_count,
-					sum(income) as click_income
-				FROM ad_clicks 
-				WHERE advertiser_id = ?
-			)
 		SELECT 
-			imp_count as impressions,
-			click_count as clicks,
-			if(imp_count > 0, click_count/imp_count * 100, 0) as conversion,
-			coalesce(imp_income, 0) as impression_income,
-			coalesce(click_income, 0) as click_income,
-			coalesce(imp_income, 0) + coalesce(click_income, 0) as total_income
-		FROM impressions
-		CROSS JOIN clicks
+			sum(impressions) as impressions_count,
+			sum(clicks) as clicks_count,
+			if(impressions_count > 0, clicks_count/impressions_count * 100, 0) as conversion,
+			sum(conversions) as conversions_count,
+			sum(conversions_value) as conversions_value_sum,
+			sum(impressions_income) as impression_income,
+			sum(clicks_income) as click_income,
+			sum(conversions_income) as action_income,
+			impression_income + click_income + action_income as total_income
+		FROM advertiser_daily_stats
+		WHERE advertiser_id = ?
 	`
 
 	var stats Stats
-	row := r.conn.QueryRow(ctx, query, advertiserID, advertiserID)
-	if err := row.Scan(&stats.ImpressionsCount, &stats.ClicksCount, &stats.Conversion, &stats.SpentImpressions, &stats.SpentClicks, &stats.SpentTotal); err != nil {
+	row := r.conn.QueryRow(ctx, query, advertiserID)
+	if err := row.Scan(&stats.ImpressionsCount, &stats.ClicksCount, &stats.Conversion, &stats.ConversionsCount, &stats.ConversionsValue, &stats.SpentImpressions, &stats.SpentClicks, &stats.SpentActions, &stats.SpentTotal); err != nil {
 		return nil, fmt.Errorf("failed to get advertiser stats: %w", err)
 	}
 
@@ -351,39 +720,24 @@ func (r *Repository) AdvertiserStats(ctx context.Context, advertiserID uuid.UUID
 // AdvertiserDailyStats возвращает ежедневную статистику по рекламодателю
 func (r *Repository) AdvertiserDailyStats(ctx context.Context, advertiserID uuid.UUID) ([]*StatsDaily, error) {
 	query := `
-		WITH 
-			daily_impressions AS (
-				SELECT 
-					day,
-					count(*) as imp_count,
-					sum(income) as imp_income
-				FROM ad_impressions 
-				WHERE advertiser_id = ?
-				GROUP BY day
-			),
-			daily_clicks AS (
-				SELECT 
-					day,
-					count(*) as click_count,
-					sum(income) as click_income
-				FROM ad_clicks 
-				WHERE advertiser_id = ?
-				GROUP BY day
-			)
 		SELECT 
-			di.day,
-			di.imp_count as impressions,
-			COALESCE(dc.click_count, 0) as clicks,
-			if(di.imp_count > 0, COALESCE(dc.click_count, 0)/di.imp_count * 100, 0) as conversion,
-			COALESCE(di.imp_income, 0) as impression_income,
-			COALESCE(dc.click_income, 0) as click_income,
-			COALESCE(di.imp_income, 0) + COALESCE(dc.click_income, 0) as total_income
-		FROM daily_impressions di
-		LEFT JOIN daily_clicks dc ON dc.day = di.day
-		ORDER BY di.day
+			day,
+			sum(impressions) as impressions_count,
+			sum(clicks) as clicks_count,
+			if(impressions_count > 0, clicks_count/impressions_count * 100, 0) as conversion,
+			sum(conversions) as conversions_count,
+			sum(conversions_value) as conversions_value_sum,
+			sum(impressions_income) as impression_income,
+			sum(clicks_income) as click_income,
+			sum(conversions_income) as action_income,
+			impression_income + click_income + action_income as total_income
+		FROM advertiser_daily_stats
+		WHERE advertiser_id = ?
+		GROUP BY day
+		ORDER BY day
 	`
 
-	rows, err := r.conn.Query(ctx, query, advertiserID, advertiserID)
+	rows, err := r.conn.Query(ctx, query, advertiserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query daily advertiser stats: %w", err)
 	}
@@ -392,7 +746,7 @@ func (r *Repository) AdvertiserDailyStats(ctx context.Context, advertiserID uuid
 	var stats []*StatsDaily
 	for rows.Next() {
 		var stat StatsDaily
-		if err := rows.Scan(&stat.Date, &stat.ImpressionsCount, &stat.ClicksCount, &stat.Conversion, &stat.SpentImpressions, &stat.SpentClicks, &stat.SpentTotal); err != nil {
+		if err := rows.Scan(&stat.Date, &stat.ImpressionsCount, &stat.ClicksCount, &stat.Conversion, &stat.ConversionsCount, &stat.ConversionsValue, &stat.SpentImpressions, &stat.SpentClicks, &stat.SpentActions, &stat.SpentTotal); err != nil {
 			return nil, fmt.Errorf("failed to scan daily advertiser stats: %w", err)
 		}
 		stats = append(stats, &stat)
@@ -405,6 +759,60 @@ func (r *Repository) AdvertiserDailyStats(ctx context.Context, advertiserID uuid
 	return stats, nil
 }
 
+// StreamCampaignDailyStats построчно передает в fn ежедневную статистику кампании за дни [from, to]
+func (r *Repository) StreamCampaignDailyStats(ctx context.Context, campaignID uuid.UUID, from, to int, fn func(*StatsDaily) error) error {
+	return r.streamDailyStats(ctx, "campaign_daily_stats", "campaign_id", campaignID, from, to, fn)
+}
+
+// StreamAdvertiserDailyStats построчно передает в fn ежедневную статистику рекламодателя за дни [from, to]
+func (r *Repository) StreamAdvertiserDailyStats(ctx context.Context, advertiserID uuid.UUID, from, to int, fn func(*StatsDaily) error) error {
+	return r.streamDailyStats(ctx, "advertiser_daily_stats", "advertiser_id", advertiserID, from, to, fn)
+}
+
+// streamDailyStats читает ежедневную статистику из rollup-таблицы по мере получения строк от ClickHouse,
+// не загружая весь результат в память. Чтение прерывается, если fn возвращает ошибку
+func (r *Repository) streamDailyStats(ctx context.Context, table, keyColumn string, id uuid.UUID, from, to int, fn func(*StatsDaily) error) error {
+	query := fmt.Sprintf(`
+		SELECT 
+			day,
+			sum(impressions) as impressions_count,
+			sum(clicks) as clicks_count,
+			if(impressions_count > 0, clicks_count/impressions_count * 100, 0) as conversion,
+			sum(conversions) as conversions_count,
+			sum(conversions_value) as conversions_value_sum,
+			sum(impressions_income) as impression_income,
+			sum(clicks_income) as click_income,
+			sum(conversions_income) as action_income,
+			impression_income + click_income + action_income as total_income
+		FROM %s
+		WHERE %s = ? AND day BETWEEN ? AND ?
+		GROUP BY day
+		ORDER BY day
+	`, table, keyColumn)
+
+	rows, err := r.conn.Query(ctx, query, id, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to query daily stats from %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var stat StatsDaily
+		if err := rows.Scan(&stat.Date, &stat.ImpressionsCount, &stat.ClicksCount, &stat.Conversion, &stat.ConversionsCount, &stat.ConversionsValue, &stat.SpentImpressions, &stat.SpentClicks, &stat.SpentActions, &stat.SpentTotal); err != nil {
+			return fmt.Errorf("failed to scan daily stats from %s: %w", table, err)
+		}
+		if err := fn(&stat); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating daily stats from %s: %w", table, err)
+	}
+
+	return nil
+}
+
 func (r *Repository) UserCampaignsStats(ctx context.Context, campaignIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID]*UserCampaignStats, error) {
 	if len(campaignIDs) == 0 {
 		return make(map[uuid.UUID]*UserCampaignStats), nil
@@ -416,65 +824,59 @@ func (r *Repository) UserCampaignsStats(ctx context.Context, campaignIDs []uuid.
 		campaignIDStrings[i] = fmt.Sprintf("toUUID('%s')", id.String())
 	}
 
+	// Общее количество показов и кликов берется из предагрегированной таблицы,
+	// по сырым таблицам проверяется только взаимодействие конкретного пользователя
 	query := `
 		SELECT
 			campaign_id,
-			countIf(type = 'impression') as impressions_count,
-			countIf(type = 'click') as clicks_count,
+			sum(impressions) as impressions_count,
+			sum(clicks) as clicks_count,
 			max(is_viewed_by_user) as is_viewed,
 			max(is_clicked_by_user) as is_clicked
 		FROM
 		(
-			-- Показы
+			-- Общая статистика
 			SELECT
 				campaign_id,
-				'impression' as type,
-				1 as is_viewed_by_user,
-				0 as is_clicked_by_user
-			FROM ad_impressions
+				impressions,
+				clicks,
+				toUInt8(0) as is_viewed_by_user,
+				toUInt8(0) as is_clicked_by_user
+			FROM campaign_daily_stats
 			WHERE campaign_id IN (%s)
-				AND client_id = toUUID(?)
 			
 			UNION ALL
 			
-			-- Клики
-			SELECT
-				campaign_id,
-				'click' as type,
-				0 as is_viewed_by_user,
-				1 as is_clicked_by_user
-			FROM ad_clicks
-			WHERE campaign_id IN (%s)
-				AND client_id = toUUID(?)
-			
-			UNION ALL
-			
-			-- Общая статистика показов
+			-- Показы
 			SELECT
 				campaign_id,
-				'impression' as type,
-				0 as is_viewed_by_user,
-				0 as is_clicked_by_user
+				toUInt64(0) as impressions,
+				toUInt64(0) as clicks,
+				toUInt8(1) as is_viewed_by_user,
+				toUInt8(0) as is_clicked_by_user
 			FROM ad_impressions
 			WHERE campaign_id IN (%s)
+				AND client_id = toUUID(?)
 			
 			UNION ALL
 			
-			-- Общая статистика кликов
+			-- Клики
 			SELECT
 				campaign_id,
-				'click' as type,
-				0 as is_viewed_by_user,
-				0 as is_clicked_by_user
+				toUInt64(0) as impressions,
+				toUInt64(0) as clicks,
+				toUInt8(0) as is_viewed_by_user,
+				toUInt8(1) as is_clicked_by_user
 			FROM ad_clicks
 			WHERE campaign_id IN (%s)
+				AND client_id = toUUID(?)
 		)
 		GROUP BY campaign_id
 	`
 
 	// Форматируем запрос, подставляя списки UUID
 	campaignIDsStr := strings.Join(campaignIDStrings, ", ")
-	formattedQuery := fmt.Sprintf(query, campaignIDsStr, campaignIDsStr, campaignIDsStr, campaignIDsStr)
+	formattedQuery := fmt.Sprintf(query, campaignIDsStr, campaignIDsStr, campaignIDsStr)
 
 	// Выполняем запрос с параметрами для client_id
 	args := []interface{}{
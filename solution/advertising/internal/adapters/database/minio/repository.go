@@ -3,18 +3,23 @@ package minio
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
 type AdImagesRepository interface {
 	UploadImage(ctx context.Context, campaignID string, imageData io.Reader) (string, error)
-	GetImage(ctx context.Context, campaignID string) (string, error)
-	DeleteImage(ctx context.Context, campaignID string) error
+	DeleteImage(ctx context.Context, imageURL string) error
+	// DeleteCampaignObjects deletes every object stored under the campaign prefix
+	DeleteCampaignObjects(ctx context.Context, campaignID string) error
 }
 
 type repository struct {
@@ -90,10 +95,10 @@ func isImageContentType(contentType string) bool {
 	return strings.HasPrefix(contentType, "image/")
 }
 
-// UploadImage uploads an image for an advertising campaign
+// UploadImage uploads an image for an advertising campaign. The object key is derived from the image content,
+// so an upload never overwrites the image the campaign is currently serving: the caller switches image_url
+// to the returned URL and deletes the previous object when it is no longer referenced
 func (r *repository) UploadImage(ctx context.Context, campaignID string, imageData io.Reader) (string, error) {
-	objectName := fmt.Sprintf("campaigns/%s/image", campaignID)
-
 	// Сохраняем в буфер
 	var buf bytes.Buffer
 	size, err := io.Copy(&buf, imageData)
@@ -109,6 +114,9 @@ func (r *repository) UploadImage(ctx context.Context, campaignID string, imageDa
 		return "", ErrFileNotImage
 	}
 
+	sum := sha256.Sum256(buf.Bytes())
+	objectName := fmt.Sprintf("campaigns/%s/%s", campaignID, hex.EncodeToString(sum[:]))
+
 	_, err = r.client.PutObject(ctx, r.bucketName, objectName, &buf, size, minio.PutObjectOptions{
 		ContentType: contentType,
 	})
@@ -119,27 +127,73 @@ func (r *repository) UploadImage(ctx context.Context, campaignID string, imageDa
 	return r.getPublicURL(objectName), nil
 }
 
-// GetImage returns a public URL for an advertising campaign image
-func (r *repository) GetImage(ctx context.Context, campaignID string) (string, error) {
-	objectName := fmt.Sprintf("campaigns/%s/image", campaignID)
+// DeleteImage deletes the campaign image object the public URL points to
+func (r *repository) DeleteImage(ctx context.Context, imageURL string) error {
+	objectName, err := r.objectName(imageURL)
+	if err != nil {
+		return err
+	}
 
-	// Проверяем существование объекта
-	_, err := r.client.StatObject(ctx, r.bucketName, objectName, minio.StatObjectOptions{})
+	err = r.client.RemoveObject(ctx, r.bucketName, objectName, minio.RemoveObjectOptions{})
 	if err != nil {
-		return "", fmt.Errorf("image not found: %w", err)
+		return fmt.Errorf("failed to delete image: %w", err)
 	}
 
-	return r.getPublicURL(objectName), nil
+	return nil
 }
 
-// DeleteImage deletes an image for an advertising campaign
-func (r *repository) DeleteImage(ctx context.Context, campaignID string) error {
-	objectName := fmt.Sprintf("campaigns/%s/image", campaignID)
-
-	err := r.client.RemoveObject(ctx, r.bucketName, objectName, minio.RemoveObjectOptions{})
+// objectName возвращает имя объекта по публичному URL. Хост не проверяется: он мог измениться вместе с http-endpoint
+func (r *repository) objectName(imageURL string) (string, error) {
+	u, err := url.Parse(imageURL)
 	if err != nil {
-		return fmt.Errorf("failed to delete image: %w", err)
+		return "", fmt.Errorf("failed to parse image url: %w", err)
 	}
 
+	objectName, ok := strings.CutPrefix(u.Path, "/"+r.bucketName+"/")
+	if !ok || objectName == "" {
+		return "", fmt.Errorf("image url %q is not in bucket %s", imageURL, r.bucketName)
+	}
+	return objectName, nil
+}
+
+// DeleteCampaignObjects deletes every object under campaigns/<id>/: the current image, the approved one
+// and anything added later. Objects that are already gone are not an error
+func (r *repository) DeleteCampaignObjects(ctx context.Context, campaignID string) error {
+	objects := make(chan minio.ObjectInfo)
+	var listErr error
+	go func() {
+		defer close(objects)
+		for object := range r.client.ListObjects(ctx, r.bucketName, minio.ListObjectsOptions{
+			Prefix:    fmt.Sprintf("campaigns/%s/", campaignID),
+			Recursive: true,
+		}) {
+			if object.Err != nil {
+				listErr = object.Err
+				return
+			}
+			objects <- object
+		}
+	}()
+
+	// Канал результатов читается до конца, иначе горутины удаления не завершатся
+	var removeErr error
+	for result := range r.client.RemoveObjects(ctx, r.bucketName, objects, minio.RemoveObjectsOptions{}) {
+		if removeErr == nil && result.Err != nil && !isNotFound(result.Err) {
+			removeErr = result.Err
+		}
+	}
+
+	if err := errors.Join(listErr, removeErr); err != nil {
+		return fmt.Errorf("failed to delete campaign objects: %w", err)
+	}
 	return nil
 }
+
+// isNotFound проверяет, что объекта уже нет в бакете
+func isNotFound(err error) bool {
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey", "NoSuchPrefix":
+		return true
+	}
+	return false
+}
@@ -18,7 +18,9 @@ type Advertiser struct {
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
-	Name         string `json:"name,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance      float64 `json:"balance,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -27,6 +29,8 @@ func (*Advertiser) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case advertiser.FieldBalance:
+			values[i] = new(sql.NullFloat64)
 		case advertiser.FieldName:
 			values[i] = new(sql.NullString)
 		case advertiser.FieldID:
@@ -58,6 +62,12 @@ func (a *Advertiser) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Name = value.String
 			}
+		case advertiser.FieldBalance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				a.Balance = value.Float64
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -96,6 +106,9 @@ func (a *Advertiser) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
 	builder.WriteString("name=")
 	builder.WriteString(a.Name)
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", a.Balance))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -14,6 +14,8 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
 	// Table holds the table name of the advertiser in the database.
 	Table = "advertisers"
 )
@@ -22,6 +24,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldBalance,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -37,6 +40,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultBalance holds the default value on creation for the "balance" field.
+	DefaultBalance float64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -53,3 +58,8 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
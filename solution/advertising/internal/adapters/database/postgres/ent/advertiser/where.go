@@ -59,6 +59,11 @@ func Name(v string) predicate.Advertiser {
 	return predicate.Advertiser(sql.FieldEQ(FieldName, v))
 }
 
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldEQ(FieldBalance, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Advertiser {
 	return predicate.Advertiser(sql.FieldEQ(FieldName, v))
@@ -124,6 +129,46 @@ func NameContainsFold(v string) predicate.Advertiser {
 	return predicate.Advertiser(sql.FieldContainsFold(FieldName, v))
 }
 
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v float64) predicate.Advertiser {
+	return predicate.Advertiser(sql.FieldLTE(FieldBalance, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Advertiser) predicate.Advertiser {
 	return predicate.Advertiser(sql.AndPredicates(predicates...))
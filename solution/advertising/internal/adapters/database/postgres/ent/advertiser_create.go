@@ -29,6 +29,20 @@ func (ac *AdvertiserCreate) SetName(s string) *AdvertiserCreate {
 	return ac
 }
 
+// SetBalance sets the "balance" field.
+func (ac *AdvertiserCreate) SetBalance(f float64) *AdvertiserCreate {
+	ac.mutation.SetBalance(f)
+	return ac
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (ac *AdvertiserCreate) SetNillableBalance(f *float64) *AdvertiserCreate {
+	if f != nil {
+		ac.SetBalance(*f)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AdvertiserCreate) SetID(u uuid.UUID) *AdvertiserCreate {
 	ac.mutation.SetID(u)
@@ -78,6 +92,10 @@ func (ac *AdvertiserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ac *AdvertiserCreate) defaults() {
+	if _, ok := ac.mutation.Balance(); !ok {
+		v := advertiser.DefaultBalance
+		ac.mutation.SetBalance(v)
+	}
 	if _, ok := ac.mutation.ID(); !ok {
 		v := advertiser.DefaultID()
 		ac.mutation.SetID(v)
@@ -94,6 +112,9 @@ func (ac *AdvertiserCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Advertiser.name": %w`, err)}
 		}
 	}
+	if _, ok := ac.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Advertiser.balance"`)}
+	}
 	return nil
 }
 
@@ -134,6 +155,10 @@ func (ac *AdvertiserCreate) createSpec() (*Advertiser, *sqlgraph.CreateSpec) {
 		_spec.SetField(advertiser.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := ac.mutation.Balance(); ok {
+		_spec.SetField(advertiser.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
 	return _node, _spec
 }
 
@@ -198,6 +223,24 @@ func (u *AdvertiserUpsert) UpdateName() *AdvertiserUpsert {
 	return u
 }
 
+// SetBalance sets the "balance" field.
+func (u *AdvertiserUpsert) SetBalance(v float64) *AdvertiserUpsert {
+	u.Set(advertiser.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *AdvertiserUpsert) UpdateBalance() *AdvertiserUpsert {
+	u.SetExcluded(advertiser.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *AdvertiserUpsert) AddBalance(v float64) *AdvertiserUpsert {
+	u.Add(advertiser.FieldBalance, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -260,6 +303,27 @@ func (u *AdvertiserUpsertOne) UpdateName() *AdvertiserUpsertOne {
 	})
 }
 
+// SetBalance sets the "balance" field.
+func (u *AdvertiserUpsertOne) SetBalance(v float64) *AdvertiserUpsertOne {
+	return u.Update(func(s *AdvertiserUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *AdvertiserUpsertOne) AddBalance(v float64) *AdvertiserUpsertOne {
+	return u.Update(func(s *AdvertiserUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *AdvertiserUpsertOne) UpdateBalance() *AdvertiserUpsertOne {
+	return u.Update(func(s *AdvertiserUpsert) {
+		s.UpdateBalance()
+	})
+}
+
 // Exec executes the query.
 func (u *AdvertiserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -489,6 +553,27 @@ func (u *AdvertiserUpsertBulk) UpdateName() *AdvertiserUpsertBulk {
 	})
 }
 
+// SetBalance sets the "balance" field.
+func (u *AdvertiserUpsertBulk) SetBalance(v float64) *AdvertiserUpsertBulk {
+	return u.Update(func(s *AdvertiserUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *AdvertiserUpsertBulk) AddBalance(v float64) *AdvertiserUpsertBulk {
+	return u.Update(func(s *AdvertiserUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *AdvertiserUpsertBulk) UpdateBalance() *AdvertiserUpsertBulk {
+	return u.Update(func(s *AdvertiserUpsert) {
+		s.UpdateBalance()
+	})
+}
+
 // Exec executes the query.
 func (u *AdvertiserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -41,6 +41,27 @@ func (au *AdvertiserUpdate) SetNillableName(s *string) *AdvertiserUpdate {
 	return au
 }
 
+// SetBalance sets the "balance" field.
+func (au *AdvertiserUpdate) SetBalance(f float64) *AdvertiserUpdate {
+	au.mutation.ResetBalance()
+	au.mutation.SetBalance(f)
+	return au
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (au *AdvertiserUpdate) SetNillableBalance(f *float64) *AdvertiserUpdate {
+	if f != nil {
+		au.SetBalance(*f)
+	}
+	return au
+}
+
+// AddBalance adds f to the "balance" field.
+func (au *AdvertiserUpdate) AddBalance(f float64) *AdvertiserUpdate {
+	au.mutation.AddBalance(f)
+	return au
+}
+
 // Mutation returns the AdvertiserMutation object of the builder.
 func (au *AdvertiserUpdate) Mutation() *AdvertiserMutation {
 	return au.mutation
@@ -98,6 +119,12 @@ func (au *AdvertiserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Name(); ok {
 		_spec.SetField(advertiser.FieldName, field.TypeString, value)
 	}
+	if value, ok := au.mutation.Balance(); ok {
+		_spec.SetField(advertiser.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedBalance(); ok {
+		_spec.AddField(advertiser.FieldBalance, field.TypeFloat64, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{advertiser.Label}
@@ -132,6 +159,27 @@ func (auo *AdvertiserUpdateOne) SetNillableName(s *string) *AdvertiserUpdateOne
 	return auo
 }
 
+// SetBalance sets the "balance" field.
+func (auo *AdvertiserUpdateOne) SetBalance(f float64) *AdvertiserUpdateOne {
+	auo.mutation.ResetBalance()
+	auo.mutation.SetBalance(f)
+	return auo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (auo *AdvertiserUpdateOne) SetNillableBalance(f *float64) *AdvertiserUpdateOne {
+	if f != nil {
+		auo.SetBalance(*f)
+	}
+	return auo
+}
+
+// AddBalance adds f to the "balance" field.
+func (auo *AdvertiserUpdateOne) AddBalance(f float64) *AdvertiserUpdateOne {
+	auo.mutation.AddBalance(f)
+	return auo
+}
+
 // Mutation returns the AdvertiserMutation object of the builder.
 func (auo *AdvertiserUpdateOne) Mutation() *AdvertiserMutation {
 	return auo.mutation
@@ -219,6 +267,12 @@ func (auo *AdvertiserUpdateOne) sqlSave(ctx context.Context) (_node *Advertiser,
 	if value, ok := auo.mutation.Name(); ok {
 		_spec.SetField(advertiser.FieldName, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.Balance(); ok {
+		_spec.SetField(advertiser.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedBalance(); ok {
+		_spec.AddField(advertiser.FieldBalance, field.TypeFloat64, value)
+	}
 	_node = &Advertiser{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/apikey"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUpdate is the builder for updating APIKey entities.
+type APIKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (aku *APIKeyUpdate) Where(ps ...predicate.APIKey) *APIKeyUpdate {
+	aku.mutation.Where(ps...)
+	return aku
+}
+
+// SetName sets the "name" field.
+func (aku *APIKeyUpdate) SetName(s string) *APIKeyUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableName(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetName(*s)
+	}
+	return aku
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (aku *APIKeyUpdate) SetRevokedAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetRevokedAt(t)
+	return aku
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableRevokedAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetRevokedAt(*t)
+	}
+	return aku
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (aku *APIKeyUpdate) ClearRevokedAt() *APIKeyUpdate {
+	aku.mutation.ClearRevokedAt()
+	return aku
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (aku *APIKeyUpdate) Mutation() *APIKeyMutation {
+	return aku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aku *APIKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aku.sqlSave, aku.mutation, aku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aku *APIKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := aku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aku *APIKeyUpdate) Exec(ctx context.Context) error {
+	_, err := aku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aku *APIKeyUpdate) ExecX(ctx context.Context) {
+	if err := aku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aku *APIKeyUpdate) check() error {
+	if v, ok := aku.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aku *APIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID))
+	if ps := aku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if aku.mutation.AdvertiserIDCleared() {
+		_spec.ClearField(apikey.FieldAdvertiserID, field.TypeUUID)
+	}
+	if value, ok := aku.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if aku.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aku.mutation.done = true
+	return n, nil
+}
+
+// APIKeyUpdateOne is the builder for updating a single APIKey entity.
+type APIKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// SetName sets the "name" field.
+func (akuo *APIKeyUpdateOne) SetName(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableName(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetName(*s)
+	}
+	return akuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (akuo *APIKeyUpdateOne) SetRevokedAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetRevokedAt(t)
+	return akuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableRevokedAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetRevokedAt(*t)
+	}
+	return akuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (akuo *APIKeyUpdateOne) ClearRevokedAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearRevokedAt()
+	return akuo
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (akuo *APIKeyUpdateOne) Mutation() *APIKeyMutation {
+	return akuo.mutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (akuo *APIKeyUpdateOne) Where(ps ...predicate.APIKey) *APIKeyUpdateOne {
+	akuo.mutation.Where(ps...)
+	return akuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (akuo *APIKeyUpdateOne) Select(field string, fields ...string) *APIKeyUpdateOne {
+	akuo.fields = append([]string{field}, fields...)
+	return akuo
+}
+
+// Save executes the query and returns the updated APIKey entity.
+func (akuo *APIKeyUpdateOne) Save(ctx context.Context) (*APIKey, error) {
+	return withHooks(ctx, akuo.sqlSave, akuo.mutation, akuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) SaveX(ctx context.Context) *APIKey {
+	node, err := akuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (akuo *APIKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := akuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := akuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akuo *APIKeyUpdateOne) check() error {
+	if v, ok := akuo.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (akuo *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err error) {
+	if err := akuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID))
+	id, ok := akuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := akuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := akuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if akuo.mutation.AdvertiserIDCleared() {
+		_spec.ClearField(apikey.FieldAdvertiserID, field.TypeUUID)
+	}
+	if value, ok := akuo.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &APIKey{config: akuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, akuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	akuo.mutation.done = true
+	return _node, nil
+}
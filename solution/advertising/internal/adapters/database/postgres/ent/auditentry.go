@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/auditentry"
+	"nlypage-final/pkg/jsondiff"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// AuditEntry is the model entity for the AuditEntry schema.
+type AuditEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ActorKeyID holds the value of the "actor_key_id" field.
+	ActorKeyID *uuid.UUID `json:"actor_key_id,omitempty"`
+	// ActorName holds the value of the "actor_name" field.
+	ActorName string `json:"actor_name,omitempty"`
+	// ActorRole holds the value of the "actor_role" field.
+	ActorRole string `json:"actor_role,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Entity holds the value of the "entity" field.
+	Entity string `json:"entity,omitempty"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID string `json:"entity_id,omitempty"`
+	// Diff holds the value of the "diff" field.
+	Diff map[string]jsondiff.Change `json:"diff,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldActorKeyID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case auditentry.FieldDiff:
+			values[i] = new([]byte)
+		case auditentry.FieldID:
+			values[i] = new(sql.NullInt64)
+		case auditentry.FieldActorName, auditentry.FieldActorRole, auditentry.FieldAction, auditentry.FieldEntity, auditentry.FieldEntityID:
+			values[i] = new(sql.NullString)
+		case auditentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEntry fields.
+func (ae *AuditEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = int(value.Int64)
+		case auditentry.FieldActorKeyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_key_id", values[i])
+			} else if value.Valid {
+				ae.ActorKeyID = new(uuid.UUID)
+				*ae.ActorKeyID = *value.S.(*uuid.UUID)
+			}
+		case auditentry.FieldActorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_name", values[i])
+			} else if value.Valid {
+				ae.ActorName = value.String
+			}
+		case auditentry.FieldActorRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_role", values[i])
+			} else if value.Valid {
+				ae.ActorRole = value.String
+			}
+		case auditentry.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ae.Action = value.String
+			}
+		case auditentry.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				ae.Entity = value.String
+			}
+		case auditentry.FieldEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				ae.EntityID = value.String
+			}
+		case auditentry.FieldDiff:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field diff", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Diff); err != nil {
+					return fmt.Errorf("unmarshal field diff: %w", err)
+				}
+			}
+		case auditentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditEntry.
+// This includes values selected through modifiers, order, etc.
+func (ae *AuditEntry) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditEntry.
+// Note that you need to call AuditEntry.Unwrap() before calling this method if this AuditEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEntry) Update() *AuditEntryUpdateOne {
+	return NewAuditEntryClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEntry) Unwrap() *AuditEntry {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditEntry is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	if v := ae.ActorKeyID; v != nil {
+		builder.WriteString("actor_key_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("actor_name=")
+	builder.WriteString(ae.ActorName)
+	builder.WriteString(", ")
+	builder.WriteString("actor_role=")
+	builder.WriteString(ae.ActorRole)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(ae.Action)
+	builder.WriteString(", ")
+	builder.WriteString("entity=")
+	builder.WriteString(ae.Entity)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(ae.EntityID)
+	builder.WriteString(", ")
+	builder.WriteString("diff=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Diff))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEntries is a parsable slice of AuditEntry.
+type AuditEntries []*AuditEntry
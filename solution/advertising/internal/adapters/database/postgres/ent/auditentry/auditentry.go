@@ -0,0 +1,105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditentry type in the database.
+	Label = "audit_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldActorKeyID holds the string denoting the actor_key_id field in the database.
+	FieldActorKeyID = "actor_key_id"
+	// FieldActorName holds the string denoting the actor_name field in the database.
+	FieldActorName = "actor_name"
+	// FieldActorRole holds the string denoting the actor_role field in the database.
+	FieldActorRole = "actor_role"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldEntity holds the string denoting the entity field in the database.
+	FieldEntity = "entity"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldDiff holds the string denoting the diff field in the database.
+	FieldDiff = "diff"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditentry in the database.
+	Table = "audit_entries"
+)
+
+// Columns holds all SQL columns for auditentry fields.
+var Columns = []string{
+	FieldID,
+	FieldActorKeyID,
+	FieldActorName,
+	FieldActorRole,
+	FieldAction,
+	FieldEntity,
+	FieldEntityID,
+	FieldDiff,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AuditEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByActorKeyID orders the results by the actor_key_id field.
+func ByActorKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorKeyID, opts...).ToFunc()
+}
+
+// ByActorName orders the results by the actor_name field.
+func ByActorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorName, opts...).ToFunc()
+}
+
+// ByActorRole orders the results by the actor_role field.
+func ByActorRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorRole, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByEntity orders the results by the entity field.
+func ByEntity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntity, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/auditentry"
+	"nlypage-final/pkg/jsondiff"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AuditEntryCreate is the builder for creating a AuditEntry entity.
+type AuditEntryCreate struct {
+	config
+	mutation *AuditEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetActorKeyID sets the "actor_key_id" field.
+func (aec *AuditEntryCreate) SetActorKeyID(u uuid.UUID) *AuditEntryCreate {
+	aec.mutation.SetActorKeyID(u)
+	return aec
+}
+
+// SetNillableActorKeyID sets the "actor_key_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableActorKeyID(u *uuid.UUID) *AuditEntryCreate {
+	if u != nil {
+		aec.SetActorKeyID(*u)
+	}
+	return aec
+}
+
+// SetActorName sets the "actor_name" field.
+func (aec *AuditEntryCreate) SetActorName(s string) *AuditEntryCreate {
+	aec.mutation.SetActorName(s)
+	return aec
+}
+
+// SetNillableActorName sets the "actor_name" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableActorName(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetActorName(*s)
+	}
+	return aec
+}
+
+// SetActorRole sets the "actor_role" field.
+func (aec *AuditEntryCreate) SetActorRole(s string) *AuditEntryCreate {
+	aec.mutation.SetActorRole(s)
+	return aec
+}
+
+// SetNillableActorRole sets the "actor_role" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableActorRole(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetActorRole(*s)
+	}
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEntryCreate) SetAction(s string) *AuditEntryCreate {
+	aec.mutation.SetAction(s)
+	return aec
+}
+
+// SetEntity sets the "entity" field.
+func (aec *AuditEntryCreate) SetEntity(s string) *AuditEntryCreate {
+	aec.mutation.SetEntity(s)
+	return aec
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aec *AuditEntryCreate) SetEntityID(s string) *AuditEntryCreate {
+	aec.mutation.SetEntityID(s)
+	return aec
+}
+
+// SetDiff sets the "diff" field.
+func (aec *AuditEntryCreate) SetDiff(m map[string]jsondiff.Change) *AuditEntryCreate {
+	aec.mutation.SetDiff(m)
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEntryCreate) SetCreatedAt(t time.Time) *AuditEntryCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableCreatedAt(t *time.Time) *AuditEntryCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aec *AuditEntryCreate) Mutation() *AuditEntryMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEntry in the database.
+func (aec *AuditEntryCreate) Save(ctx context.Context) (*AuditEntry, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEntryCreate) SaveX(ctx context.Context) *AuditEntry {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEntryCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEntryCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEntryCreate) defaults() {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditentry.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEntryCreate) check() error {
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditEntry.action"`)}
+	}
+	if _, ok := aec.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "AuditEntry.entity"`)}
+	}
+	if _, ok := aec.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "AuditEntry.entity_id"`)}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEntryCreate) sqlSave(ctx context.Context) (*AuditEntry, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEntryCreate) createSpec() (*AuditEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEntry{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditentry.Table, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = aec.conflict
+	if value, ok := aec.mutation.ActorKeyID(); ok {
+		_spec.SetField(auditentry.FieldActorKeyID, field.TypeUUID, value)
+		_node.ActorKeyID = &value
+	}
+	if value, ok := aec.mutation.ActorName(); ok {
+		_spec.SetField(auditentry.FieldActorName, field.TypeString, value)
+		_node.ActorName = value
+	}
+	if value, ok := aec.mutation.ActorRole(); ok {
+		_spec.SetField(auditentry.FieldActorRole, field.TypeString, value)
+		_node.ActorRole = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.SetField(auditentry.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.Entity(); ok {
+		_spec.SetField(auditentry.FieldEntity, field.TypeString, value)
+		_node.Entity = value
+	}
+	if value, ok := aec.mutation.EntityID(); ok {
+		_spec.SetField(auditentry.FieldEntityID, field.TypeString, value)
+		_node.EntityID = value
+	}
+	if value, ok := aec.mutation.Diff(); ok {
+		_spec.SetField(auditentry.FieldDiff, field.TypeJSON, value)
+		_node.Diff = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(auditentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditEntry.Create().
+//		SetActorKeyID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditEntryUpsert) {
+//			SetActorKeyID(v+v).
+//		}).
+//		Exec(ctx)
+func (aec *AuditEntryCreate) OnConflict(opts ...sql.ConflictOption) *AuditEntryUpsertOne {
+	aec.conflict = opts
+	return &AuditEntryUpsertOne{
+		create: aec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aec *AuditEntryCreate) OnConflictColumns(columns ...string) *AuditEntryUpsertOne {
+	aec.conflict = append(aec.conflict, sql.ConflictColumns(columns...))
+	return &AuditEntryUpsertOne{
+		create: aec,
+	}
+}
+
+type (
+	// AuditEntryUpsertOne is the builder for "upsert"-ing
+	//  one AuditEntry node.
+	AuditEntryUpsertOne struct {
+		create *AuditEntryCreate
+	}
+
+	// AuditEntryUpsert is the "OnConflict" setter.
+	AuditEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AuditEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AuditEntryUpsertOne) UpdateNewValues() *AuditEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ActorKeyID(); exists {
+			s.SetIgnore(auditentry.FieldActorKeyID)
+		}
+		if _, exists := u.create.mutation.ActorName(); exists {
+			s.SetIgnore(auditentry.FieldActorName)
+		}
+		if _, exists := u.create.mutation.ActorRole(); exists {
+			s.SetIgnore(auditentry.FieldActorRole)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(auditentry.FieldAction)
+		}
+		if _, exists := u.create.mutation.Entity(); exists {
+			s.SetIgnore(auditentry.FieldEntity)
+		}
+		if _, exists := u.create.mutation.EntityID(); exists {
+			s.SetIgnore(auditentry.FieldEntityID)
+		}
+		if _, exists := u.create.mutation.Diff(); exists {
+			s.SetIgnore(auditentry.FieldDiff)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditEntryUpsertOne) Ignore() *AuditEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditEntryUpsertOne) DoNothing() *AuditEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditEntryCreate.OnConflict
+// documentation for more info.
+func (u *AuditEntryUpsertOne) Update(set func(*AuditEntryUpsert)) *AuditEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditEntryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditEntryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditEntryCreateBulk is the builder for creating many AuditEntry entities in bulk.
+type AuditEntryCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditEntry entities in the database.
+func (aecb *AuditEntryCreateBulk) Save(ctx context.Context) ([]*AuditEntry, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEntry, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) SaveX(ctx context.Context) []*AuditEntry {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditEntryUpsert) {
+//			SetActorKeyID(v+v).
+//		}).
+//		Exec(ctx)
+func (aecb *AuditEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditEntryUpsertBulk {
+	aecb.conflict = opts
+	return &AuditEntryUpsertBulk{
+		create: aecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aecb *AuditEntryCreateBulk) OnConflictColumns(columns ...string) *AuditEntryUpsertBulk {
+	aecb.conflict = append(aecb.conflict, sql.ConflictColumns(columns...))
+	return &AuditEntryUpsertBulk{
+		create: aecb,
+	}
+}
+
+// AuditEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditEntry nodes.
+type AuditEntryUpsertBulk struct {
+	create *AuditEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AuditEntryUpsertBulk) UpdateNewValues() *AuditEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ActorKeyID(); exists {
+				s.SetIgnore(auditentry.FieldActorKeyID)
+			}
+			if _, exists := b.mutation.ActorName(); exists {
+				s.SetIgnore(auditentry.FieldActorName)
+			}
+			if _, exists := b.mutation.ActorRole(); exists {
+				s.SetIgnore(auditentry.FieldActorRole)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(auditentry.FieldAction)
+			}
+			if _, exists := b.mutation.Entity(); exists {
+				s.SetIgnore(auditentry.FieldEntity)
+			}
+			if _, exists := b.mutation.EntityID(); exists {
+				s.SetIgnore(auditentry.FieldEntityID)
+			}
+			if _, exists := b.mutation.Diff(); exists {
+				s.SetIgnore(auditentry.FieldDiff)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditEntryUpsertBulk) Ignore() *AuditEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditEntryUpsertBulk) DoNothing() *AuditEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditEntryUpsertBulk) Update(set func(*AuditEntryUpsert)) *AuditEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
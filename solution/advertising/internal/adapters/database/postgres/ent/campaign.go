@@ -3,10 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/schema"
 	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -28,18 +31,58 @@ type Campaign struct {
 	CostPerImpression float64 `json:"cost_per_impression,omitempty"`
 	// CostPerClick holds the value of the "cost_per_click" field.
 	CostPerClick float64 `json:"cost_per_click,omitempty"`
+	// CostPerAction holds the value of the "cost_per_action" field.
+	CostPerAction float64 `json:"cost_per_action,omitempty"`
+	// DailyBudget holds the value of the "daily_budget" field.
+	DailyBudget *float64 `json:"daily_budget,omitempty"`
+	// TotalBudget holds the value of the "total_budget" field.
+	TotalBudget *float64 `json:"total_budget,omitempty"`
+	// Spent holds the value of the "spent" field.
+	Spent float64 `json:"spent,omitempty"`
 	// AdTitle holds the value of the "ad_title" field.
 	AdTitle string `json:"ad_title,omitempty"`
 	// AdText holds the value of the "ad_text" field.
 	AdText string `json:"ad_text,omitempty"`
 	// ImageURL holds the value of the "image_url" field.
 	ImageURL string `json:"image_url,omitempty"`
+	// ImageHash holds the value of the "image_hash" field.
+	ImageHash *int64 `json:"image_hash,omitempty"`
 	// StartDate holds the value of the "start_date" field.
 	StartDate int `json:"start_date,omitempty"`
 	// EndDate holds the value of the "end_date" field.
 	EndDate int `json:"end_date,omitempty"`
 	// Moderated holds the value of the "moderated" field.
 	Moderated bool `json:"moderated,omitempty"`
+	// ModerationStatus holds the value of the "moderation_status" field.
+	ModerationStatus campaign.ModerationStatus `json:"moderation_status,omitempty"`
+	// RejectionReason holds the value of the "rejection_reason" field.
+	RejectionReason *campaign.RejectionReason `json:"rejection_reason,omitempty"`
+	// ModerationComment holds the value of the "moderation_comment" field.
+	ModerationComment *string `json:"moderation_comment,omitempty"`
+	// ApprovedAdTitle holds the value of the "approved_ad_title" field.
+	ApprovedAdTitle *string `json:"approved_ad_title,omitempty"`
+	// ApprovedAdText holds the value of the "approved_ad_text" field.
+	ApprovedAdText *string `json:"approved_ad_text,omitempty"`
+	// ApprovedImageURL holds the value of the "approved_image_url" field.
+	ApprovedImageURL *string `json:"approved_image_url,omitempty"`
+	// AiCategory holds the value of the "ai_category" field.
+	AiCategory *string `json:"ai_category,omitempty"`
+	// AiViolations holds the value of the "ai_violations" field.
+	AiViolations []schema.AIViolation `json:"ai_violations,omitempty"`
+	// AiConfidence holds the value of the "ai_confidence" field.
+	AiConfidence *float64 `json:"ai_confidence,omitempty"`
+	// RiskScore holds the value of the "risk_score" field.
+	RiskScore *float64 `json:"risk_score,omitempty"`
+	// AiReviewedAt holds the value of the "ai_reviewed_at" field.
+	AiReviewedAt *time.Time `json:"ai_reviewed_at,omitempty"`
+	// AiReviewAttempts holds the value of the "ai_review_attempts" field.
+	AiReviewAttempts int `json:"ai_review_attempts,omitempty"`
+	// AiReviewRetryAt holds the value of the "ai_review_retry_at" field.
+	AiReviewRetryAt *time.Time `json:"ai_review_retry_at,omitempty"`
+	// Paused holds the value of the "paused" field.
+	Paused bool `json:"paused,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CampaignQuery when eager-loading is set.
 	Edges        CampaignEdges `json:"edges"`
@@ -71,14 +114,18 @@ func (*Campaign) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case campaign.FieldModerated:
+		case campaign.FieldAiViolations:
+			values[i] = new([]byte)
+		case campaign.FieldModerated, campaign.FieldPaused:
 			values[i] = new(sql.NullBool)
-		case campaign.FieldCostPerImpression, campaign.FieldCostPerClick:
+		case campaign.FieldCostPerImpression, campaign.FieldCostPerClick, campaign.FieldCostPerAction, campaign.FieldDailyBudget, campaign.FieldTotalBudget, campaign.FieldSpent, campaign.FieldAiConfidence, campaign.FieldRiskScore:
 			values[i] = new(sql.NullFloat64)
-		case campaign.FieldImpressionsLimit, campaign.FieldClicksLimit, campaign.FieldStartDate, campaign.FieldEndDate:
+		case campaign.FieldImpressionsLimit, campaign.FieldClicksLimit, campaign.FieldImageHash, campaign.FieldStartDate, campaign.FieldEndDate, campaign.FieldAiReviewAttempts:
 			values[i] = new(sql.NullInt64)
-		case campaign.FieldAdTitle, campaign.FieldAdText, campaign.FieldImageURL:
+		case campaign.FieldAdTitle, campaign.FieldAdText, campaign.FieldImageURL, campaign.FieldModerationStatus, campaign.FieldRejectionReason, campaign.FieldModerationComment, campaign.FieldApprovedAdTitle, campaign.FieldApprovedAdText, campaign.FieldApprovedImageURL, campaign.FieldAiCategory:
 			values[i] = new(sql.NullString)
+		case campaign.FieldAiReviewedAt, campaign.FieldAiReviewRetryAt, campaign.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
 		case campaign.FieldID, campaign.FieldAdvertiserID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -132,6 +179,32 @@ func (c *Campaign) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.CostPerClick = value.Float64
 			}
+		case campaign.FieldCostPerAction:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_per_action", values[i])
+			} else if value.Valid {
+				c.CostPerAction = value.Float64
+			}
+		case campaign.FieldDailyBudget:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_budget", values[i])
+			} else if value.Valid {
+				c.DailyBudget = new(float64)
+				*c.DailyBudget = value.Float64
+			}
+		case campaign.FieldTotalBudget:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_budget", values[i])
+			} else if value.Valid {
+				c.TotalBudget = new(float64)
+				*c.TotalBudget = value.Float64
+			}
+		case campaign.FieldSpent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field spent", values[i])
+			} else if value.Valid {
+				c.Spent = value.Float64
+			}
 		case campaign.FieldAdTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field ad_title", values[i])
@@ -150,6 +223,13 @@ func (c *Campaign) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.ImageURL = value.String
 			}
+		case campaign.FieldImageHash:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field image_hash", values[i])
+			} else if value.Valid {
+				c.ImageHash = new(int64)
+				*c.ImageHash = value.Int64
+			}
 		case campaign.FieldStartDate:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field start_date", values[i])
@@ -168,6 +248,109 @@ func (c *Campaign) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Moderated = value.Bool
 			}
+		case campaign.FieldModerationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_status", values[i])
+			} else if value.Valid {
+				c.ModerationStatus = campaign.ModerationStatus(value.String)
+			}
+		case campaign.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				c.RejectionReason = new(campaign.RejectionReason)
+				*c.RejectionReason = campaign.RejectionReason(value.String)
+			}
+		case campaign.FieldModerationComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_comment", values[i])
+			} else if value.Valid {
+				c.ModerationComment = new(string)
+				*c.ModerationComment = value.String
+			}
+		case campaign.FieldApprovedAdTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_ad_title", values[i])
+			} else if value.Valid {
+				c.ApprovedAdTitle = new(string)
+				*c.ApprovedAdTitle = value.String
+			}
+		case campaign.FieldApprovedAdText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_ad_text", values[i])
+			} else if value.Valid {
+				c.ApprovedAdText = new(string)
+				*c.ApprovedAdText = value.String
+			}
+		case campaign.FieldApprovedImageURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_image_url", values[i])
+			} else if value.Valid {
+				c.ApprovedImageURL = new(string)
+				*c.ApprovedImageURL = value.String
+			}
+		case campaign.FieldAiCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_category", values[i])
+			} else if value.Valid {
+				c.AiCategory = new(string)
+				*c.AiCategory = value.String
+			}
+		case campaign.FieldAiViolations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_violations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.AiViolations); err != nil {
+					return fmt.Errorf("unmarshal field ai_violations: %w", err)
+				}
+			}
+		case campaign.FieldAiConfidence:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_confidence", values[i])
+			} else if value.Valid {
+				c.AiConfidence = new(float64)
+				*c.AiConfidence = value.Float64
+			}
+		case campaign.FieldRiskScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_score", values[i])
+			} else if value.Valid {
+				c.RiskScore = new(float64)
+				*c.RiskScore = value.Float64
+			}
+		case campaign.FieldAiReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_reviewed_at", values[i])
+			} else if value.Valid {
+				c.AiReviewedAt = new(time.Time)
+				*c.AiReviewedAt = value.Time
+			}
+		case campaign.FieldAiReviewAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_review_attempts", values[i])
+			} else if value.Valid {
+				c.AiReviewAttempts = int(value.Int64)
+			}
+		case campaign.FieldAiReviewRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_review_retry_at", values[i])
+			} else if value.Valid {
+				c.AiReviewRetryAt = new(time.Time)
+				*c.AiReviewRetryAt = value.Time
+			}
+		case campaign.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				c.Paused = value.Bool
+			}
+		case campaign.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -224,6 +407,22 @@ func (c *Campaign) String() string {
 	builder.WriteString("cost_per_click=")
 	builder.WriteString(fmt.Sprintf("%v", c.CostPerClick))
 	builder.WriteString(", ")
+	builder.WriteString("cost_per_action=")
+	builder.WriteString(fmt.Sprintf("%v", c.CostPerAction))
+	builder.WriteString(", ")
+	if v := c.DailyBudget; v != nil {
+		builder.WriteString("daily_budget=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.TotalBudget; v != nil {
+		builder.WriteString("total_budget=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("spent=")
+	builder.WriteString(fmt.Sprintf("%v", c.Spent))
+	builder.WriteString(", ")
 	builder.WriteString("ad_title=")
 	builder.WriteString(c.AdTitle)
 	builder.WriteString(", ")
@@ -233,6 +432,11 @@ func (c *Campaign) String() string {
 	builder.WriteString("image_url=")
 	builder.WriteString(c.ImageURL)
 	builder.WriteString(", ")
+	if v := c.ImageHash; v != nil {
+		builder.WriteString("image_hash=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("start_date=")
 	builder.WriteString(fmt.Sprintf("%v", c.StartDate))
 	builder.WriteString(", ")
@@ -241,6 +445,73 @@ func (c *Campaign) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("moderated=")
 	builder.WriteString(fmt.Sprintf("%v", c.Moderated))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_status=")
+	builder.WriteString(fmt.Sprintf("%v", c.ModerationStatus))
+	builder.WriteString(", ")
+	if v := c.RejectionReason; v != nil {
+		builder.WriteString("rejection_reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.ModerationComment; v != nil {
+		builder.WriteString("moderation_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := c.ApprovedAdTitle; v != nil {
+		builder.WriteString("approved_ad_title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := c.ApprovedAdText; v != nil {
+		builder.WriteString("approved_ad_text=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := c.ApprovedImageURL; v != nil {
+		builder.WriteString("approved_image_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := c.AiCategory; v != nil {
+		builder.WriteString("ai_category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ai_violations=")
+	builder.WriteString(fmt.Sprintf("%v", c.AiViolations))
+	builder.WriteString(", ")
+	if v := c.AiConfidence; v != nil {
+		builder.WriteString("ai_confidence=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.RiskScore; v != nil {
+		builder.WriteString("risk_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.AiReviewedAt; v != nil {
+		builder.WriteString("ai_reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ai_review_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", c.AiReviewAttempts))
+	builder.WriteString(", ")
+	if v := c.AiReviewRetryAt; v != nil {
+		builder.WriteString("ai_review_retry_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", c.Paused))
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
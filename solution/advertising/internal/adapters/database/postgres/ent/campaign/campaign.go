@@ -3,6 +3,8 @@
 package campaign
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -23,18 +25,58 @@ const (
 	FieldCostPerImpression = "cost_per_impression"
 	// FieldCostPerClick holds the string denoting the cost_per_click field in the database.
 	FieldCostPerClick = "cost_per_click"
+	// FieldCostPerAction holds the string denoting the cost_per_action field in the database.
+	FieldCostPerAction = "cost_per_action"
+	// FieldDailyBudget holds the string denoting the daily_budget field in the database.
+	FieldDailyBudget = "daily_budget"
+	// FieldTotalBudget holds the string denoting the total_budget field in the database.
+	FieldTotalBudget = "total_budget"
+	// FieldSpent holds the string denoting the spent field in the database.
+	FieldSpent = "spent"
 	// FieldAdTitle holds the string denoting the ad_title field in the database.
 	FieldAdTitle = "ad_title"
 	// FieldAdText holds the string denoting the ad_text field in the database.
 	FieldAdText = "ad_text"
 	// FieldImageURL holds the string denoting the image_url field in the database.
 	FieldImageURL = "image_url"
+	// FieldImageHash holds the string denoting the image_hash field in the database.
+	FieldImageHash = "image_hash"
 	// FieldStartDate holds the string denoting the start_date field in the database.
 	FieldStartDate = "start_date"
 	// FieldEndDate holds the string denoting the end_date field in the database.
 	FieldEndDate = "end_date"
 	// FieldModerated holds the string denoting the moderated field in the database.
 	FieldModerated = "moderated"
+	// FieldModerationStatus holds the string denoting the moderation_status field in the database.
+	FieldModerationStatus = "moderation_status"
+	// FieldRejectionReason holds the string denoting the rejection_reason field in the database.
+	FieldRejectionReason = "rejection_reason"
+	// FieldModerationComment holds the string denoting the moderation_comment field in the database.
+	FieldModerationComment = "moderation_comment"
+	// FieldApprovedAdTitle holds the string denoting the approved_ad_title field in the database.
+	FieldApprovedAdTitle = "approved_ad_title"
+	// FieldApprovedAdText holds the string denoting the approved_ad_text field in the database.
+	FieldApprovedAdText = "approved_ad_text"
+	// FieldApprovedImageURL holds the string denoting the approved_image_url field in the database.
+	FieldApprovedImageURL = "approved_image_url"
+	// FieldAiCategory holds the string denoting the ai_category field in the database.
+	FieldAiCategory = "ai_category"
+	// FieldAiViolations holds the string denoting the ai_violations field in the database.
+	FieldAiViolations = "ai_violations"
+	// FieldAiConfidence holds the string denoting the ai_confidence field in the database.
+	FieldAiConfidence = "ai_confidence"
+	// FieldRiskScore holds the string denoting the risk_score field in the database.
+	FieldRiskScore = "risk_score"
+	// FieldAiReviewedAt holds the string denoting the ai_reviewed_at field in the database.
+	FieldAiReviewedAt = "ai_reviewed_at"
+	// FieldAiReviewAttempts holds the string denoting the ai_review_attempts field in the database.
+	FieldAiReviewAttempts = "ai_review_attempts"
+	// FieldAiReviewRetryAt holds the string denoting the ai_review_retry_at field in the database.
+	FieldAiReviewRetryAt = "ai_review_retry_at"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeTargeting holds the string denoting the targeting edge name in mutations.
 	EdgeTargeting = "targeting"
 	// Table holds the table name of the campaign in the database.
@@ -56,12 +98,32 @@ var Columns = []string{
 	FieldClicksLimit,
 	FieldCostPerImpression,
 	FieldCostPerClick,
+	FieldCostPerAction,
+	FieldDailyBudget,
+	FieldTotalBudget,
+	FieldSpent,
 	FieldAdTitle,
 	FieldAdText,
 	FieldImageURL,
+	FieldImageHash,
 	FieldStartDate,
 	FieldEndDate,
 	FieldModerated,
+	FieldModerationStatus,
+	FieldRejectionReason,
+	FieldModerationComment,
+	FieldApprovedAdTitle,
+	FieldApprovedAdText,
+	FieldApprovedImageURL,
+	FieldAiCategory,
+	FieldAiViolations,
+	FieldAiConfidence,
+	FieldRiskScore,
+	FieldAiReviewedAt,
+	FieldAiReviewAttempts,
+	FieldAiReviewRetryAt,
+	FieldPaused,
+	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -79,6 +141,10 @@ var (
 	ImpressionsLimitValidator func(int) error
 	// ClicksLimitValidator is a validator for the "clicks_limit" field. It is called by the builders before save.
 	ClicksLimitValidator func(int) error
+	// DefaultCostPerAction holds the default value on creation for the "cost_per_action" field.
+	DefaultCostPerAction float64
+	// DefaultSpent holds the default value on creation for the "spent" field.
+	DefaultSpent float64
 	// AdTitleValidator is a validator for the "ad_title" field. It is called by the builders before save.
 	AdTitleValidator func(string) error
 	// AdTextValidator is a validator for the "ad_text" field. It is called by the builders before save.
@@ -87,10 +153,68 @@ var (
 	StartDateValidator func(int) error
 	// EndDateValidator is a validator for the "end_date" field. It is called by the builders before save.
 	EndDateValidator func(int) error
+	// DefaultAiReviewAttempts holds the default value on creation for the "ai_review_attempts" field.
+	DefaultAiReviewAttempts int
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// ModerationStatus defines the type for the "moderation_status" enum field.
+type ModerationStatus string
+
+// ModerationStatusPENDING is the default value of the ModerationStatus enum.
+const DefaultModerationStatus = ModerationStatusPENDING
+
+// ModerationStatus values.
+const (
+	ModerationStatusPENDING  ModerationStatus = "PENDING"
+	ModerationStatusAPPROVED ModerationStatus = "APPROVED"
+	ModerationStatusREJECTED ModerationStatus = "REJECTED"
+)
+
+func (ms ModerationStatus) String() string {
+	return string(ms)
+}
+
+// ModerationStatusValidator is a validator for the "moderation_status" field enum values. It is called by the builders before save.
+func ModerationStatusValidator(ms ModerationStatus) error {
+	switch ms {
+	case ModerationStatusPENDING, ModerationStatusAPPROVED, ModerationStatusREJECTED:
+		return nil
+	default:
+		return fmt.Errorf("campaign: invalid enum value for moderation_status field: %q", ms)
+	}
+}
+
+// RejectionReason defines the type for the "rejection_reason" enum field.
+type RejectionReason string
+
+// RejectionReason values.
+const (
+	RejectionReasonPROHIBITED_CONTENT     RejectionReason = "PROHIBITED_CONTENT"
+	RejectionReasonMISLEADING_CLAIMS      RejectionReason = "MISLEADING_CLAIMS"
+	RejectionReasonINAPPROPRIATE_LANGUAGE RejectionReason = "INAPPROPRIATE_LANGUAGE"
+	RejectionReasonLOW_QUALITY_CREATIVE   RejectionReason = "LOW_QUALITY_CREATIVE"
+	RejectionReasonTARGETING_VIOLATION    RejectionReason = "TARGETING_VIOLATION"
+	RejectionReasonOTHER                  RejectionReason = "OTHER"
+)
+
+func (rr RejectionReason) String() string {
+	return string(rr)
+}
+
+// RejectionReasonValidator is a validator for the "rejection_reason" field enum values. It is called by the builders before save.
+func RejectionReasonValidator(rr RejectionReason) error {
+	switch rr {
+	case RejectionReasonPROHIBITED_CONTENT, RejectionReasonMISLEADING_CLAIMS, RejectionReasonINAPPROPRIATE_LANGUAGE, RejectionReasonLOW_QUALITY_CREATIVE, RejectionReasonTARGETING_VIOLATION, RejectionReasonOTHER:
+		return nil
+	default:
+		return fmt.Errorf("campaign: invalid enum value for rejection_reason field: %q", rr)
+	}
+}
+
 // OrderOption defines the ordering options for the Campaign queries.
 type OrderOption func(*sql.Selector)
 
@@ -124,6 +248,26 @@ func ByCostPerClick(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCostPerClick, opts...).ToFunc()
 }
 
+// ByCostPerAction orders the results by the cost_per_action field.
+func ByCostPerAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostPerAction, opts...).ToFunc()
+}
+
+// ByDailyBudget orders the results by the daily_budget field.
+func ByDailyBudget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyBudget, opts...).ToFunc()
+}
+
+// ByTotalBudget orders the results by the total_budget field.
+func ByTotalBudget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalBudget, opts...).ToFunc()
+}
+
+// BySpent orders the results by the spent field.
+func BySpent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpent, opts...).ToFunc()
+}
+
 // ByAdTitle orders the results by the ad_title field.
 func ByAdTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdTitle, opts...).ToFunc()
@@ -139,6 +283,11 @@ func ByImageURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImageURL, opts...).ToFunc()
 }
 
+// ByImageHash orders the results by the image_hash field.
+func ByImageHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageHash, opts...).ToFunc()
+}
+
 // ByStartDate orders the results by the start_date field.
 func ByStartDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartDate, opts...).ToFunc()
@@ -154,6 +303,76 @@ func ByModerated(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModerated, opts...).ToFunc()
 }
 
+// ByModerationStatus orders the results by the moderation_status field.
+func ByModerationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationStatus, opts...).ToFunc()
+}
+
+// ByRejectionReason orders the results by the rejection_reason field.
+func ByRejectionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectionReason, opts...).ToFunc()
+}
+
+// ByModerationComment orders the results by the moderation_comment field.
+func ByModerationComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationComment, opts...).ToFunc()
+}
+
+// ByApprovedAdTitle orders the results by the approved_ad_title field.
+func ByApprovedAdTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAdTitle, opts...).ToFunc()
+}
+
+// ByApprovedAdText orders the results by the approved_ad_text field.
+func ByApprovedAdText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAdText, opts...).ToFunc()
+}
+
+// ByApprovedImageURL orders the results by the approved_image_url field.
+func ByApprovedImageURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedImageURL, opts...).ToFunc()
+}
+
+// ByAiCategory orders the results by the ai_category field.
+func ByAiCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiCategory, opts...).ToFunc()
+}
+
+// ByAiConfidence orders the results by the ai_confidence field.
+func ByAiConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiConfidence, opts...).ToFunc()
+}
+
+// ByRiskScore orders the results by the risk_score field.
+func ByRiskScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRiskScore, opts...).ToFunc()
+}
+
+// ByAiReviewedAt orders the results by the ai_reviewed_at field.
+func ByAiReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiReviewedAt, opts...).ToFunc()
+}
+
+// ByAiReviewAttempts orders the results by the ai_review_attempts field.
+func ByAiReviewAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiReviewAttempts, opts...).ToFunc()
+}
+
+// ByAiReviewRetryAt orders the results by the ai_review_retry_at field.
+func ByAiReviewRetryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiReviewRetryAt, opts...).ToFunc()
+}
+
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByTargetingField orders the results by targeting field.
 func ByTargetingField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
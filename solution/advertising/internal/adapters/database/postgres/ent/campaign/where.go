@@ -4,6 +4,7 @@ package campaign
 
 import (
 	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -80,6 +81,26 @@ func CostPerClick(v float64) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldCostPerClick, v))
 }
 
+// CostPerAction applies equality check predicate on the "cost_per_action" field. It's identical to CostPerActionEQ.
+func CostPerAction(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldCostPerAction, v))
+}
+
+// DailyBudget applies equality check predicate on the "daily_budget" field. It's identical to DailyBudgetEQ.
+func DailyBudget(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldDailyBudget, v))
+}
+
+// TotalBudget applies equality check predicate on the "total_budget" field. It's identical to TotalBudgetEQ.
+func TotalBudget(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldTotalBudget, v))
+}
+
+// Spent applies equality check predicate on the "spent" field. It's identical to SpentEQ.
+func Spent(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldSpent, v))
+}
+
 // AdTitle applies equality check predicate on the "ad_title" field. It's identical to AdTitleEQ.
 func AdTitle(v string) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldAdTitle, v))
@@ -95,6 +116,11 @@ func ImageURL(v string) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldImageURL, v))
 }
 
+// ImageHash applies equality check predicate on the "image_hash" field. It's identical to ImageHashEQ.
+func ImageHash(v int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldImageHash, v))
+}
+
 // StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
 func StartDate(v int) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldStartDate, v))
@@ -110,6 +136,66 @@ func Moderated(v bool) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldModerated, v))
 }
 
+// ModerationComment applies equality check predicate on the "moderation_comment" field. It's identical to ModerationCommentEQ.
+func ModerationComment(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldModerationComment, v))
+}
+
+// ApprovedAdTitle applies equality check predicate on the "approved_ad_title" field. It's identical to ApprovedAdTitleEQ.
+func ApprovedAdTitle(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdText applies equality check predicate on the "approved_ad_text" field. It's identical to ApprovedAdTextEQ.
+func ApprovedAdText(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldApprovedAdText, v))
+}
+
+// ApprovedImageURL applies equality check predicate on the "approved_image_url" field. It's identical to ApprovedImageURLEQ.
+func ApprovedImageURL(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldApprovedImageURL, v))
+}
+
+// AiCategory applies equality check predicate on the "ai_category" field. It's identical to AiCategoryEQ.
+func AiCategory(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiCategory, v))
+}
+
+// AiConfidence applies equality check predicate on the "ai_confidence" field. It's identical to AiConfidenceEQ.
+func AiConfidence(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiConfidence, v))
+}
+
+// RiskScore applies equality check predicate on the "risk_score" field. It's identical to RiskScoreEQ.
+func RiskScore(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldRiskScore, v))
+}
+
+// AiReviewedAt applies equality check predicate on the "ai_reviewed_at" field. It's identical to AiReviewedAtEQ.
+func AiReviewedAt(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiReviewedAt, v))
+}
+
+// AiReviewAttempts applies equality check predicate on the "ai_review_attempts" field. It's identical to AiReviewAttemptsEQ.
+func AiReviewAttempts(v int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiReviewAttempts, v))
+}
+
+// AiReviewRetryAt applies equality check predicate on the "ai_review_retry_at" field. It's identical to AiReviewRetryAtEQ.
+func AiReviewRetryAt(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiReviewRetryAt, v))
+}
+
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldPaused, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // AdvertiserIDEQ applies the EQ predicate on the "advertiser_id" field.
 func AdvertiserIDEQ(v uuid.UUID) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldAdvertiserID, v))
@@ -310,6 +396,186 @@ func CostPerClickLTE(v float64) predicate.Campaign {
 	return predicate.Campaign(sql.FieldLTE(FieldCostPerClick, v))
 }
 
+// CostPerActionEQ applies the EQ predicate on the "cost_per_action" field.
+func CostPerActionEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldCostPerAction, v))
+}
+
+// CostPerActionNEQ applies the NEQ predicate on the "cost_per_action" field.
+func CostPerActionNEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldCostPerAction, v))
+}
+
+// CostPerActionIn applies the In predicate on the "cost_per_action" field.
+func CostPerActionIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldCostPerAction, vs...))
+}
+
+// CostPerActionNotIn applies the NotIn predicate on the "cost_per_action" field.
+func CostPerActionNotIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldCostPerAction, vs...))
+}
+
+// CostPerActionGT applies the GT predicate on the "cost_per_action" field.
+func CostPerActionGT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldCostPerAction, v))
+}
+
+// CostPerActionGTE applies the GTE predicate on the "cost_per_action" field.
+func CostPerActionGTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldCostPerAction, v))
+}
+
+// CostPerActionLT applies the LT predicate on the "cost_per_action" field.
+func CostPerActionLT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldCostPerAction, v))
+}
+
+// CostPerActionLTE applies the LTE predicate on the "cost_per_action" field.
+func CostPerActionLTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldCostPerAction, v))
+}
+
+// DailyBudgetEQ applies the EQ predicate on the "daily_budget" field.
+func DailyBudgetEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldDailyBudget, v))
+}
+
+// DailyBudgetNEQ applies the NEQ predicate on the "daily_budget" field.
+func DailyBudgetNEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldDailyBudget, v))
+}
+
+// DailyBudgetIn applies the In predicate on the "daily_budget" field.
+func DailyBudgetIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldDailyBudget, vs...))
+}
+
+// DailyBudgetNotIn applies the NotIn predicate on the "daily_budget" field.
+func DailyBudgetNotIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldDailyBudget, vs...))
+}
+
+// DailyBudgetGT applies the GT predicate on the "daily_budget" field.
+func DailyBudgetGT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldDailyBudget, v))
+}
+
+// DailyBudgetGTE applies the GTE predicate on the "daily_budget" field.
+func DailyBudgetGTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldDailyBudget, v))
+}
+
+// DailyBudgetLT applies the LT predicate on the "daily_budget" field.
+func DailyBudgetLT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldDailyBudget, v))
+}
+
+// DailyBudgetLTE applies the LTE predicate on the "daily_budget" field.
+func DailyBudgetLTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldDailyBudget, v))
+}
+
+// DailyBudgetIsNil applies the IsNil predicate on the "daily_budget" field.
+func DailyBudgetIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldDailyBudget))
+}
+
+// DailyBudgetNotNil applies the NotNil predicate on the "daily_budget" field.
+func DailyBudgetNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldDailyBudget))
+}
+
+// TotalBudgetEQ applies the EQ predicate on the "total_budget" field.
+func TotalBudgetEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldTotalBudget, v))
+}
+
+// TotalBudgetNEQ applies the NEQ predicate on the "total_budget" field.
+func TotalBudgetNEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldTotalBudget, v))
+}
+
+// TotalBudgetIn applies the In predicate on the "total_budget" field.
+func TotalBudgetIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldTotalBudget, vs...))
+}
+
+// TotalBudgetNotIn applies the NotIn predicate on the "total_budget" field.
+func TotalBudgetNotIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldTotalBudget, vs...))
+}
+
+// TotalBudgetGT applies the GT predicate on the "total_budget" field.
+func TotalBudgetGT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldTotalBudget, v))
+}
+
+// TotalBudgetGTE applies the GTE predicate on the "total_budget" field.
+func TotalBudgetGTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldTotalBudget, v))
+}
+
+// TotalBudgetLT applies the LT predicate on the "total_budget" field.
+func TotalBudgetLT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldTotalBudget, v))
+}
+
+// TotalBudgetLTE applies the LTE predicate on the "total_budget" field.
+func TotalBudgetLTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldTotalBudget, v))
+}
+
+// TotalBudgetIsNil applies the IsNil predicate on the "total_budget" field.
+func TotalBudgetIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldTotalBudget))
+}
+
+// TotalBudgetNotNil applies the NotNil predicate on the "total_budget" field.
+func TotalBudgetNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldTotalBudget))
+}
+
+// SpentEQ applies the EQ predicate on the "spent" field.
+func SpentEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldSpent, v))
+}
+
+// SpentNEQ applies the NEQ predicate on the "spent" field.
+func SpentNEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldSpent, v))
+}
+
+// SpentIn applies the In predicate on the "spent" field.
+func SpentIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldSpent, vs...))
+}
+
+// SpentNotIn applies the NotIn predicate on the "spent" field.
+func SpentNotIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldSpent, vs...))
+}
+
+// SpentGT applies the GT predicate on the "spent" field.
+func SpentGT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldSpent, v))
+}
+
+// SpentGTE applies the GTE predicate on the "spent" field.
+func SpentGTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldSpent, v))
+}
+
+// SpentLT applies the LT predicate on the "spent" field.
+func SpentLT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldSpent, v))
+}
+
+// SpentLTE applies the LTE predicate on the "spent" field.
+func SpentLTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldSpent, v))
+}
+
 // AdTitleEQ applies the EQ predicate on the "ad_title" field.
 func AdTitleEQ(v string) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldAdTitle, v))
@@ -515,6 +781,56 @@ func ImageURLContainsFold(v string) predicate.Campaign {
 	return predicate.Campaign(sql.FieldContainsFold(FieldImageURL, v))
 }
 
+// ImageHashEQ applies the EQ predicate on the "image_hash" field.
+func ImageHashEQ(v int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldImageHash, v))
+}
+
+// ImageHashNEQ applies the NEQ predicate on the "image_hash" field.
+func ImageHashNEQ(v int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldImageHash, v))
+}
+
+// ImageHashIn applies the In predicate on the "image_hash" field.
+func ImageHashIn(vs ...int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldImageHash, vs...))
+}
+
+// ImageHashNotIn applies the NotIn predicate on the "image_hash" field.
+func ImageHashNotIn(vs ...int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldImageHash, vs...))
+}
+
+// ImageHashGT applies the GT predicate on the "image_hash" field.
+func ImageHashGT(v int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldImageHash, v))
+}
+
+// ImageHashGTE applies the GTE predicate on the "image_hash" field.
+func ImageHashGTE(v int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldImageHash, v))
+}
+
+// ImageHashLT applies the LT predicate on the "image_hash" field.
+func ImageHashLT(v int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldImageHash, v))
+}
+
+// ImageHashLTE applies the LTE predicate on the "image_hash" field.
+func ImageHashLTE(v int64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldImageHash, v))
+}
+
+// ImageHashIsNil applies the IsNil predicate on the "image_hash" field.
+func ImageHashIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldImageHash))
+}
+
+// ImageHashNotNil applies the NotNil predicate on the "image_hash" field.
+func ImageHashNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldImageHash))
+}
+
 // StartDateEQ applies the EQ predicate on the "start_date" field.
 func StartDateEQ(v int) predicate.Campaign {
 	return predicate.Campaign(sql.FieldEQ(FieldStartDate, v))
@@ -605,6 +921,741 @@ func ModeratedNEQ(v bool) predicate.Campaign {
 	return predicate.Campaign(sql.FieldNEQ(FieldModerated, v))
 }
 
+// ModerationStatusEQ applies the EQ predicate on the "moderation_status" field.
+func ModerationStatusEQ(v ModerationStatus) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldModerationStatus, v))
+}
+
+// ModerationStatusNEQ applies the NEQ predicate on the "moderation_status" field.
+func ModerationStatusNEQ(v ModerationStatus) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldModerationStatus, v))
+}
+
+// ModerationStatusIn applies the In predicate on the "moderation_status" field.
+func ModerationStatusIn(vs ...ModerationStatus) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldModerationStatus, vs...))
+}
+
+// ModerationStatusNotIn applies the NotIn predicate on the "moderation_status" field.
+func ModerationStatusNotIn(vs ...ModerationStatus) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldModerationStatus, vs...))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v RejectionReason) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v RejectionReason) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...RejectionReason) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...RejectionReason) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// ModerationCommentEQ applies the EQ predicate on the "moderation_comment" field.
+func ModerationCommentEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldModerationComment, v))
+}
+
+// ModerationCommentNEQ applies the NEQ predicate on the "moderation_comment" field.
+func ModerationCommentNEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldModerationComment, v))
+}
+
+// ModerationCommentIn applies the In predicate on the "moderation_comment" field.
+func ModerationCommentIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldModerationComment, vs...))
+}
+
+// ModerationCommentNotIn applies the NotIn predicate on the "moderation_comment" field.
+func ModerationCommentNotIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldModerationComment, vs...))
+}
+
+// ModerationCommentGT applies the GT predicate on the "moderation_comment" field.
+func ModerationCommentGT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldModerationComment, v))
+}
+
+// ModerationCommentGTE applies the GTE predicate on the "moderation_comment" field.
+func ModerationCommentGTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldModerationComment, v))
+}
+
+// ModerationCommentLT applies the LT predicate on the "moderation_comment" field.
+func ModerationCommentLT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldModerationComment, v))
+}
+
+// ModerationCommentLTE applies the LTE predicate on the "moderation_comment" field.
+func ModerationCommentLTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldModerationComment, v))
+}
+
+// ModerationCommentContains applies the Contains predicate on the "moderation_comment" field.
+func ModerationCommentContains(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContains(FieldModerationComment, v))
+}
+
+// ModerationCommentHasPrefix applies the HasPrefix predicate on the "moderation_comment" field.
+func ModerationCommentHasPrefix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasPrefix(FieldModerationComment, v))
+}
+
+// ModerationCommentHasSuffix applies the HasSuffix predicate on the "moderation_comment" field.
+func ModerationCommentHasSuffix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasSuffix(FieldModerationComment, v))
+}
+
+// ModerationCommentIsNil applies the IsNil predicate on the "moderation_comment" field.
+func ModerationCommentIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldModerationComment))
+}
+
+// ModerationCommentNotNil applies the NotNil predicate on the "moderation_comment" field.
+func ModerationCommentNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldModerationComment))
+}
+
+// ModerationCommentEqualFold applies the EqualFold predicate on the "moderation_comment" field.
+func ModerationCommentEqualFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEqualFold(FieldModerationComment, v))
+}
+
+// ModerationCommentContainsFold applies the ContainsFold predicate on the "moderation_comment" field.
+func ModerationCommentContainsFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContainsFold(FieldModerationComment, v))
+}
+
+// ApprovedAdTitleEQ applies the EQ predicate on the "approved_ad_title" field.
+func ApprovedAdTitleEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleNEQ applies the NEQ predicate on the "approved_ad_title" field.
+func ApprovedAdTitleNEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleIn applies the In predicate on the "approved_ad_title" field.
+func ApprovedAdTitleIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldApprovedAdTitle, vs...))
+}
+
+// ApprovedAdTitleNotIn applies the NotIn predicate on the "approved_ad_title" field.
+func ApprovedAdTitleNotIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldApprovedAdTitle, vs...))
+}
+
+// ApprovedAdTitleGT applies the GT predicate on the "approved_ad_title" field.
+func ApprovedAdTitleGT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleGTE applies the GTE predicate on the "approved_ad_title" field.
+func ApprovedAdTitleGTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleLT applies the LT predicate on the "approved_ad_title" field.
+func ApprovedAdTitleLT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleLTE applies the LTE predicate on the "approved_ad_title" field.
+func ApprovedAdTitleLTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleContains applies the Contains predicate on the "approved_ad_title" field.
+func ApprovedAdTitleContains(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContains(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleHasPrefix applies the HasPrefix predicate on the "approved_ad_title" field.
+func ApprovedAdTitleHasPrefix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasPrefix(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleHasSuffix applies the HasSuffix predicate on the "approved_ad_title" field.
+func ApprovedAdTitleHasSuffix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasSuffix(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleIsNil applies the IsNil predicate on the "approved_ad_title" field.
+func ApprovedAdTitleIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldApprovedAdTitle))
+}
+
+// ApprovedAdTitleNotNil applies the NotNil predicate on the "approved_ad_title" field.
+func ApprovedAdTitleNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldApprovedAdTitle))
+}
+
+// ApprovedAdTitleEqualFold applies the EqualFold predicate on the "approved_ad_title" field.
+func ApprovedAdTitleEqualFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEqualFold(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTitleContainsFold applies the ContainsFold predicate on the "approved_ad_title" field.
+func ApprovedAdTitleContainsFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContainsFold(FieldApprovedAdTitle, v))
+}
+
+// ApprovedAdTextEQ applies the EQ predicate on the "approved_ad_text" field.
+func ApprovedAdTextEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextNEQ applies the NEQ predicate on the "approved_ad_text" field.
+func ApprovedAdTextNEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextIn applies the In predicate on the "approved_ad_text" field.
+func ApprovedAdTextIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldApprovedAdText, vs...))
+}
+
+// ApprovedAdTextNotIn applies the NotIn predicate on the "approved_ad_text" field.
+func ApprovedAdTextNotIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldApprovedAdText, vs...))
+}
+
+// ApprovedAdTextGT applies the GT predicate on the "approved_ad_text" field.
+func ApprovedAdTextGT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextGTE applies the GTE predicate on the "approved_ad_text" field.
+func ApprovedAdTextGTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextLT applies the LT predicate on the "approved_ad_text" field.
+func ApprovedAdTextLT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextLTE applies the LTE predicate on the "approved_ad_text" field.
+func ApprovedAdTextLTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextContains applies the Contains predicate on the "approved_ad_text" field.
+func ApprovedAdTextContains(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContains(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextHasPrefix applies the HasPrefix predicate on the "approved_ad_text" field.
+func ApprovedAdTextHasPrefix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasPrefix(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextHasSuffix applies the HasSuffix predicate on the "approved_ad_text" field.
+func ApprovedAdTextHasSuffix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasSuffix(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextIsNil applies the IsNil predicate on the "approved_ad_text" field.
+func ApprovedAdTextIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldApprovedAdText))
+}
+
+// ApprovedAdTextNotNil applies the NotNil predicate on the "approved_ad_text" field.
+func ApprovedAdTextNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldApprovedAdText))
+}
+
+// ApprovedAdTextEqualFold applies the EqualFold predicate on the "approved_ad_text" field.
+func ApprovedAdTextEqualFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEqualFold(FieldApprovedAdText, v))
+}
+
+// ApprovedAdTextContainsFold applies the ContainsFold predicate on the "approved_ad_text" field.
+func ApprovedAdTextContainsFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContainsFold(FieldApprovedAdText, v))
+}
+
+// ApprovedImageURLEQ applies the EQ predicate on the "approved_image_url" field.
+func ApprovedImageURLEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLNEQ applies the NEQ predicate on the "approved_image_url" field.
+func ApprovedImageURLNEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLIn applies the In predicate on the "approved_image_url" field.
+func ApprovedImageURLIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldApprovedImageURL, vs...))
+}
+
+// ApprovedImageURLNotIn applies the NotIn predicate on the "approved_image_url" field.
+func ApprovedImageURLNotIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldApprovedImageURL, vs...))
+}
+
+// ApprovedImageURLGT applies the GT predicate on the "approved_image_url" field.
+func ApprovedImageURLGT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLGTE applies the GTE predicate on the "approved_image_url" field.
+func ApprovedImageURLGTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLLT applies the LT predicate on the "approved_image_url" field.
+func ApprovedImageURLLT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLLTE applies the LTE predicate on the "approved_image_url" field.
+func ApprovedImageURLLTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLContains applies the Contains predicate on the "approved_image_url" field.
+func ApprovedImageURLContains(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContains(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLHasPrefix applies the HasPrefix predicate on the "approved_image_url" field.
+func ApprovedImageURLHasPrefix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasPrefix(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLHasSuffix applies the HasSuffix predicate on the "approved_image_url" field.
+func ApprovedImageURLHasSuffix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasSuffix(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLIsNil applies the IsNil predicate on the "approved_image_url" field.
+func ApprovedImageURLIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldApprovedImageURL))
+}
+
+// ApprovedImageURLNotNil applies the NotNil predicate on the "approved_image_url" field.
+func ApprovedImageURLNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldApprovedImageURL))
+}
+
+// ApprovedImageURLEqualFold applies the EqualFold predicate on the "approved_image_url" field.
+func ApprovedImageURLEqualFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEqualFold(FieldApprovedImageURL, v))
+}
+
+// ApprovedImageURLContainsFold applies the ContainsFold predicate on the "approved_image_url" field.
+func ApprovedImageURLContainsFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContainsFold(FieldApprovedImageURL, v))
+}
+
+// AiCategoryEQ applies the EQ predicate on the "ai_category" field.
+func AiCategoryEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiCategory, v))
+}
+
+// AiCategoryNEQ applies the NEQ predicate on the "ai_category" field.
+func AiCategoryNEQ(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldAiCategory, v))
+}
+
+// AiCategoryIn applies the In predicate on the "ai_category" field.
+func AiCategoryIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldAiCategory, vs...))
+}
+
+// AiCategoryNotIn applies the NotIn predicate on the "ai_category" field.
+func AiCategoryNotIn(vs ...string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldAiCategory, vs...))
+}
+
+// AiCategoryGT applies the GT predicate on the "ai_category" field.
+func AiCategoryGT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldAiCategory, v))
+}
+
+// AiCategoryGTE applies the GTE predicate on the "ai_category" field.
+func AiCategoryGTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldAiCategory, v))
+}
+
+// AiCategoryLT applies the LT predicate on the "ai_category" field.
+func AiCategoryLT(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldAiCategory, v))
+}
+
+// AiCategoryLTE applies the LTE predicate on the "ai_category" field.
+func AiCategoryLTE(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldAiCategory, v))
+}
+
+// AiCategoryContains applies the Contains predicate on the "ai_category" field.
+func AiCategoryContains(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContains(FieldAiCategory, v))
+}
+
+// AiCategoryHasPrefix applies the HasPrefix predicate on the "ai_category" field.
+func AiCategoryHasPrefix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasPrefix(FieldAiCategory, v))
+}
+
+// AiCategoryHasSuffix applies the HasSuffix predicate on the "ai_category" field.
+func AiCategoryHasSuffix(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldHasSuffix(FieldAiCategory, v))
+}
+
+// AiCategoryIsNil applies the IsNil predicate on the "ai_category" field.
+func AiCategoryIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldAiCategory))
+}
+
+// AiCategoryNotNil applies the NotNil predicate on the "ai_category" field.
+func AiCategoryNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldAiCategory))
+}
+
+// AiCategoryEqualFold applies the EqualFold predicate on the "ai_category" field.
+func AiCategoryEqualFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEqualFold(FieldAiCategory, v))
+}
+
+// AiCategoryContainsFold applies the ContainsFold predicate on the "ai_category" field.
+func AiCategoryContainsFold(v string) predicate.Campaign {
+	return predicate.Campaign(sql.FieldContainsFold(FieldAiCategory, v))
+}
+
+// AiViolationsIsNil applies the IsNil predicate on the "ai_violations" field.
+func AiViolationsIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldAiViolations))
+}
+
+// AiViolationsNotNil applies the NotNil predicate on the "ai_violations" field.
+func AiViolationsNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldAiViolations))
+}
+
+// AiConfidenceEQ applies the EQ predicate on the "ai_confidence" field.
+func AiConfidenceEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiConfidence, v))
+}
+
+// AiConfidenceNEQ applies the NEQ predicate on the "ai_confidence" field.
+func AiConfidenceNEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldAiConfidence, v))
+}
+
+// AiConfidenceIn applies the In predicate on the "ai_confidence" field.
+func AiConfidenceIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldAiConfidence, vs...))
+}
+
+// AiConfidenceNotIn applies the NotIn predicate on the "ai_confidence" field.
+func AiConfidenceNotIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldAiConfidence, vs...))
+}
+
+// AiConfidenceGT applies the GT predicate on the "ai_confidence" field.
+func AiConfidenceGT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldAiConfidence, v))
+}
+
+// AiConfidenceGTE applies the GTE predicate on the "ai_confidence" field.
+func AiConfidenceGTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldAiConfidence, v))
+}
+
+// AiConfidenceLT applies the LT predicate on the "ai_confidence" field.
+func AiConfidenceLT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldAiConfidence, v))
+}
+
+// AiConfidenceLTE applies the LTE predicate on the "ai_confidence" field.
+func AiConfidenceLTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldAiConfidence, v))
+}
+
+// AiConfidenceIsNil applies the IsNil predicate on the "ai_confidence" field.
+func AiConfidenceIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldAiConfidence))
+}
+
+// AiConfidenceNotNil applies the NotNil predicate on the "ai_confidence" field.
+func AiConfidenceNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldAiConfidence))
+}
+
+// RiskScoreEQ applies the EQ predicate on the "risk_score" field.
+func RiskScoreEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldRiskScore, v))
+}
+
+// RiskScoreNEQ applies the NEQ predicate on the "risk_score" field.
+func RiskScoreNEQ(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldRiskScore, v))
+}
+
+// RiskScoreIn applies the In predicate on the "risk_score" field.
+func RiskScoreIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldRiskScore, vs...))
+}
+
+// RiskScoreNotIn applies the NotIn predicate on the "risk_score" field.
+func RiskScoreNotIn(vs ...float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldRiskScore, vs...))
+}
+
+// RiskScoreGT applies the GT predicate on the "risk_score" field.
+func RiskScoreGT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldRiskScore, v))
+}
+
+// RiskScoreGTE applies the GTE predicate on the "risk_score" field.
+func RiskScoreGTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldRiskScore, v))
+}
+
+// RiskScoreLT applies the LT predicate on the "risk_score" field.
+func RiskScoreLT(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldRiskScore, v))
+}
+
+// RiskScoreLTE applies the LTE predicate on the "risk_score" field.
+func RiskScoreLTE(v float64) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldRiskScore, v))
+}
+
+// RiskScoreIsNil applies the IsNil predicate on the "risk_score" field.
+func RiskScoreIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldRiskScore))
+}
+
+// RiskScoreNotNil applies the NotNil predicate on the "risk_score" field.
+func RiskScoreNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldRiskScore))
+}
+
+// AiReviewedAtEQ applies the EQ predicate on the "ai_reviewed_at" field.
+func AiReviewedAtEQ(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiReviewedAt, v))
+}
+
+// AiReviewedAtNEQ applies the NEQ predicate on the "ai_reviewed_at" field.
+func AiReviewedAtNEQ(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldAiReviewedAt, v))
+}
+
+// AiReviewedAtIn applies the In predicate on the "ai_reviewed_at" field.
+func AiReviewedAtIn(vs ...time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldAiReviewedAt, vs...))
+}
+
+// AiReviewedAtNotIn applies the NotIn predicate on the "ai_reviewed_at" field.
+func AiReviewedAtNotIn(vs ...time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldAiReviewedAt, vs...))
+}
+
+// AiReviewedAtGT applies the GT predicate on the "ai_reviewed_at" field.
+func AiReviewedAtGT(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldAiReviewedAt, v))
+}
+
+// AiReviewedAtGTE applies the GTE predicate on the "ai_reviewed_at" field.
+func AiReviewedAtGTE(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldAiReviewedAt, v))
+}
+
+// AiReviewedAtLT applies the LT predicate on the "ai_reviewed_at" field.
+func AiReviewedAtLT(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldAiReviewedAt, v))
+}
+
+// AiReviewedAtLTE applies the LTE predicate on the "ai_reviewed_at" field.
+func AiReviewedAtLTE(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldAiReviewedAt, v))
+}
+
+// AiReviewedAtIsNil applies the IsNil predicate on the "ai_reviewed_at" field.
+func AiReviewedAtIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldAiReviewedAt))
+}
+
+// AiReviewedAtNotNil applies the NotNil predicate on the "ai_reviewed_at" field.
+func AiReviewedAtNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldAiReviewedAt))
+}
+
+// AiReviewAttemptsEQ applies the EQ predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsEQ(v int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiReviewAttempts, v))
+}
+
+// AiReviewAttemptsNEQ applies the NEQ predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsNEQ(v int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldAiReviewAttempts, v))
+}
+
+// AiReviewAttemptsIn applies the In predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsIn(vs ...int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldAiReviewAttempts, vs...))
+}
+
+// AiReviewAttemptsNotIn applies the NotIn predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsNotIn(vs ...int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldAiReviewAttempts, vs...))
+}
+
+// AiReviewAttemptsGT applies the GT predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsGT(v int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldAiReviewAttempts, v))
+}
+
+// AiReviewAttemptsGTE applies the GTE predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsGTE(v int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldAiReviewAttempts, v))
+}
+
+// AiReviewAttemptsLT applies the LT predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsLT(v int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldAiReviewAttempts, v))
+}
+
+// AiReviewAttemptsLTE applies the LTE predicate on the "ai_review_attempts" field.
+func AiReviewAttemptsLTE(v int) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldAiReviewAttempts, v))
+}
+
+// AiReviewRetryAtEQ applies the EQ predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtEQ(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldAiReviewRetryAt, v))
+}
+
+// AiReviewRetryAtNEQ applies the NEQ predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtNEQ(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldAiReviewRetryAt, v))
+}
+
+// AiReviewRetryAtIn applies the In predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtIn(vs ...time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldAiReviewRetryAt, vs...))
+}
+
+// AiReviewRetryAtNotIn applies the NotIn predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtNotIn(vs ...time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldAiReviewRetryAt, vs...))
+}
+
+// AiReviewRetryAtGT applies the GT predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtGT(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldAiReviewRetryAt, v))
+}
+
+// AiReviewRetryAtGTE applies the GTE predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtGTE(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldAiReviewRetryAt, v))
+}
+
+// AiReviewRetryAtLT applies the LT predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtLT(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldAiReviewRetryAt, v))
+}
+
+// AiReviewRetryAtLTE applies the LTE predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtLTE(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldAiReviewRetryAt, v))
+}
+
+// AiReviewRetryAtIsNil applies the IsNil predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldAiReviewRetryAt))
+}
+
+// AiReviewRetryAtNotNil applies the NotNil predicate on the "ai_review_retry_at" field.
+func AiReviewRetryAtNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldAiReviewRetryAt))
+}
+
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldPaused, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Campaign {
+	return predicate.Campaign(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasTargeting applies the HasEdge predicate on the "targeting" edge.
 func HasTargeting() predicate.Campaign {
 	return predicate.Campaign(func(s *sql.Selector) {
@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/schema"
 	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -54,6 +56,62 @@ func (cc *CampaignCreate) SetCostPerClick(f float64) *CampaignCreate {
 	return cc
 }
 
+// SetCostPerAction sets the "cost_per_action" field.
+func (cc *CampaignCreate) SetCostPerAction(f float64) *CampaignCreate {
+	cc.mutation.SetCostPerAction(f)
+	return cc
+}
+
+// SetNillableCostPerAction sets the "cost_per_action" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableCostPerAction(f *float64) *CampaignCreate {
+	if f != nil {
+		cc.SetCostPerAction(*f)
+	}
+	return cc
+}
+
+// SetDailyBudget sets the "daily_budget" field.
+func (cc *CampaignCreate) SetDailyBudget(f float64) *CampaignCreate {
+	cc.mutation.SetDailyBudget(f)
+	return cc
+}
+
+// SetNillableDailyBudget sets the "daily_budget" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableDailyBudget(f *float64) *CampaignCreate {
+	if f != nil {
+		cc.SetDailyBudget(*f)
+	}
+	return cc
+}
+
+// SetTotalBudget sets the "total_budget" field.
+func (cc *CampaignCreate) SetTotalBudget(f float64) *CampaignCreate {
+	cc.mutation.SetTotalBudget(f)
+	return cc
+}
+
+// SetNillableTotalBudget sets the "total_budget" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableTotalBudget(f *float64) *CampaignCreate {
+	if f != nil {
+		cc.SetTotalBudget(*f)
+	}
+	return cc
+}
+
+// SetSpent sets the "spent" field.
+func (cc *CampaignCreate) SetSpent(f float64) *CampaignCreate {
+	cc.mutation.SetSpent(f)
+	return cc
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableSpent(f *float64) *CampaignCreate {
+	if f != nil {
+		cc.SetSpent(*f)
+	}
+	return cc
+}
+
 // SetAdTitle sets the "ad_title" field.
 func (cc *CampaignCreate) SetAdTitle(s string) *CampaignCreate {
 	cc.mutation.SetAdTitle(s)
@@ -80,6 +138,20 @@ func (cc *CampaignCreate) SetNillableImageURL(s *string) *CampaignCreate {
 	return cc
 }
 
+// SetImageHash sets the "image_hash" field.
+func (cc *CampaignCreate) SetImageHash(i int64) *CampaignCreate {
+	cc.mutation.SetImageHash(i)
+	return cc
+}
+
+// SetNillableImageHash sets the "image_hash" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableImageHash(i *int64) *CampaignCreate {
+	if i != nil {
+		cc.SetImageHash(*i)
+	}
+	return cc
+}
+
 // SetStartDate sets the "start_date" field.
 func (cc *CampaignCreate) SetStartDate(i int) *CampaignCreate {
 	cc.mutation.SetStartDate(i)
@@ -98,6 +170,208 @@ func (cc *CampaignCreate) SetModerated(b bool) *CampaignCreate {
 	return cc
 }
 
+// SetModerationStatus sets the "moderation_status" field.
+func (cc *CampaignCreate) SetModerationStatus(cs campaign.ModerationStatus) *CampaignCreate {
+	cc.mutation.SetModerationStatus(cs)
+	return cc
+}
+
+// SetNillableModerationStatus sets the "moderation_status" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableModerationStatus(cs *campaign.ModerationStatus) *CampaignCreate {
+	if cs != nil {
+		cc.SetModerationStatus(*cs)
+	}
+	return cc
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (cc *CampaignCreate) SetRejectionReason(cr campaign.RejectionReason) *CampaignCreate {
+	cc.mutation.SetRejectionReason(cr)
+	return cc
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableRejectionReason(cr *campaign.RejectionReason) *CampaignCreate {
+	if cr != nil {
+		cc.SetRejectionReason(*cr)
+	}
+	return cc
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (cc *CampaignCreate) SetModerationComment(s string) *CampaignCreate {
+	cc.mutation.SetModerationComment(s)
+	return cc
+}
+
+// SetNillableModerationComment sets the "moderation_comment" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableModerationComment(s *string) *CampaignCreate {
+	if s != nil {
+		cc.SetModerationComment(*s)
+	}
+	return cc
+}
+
+// SetApprovedAdTitle sets the "approved_ad_title" field.
+func (cc *CampaignCreate) SetApprovedAdTitle(s string) *CampaignCreate {
+	cc.mutation.SetApprovedAdTitle(s)
+	return cc
+}
+
+// SetNillableApprovedAdTitle sets the "approved_ad_title" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableApprovedAdTitle(s *string) *CampaignCreate {
+	if s != nil {
+		cc.SetApprovedAdTitle(*s)
+	}
+	return cc
+}
+
+// SetApprovedAdText sets the "approved_ad_text" field.
+func (cc *CampaignCreate) SetApprovedAdText(s string) *CampaignCreate {
+	cc.mutation.SetApprovedAdText(s)
+	return cc
+}
+
+// SetNillableApprovedAdText sets the "approved_ad_text" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableApprovedAdText(s *string) *CampaignCreate {
+	if s != nil {
+		cc.SetApprovedAdText(*s)
+	}
+	return cc
+}
+
+// SetApprovedImageURL sets the "approved_image_url" field.
+func (cc *CampaignCreate) SetApprovedImageURL(s string) *CampaignCreate {
+	cc.mutation.SetApprovedImageURL(s)
+	return cc
+}
+
+// SetNillableApprovedImageURL sets the "approved_image_url" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableApprovedImageURL(s *string) *CampaignCreate {
+	if s != nil {
+		cc.SetApprovedImageURL(*s)
+	}
+	return cc
+}
+
+// SetAiCategory sets the "ai_category" field.
+func (cc *CampaignCreate) SetAiCategory(s string) *CampaignCreate {
+	cc.mutation.SetAiCategory(s)
+	return cc
+}
+
+// SetNillableAiCategory sets the "ai_category" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableAiCategory(s *string) *CampaignCreate {
+	if s != nil {
+		cc.SetAiCategory(*s)
+	}
+	return cc
+}
+
+// SetAiViolations sets the "ai_violations" field.
+func (cc *CampaignCreate) SetAiViolations(sv []schema.AIViolation) *CampaignCreate {
+	cc.mutation.SetAiViolations(sv)
+	return cc
+}
+
+// SetAiConfidence sets the "ai_confidence" field.
+func (cc *CampaignCreate) SetAiConfidence(f float64) *CampaignCreate {
+	cc.mutation.SetAiConfidence(f)
+	return cc
+}
+
+// SetNillableAiConfidence sets the "ai_confidence" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableAiConfidence(f *float64) *CampaignCreate {
+	if f != nil {
+		cc.SetAiConfidence(*f)
+	}
+	return cc
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (cc *CampaignCreate) SetRiskScore(f float64) *CampaignCreate {
+	cc.mutation.SetRiskScore(f)
+	return cc
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableRiskScore(f *float64) *CampaignCreate {
+	if f != nil {
+		cc.SetRiskScore(*f)
+	}
+	return cc
+}
+
+// SetAiReviewedAt sets the "ai_reviewed_at" field.
+func (cc *CampaignCreate) SetAiReviewedAt(t time.Time) *CampaignCreate {
+	cc.mutation.SetAiReviewedAt(t)
+	return cc
+}
+
+// SetNillableAiReviewedAt sets the "ai_reviewed_at" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableAiReviewedAt(t *time.Time) *CampaignCreate {
+	if t != nil {
+		cc.SetAiReviewedAt(*t)
+	}
+	return cc
+}
+
+// SetAiReviewAttempts sets the "ai_review_attempts" field.
+func (cc *CampaignCreate) SetAiReviewAttempts(i int) *CampaignCreate {
+	cc.mutation.SetAiReviewAttempts(i)
+	return cc
+}
+
+// SetNillableAiReviewAttempts sets the "ai_review_attempts" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableAiReviewAttempts(i *int) *CampaignCreate {
+	if i != nil {
+		cc.SetAiReviewAttempts(*i)
+	}
+	return cc
+}
+
+// SetAiReviewRetryAt sets the "ai_review_retry_at" field.
+func (cc *CampaignCreate) SetAiReviewRetryAt(t time.Time) *CampaignCreate {
+	cc.mutation.SetAiReviewRetryAt(t)
+	return cc
+}
+
+// SetNillableAiReviewRetryAt sets the "ai_review_retry_at" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableAiReviewRetryAt(t *time.Time) *CampaignCreate {
+	if t != nil {
+		cc.SetAiReviewRetryAt(*t)
+	}
+	return cc
+}
+
+// SetPaused sets the "paused" field.
+func (cc *CampaignCreate) SetPaused(b bool) *CampaignCreate {
+	cc.mutation.SetPaused(b)
+	return cc
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillablePaused(b *bool) *CampaignCreate {
+	if b != nil {
+		cc.SetPaused(*b)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CampaignCreate) SetDeletedAt(t time.Time) *CampaignCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableDeletedAt(t *time.Time) *CampaignCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CampaignCreate) SetID(u uuid.UUID) *CampaignCreate {
 	cc.mutation.SetID(u)
@@ -166,6 +440,26 @@ func (cc *CampaignCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *CampaignCreate) defaults() {
+	if _, ok := cc.mutation.CostPerAction(); !ok {
+		v := campaign.DefaultCostPerAction
+		cc.mutation.SetCostPerAction(v)
+	}
+	if _, ok := cc.mutation.Spent(); !ok {
+		v := campaign.DefaultSpent
+		cc.mutation.SetSpent(v)
+	}
+	if _, ok := cc.mutation.ModerationStatus(); !ok {
+		v := campaign.DefaultModerationStatus
+		cc.mutation.SetModerationStatus(v)
+	}
+	if _, ok := cc.mutation.AiReviewAttempts(); !ok {
+		v := campaign.DefaultAiReviewAttempts
+		cc.mutation.SetAiReviewAttempts(v)
+	}
+	if _, ok := cc.mutation.Paused(); !ok {
+		v := campaign.DefaultPaused
+		cc.mutation.SetPaused(v)
+	}
 	if _, ok := cc.mutation.ID(); !ok {
 		v := campaign.DefaultID()
 		cc.mutation.SetID(v)
@@ -199,6 +493,12 @@ func (cc *CampaignCreate) check() error {
 	if _, ok := cc.mutation.CostPerClick(); !ok {
 		return &ValidationError{Name: "cost_per_click", err: errors.New(`ent: missing required field "Campaign.cost_per_click"`)}
 	}
+	if _, ok := cc.mutation.CostPerAction(); !ok {
+		return &ValidationError{Name: "cost_per_action", err: errors.New(`ent: missing required field "Campaign.cost_per_action"`)}
+	}
+	if _, ok := cc.mutation.Spent(); !ok {
+		return &ValidationError{Name: "spent", err: errors.New(`ent: missing required field "Campaign.spent"`)}
+	}
 	if _, ok := cc.mutation.AdTitle(); !ok {
 		return &ValidationError{Name: "ad_title", err: errors.New(`ent: missing required field "Campaign.ad_title"`)}
 	}
@@ -234,6 +534,25 @@ func (cc *CampaignCreate) check() error {
 	if _, ok := cc.mutation.Moderated(); !ok {
 		return &ValidationError{Name: "moderated", err: errors.New(`ent: missing required field "Campaign.moderated"`)}
 	}
+	if _, ok := cc.mutation.ModerationStatus(); !ok {
+		return &ValidationError{Name: "moderation_status", err: errors.New(`ent: missing required field "Campaign.moderation_status"`)}
+	}
+	if v, ok := cc.mutation.ModerationStatus(); ok {
+		if err := campaign.ModerationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_status", err: fmt.Errorf(`ent: validator failed for field "Campaign.moderation_status": %w`, err)}
+		}
+	}
+	if v, ok := cc.mutation.RejectionReason(); ok {
+		if err := campaign.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "Campaign.rejection_reason": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.AiReviewAttempts(); !ok {
+		return &ValidationError{Name: "ai_review_attempts", err: errors.New(`ent: missing required field "Campaign.ai_review_attempts"`)}
+	}
+	if _, ok := cc.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "Campaign.paused"`)}
+	}
 	return nil
 }
 
@@ -290,6 +609,22 @@ func (cc *CampaignCreate) createSpec() (*Campaign, *sqlgraph.CreateSpec) {
 		_spec.SetField(campaign.FieldCostPerClick, field.TypeFloat64, value)
 		_node.CostPerClick = value
 	}
+	if value, ok := cc.mutation.CostPerAction(); ok {
+		_spec.SetField(campaign.FieldCostPerAction, field.TypeFloat64, value)
+		_node.CostPerAction = value
+	}
+	if value, ok := cc.mutation.DailyBudget(); ok {
+		_spec.SetField(campaign.FieldDailyBudget, field.TypeFloat64, value)
+		_node.DailyBudget = &value
+	}
+	if value, ok := cc.mutation.TotalBudget(); ok {
+		_spec.SetField(campaign.FieldTotalBudget, field.TypeFloat64, value)
+		_node.TotalBudget = &value
+	}
+	if value, ok := cc.mutation.Spent(); ok {
+		_spec.SetField(campaign.FieldSpent, field.TypeFloat64, value)
+		_node.Spent = value
+	}
 	if value, ok := cc.mutation.AdTitle(); ok {
 		_spec.SetField(campaign.FieldAdTitle, field.TypeString, value)
 		_node.AdTitle = value
@@ -302,6 +637,10 @@ func (cc *CampaignCreate) createSpec() (*Campaign, *sqlgraph.CreateSpec) {
 		_spec.SetField(campaign.FieldImageURL, field.TypeString, value)
 		_node.ImageURL = value
 	}
+	if value, ok := cc.mutation.ImageHash(); ok {
+		_spec.SetField(campaign.FieldImageHash, field.TypeInt64, value)
+		_node.ImageHash = &value
+	}
 	if value, ok := cc.mutation.StartDate(); ok {
 		_spec.SetField(campaign.FieldStartDate, field.TypeInt, value)
 		_node.StartDate = value
@@ -314,6 +653,66 @@ func (cc *CampaignCreate) createSpec() (*Campaign, *sqlgraph.CreateSpec) {
 		_spec.SetField(campaign.FieldModerated, field.TypeBool, value)
 		_node.Moderated = value
 	}
+	if value, ok := cc.mutation.ModerationStatus(); ok {
+		_spec.SetField(campaign.FieldModerationStatus, field.TypeEnum, value)
+		_node.ModerationStatus = value
+	}
+	if value, ok := cc.mutation.RejectionReason(); ok {
+		_spec.SetField(campaign.FieldRejectionReason, field.TypeEnum, value)
+		_node.RejectionReason = &value
+	}
+	if value, ok := cc.mutation.ModerationComment(); ok {
+		_spec.SetField(campaign.FieldModerationComment, field.TypeString, value)
+		_node.ModerationComment = &value
+	}
+	if value, ok := cc.mutation.ApprovedAdTitle(); ok {
+		_spec.SetField(campaign.FieldApprovedAdTitle, field.TypeString, value)
+		_node.ApprovedAdTitle = &value
+	}
+	if value, ok := cc.mutation.ApprovedAdText(); ok {
+		_spec.SetField(campaign.FieldApprovedAdText, field.TypeString, value)
+		_node.ApprovedAdText = &value
+	}
+	if value, ok := cc.mutation.ApprovedImageURL(); ok {
+		_spec.SetField(campaign.FieldApprovedImageURL, field.TypeString, value)
+		_node.ApprovedImageURL = &value
+	}
+	if value, ok := cc.mutation.AiCategory(); ok {
+		_spec.SetField(campaign.FieldAiCategory, field.TypeString, value)
+		_node.AiCategory = &value
+	}
+	if value, ok := cc.mutation.AiViolations(); ok {
+		_spec.SetField(campaign.FieldAiViolations, field.TypeJSON, value)
+		_node.AiViolations = value
+	}
+	if value, ok := cc.mutation.AiConfidence(); ok {
+		_spec.SetField(campaign.FieldAiConfidence, field.TypeFloat64, value)
+		_node.AiConfidence = &value
+	}
+	if value, ok := cc.mutation.RiskScore(); ok {
+		_spec.SetField(campaign.FieldRiskScore, field.TypeFloat64, value)
+		_node.RiskScore = &value
+	}
+	if value, ok := cc.mutation.AiReviewedAt(); ok {
+		_spec.SetField(campaign.FieldAiReviewedAt, field.TypeTime, value)
+		_node.AiReviewedAt = &value
+	}
+	if value, ok := cc.mutation.AiReviewAttempts(); ok {
+		_spec.SetField(campaign.FieldAiReviewAttempts, field.TypeInt, value)
+		_node.AiReviewAttempts = value
+	}
+	if value, ok := cc.mutation.AiReviewRetryAt(); ok {
+		_spec.SetField(campaign.FieldAiReviewRetryAt, field.TypeTime, value)
+		_node.AiReviewRetryAt = &value
+	}
+	if value, ok := cc.mutation.Paused(); ok {
+		_spec.SetField(campaign.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(campaign.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := cc.mutation.TargetingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -466,6 +865,90 @@ func (u *CampaignUpsert) AddCostPerClick(v float64) *CampaignUpsert {
 	return u
 }
 
+// SetCostPerAction sets the "cost_per_action" field.
+func (u *CampaignUpsert) SetCostPerAction(v float64) *CampaignUpsert {
+	u.Set(campaign.FieldCostPerAction, v)
+	return u
+}
+
+// UpdateCostPerAction sets the "cost_per_action" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateCostPerAction() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldCostPerAction)
+	return u
+}
+
+// AddCostPerAction adds v to the "cost_per_action" field.
+func (u *CampaignUpsert) AddCostPerAction(v float64) *CampaignUpsert {
+	u.Add(campaign.FieldCostPerAction, v)
+	return u
+}
+
+// SetDailyBudget sets the "daily_budget" field.
+func (u *CampaignUpsert) SetDailyBudget(v float64) *CampaignUpsert {
+	u.Set(campaign.FieldDailyBudget, v)
+	return u
+}
+
+// UpdateDailyBudget sets the "daily_budget" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateDailyBudget() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldDailyBudget)
+	return u
+}
+
+// AddDailyBudget adds v to the "daily_budget" field.
+func (u *CampaignUpsert) AddDailyBudget(v float64) *CampaignUpsert {
+	u.Add(campaign.FieldDailyBudget, v)
+	return u
+}
+
+// ClearDailyBudget clears the value of the "daily_budget" field.
+func (u *CampaignUpsert) ClearDailyBudget() *CampaignUpsert {
+	u.SetNull(campaign.FieldDailyBudget)
+	return u
+}
+
+// SetTotalBudget sets the "total_budget" field.
+func (u *CampaignUpsert) SetTotalBudget(v float64) *CampaignUpsert {
+	u.Set(campaign.FieldTotalBudget, v)
+	return u
+}
+
+// UpdateTotalBudget sets the "total_budget" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateTotalBudget() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldTotalBudget)
+	return u
+}
+
+// AddTotalBudget adds v to the "total_budget" field.
+func (u *CampaignUpsert) AddTotalBudget(v float64) *CampaignUpsert {
+	u.Add(campaign.FieldTotalBudget, v)
+	return u
+}
+
+// ClearTotalBudget clears the value of the "total_budget" field.
+func (u *CampaignUpsert) ClearTotalBudget() *CampaignUpsert {
+	u.SetNull(campaign.FieldTotalBudget)
+	return u
+}
+
+// SetSpent sets the "spent" field.
+func (u *CampaignUpsert) SetSpent(v float64) *CampaignUpsert {
+	u.Set(campaign.FieldSpent, v)
+	return u
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateSpent() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldSpent)
+	return u
+}
+
+// AddSpent adds v to the "spent" field.
+func (u *CampaignUpsert) AddSpent(v float64) *CampaignUpsert {
+	u.Add(campaign.FieldSpent, v)
+	return u
+}
+
 // SetAdTitle sets the "ad_title" field.
 func (u *CampaignUpsert) SetAdTitle(v string) *CampaignUpsert {
 	u.Set(campaign.FieldAdTitle, v)
@@ -508,6 +991,30 @@ func (u *CampaignUpsert) ClearImageURL() *CampaignUpsert {
 	return u
 }
 
+// SetImageHash sets the "image_hash" field.
+func (u *CampaignUpsert) SetImageHash(v int64) *CampaignUpsert {
+	u.Set(campaign.FieldImageHash, v)
+	return u
+}
+
+// UpdateImageHash sets the "image_hash" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateImageHash() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldImageHash)
+	return u
+}
+
+// AddImageHash adds v to the "image_hash" field.
+func (u *CampaignUpsert) AddImageHash(v int64) *CampaignUpsert {
+	u.Add(campaign.FieldImageHash, v)
+	return u
+}
+
+// ClearImageHash clears the value of the "image_hash" field.
+func (u *CampaignUpsert) ClearImageHash() *CampaignUpsert {
+	u.SetNull(campaign.FieldImageHash)
+	return u
+}
+
 // SetStartDate sets the "start_date" field.
 func (u *CampaignUpsert) SetStartDate(v int) *CampaignUpsert {
 	u.Set(campaign.FieldStartDate, v)
@@ -556,39 +1063,309 @@ func (u *CampaignUpsert) UpdateModerated() *CampaignUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.Campaign.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(campaign.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *CampaignUpsertOne) UpdateNewValues() *CampaignUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(campaign.FieldID)
-		}
-	}))
+// SetModerationStatus sets the "moderation_status" field.
+func (u *CampaignUpsert) SetModerationStatus(v campaign.ModerationStatus) *CampaignUpsert {
+	u.Set(campaign.FieldModerationStatus, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Campaign.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *CampaignUpsertOne) Ignore() *CampaignUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateModerationStatus sets the "moderation_status" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateModerationStatus() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldModerationStatus)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CampaignUpsert) SetRejectionReason(v campaign.RejectionReason) *CampaignUpsert {
+	u.Set(campaign.FieldRejectionReason, v)
+	return u
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateRejectionReason() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldRejectionReason)
+	return u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CampaignUpsert) ClearRejectionReason() *CampaignUpsert {
+	u.SetNull(campaign.FieldRejectionReason)
+	return u
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (u *CampaignUpsert) SetModerationComment(v string) *CampaignUpsert {
+	u.Set(campaign.FieldModerationComment, v)
+	return u
+}
+
+// UpdateModerationComment sets the "moderation_comment" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateModerationComment() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldModerationComment)
+	return u
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (u *CampaignUpsert) ClearModerationComment() *CampaignUpsert {
+	u.SetNull(campaign.FieldModerationComment)
+	return u
+}
+
+// SetApprovedAdTitle sets the "approved_ad_title" field.
+func (u *CampaignUpsert) SetApprovedAdTitle(v string) *CampaignUpsert {
+	u.Set(campaign.FieldApprovedAdTitle, v)
+	return u
+}
+
+// UpdateApprovedAdTitle sets the "approved_ad_title" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateApprovedAdTitle() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldApprovedAdTitle)
+	return u
+}
+
+// ClearApprovedAdTitle clears the value of the "approved_ad_title" field.
+func (u *CampaignUpsert) ClearApprovedAdTitle() *CampaignUpsert {
+	u.SetNull(campaign.FieldApprovedAdTitle)
+	return u
+}
+
+// SetApprovedAdText sets the "approved_ad_text" field.
+func (u *CampaignUpsert) SetApprovedAdText(v string) *CampaignUpsert {
+	u.Set(campaign.FieldApprovedAdText, v)
+	return u
+}
+
+// UpdateApprovedAdText sets the "approved_ad_text" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateApprovedAdText() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldApprovedAdText)
+	return u
+}
+
+// ClearApprovedAdText clears the value of the "approved_ad_text" field.
+func (u *CampaignUpsert) ClearApprovedAdText() *CampaignUpsert {
+	u.SetNull(campaign.FieldApprovedAdText)
+	return u
+}
+
+// SetApprovedImageURL sets the "approved_image_url" field.
+func (u *CampaignUpsert) SetApprovedImageURL(v string) *CampaignUpsert {
+	u.Set(campaign.FieldApprovedImageURL, v)
+	return u
+}
+
+// UpdateApprovedImageURL sets the "approved_image_url" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateApprovedImageURL() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldApprovedImageURL)
+	return u
+}
+
+// ClearApprovedImageURL clears the value of the "approved_image_url" field.
+func (u *CampaignUpsert) ClearApprovedImageURL() *CampaignUpsert {
+	u.SetNull(campaign.FieldApprovedImageURL)
+	return u
+}
+
+// SetAiCategory sets the "ai_category" field.
+func (u *CampaignUpsert) SetAiCategory(v string) *CampaignUpsert {
+	u.Set(campaign.FieldAiCategory, v)
+	return u
+}
+
+// UpdateAiCategory sets the "ai_category" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateAiCategory() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldAiCategory)
+	return u
+}
+
+// ClearAiCategory clears the value of the "ai_category" field.
+func (u *CampaignUpsert) ClearAiCategory() *CampaignUpsert {
+	u.SetNull(campaign.FieldAiCategory)
+	return u
+}
+
+// SetAiViolations sets the "ai_violations" field.
+func (u *CampaignUpsert) SetAiViolations(v []schema.AIViolation) *CampaignUpsert {
+	u.Set(campaign.FieldAiViolations, v)
+	return u
+}
+
+// UpdateAiViolations sets the "ai_violations" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateAiViolations() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldAiViolations)
+	return u
+}
+
+// ClearAiViolations clears the value of the "ai_violations" field.
+func (u *CampaignUpsert) ClearAiViolations() *CampaignUpsert {
+	u.SetNull(campaign.FieldAiViolations)
+	return u
+}
+
+// SetAiConfidence sets the "ai_confidence" field.
+func (u *CampaignUpsert) SetAiConfidence(v float64) *CampaignUpsert {
+	u.Set(campaign.FieldAiConfidence, v)
+	return u
+}
+
+// UpdateAiConfidence sets the "ai_confidence" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateAiConfidence() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldAiConfidence)
+	return u
+}
+
+// AddAiConfidence adds v to the "ai_confidence" field.
+func (u *CampaignUpsert) AddAiConfidence(v float64) *CampaignUpsert {
+	u.Add(campaign.FieldAiConfidence, v)
+	return u
+}
+
+// ClearAiConfidence clears the value of the "ai_confidence" field.
+func (u *CampaignUpsert) ClearAiConfidence() *CampaignUpsert {
+	u.SetNull(campaign.FieldAiConfidence)
+	return u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (u *CampaignUpsert) SetRiskScore(v float64) *CampaignUpsert {
+	u.Set(campaign.FieldRiskScore, v)
+	return u
+}
+
+// UpdateRiskScore sets the "risk_score" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateRiskScore() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldRiskScore)
+	return u
+}
+
+// AddRiskScore adds v to the "risk_score" field.
+func (u *CampaignUpsert) AddRiskScore(v float64) *CampaignUpsert {
+	u.Add(campaign.FieldRiskScore, v)
+	return u
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (u *CampaignUpsert) ClearRiskScore() *CampaignUpsert {
+	u.SetNull(campaign.FieldRiskScore)
+	return u
+}
+
+// SetAiReviewedAt sets the "ai_reviewed_at" field.
+func (u *CampaignUpsert) SetAiReviewedAt(v time.Time) *CampaignUpsert {
+	u.Set(campaign.FieldAiReviewedAt, v)
+	return u
+}
+
+// UpdateAiReviewedAt sets the "ai_reviewed_at" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateAiReviewedAt() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldAiReviewedAt)
+	return u
+}
+
+// ClearAiReviewedAt clears the value of the "ai_reviewed_at" field.
+func (u *CampaignUpsert) ClearAiReviewedAt() *CampaignUpsert {
+	u.SetNull(campaign.FieldAiReviewedAt)
+	return u
+}
+
+// SetAiReviewAttempts sets the "ai_review_attempts" field.
+func (u *CampaignUpsert) SetAiReviewAttempts(v int) *CampaignUpsert {
+	u.Set(campaign.FieldAiReviewAttempts, v)
+	return u
+}
+
+// UpdateAiReviewAttempts sets the "ai_review_attempts" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateAiReviewAttempts() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldAiReviewAttempts)
+	return u
+}
+
+// AddAiReviewAttempts adds v to the "ai_review_attempts" field.
+func (u *CampaignUpsert) AddAiReviewAttempts(v int) *CampaignUpsert {
+	u.Add(campaign.FieldAiReviewAttempts, v)
+	return u
+}
+
+// SetAiReviewRetryAt sets the "ai_review_retry_at" field.
+func (u *CampaignUpsert) SetAiReviewRetryAt(v time.Time) *CampaignUpsert {
+	u.Set(campaign.FieldAiReviewRetryAt, v)
+	return u
+}
+
+// UpdateAiReviewRetryAt sets the "ai_review_retry_at" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateAiReviewRetryAt() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldAiReviewRetryAt)
+	return u
+}
+
+// ClearAiReviewRetryAt clears the value of the "ai_review_retry_at" field.
+func (u *CampaignUpsert) ClearAiReviewRetryAt() *CampaignUpsert {
+	u.SetNull(campaign.FieldAiReviewRetryAt)
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *CampaignUpsert) SetPaused(v bool) *CampaignUpsert {
+	u.Set(campaign.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdatePaused() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldPaused)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CampaignUpsert) SetDeletedAt(v time.Time) *CampaignUpsert {
+	u.Set(campaign.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CampaignUpsert) UpdateDeletedAt() *CampaignUpsert {
+	u.SetExcluded(campaign.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CampaignUpsert) ClearDeletedAt() *CampaignUpsert {
+	u.SetNull(campaign.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Campaign.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(campaign.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CampaignUpsertOne) UpdateNewValues() *CampaignUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(campaign.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Campaign.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CampaignUpsertOne) Ignore() *CampaignUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
 // Supported only by SQLite and PostgreSQL.
 func (u *CampaignUpsertOne) DoNothing() *CampaignUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.DoNothing())
@@ -702,6 +1479,104 @@ func (u *CampaignUpsertOne) UpdateCostPerClick() *CampaignUpsertOne {
 	})
 }
 
+// SetCostPerAction sets the "cost_per_action" field.
+func (u *CampaignUpsertOne) SetCostPerAction(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetCostPerAction(v)
+	})
+}
+
+// AddCostPerAction adds v to the "cost_per_action" field.
+func (u *CampaignUpsertOne) AddCostPerAction(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddCostPerAction(v)
+	})
+}
+
+// UpdateCostPerAction sets the "cost_per_action" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateCostPerAction() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateCostPerAction()
+	})
+}
+
+// SetDailyBudget sets the "daily_budget" field.
+func (u *CampaignUpsertOne) SetDailyBudget(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetDailyBudget(v)
+	})
+}
+
+// AddDailyBudget adds v to the "daily_budget" field.
+func (u *CampaignUpsertOne) AddDailyBudget(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddDailyBudget(v)
+	})
+}
+
+// UpdateDailyBudget sets the "daily_budget" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateDailyBudget() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateDailyBudget()
+	})
+}
+
+// ClearDailyBudget clears the value of the "daily_budget" field.
+func (u *CampaignUpsertOne) ClearDailyBudget() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearDailyBudget()
+	})
+}
+
+// SetTotalBudget sets the "total_budget" field.
+func (u *CampaignUpsertOne) SetTotalBudget(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetTotalBudget(v)
+	})
+}
+
+// AddTotalBudget adds v to the "total_budget" field.
+func (u *CampaignUpsertOne) AddTotalBudget(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddTotalBudget(v)
+	})
+}
+
+// UpdateTotalBudget sets the "total_budget" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateTotalBudget() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateTotalBudget()
+	})
+}
+
+// ClearTotalBudget clears the value of the "total_budget" field.
+func (u *CampaignUpsertOne) ClearTotalBudget() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearTotalBudget()
+	})
+}
+
+// SetSpent sets the "spent" field.
+func (u *CampaignUpsertOne) SetSpent(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetSpent(v)
+	})
+}
+
+// AddSpent adds v to the "spent" field.
+func (u *CampaignUpsertOne) AddSpent(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddSpent(v)
+	})
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateSpent() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateSpent()
+	})
+}
+
 // SetAdTitle sets the "ad_title" field.
 func (u *CampaignUpsertOne) SetAdTitle(v string) *CampaignUpsertOne {
 	return u.Update(func(s *CampaignUpsert) {
@@ -751,6 +1626,34 @@ func (u *CampaignUpsertOne) ClearImageURL() *CampaignUpsertOne {
 	})
 }
 
+// SetImageHash sets the "image_hash" field.
+func (u *CampaignUpsertOne) SetImageHash(v int64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetImageHash(v)
+	})
+}
+
+// AddImageHash adds v to the "image_hash" field.
+func (u *CampaignUpsertOne) AddImageHash(v int64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddImageHash(v)
+	})
+}
+
+// UpdateImageHash sets the "image_hash" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateImageHash() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateImageHash()
+	})
+}
+
+// ClearImageHash clears the value of the "image_hash" field.
+func (u *CampaignUpsertOne) ClearImageHash() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearImageHash()
+	})
+}
+
 // SetStartDate sets the "start_date" field.
 func (u *CampaignUpsertOne) SetStartDate(v int) *CampaignUpsertOne {
 	return u.Update(func(s *CampaignUpsert) {
@@ -796,14 +1699,329 @@ func (u *CampaignUpsertOne) UpdateEndDate() *CampaignUpsertOne {
 // SetModerated sets the "moderated" field.
 func (u *CampaignUpsertOne) SetModerated(v bool) *CampaignUpsertOne {
 	return u.Update(func(s *CampaignUpsert) {
-		s.SetModerated(v)
+		s.SetModerated(v)
+	})
+}
+
+// UpdateModerated sets the "moderated" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateModerated() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateModerated()
+	})
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (u *CampaignUpsertOne) SetModerationStatus(v campaign.ModerationStatus) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetModerationStatus(v)
+	})
+}
+
+// UpdateModerationStatus sets the "moderation_status" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateModerationStatus() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateModerationStatus()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CampaignUpsertOne) SetRejectionReason(v campaign.RejectionReason) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateRejectionReason() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CampaignUpsertOne) ClearRejectionReason() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (u *CampaignUpsertOne) SetModerationComment(v string) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetModerationComment(v)
+	})
+}
+
+// UpdateModerationComment sets the "moderation_comment" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateModerationComment() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateModerationComment()
+	})
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (u *CampaignUpsertOne) ClearModerationComment() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearModerationComment()
+	})
+}
+
+// SetApprovedAdTitle sets the "approved_ad_title" field.
+func (u *CampaignUpsertOne) SetApprovedAdTitle(v string) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetApprovedAdTitle(v)
+	})
+}
+
+// UpdateApprovedAdTitle sets the "approved_ad_title" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateApprovedAdTitle() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateApprovedAdTitle()
+	})
+}
+
+// ClearApprovedAdTitle clears the value of the "approved_ad_title" field.
+func (u *CampaignUpsertOne) ClearApprovedAdTitle() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearApprovedAdTitle()
+	})
+}
+
+// SetApprovedAdText sets the "approved_ad_text" field.
+func (u *CampaignUpsertOne) SetApprovedAdText(v string) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetApprovedAdText(v)
+	})
+}
+
+// UpdateApprovedAdText sets the "approved_ad_text" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateApprovedAdText() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateApprovedAdText()
+	})
+}
+
+// ClearApprovedAdText clears the value of the "approved_ad_text" field.
+func (u *CampaignUpsertOne) ClearApprovedAdText() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearApprovedAdText()
+	})
+}
+
+// SetApprovedImageURL sets the "approved_image_url" field.
+func (u *CampaignUpsertOne) SetApprovedImageURL(v string) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetApprovedImageURL(v)
+	})
+}
+
+// UpdateApprovedImageURL sets the "approved_image_url" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateApprovedImageURL() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateApprovedImageURL()
+	})
+}
+
+// ClearApprovedImageURL clears the value of the "approved_image_url" field.
+func (u *CampaignUpsertOne) ClearApprovedImageURL() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearApprovedImageURL()
+	})
+}
+
+// SetAiCategory sets the "ai_category" field.
+func (u *CampaignUpsertOne) SetAiCategory(v string) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiCategory(v)
+	})
+}
+
+// UpdateAiCategory sets the "ai_category" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateAiCategory() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiCategory()
+	})
+}
+
+// ClearAiCategory clears the value of the "ai_category" field.
+func (u *CampaignUpsertOne) ClearAiCategory() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiCategory()
+	})
+}
+
+// SetAiViolations sets the "ai_violations" field.
+func (u *CampaignUpsertOne) SetAiViolations(v []schema.AIViolation) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiViolations(v)
+	})
+}
+
+// UpdateAiViolations sets the "ai_violations" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateAiViolations() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiViolations()
+	})
+}
+
+// ClearAiViolations clears the value of the "ai_violations" field.
+func (u *CampaignUpsertOne) ClearAiViolations() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiViolations()
+	})
+}
+
+// SetAiConfidence sets the "ai_confidence" field.
+func (u *CampaignUpsertOne) SetAiConfidence(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiConfidence(v)
+	})
+}
+
+// AddAiConfidence adds v to the "ai_confidence" field.
+func (u *CampaignUpsertOne) AddAiConfidence(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddAiConfidence(v)
+	})
+}
+
+// UpdateAiConfidence sets the "ai_confidence" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateAiConfidence() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiConfidence()
+	})
+}
+
+// ClearAiConfidence clears the value of the "ai_confidence" field.
+func (u *CampaignUpsertOne) ClearAiConfidence() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiConfidence()
+	})
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (u *CampaignUpsertOne) SetRiskScore(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetRiskScore(v)
+	})
+}
+
+// AddRiskScore adds v to the "risk_score" field.
+func (u *CampaignUpsertOne) AddRiskScore(v float64) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddRiskScore(v)
+	})
+}
+
+// UpdateRiskScore sets the "risk_score" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateRiskScore() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateRiskScore()
+	})
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (u *CampaignUpsertOne) ClearRiskScore() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearRiskScore()
+	})
+}
+
+// SetAiReviewedAt sets the "ai_reviewed_at" field.
+func (u *CampaignUpsertOne) SetAiReviewedAt(v time.Time) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiReviewedAt(v)
+	})
+}
+
+// UpdateAiReviewedAt sets the "ai_reviewed_at" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateAiReviewedAt() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiReviewedAt()
+	})
+}
+
+// ClearAiReviewedAt clears the value of the "ai_reviewed_at" field.
+func (u *CampaignUpsertOne) ClearAiReviewedAt() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiReviewedAt()
+	})
+}
+
+// SetAiReviewAttempts sets the "ai_review_attempts" field.
+func (u *CampaignUpsertOne) SetAiReviewAttempts(v int) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiReviewAttempts(v)
+	})
+}
+
+// AddAiReviewAttempts adds v to the "ai_review_attempts" field.
+func (u *CampaignUpsertOne) AddAiReviewAttempts(v int) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddAiReviewAttempts(v)
+	})
+}
+
+// UpdateAiReviewAttempts sets the "ai_review_attempts" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateAiReviewAttempts() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiReviewAttempts()
+	})
+}
+
+// SetAiReviewRetryAt sets the "ai_review_retry_at" field.
+func (u *CampaignUpsertOne) SetAiReviewRetryAt(v time.Time) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiReviewRetryAt(v)
+	})
+}
+
+// UpdateAiReviewRetryAt sets the "ai_review_retry_at" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateAiReviewRetryAt() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiReviewRetryAt()
+	})
+}
+
+// ClearAiReviewRetryAt clears the value of the "ai_review_retry_at" field.
+func (u *CampaignUpsertOne) ClearAiReviewRetryAt() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiReviewRetryAt()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *CampaignUpsertOne) SetPaused(v bool) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdatePaused() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CampaignUpsertOne) SetDeletedAt(v time.Time) *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CampaignUpsertOne) UpdateDeletedAt() *CampaignUpsertOne {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateDeletedAt()
 	})
 }
 
-// UpdateModerated sets the "moderated" field to the value that was provided on create.
-func (u *CampaignUpsertOne) UpdateModerated() *CampaignUpsertOne {
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CampaignUpsertOne) ClearDeletedAt() *CampaignUpsertOne {
 	return u.Update(func(s *CampaignUpsert) {
-		s.UpdateModerated()
+		s.ClearDeletedAt()
 	})
 }
 
@@ -1120,6 +2338,104 @@ func (u *CampaignUpsertBulk) UpdateCostPerClick() *CampaignUpsertBulk {
 	})
 }
 
+// SetCostPerAction sets the "cost_per_action" field.
+func (u *CampaignUpsertBulk) SetCostPerAction(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetCostPerAction(v)
+	})
+}
+
+// AddCostPerAction adds v to the "cost_per_action" field.
+func (u *CampaignUpsertBulk) AddCostPerAction(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddCostPerAction(v)
+	})
+}
+
+// UpdateCostPerAction sets the "cost_per_action" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateCostPerAction() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateCostPerAction()
+	})
+}
+
+// SetDailyBudget sets the "daily_budget" field.
+func (u *CampaignUpsertBulk) SetDailyBudget(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetDailyBudget(v)
+	})
+}
+
+// AddDailyBudget adds v to the "daily_budget" field.
+func (u *CampaignUpsertBulk) AddDailyBudget(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddDailyBudget(v)
+	})
+}
+
+// UpdateDailyBudget sets the "daily_budget" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateDailyBudget() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateDailyBudget()
+	})
+}
+
+// ClearDailyBudget clears the value of the "daily_budget" field.
+func (u *CampaignUpsertBulk) ClearDailyBudget() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearDailyBudget()
+	})
+}
+
+// SetTotalBudget sets the "total_budget" field.
+func (u *CampaignUpsertBulk) SetTotalBudget(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetTotalBudget(v)
+	})
+}
+
+// AddTotalBudget adds v to the "total_budget" field.
+func (u *CampaignUpsertBulk) AddTotalBudget(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddTotalBudget(v)
+	})
+}
+
+// UpdateTotalBudget sets the "total_budget" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateTotalBudget() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateTotalBudget()
+	})
+}
+
+// ClearTotalBudget clears the value of the "total_budget" field.
+func (u *CampaignUpsertBulk) ClearTotalBudget() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearTotalBudget()
+	})
+}
+
+// SetSpent sets the "spent" field.
+func (u *CampaignUpsertBulk) SetSpent(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetSpent(v)
+	})
+}
+
+// AddSpent adds v to the "spent" field.
+func (u *CampaignUpsertBulk) AddSpent(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddSpent(v)
+	})
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateSpent() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateSpent()
+	})
+}
+
 // SetAdTitle sets the "ad_title" field.
 func (u *CampaignUpsertBulk) SetAdTitle(v string) *CampaignUpsertBulk {
 	return u.Update(func(s *CampaignUpsert) {
@@ -1169,6 +2485,34 @@ func (u *CampaignUpsertBulk) ClearImageURL() *CampaignUpsertBulk {
 	})
 }
 
+// SetImageHash sets the "image_hash" field.
+func (u *CampaignUpsertBulk) SetImageHash(v int64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetImageHash(v)
+	})
+}
+
+// AddImageHash adds v to the "image_hash" field.
+func (u *CampaignUpsertBulk) AddImageHash(v int64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddImageHash(v)
+	})
+}
+
+// UpdateImageHash sets the "image_hash" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateImageHash() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateImageHash()
+	})
+}
+
+// ClearImageHash clears the value of the "image_hash" field.
+func (u *CampaignUpsertBulk) ClearImageHash() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearImageHash()
+	})
+}
+
 // SetStartDate sets the "start_date" field.
 func (u *CampaignUpsertBulk) SetStartDate(v int) *CampaignUpsertBulk {
 	return u.Update(func(s *CampaignUpsert) {
@@ -1225,6 +2569,321 @@ func (u *CampaignUpsertBulk) UpdateModerated() *CampaignUpsertBulk {
 	})
 }
 
+// SetModerationStatus sets the "moderation_status" field.
+func (u *CampaignUpsertBulk) SetModerationStatus(v campaign.ModerationStatus) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetModerationStatus(v)
+	})
+}
+
+// UpdateModerationStatus sets the "moderation_status" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateModerationStatus() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateModerationStatus()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CampaignUpsertBulk) SetRejectionReason(v campaign.RejectionReason) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateRejectionReason() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CampaignUpsertBulk) ClearRejectionReason() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (u *CampaignUpsertBulk) SetModerationComment(v string) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetModerationComment(v)
+	})
+}
+
+// UpdateModerationComment sets the "moderation_comment" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateModerationComment() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateModerationComment()
+	})
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (u *CampaignUpsertBulk) ClearModerationComment() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearModerationComment()
+	})
+}
+
+// SetApprovedAdTitle sets the "approved_ad_title" field.
+func (u *CampaignUpsertBulk) SetApprovedAdTitle(v string) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetApprovedAdTitle(v)
+	})
+}
+
+// UpdateApprovedAdTitle sets the "approved_ad_title" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateApprovedAdTitle() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateApprovedAdTitle()
+	})
+}
+
+// ClearApprovedAdTitle clears the value of the "approved_ad_title" field.
+func (u *CampaignUpsertBulk) ClearApprovedAdTitle() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearApprovedAdTitle()
+	})
+}
+
+// SetApprovedAdText sets the "approved_ad_text" field.
+func (u *CampaignUpsertBulk) SetApprovedAdText(v string) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetApprovedAdText(v)
+	})
+}
+
+// UpdateApprovedAdText sets the "approved_ad_text" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateApprovedAdText() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateApprovedAdText()
+	})
+}
+
+// ClearApprovedAdText clears the value of the "approved_ad_text" field.
+func (u *CampaignUpsertBulk) ClearApprovedAdText() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearApprovedAdText()
+	})
+}
+
+// SetApprovedImageURL sets the "approved_image_url" field.
+func (u *CampaignUpsertBulk) SetApprovedImageURL(v string) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetApprovedImageURL(v)
+	})
+}
+
+// UpdateApprovedImageURL sets the "approved_image_url" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateApprovedImageURL() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateApprovedImageURL()
+	})
+}
+
+// ClearApprovedImageURL clears the value of the "approved_image_url" field.
+func (u *CampaignUpsertBulk) ClearApprovedImageURL() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearApprovedImageURL()
+	})
+}
+
+// SetAiCategory sets the "ai_category" field.
+func (u *CampaignUpsertBulk) SetAiCategory(v string) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiCategory(v)
+	})
+}
+
+// UpdateAiCategory sets the "ai_category" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateAiCategory() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiCategory()
+	})
+}
+
+// ClearAiCategory clears the value of the "ai_category" field.
+func (u *CampaignUpsertBulk) ClearAiCategory() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiCategory()
+	})
+}
+
+// SetAiViolations sets the "ai_violations" field.
+func (u *CampaignUpsertBulk) SetAiViolations(v []schema.AIViolation) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiViolations(v)
+	})
+}
+
+// UpdateAiViolations sets the "ai_violations" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateAiViolations() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiViolations()
+	})
+}
+
+// ClearAiViolations clears the value of the "ai_violations" field.
+func (u *CampaignUpsertBulk) ClearAiViolations() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiViolations()
+	})
+}
+
+// SetAiConfidence sets the "ai_confidence" field.
+func (u *CampaignUpsertBulk) SetAiConfidence(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiConfidence(v)
+	})
+}
+
+// AddAiConfidence adds v to the "ai_confidence" field.
+func (u *CampaignUpsertBulk) AddAiConfidence(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddAiConfidence(v)
+	})
+}
+
+// UpdateAiConfidence sets the "ai_confidence" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateAiConfidence() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiConfidence()
+	})
+}
+
+// ClearAiConfidence clears the value of the "ai_confidence" field.
+func (u *CampaignUpsertBulk) ClearAiConfidence() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiConfidence()
+	})
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (u *CampaignUpsertBulk) SetRiskScore(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetRiskScore(v)
+	})
+}
+
+// AddRiskScore adds v to the "risk_score" field.
+func (u *CampaignUpsertBulk) AddRiskScore(v float64) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddRiskScore(v)
+	})
+}
+
+// UpdateRiskScore sets the "risk_score" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateRiskScore() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateRiskScore()
+	})
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (u *CampaignUpsertBulk) ClearRiskScore() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearRiskScore()
+	})
+}
+
+// SetAiReviewedAt sets the "ai_reviewed_at" field.
+func (u *CampaignUpsertBulk) SetAiReviewedAt(v time.Time) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiReviewedAt(v)
+	})
+}
+
+// UpdateAiReviewedAt sets the "ai_reviewed_at" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateAiReviewedAt() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiReviewedAt()
+	})
+}
+
+// ClearAiReviewedAt clears the value of the "ai_reviewed_at" field.
+func (u *CampaignUpsertBulk) ClearAiReviewedAt() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiReviewedAt()
+	})
+}
+
+// SetAiReviewAttempts sets the "ai_review_attempts" field.
+func (u *CampaignUpsertBulk) SetAiReviewAttempts(v int) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiReviewAttempts(v)
+	})
+}
+
+// AddAiReviewAttempts adds v to the "ai_review_attempts" field.
+func (u *CampaignUpsertBulk) AddAiReviewAttempts(v int) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.AddAiReviewAttempts(v)
+	})
+}
+
+// UpdateAiReviewAttempts sets the "ai_review_attempts" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateAiReviewAttempts() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiReviewAttempts()
+	})
+}
+
+// SetAiReviewRetryAt sets the "ai_review_retry_at" field.
+func (u *CampaignUpsertBulk) SetAiReviewRetryAt(v time.Time) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetAiReviewRetryAt(v)
+	})
+}
+
+// UpdateAiReviewRetryAt sets the "ai_review_retry_at" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateAiReviewRetryAt() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateAiReviewRetryAt()
+	})
+}
+
+// ClearAiReviewRetryAt clears the value of the "ai_review_retry_at" field.
+func (u *CampaignUpsertBulk) ClearAiReviewRetryAt() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearAiReviewRetryAt()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *CampaignUpsertBulk) SetPaused(v bool) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdatePaused() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CampaignUpsertBulk) SetDeletedAt(v time.Time) *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CampaignUpsertBulk) UpdateDeletedAt() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CampaignUpsertBulk) ClearDeletedAt() *CampaignUpsertBulk {
+	return u.Update(func(s *CampaignUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *CampaignUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
 	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"nlypage-final/internal/adapters/database/postgres/ent/schema"
 	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -127,6 +130,102 @@ func (cu *CampaignUpdate) AddCostPerClick(f float64) *CampaignUpdate {
 	return cu
 }
 
+// SetCostPerAction sets the "cost_per_action" field.
+func (cu *CampaignUpdate) SetCostPerAction(f float64) *CampaignUpdate {
+	cu.mutation.ResetCostPerAction()
+	cu.mutation.SetCostPerAction(f)
+	return cu
+}
+
+// SetNillableCostPerAction sets the "cost_per_action" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableCostPerAction(f *float64) *CampaignUpdate {
+	if f != nil {
+		cu.SetCostPerAction(*f)
+	}
+	return cu
+}
+
+// AddCostPerAction adds f to the "cost_per_action" field.
+func (cu *CampaignUpdate) AddCostPerAction(f float64) *CampaignUpdate {
+	cu.mutation.AddCostPerAction(f)
+	return cu
+}
+
+// SetDailyBudget sets the "daily_budget" field.
+func (cu *CampaignUpdate) SetDailyBudget(f float64) *CampaignUpdate {
+	cu.mutation.ResetDailyBudget()
+	cu.mutation.SetDailyBudget(f)
+	return cu
+}
+
+// SetNillableDailyBudget sets the "daily_budget" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableDailyBudget(f *float64) *CampaignUpdate {
+	if f != nil {
+		cu.SetDailyBudget(*f)
+	}
+	return cu
+}
+
+// AddDailyBudget adds f to the "daily_budget" field.
+func (cu *CampaignUpdate) AddDailyBudget(f float64) *CampaignUpdate {
+	cu.mutation.AddDailyBudget(f)
+	return cu
+}
+
+// ClearDailyBudget clears the value of the "daily_budget" field.
+func (cu *CampaignUpdate) ClearDailyBudget() *CampaignUpdate {
+	cu.mutation.ClearDailyBudget()
+	return cu
+}
+
+// SetTotalBudget sets the "total_budget" field.
+func (cu *CampaignUpdate) SetTotalBudget(f float64) *CampaignUpdate {
+	cu.mutation.ResetTotalBudget()
+	cu.mutation.SetTotalBudget(f)
+	return cu
+}
+
+// SetNillableTotalBudget sets the "total_budget" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableTotalBudget(f *float64) *CampaignUpdate {
+	if f != nil {
+		cu.SetTotalBudget(*f)
+	}
+	return cu
+}
+
+// AddTotalBudget adds f to the "total_budget" field.
+func (cu *CampaignUpdate) AddTotalBudget(f float64) *CampaignUpdate {
+	cu.mutation.AddTotalBudget(f)
+	return cu
+}
+
+// ClearTotalBudget clears the value of the "total_budget" field.
+func (cu *CampaignUpdate) ClearTotalBudget() *CampaignUpdate {
+	cu.mutation.ClearTotalBudget()
+	return cu
+}
+
+// SetSpent sets the "spent" field.
+func (cu *CampaignUpdate) SetSpent(f float64) *CampaignUpdate {
+	cu.mutation.ResetSpent()
+	cu.mutation.SetSpent(f)
+	return cu
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableSpent(f *float64) *CampaignUpdate {
+	if f != nil {
+		cu.SetSpent(*f)
+	}
+	return cu
+}
+
+// AddSpent adds f to the "spent" field.
+func (cu *CampaignUpdate) AddSpent(f float64) *CampaignUpdate {
+	cu.mutation.AddSpent(f)
+	return cu
+}
+
 // SetAdTitle sets the "ad_title" field.
 func (cu *CampaignUpdate) SetAdTitle(s string) *CampaignUpdate {
 	cu.mutation.SetAdTitle(s)
@@ -175,6 +274,33 @@ func (cu *CampaignUpdate) ClearImageURL() *CampaignUpdate {
 	return cu
 }
 
+// SetImageHash sets the "image_hash" field.
+func (cu *CampaignUpdate) SetImageHash(i int64) *CampaignUpdate {
+	cu.mutation.ResetImageHash()
+	cu.mutation.SetImageHash(i)
+	return cu
+}
+
+// SetNillableImageHash sets the "image_hash" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableImageHash(i *int64) *CampaignUpdate {
+	if i != nil {
+		cu.SetImageHash(*i)
+	}
+	return cu
+}
+
+// AddImageHash adds i to the "image_hash" field.
+func (cu *CampaignUpdate) AddImageHash(i int64) *CampaignUpdate {
+	cu.mutation.AddImageHash(i)
+	return cu
+}
+
+// ClearImageHash clears the value of the "image_hash" field.
+func (cu *CampaignUpdate) ClearImageHash() *CampaignUpdate {
+	cu.mutation.ClearImageHash()
+	return cu
+}
+
 // SetStartDate sets the "start_date" field.
 func (cu *CampaignUpdate) SetStartDate(i int) *CampaignUpdate {
 	cu.mutation.ResetStartDate()
@@ -231,6 +357,307 @@ func (cu *CampaignUpdate) SetNillableModerated(b *bool) *CampaignUpdate {
 	return cu
 }
 
+// SetModerationStatus sets the "moderation_status" field.
+func (cu *CampaignUpdate) SetModerationStatus(cs campaign.ModerationStatus) *CampaignUpdate {
+	cu.mutation.SetModerationStatus(cs)
+	return cu
+}
+
+// SetNillableModerationStatus sets the "moderation_status" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableModerationStatus(cs *campaign.ModerationStatus) *CampaignUpdate {
+	if cs != nil {
+		cu.SetModerationStatus(*cs)
+	}
+	return cu
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (cu *CampaignUpdate) SetRejectionReason(cr campaign.RejectionReason) *CampaignUpdate {
+	cu.mutation.SetRejectionReason(cr)
+	return cu
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableRejectionReason(cr *campaign.RejectionReason) *CampaignUpdate {
+	if cr != nil {
+		cu.SetRejectionReason(*cr)
+	}
+	return cu
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (cu *CampaignUpdate) ClearRejectionReason() *CampaignUpdate {
+	cu.mutation.ClearRejectionReason()
+	return cu
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (cu *CampaignUpdate) SetModerationComment(s string) *CampaignUpdate {
+	cu.mutation.SetModerationComment(s)
+	return cu
+}
+
+// SetNillableModerationComment sets the "moderation_comment" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableModerationComment(s *string) *CampaignUpdate {
+	if s != nil {
+		cu.SetModerationComment(*s)
+	}
+	return cu
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (cu *CampaignUpdate) ClearModerationComment() *CampaignUpdate {
+	cu.mutation.ClearModerationComment()
+	return cu
+}
+
+// SetApprovedAdTitle sets the "approved_ad_title" field.
+func (cu *CampaignUpdate) SetApprovedAdTitle(s string) *CampaignUpdate {
+	cu.mutation.SetApprovedAdTitle(s)
+	return cu
+}
+
+// SetNillableApprovedAdTitle sets the "approved_ad_title" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableApprovedAdTitle(s *string) *CampaignUpdate {
+	if s != nil {
+		cu.SetApprovedAdTitle(*s)
+	}
+	return cu
+}
+
+// ClearApprovedAdTitle clears the value of the "approved_ad_title" field.
+func (cu *CampaignUpdate) ClearApprovedAdTitle() *CampaignUpdate {
+	cu.mutation.ClearApprovedAdTitle()
+	return cu
+}
+
+// SetApprovedAdText sets the "approved_ad_text" field.
+func (cu *CampaignUpdate) SetApprovedAdText(s string) *CampaignUpdate {
+	cu.mutation.SetApprovedAdText(s)
+	return cu
+}
+
+// SetNillableApprovedAdText sets the "approved_ad_text" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableApprovedAdText(s *string) *CampaignUpdate {
+	if s != nil {
+		cu.SetApprovedAdText(*s)
+	}
+	return cu
+}
+
+// ClearApprovedAdText clears the value of the "approved_ad_text" field.
+func (cu *CampaignUpdate) ClearApprovedAdText() *CampaignUpdate {
+	cu.mutation.ClearApprovedAdText()
+	return cu
+}
+
+// SetApprovedImageURL sets the "approved_image_url" field.
+func (cu *CampaignUpdate) SetApprovedImageURL(s string) *CampaignUpdate {
+	cu.mutation.SetApprovedImageURL(s)
+	return cu
+}
+
+// SetNillableApprovedImageURL sets the "approved_image_url" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableApprovedImageURL(s *string) *CampaignUpdate {
+	if s != nil {
+		cu.SetApprovedImageURL(*s)
+	}
+	return cu
+}
+
+// ClearApprovedImageURL clears the value of the "approved_image_url" field.
+func (cu *CampaignUpdate) ClearApprovedImageURL() *CampaignUpdate {
+	cu.mutation.ClearApprovedImageURL()
+	return cu
+}
+
+// SetAiCategory sets the "ai_category" field.
+func (cu *CampaignUpdate) SetAiCategory(s string) *CampaignUpdate {
+	cu.mutation.SetAiCategory(s)
+	return cu
+}
+
+// SetNillableAiCategory sets the "ai_category" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableAiCategory(s *string) *CampaignUpdate {
+	if s != nil {
+		cu.SetAiCategory(*s)
+	}
+	return cu
+}
+
+// ClearAiCategory clears the value of the "ai_category" field.
+func (cu *CampaignUpdate) ClearAiCategory() *CampaignUpdate {
+	cu.mutation.ClearAiCategory()
+	return cu
+}
+
+// SetAiViolations sets the "ai_violations" field.
+func (cu *CampaignUpdate) SetAiViolations(sv []schema.AIViolation) *CampaignUpdate {
+	cu.mutation.SetAiViolations(sv)
+	return cu
+}
+
+// AppendAiViolations appends sv to the "ai_violations" field.
+func (cu *CampaignUpdate) AppendAiViolations(sv []schema.AIViolation) *CampaignUpdate {
+	cu.mutation.AppendAiViolations(sv)
+	return cu
+}
+
+// ClearAiViolations clears the value of the "ai_violations" field.
+func (cu *CampaignUpdate) ClearAiViolations() *CampaignUpdate {
+	cu.mutation.ClearAiViolations()
+	return cu
+}
+
+// SetAiConfidence sets the "ai_confidence" field.
+func (cu *CampaignUpdate) SetAiConfidence(f float64) *CampaignUpdate {
+	cu.mutation.ResetAiConfidence()
+	cu.mutation.SetAiConfidence(f)
+	return cu
+}
+
+// SetNillableAiConfidence sets the "ai_confidence" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableAiConfidence(f *float64) *CampaignUpdate {
+	if f != nil {
+		cu.SetAiConfidence(*f)
+	}
+	return cu
+}
+
+// AddAiConfidence adds f to the "ai_confidence" field.
+func (cu *CampaignUpdate) AddAiConfidence(f float64) *CampaignUpdate {
+	cu.mutation.AddAiConfidence(f)
+	return cu
+}
+
+// ClearAiConfidence clears the value of the "ai_confidence" field.
+func (cu *CampaignUpdate) ClearAiConfidence() *CampaignUpdate {
+	cu.mutation.ClearAiConfidence()
+	return cu
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (cu *CampaignUpdate) SetRiskScore(f float64) *CampaignUpdate {
+	cu.mutation.ResetRiskScore()
+	cu.mutation.SetRiskScore(f)
+	return cu
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableRiskScore(f *float64) *CampaignUpdate {
+	if f != nil {
+		cu.SetRiskScore(*f)
+	}
+	return cu
+}
+
+// AddRiskScore adds f to the "risk_score" field.
+func (cu *CampaignUpdate) AddRiskScore(f float64) *CampaignUpdate {
+	cu.mutation.AddRiskScore(f)
+	return cu
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (cu *CampaignUpdate) ClearRiskScore() *CampaignUpdate {
+	cu.mutation.ClearRiskScore()
+	return cu
+}
+
+// SetAiReviewedAt sets the "ai_reviewed_at" field.
+func (cu *CampaignUpdate) SetAiReviewedAt(t time.Time) *CampaignUpdate {
+	cu.mutation.SetAiReviewedAt(t)
+	return cu
+}
+
+// SetNillableAiReviewedAt sets the "ai_reviewed_at" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableAiReviewedAt(t *time.Time) *CampaignUpdate {
+	if t != nil {
+		cu.SetAiReviewedAt(*t)
+	}
+	return cu
+}
+
+// ClearAiReviewedAt clears the value of the "ai_reviewed_at" field.
+func (cu *CampaignUpdate) ClearAiReviewedAt() *CampaignUpdate {
+	cu.mutation.ClearAiReviewedAt()
+	return cu
+}
+
+// SetAiReviewAttempts sets the "ai_review_attempts" field.
+func (cu *CampaignUpdate) SetAiReviewAttempts(i int) *CampaignUpdate {
+	cu.mutation.ResetAiReviewAttempts()
+	cu.mutation.SetAiReviewAttempts(i)
+	return cu
+}
+
+// SetNillableAiReviewAttempts sets the "ai_review_attempts" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableAiReviewAttempts(i *int) *CampaignUpdate {
+	if i != nil {
+		cu.SetAiReviewAttempts(*i)
+	}
+	return cu
+}
+
+// AddAiReviewAttempts adds i to the "ai_review_attempts" field.
+func (cu *CampaignUpdate) AddAiReviewAttempts(i int) *CampaignUpdate {
+	cu.mutation.AddAiReviewAttempts(i)
+	return cu
+}
+
+// SetAiReviewRetryAt sets the "ai_review_retry_at" field.
+func (cu *CampaignUpdate) SetAiReviewRetryAt(t time.Time) *CampaignUpdate {
+	cu.mutation.SetAiReviewRetryAt(t)
+	return cu
+}
+
+// SetNillableAiReviewRetryAt sets the "ai_review_retry_at" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableAiReviewRetryAt(t *time.Time) *CampaignUpdate {
+	if t != nil {
+		cu.SetAiReviewRetryAt(*t)
+	}
+	return cu
+}
+
+// ClearAiReviewRetryAt clears the value of the "ai_review_retry_at" field.
+func (cu *CampaignUpdate) ClearAiReviewRetryAt() *CampaignUpdate {
+	cu.mutation.ClearAiReviewRetryAt()
+	return cu
+}
+
+// SetPaused sets the "paused" field.
+func (cu *CampaignUpdate) SetPaused(b bool) *CampaignUpdate {
+	cu.mutation.SetPaused(b)
+	return cu
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillablePaused(b *bool) *CampaignUpdate {
+	if b != nil {
+		cu.SetPaused(*b)
+	}
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CampaignUpdate) SetDeletedAt(t time.Time) *CampaignUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableDeletedAt(t *time.Time) *CampaignUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CampaignUpdate) ClearDeletedAt() *CampaignUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
 // SetTargetingID sets the "targeting" edge to the Targeting entity by ID.
 func (cu *CampaignUpdate) SetTargetingID(id int) *CampaignUpdate {
 	cu.mutation.SetTargetingID(id)
@@ -320,6 +747,16 @@ func (cu *CampaignUpdate) check() error {
 			return &ValidationError{Name: "end_date", err: fmt.Errorf(`ent: validator failed for field "Campaign.end_date": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.ModerationStatus(); ok {
+		if err := campaign.ModerationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_status", err: fmt.Errorf(`ent: validator failed for field "Campaign.moderation_status": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.RejectionReason(); ok {
+		if err := campaign.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "Campaign.rejection_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -362,6 +799,36 @@ func (cu *CampaignUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.AddedCostPerClick(); ok {
 		_spec.AddField(campaign.FieldCostPerClick, field.TypeFloat64, value)
 	}
+	if value, ok := cu.mutation.CostPerAction(); ok {
+		_spec.SetField(campaign.FieldCostPerAction, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedCostPerAction(); ok {
+		_spec.AddField(campaign.FieldCostPerAction, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.DailyBudget(); ok {
+		_spec.SetField(campaign.FieldDailyBudget, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedDailyBudget(); ok {
+		_spec.AddField(campaign.FieldDailyBudget, field.TypeFloat64, value)
+	}
+	if cu.mutation.DailyBudgetCleared() {
+		_spec.ClearField(campaign.FieldDailyBudget, field.TypeFloat64)
+	}
+	if value, ok := cu.mutation.TotalBudget(); ok {
+		_spec.SetField(campaign.FieldTotalBudget, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedTotalBudget(); ok {
+		_spec.AddField(campaign.FieldTotalBudget, field.TypeFloat64, value)
+	}
+	if cu.mutation.TotalBudgetCleared() {
+		_spec.ClearField(campaign.FieldTotalBudget, field.TypeFloat64)
+	}
+	if value, ok := cu.mutation.Spent(); ok {
+		_spec.SetField(campaign.FieldSpent, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedSpent(); ok {
+		_spec.AddField(campaign.FieldSpent, field.TypeFloat64, value)
+	}
 	if value, ok := cu.mutation.AdTitle(); ok {
 		_spec.SetField(campaign.FieldAdTitle, field.TypeString, value)
 	}
@@ -374,6 +841,15 @@ func (cu *CampaignUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.ImageURLCleared() {
 		_spec.ClearField(campaign.FieldImageURL, field.TypeString)
 	}
+	if value, ok := cu.mutation.ImageHash(); ok {
+		_spec.SetField(campaign.FieldImageHash, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedImageHash(); ok {
+		_spec.AddField(campaign.FieldImageHash, field.TypeInt64, value)
+	}
+	if cu.mutation.ImageHashCleared() {
+		_spec.ClearField(campaign.FieldImageHash, field.TypeInt64)
+	}
 	if value, ok := cu.mutation.StartDate(); ok {
 		_spec.SetField(campaign.FieldStartDate, field.TypeInt, value)
 	}
@@ -389,6 +865,101 @@ func (cu *CampaignUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.Moderated(); ok {
 		_spec.SetField(campaign.FieldModerated, field.TypeBool, value)
 	}
+	if value, ok := cu.mutation.ModerationStatus(); ok {
+		_spec.SetField(campaign.FieldModerationStatus, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.RejectionReason(); ok {
+		_spec.SetField(campaign.FieldRejectionReason, field.TypeEnum, value)
+	}
+	if cu.mutation.RejectionReasonCleared() {
+		_spec.ClearField(campaign.FieldRejectionReason, field.TypeEnum)
+	}
+	if value, ok := cu.mutation.ModerationComment(); ok {
+		_spec.SetField(campaign.FieldModerationComment, field.TypeString, value)
+	}
+	if cu.mutation.ModerationCommentCleared() {
+		_spec.ClearField(campaign.FieldModerationComment, field.TypeString)
+	}
+	if value, ok := cu.mutation.ApprovedAdTitle(); ok {
+		_spec.SetField(campaign.FieldApprovedAdTitle, field.TypeString, value)
+	}
+	if cu.mutation.ApprovedAdTitleCleared() {
+		_spec.ClearField(campaign.FieldApprovedAdTitle, field.TypeString)
+	}
+	if value, ok := cu.mutation.ApprovedAdText(); ok {
+		_spec.SetField(campaign.FieldApprovedAdText, field.TypeString, value)
+	}
+	if cu.mutation.ApprovedAdTextCleared() {
+		_spec.ClearField(campaign.FieldApprovedAdText, field.TypeString)
+	}
+	if value, ok := cu.mutation.ApprovedImageURL(); ok {
+		_spec.SetField(campaign.FieldApprovedImageURL, field.TypeString, value)
+	}
+	if cu.mutation.ApprovedImageURLCleared() {
+		_spec.ClearField(campaign.FieldApprovedImageURL, field.TypeString)
+	}
+	if value, ok := cu.mutation.AiCategory(); ok {
+		_spec.SetField(campaign.FieldAiCategory, field.TypeString, value)
+	}
+	if cu.mutation.AiCategoryCleared() {
+		_spec.ClearField(campaign.FieldAiCategory, field.TypeString)
+	}
+	if value, ok := cu.mutation.AiViolations(); ok {
+		_spec.SetField(campaign.FieldAiViolations, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedAiViolations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, campaign.FieldAiViolations, value)
+		})
+	}
+	if cu.mutation.AiViolationsCleared() {
+		_spec.ClearField(campaign.FieldAiViolations, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.AiConfidence(); ok {
+		_spec.SetField(campaign.FieldAiConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedAiConfidence(); ok {
+		_spec.AddField(campaign.FieldAiConfidence, field.TypeFloat64, value)
+	}
+	if cu.mutation.AiConfidenceCleared() {
+		_spec.ClearField(campaign.FieldAiConfidence, field.TypeFloat64)
+	}
+	if value, ok := cu.mutation.RiskScore(); ok {
+		_spec.SetField(campaign.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedRiskScore(); ok {
+		_spec.AddField(campaign.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if cu.mutation.RiskScoreCleared() {
+		_spec.ClearField(campaign.FieldRiskScore, field.TypeFloat64)
+	}
+	if value, ok := cu.mutation.AiReviewedAt(); ok {
+		_spec.SetField(campaign.FieldAiReviewedAt, field.TypeTime, value)
+	}
+	if cu.mutation.AiReviewedAtCleared() {
+		_spec.ClearField(campaign.FieldAiReviewedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.AiReviewAttempts(); ok {
+		_spec.SetField(campaign.FieldAiReviewAttempts, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedAiReviewAttempts(); ok {
+		_spec.AddField(campaign.FieldAiReviewAttempts, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AiReviewRetryAt(); ok {
+		_spec.SetField(campaign.FieldAiReviewRetryAt, field.TypeTime, value)
+	}
+	if cu.mutation.AiReviewRetryAtCleared() {
+		_spec.ClearField(campaign.FieldAiReviewRetryAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.Paused(); ok {
+		_spec.SetField(campaign.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(campaign.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(campaign.FieldDeletedAt, field.TypeTime)
+	}
 	if cu.mutation.TargetingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -480,59 +1051,155 @@ func (cuo *CampaignUpdateOne) SetClicksLimit(i int) *CampaignUpdateOne {
 	return cuo
 }
 
-// SetNillableClicksLimit sets the "clicks_limit" field if the given value is not nil.
-func (cuo *CampaignUpdateOne) SetNillableClicksLimit(i *int) *CampaignUpdateOne {
-	if i != nil {
-		cuo.SetClicksLimit(*i)
+// SetNillableClicksLimit sets the "clicks_limit" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableClicksLimit(i *int) *CampaignUpdateOne {
+	if i != nil {
+		cuo.SetClicksLimit(*i)
+	}
+	return cuo
+}
+
+// AddClicksLimit adds i to the "clicks_limit" field.
+func (cuo *CampaignUpdateOne) AddClicksLimit(i int) *CampaignUpdateOne {
+	cuo.mutation.AddClicksLimit(i)
+	return cuo
+}
+
+// SetCostPerImpression sets the "cost_per_impression" field.
+func (cuo *CampaignUpdateOne) SetCostPerImpression(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetCostPerImpression()
+	cuo.mutation.SetCostPerImpression(f)
+	return cuo
+}
+
+// SetNillableCostPerImpression sets the "cost_per_impression" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableCostPerImpression(f *float64) *CampaignUpdateOne {
+	if f != nil {
+		cuo.SetCostPerImpression(*f)
+	}
+	return cuo
+}
+
+// AddCostPerImpression adds f to the "cost_per_impression" field.
+func (cuo *CampaignUpdateOne) AddCostPerImpression(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddCostPerImpression(f)
+	return cuo
+}
+
+// SetCostPerClick sets the "cost_per_click" field.
+func (cuo *CampaignUpdateOne) SetCostPerClick(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetCostPerClick()
+	cuo.mutation.SetCostPerClick(f)
+	return cuo
+}
+
+// SetNillableCostPerClick sets the "cost_per_click" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableCostPerClick(f *float64) *CampaignUpdateOne {
+	if f != nil {
+		cuo.SetCostPerClick(*f)
+	}
+	return cuo
+}
+
+// AddCostPerClick adds f to the "cost_per_click" field.
+func (cuo *CampaignUpdateOne) AddCostPerClick(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddCostPerClick(f)
+	return cuo
+}
+
+// SetCostPerAction sets the "cost_per_action" field.
+func (cuo *CampaignUpdateOne) SetCostPerAction(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetCostPerAction()
+	cuo.mutation.SetCostPerAction(f)
+	return cuo
+}
+
+// SetNillableCostPerAction sets the "cost_per_action" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableCostPerAction(f *float64) *CampaignUpdateOne {
+	if f != nil {
+		cuo.SetCostPerAction(*f)
+	}
+	return cuo
+}
+
+// AddCostPerAction adds f to the "cost_per_action" field.
+func (cuo *CampaignUpdateOne) AddCostPerAction(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddCostPerAction(f)
+	return cuo
+}
+
+// SetDailyBudget sets the "daily_budget" field.
+func (cuo *CampaignUpdateOne) SetDailyBudget(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetDailyBudget()
+	cuo.mutation.SetDailyBudget(f)
+	return cuo
+}
+
+// SetNillableDailyBudget sets the "daily_budget" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableDailyBudget(f *float64) *CampaignUpdateOne {
+	if f != nil {
+		cuo.SetDailyBudget(*f)
 	}
 	return cuo
 }
 
-// AddClicksLimit adds i to the "clicks_limit" field.
-func (cuo *CampaignUpdateOne) AddClicksLimit(i int) *CampaignUpdateOne {
-	cuo.mutation.AddClicksLimit(i)
+// AddDailyBudget adds f to the "daily_budget" field.
+func (cuo *CampaignUpdateOne) AddDailyBudget(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddDailyBudget(f)
 	return cuo
 }
 
-// SetCostPerImpression sets the "cost_per_impression" field.
-func (cuo *CampaignUpdateOne) SetCostPerImpression(f float64) *CampaignUpdateOne {
-	cuo.mutation.ResetCostPerImpression()
-	cuo.mutation.SetCostPerImpression(f)
+// ClearDailyBudget clears the value of the "daily_budget" field.
+func (cuo *CampaignUpdateOne) ClearDailyBudget() *CampaignUpdateOne {
+	cuo.mutation.ClearDailyBudget()
 	return cuo
 }
 
-// SetNillableCostPerImpression sets the "cost_per_impression" field if the given value is not nil.
-func (cuo *CampaignUpdateOne) SetNillableCostPerImpression(f *float64) *CampaignUpdateOne {
+// SetTotalBudget sets the "total_budget" field.
+func (cuo *CampaignUpdateOne) SetTotalBudget(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetTotalBudget()
+	cuo.mutation.SetTotalBudget(f)
+	return cuo
+}
+
+// SetNillableTotalBudget sets the "total_budget" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableTotalBudget(f *float64) *CampaignUpdateOne {
 	if f != nil {
-		cuo.SetCostPerImpression(*f)
+		cuo.SetTotalBudget(*f)
 	}
 	return cuo
 }
 
-// AddCostPerImpression adds f to the "cost_per_impression" field.
-func (cuo *CampaignUpdateOne) AddCostPerImpression(f float64) *CampaignUpdateOne {
-	cuo.mutation.AddCostPerImpression(f)
+// AddTotalBudget adds f to the "total_budget" field.
+func (cuo *CampaignUpdateOne) AddTotalBudget(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddTotalBudget(f)
 	return cuo
 }
 
-// SetCostPerClick sets the "cost_per_click" field.
-func (cuo *CampaignUpdateOne) SetCostPerClick(f float64) *CampaignUpdateOne {
-	cuo.mutation.ResetCostPerClick()
-	cuo.mutation.SetCostPerClick(f)
+// ClearTotalBudget clears the value of the "total_budget" field.
+func (cuo *CampaignUpdateOne) ClearTotalBudget() *CampaignUpdateOne {
+	cuo.mutation.ClearTotalBudget()
 	return cuo
 }
 
-// SetNillableCostPerClick sets the "cost_per_click" field if the given value is not nil.
-func (cuo *CampaignUpdateOne) SetNillableCostPerClick(f *float64) *CampaignUpdateOne {
+// SetSpent sets the "spent" field.
+func (cuo *CampaignUpdateOne) SetSpent(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetSpent()
+	cuo.mutation.SetSpent(f)
+	return cuo
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableSpent(f *float64) *CampaignUpdateOne {
 	if f != nil {
-		cuo.SetCostPerClick(*f)
+		cuo.SetSpent(*f)
 	}
 	return cuo
 }
 
-// AddCostPerClick adds f to the "cost_per_click" field.
-func (cuo *CampaignUpdateOne) AddCostPerClick(f float64) *CampaignUpdateOne {
-	cuo.mutation.AddCostPerClick(f)
+// AddSpent adds f to the "spent" field.
+func (cuo *CampaignUpdateOne) AddSpent(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddSpent(f)
 	return cuo
 }
 
@@ -584,6 +1251,33 @@ func (cuo *CampaignUpdateOne) ClearImageURL() *CampaignUpdateOne {
 	return cuo
 }
 
+// SetImageHash sets the "image_hash" field.
+func (cuo *CampaignUpdateOne) SetImageHash(i int64) *CampaignUpdateOne {
+	cuo.mutation.ResetImageHash()
+	cuo.mutation.SetImageHash(i)
+	return cuo
+}
+
+// SetNillableImageHash sets the "image_hash" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableImageHash(i *int64) *CampaignUpdateOne {
+	if i != nil {
+		cuo.SetImageHash(*i)
+	}
+	return cuo
+}
+
+// AddImageHash adds i to the "image_hash" field.
+func (cuo *CampaignUpdateOne) AddImageHash(i int64) *CampaignUpdateOne {
+	cuo.mutation.AddImageHash(i)
+	return cuo
+}
+
+// ClearImageHash clears the value of the "image_hash" field.
+func (cuo *CampaignUpdateOne) ClearImageHash() *CampaignUpdateOne {
+	cuo.mutation.ClearImageHash()
+	return cuo
+}
+
 // SetStartDate sets the "start_date" field.
 func (cuo *CampaignUpdateOne) SetStartDate(i int) *CampaignUpdateOne {
 	cuo.mutation.ResetStartDate()
@@ -640,6 +1334,307 @@ func (cuo *CampaignUpdateOne) SetNillableModerated(b *bool) *CampaignUpdateOne {
 	return cuo
 }
 
+// SetModerationStatus sets the "moderation_status" field.
+func (cuo *CampaignUpdateOne) SetModerationStatus(cs campaign.ModerationStatus) *CampaignUpdateOne {
+	cuo.mutation.SetModerationStatus(cs)
+	return cuo
+}
+
+// SetNillableModerationStatus sets the "moderation_status" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableModerationStatus(cs *campaign.ModerationStatus) *CampaignUpdateOne {
+	if cs != nil {
+		cuo.SetModerationStatus(*cs)
+	}
+	return cuo
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (cuo *CampaignUpdateOne) SetRejectionReason(cr campaign.RejectionReason) *CampaignUpdateOne {
+	cuo.mutation.SetRejectionReason(cr)
+	return cuo
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableRejectionReason(cr *campaign.RejectionReason) *CampaignUpdateOne {
+	if cr != nil {
+		cuo.SetRejectionReason(*cr)
+	}
+	return cuo
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (cuo *CampaignUpdateOne) ClearRejectionReason() *CampaignUpdateOne {
+	cuo.mutation.ClearRejectionReason()
+	return cuo
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (cuo *CampaignUpdateOne) SetModerationComment(s string) *CampaignUpdateOne {
+	cuo.mutation.SetModerationComment(s)
+	return cuo
+}
+
+// SetNillableModerationComment sets the "moderation_comment" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableModerationComment(s *string) *CampaignUpdateOne {
+	if s != nil {
+		cuo.SetModerationComment(*s)
+	}
+	return cuo
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (cuo *CampaignUpdateOne) ClearModerationComment() *CampaignUpdateOne {
+	cuo.mutation.ClearModerationComment()
+	return cuo
+}
+
+// SetApprovedAdTitle sets the "approved_ad_title" field.
+func (cuo *CampaignUpdateOne) SetApprovedAdTitle(s string) *CampaignUpdateOne {
+	cuo.mutation.SetApprovedAdTitle(s)
+	return cuo
+}
+
+// SetNillableApprovedAdTitle sets the "approved_ad_title" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableApprovedAdTitle(s *string) *CampaignUpdateOne {
+	if s != nil {
+		cuo.SetApprovedAdTitle(*s)
+	}
+	return cuo
+}
+
+// ClearApprovedAdTitle clears the value of the "approved_ad_title" field.
+func (cuo *CampaignUpdateOne) ClearApprovedAdTitle() *CampaignUpdateOne {
+	cuo.mutation.ClearApprovedAdTitle()
+	return cuo
+}
+
+// SetApprovedAdText sets the "approved_ad_text" field.
+func (cuo *CampaignUpdateOne) SetApprovedAdText(s string) *CampaignUpdateOne {
+	cuo.mutation.SetApprovedAdText(s)
+	return cuo
+}
+
+// SetNillableApprovedAdText sets the "approved_ad_text" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableApprovedAdText(s *string) *CampaignUpdateOne {
+	if s != nil {
+		cuo.SetApprovedAdText(*s)
+	}
+	return cuo
+}
+
+// ClearApprovedAdText clears the value of the "approved_ad_text" field.
+func (cuo *CampaignUpdateOne) ClearApprovedAdText() *CampaignUpdateOne {
+	cuo.mutation.ClearApprovedAdText()
+	return cuo
+}
+
+// SetApprovedImageURL sets the "approved_image_url" field.
+func (cuo *CampaignUpdateOne) SetApprovedImageURL(s string) *CampaignUpdateOne {
+	cuo.mutation.SetApprovedImageURL(s)
+	return cuo
+}
+
+// SetNillableApprovedImageURL sets the "approved_image_url" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableApprovedImageURL(s *string) *CampaignUpdateOne {
+	if s != nil {
+		cuo.SetApprovedImageURL(*s)
+	}
+	return cuo
+}
+
+// ClearApprovedImageURL clears the value of the "approved_image_url" field.
+func (cuo *CampaignUpdateOne) ClearApprovedImageURL() *CampaignUpdateOne {
+	cuo.mutation.ClearApprovedImageURL()
+	return cuo
+}
+
+// SetAiCategory sets the "ai_category" field.
+func (cuo *CampaignUpdateOne) SetAiCategory(s string) *CampaignUpdateOne {
+	cuo.mutation.SetAiCategory(s)
+	return cuo
+}
+
+// SetNillableAiCategory sets the "ai_category" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableAiCategory(s *string) *CampaignUpdateOne {
+	if s != nil {
+		cuo.SetAiCategory(*s)
+	}
+	return cuo
+}
+
+// ClearAiCategory clears the value of the "ai_category" field.
+func (cuo *CampaignUpdateOne) ClearAiCategory() *CampaignUpdateOne {
+	cuo.mutation.ClearAiCategory()
+	return cuo
+}
+
+// SetAiViolations sets the "ai_violations" field.
+func (cuo *CampaignUpdateOne) SetAiViolations(sv []schema.AIViolation) *CampaignUpdateOne {
+	cuo.mutation.SetAiViolations(sv)
+	return cuo
+}
+
+// AppendAiViolations appends sv to the "ai_violations" field.
+func (cuo *CampaignUpdateOne) AppendAiViolations(sv []schema.AIViolation) *CampaignUpdateOne {
+	cuo.mutation.AppendAiViolations(sv)
+	return cuo
+}
+
+// ClearAiViolations clears the value of the "ai_violations" field.
+func (cuo *CampaignUpdateOne) ClearAiViolations() *CampaignUpdateOne {
+	cuo.mutation.ClearAiViolations()
+	return cuo
+}
+
+// SetAiConfidence sets the "ai_confidence" field.
+func (cuo *CampaignUpdateOne) SetAiConfidence(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetAiConfidence()
+	cuo.mutation.SetAiConfidence(f)
+	return cuo
+}
+
+// SetNillableAiConfidence sets the "ai_confidence" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableAiConfidence(f *float64) *CampaignUpdateOne {
+	if f != nil {
+		cuo.SetAiConfidence(*f)
+	}
+	return cuo
+}
+
+// AddAiConfidence adds f to the "ai_confidence" field.
+func (cuo *CampaignUpdateOne) AddAiConfidence(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddAiConfidence(f)
+	return cuo
+}
+
+// ClearAiConfidence clears the value of the "ai_confidence" field.
+func (cuo *CampaignUpdateOne) ClearAiConfidence() *CampaignUpdateOne {
+	cuo.mutation.ClearAiConfidence()
+	return cuo
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (cuo *CampaignUpdateOne) SetRiskScore(f float64) *CampaignUpdateOne {
+	cuo.mutation.ResetRiskScore()
+	cuo.mutation.SetRiskScore(f)
+	return cuo
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableRiskScore(f *float64) *CampaignUpdateOne {
+	if f != nil {
+		cuo.SetRiskScore(*f)
+	}
+	return cuo
+}
+
+// AddRiskScore adds f to the "risk_score" field.
+func (cuo *CampaignUpdateOne) AddRiskScore(f float64) *CampaignUpdateOne {
+	cuo.mutation.AddRiskScore(f)
+	return cuo
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (cuo *CampaignUpdateOne) ClearRiskScore() *CampaignUpdateOne {
+	cuo.mutation.ClearRiskScore()
+	return cuo
+}
+
+// SetAiReviewedAt sets the "ai_reviewed_at" field.
+func (cuo *CampaignUpdateOne) SetAiReviewedAt(t time.Time) *CampaignUpdateOne {
+	cuo.mutation.SetAiReviewedAt(t)
+	return cuo
+}
+
+// SetNillableAiReviewedAt sets the "ai_reviewed_at" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableAiReviewedAt(t *time.Time) *CampaignUpdateOne {
+	if t != nil {
+		cuo.SetAiReviewedAt(*t)
+	}
+	return cuo
+}
+
+// ClearAiReviewedAt clears the value of the "ai_reviewed_at" field.
+func (cuo *CampaignUpdateOne) ClearAiReviewedAt() *CampaignUpdateOne {
+	cuo.mutation.ClearAiReviewedAt()
+	return cuo
+}
+
+// SetAiReviewAttempts sets the "ai_review_attempts" field.
+func (cuo *CampaignUpdateOne) SetAiReviewAttempts(i int) *CampaignUpdateOne {
+	cuo.mutation.ResetAiReviewAttempts()
+	cuo.mutation.SetAiReviewAttempts(i)
+	return cuo
+}
+
+// SetNillableAiReviewAttempts sets the "ai_review_attempts" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableAiReviewAttempts(i *int) *CampaignUpdateOne {
+	if i != nil {
+		cuo.SetAiReviewAttempts(*i)
+	}
+	return cuo
+}
+
+// AddAiReviewAttempts adds i to the "ai_review_attempts" field.
+func (cuo *CampaignUpdateOne) AddAiReviewAttempts(i int) *CampaignUpdateOne {
+	cuo.mutation.AddAiReviewAttempts(i)
+	return cuo
+}
+
+// SetAiReviewRetryAt sets the "ai_review_retry_at" field.
+func (cuo *CampaignUpdateOne) SetAiReviewRetryAt(t time.Time) *CampaignUpdateOne {
+	cuo.mutation.SetAiReviewRetryAt(t)
+	return cuo
+}
+
+// SetNillableAiReviewRetryAt sets the "ai_review_retry_at" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableAiReviewRetryAt(t *time.Time) *CampaignUpdateOne {
+	if t != nil {
+		cuo.SetAiReviewRetryAt(*t)
+	}
+	return cuo
+}
+
+// ClearAiReviewRetryAt clears the value of the "ai_review_retry_at" field.
+func (cuo *CampaignUpdateOne) ClearAiReviewRetryAt() *CampaignUpdateOne {
+	cuo.mutation.ClearAiReviewRetryAt()
+	return cuo
+}
+
+// SetPaused sets the "paused" field.
+func (cuo *CampaignUpdateOne) SetPaused(b bool) *CampaignUpdateOne {
+	cuo.mutation.SetPaused(b)
+	return cuo
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillablePaused(b *bool) *CampaignUpdateOne {
+	if b != nil {
+		cuo.SetPaused(*b)
+	}
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CampaignUpdateOne) SetDeletedAt(t time.Time) *CampaignUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableDeletedAt(t *time.Time) *CampaignUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CampaignUpdateOne) ClearDeletedAt() *CampaignUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
 // SetTargetingID sets the "targeting" edge to the Targeting entity by ID.
 func (cuo *CampaignUpdateOne) SetTargetingID(id int) *CampaignUpdateOne {
 	cuo.mutation.SetTargetingID(id)
@@ -742,6 +1737,16 @@ func (cuo *CampaignUpdateOne) check() error {
 			return &ValidationError{Name: "end_date", err: fmt.Errorf(`ent: validator failed for field "Campaign.end_date": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.ModerationStatus(); ok {
+		if err := campaign.ModerationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_status", err: fmt.Errorf(`ent: validator failed for field "Campaign.moderation_status": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.RejectionReason(); ok {
+		if err := campaign.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "Campaign.rejection_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -801,6 +1806,36 @@ func (cuo *CampaignUpdateOne) sqlSave(ctx context.Context) (_node *Campaign, err
 	if value, ok := cuo.mutation.AddedCostPerClick(); ok {
 		_spec.AddField(campaign.FieldCostPerClick, field.TypeFloat64, value)
 	}
+	if value, ok := cuo.mutation.CostPerAction(); ok {
+		_spec.SetField(campaign.FieldCostPerAction, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedCostPerAction(); ok {
+		_spec.AddField(campaign.FieldCostPerAction, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.DailyBudget(); ok {
+		_spec.SetField(campaign.FieldDailyBudget, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedDailyBudget(); ok {
+		_spec.AddField(campaign.FieldDailyBudget, field.TypeFloat64, value)
+	}
+	if cuo.mutation.DailyBudgetCleared() {
+		_spec.ClearField(campaign.FieldDailyBudget, field.TypeFloat64)
+	}
+	if value, ok := cuo.mutation.TotalBudget(); ok {
+		_spec.SetField(campaign.FieldTotalBudget, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedTotalBudget(); ok {
+		_spec.AddField(campaign.FieldTotalBudget, field.TypeFloat64, value)
+	}
+	if cuo.mutation.TotalBudgetCleared() {
+		_spec.ClearField(campaign.FieldTotalBudget, field.TypeFloat64)
+	}
+	if value, ok := cuo.mutation.Spent(); ok {
+		_spec.SetField(campaign.FieldSpent, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedSpent(); ok {
+		_spec.AddField(campaign.FieldSpent, field.TypeFloat64, value)
+	}
 	if value, ok := cuo.mutation.AdTitle(); ok {
 		_spec.SetField(campaign.FieldAdTitle, field.TypeString, value)
 	}
@@ -813,6 +1848,15 @@ func (cuo *CampaignUpdateOne) sqlSave(ctx context.Context) (_node *Campaign, err
 	if cuo.mutation.ImageURLCleared() {
 		_spec.ClearField(campaign.FieldImageURL, field.TypeString)
 	}
+	if value, ok := cuo.mutation.ImageHash(); ok {
+		_spec.SetField(campaign.FieldImageHash, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedImageHash(); ok {
+		_spec.AddField(campaign.FieldImageHash, field.TypeInt64, value)
+	}
+	if cuo.mutation.ImageHashCleared() {
+		_spec.ClearField(campaign.FieldImageHash, field.TypeInt64)
+	}
 	if value, ok := cuo.mutation.StartDate(); ok {
 		_spec.SetField(campaign.FieldStartDate, field.TypeInt, value)
 	}
@@ -828,6 +1872,101 @@ func (cuo *CampaignUpdateOne) sqlSave(ctx context.Context) (_node *Campaign, err
 	if value, ok := cuo.mutation.Moderated(); ok {
 		_spec.SetField(campaign.FieldModerated, field.TypeBool, value)
 	}
+	if value, ok := cuo.mutation.ModerationStatus(); ok {
+		_spec.SetField(campaign.FieldModerationStatus, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.RejectionReason(); ok {
+		_spec.SetField(campaign.FieldRejectionReason, field.TypeEnum, value)
+	}
+	if cuo.mutation.RejectionReasonCleared() {
+		_spec.ClearField(campaign.FieldRejectionReason, field.TypeEnum)
+	}
+	if value, ok := cuo.mutation.ModerationComment(); ok {
+		_spec.SetField(campaign.FieldModerationComment, field.TypeString, value)
+	}
+	if cuo.mutation.ModerationCommentCleared() {
+		_spec.ClearField(campaign.FieldModerationComment, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ApprovedAdTitle(); ok {
+		_spec.SetField(campaign.FieldApprovedAdTitle, field.TypeString, value)
+	}
+	if cuo.mutation.ApprovedAdTitleCleared() {
+		_spec.ClearField(campaign.FieldApprovedAdTitle, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ApprovedAdText(); ok {
+		_spec.SetField(campaign.FieldApprovedAdText, field.TypeString, value)
+	}
+	if cuo.mutation.ApprovedAdTextCleared() {
+		_spec.ClearField(campaign.FieldApprovedAdText, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ApprovedImageURL(); ok {
+		_spec.SetField(campaign.FieldApprovedImageURL, field.TypeString, value)
+	}
+	if cuo.mutation.ApprovedImageURLCleared() {
+		_spec.ClearField(campaign.FieldApprovedImageURL, field.TypeString)
+	}
+	if value, ok := cuo.mutation.AiCategory(); ok {
+		_spec.SetField(campaign.FieldAiCategory, field.TypeString, value)
+	}
+	if cuo.mutation.AiCategoryCleared() {
+		_spec.ClearField(campaign.FieldAiCategory, field.TypeString)
+	}
+	if value, ok := cuo.mutation.AiViolations(); ok {
+		_spec.SetField(campaign.FieldAiViolations, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedAiViolations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, campaign.FieldAiViolations, value)
+		})
+	}
+	if cuo.mutation.AiViolationsCleared() {
+		_spec.ClearField(campaign.FieldAiViolations, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.AiConfidence(); ok {
+		_spec.SetField(campaign.FieldAiConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedAiConfidence(); ok {
+		_spec.AddField(campaign.FieldAiConfidence, field.TypeFloat64, value)
+	}
+	if cuo.mutation.AiConfidenceCleared() {
+		_spec.ClearField(campaign.FieldAiConfidence, field.TypeFloat64)
+	}
+	if value, ok := cuo.mutation.RiskScore(); ok {
+		_spec.SetField(campaign.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedRiskScore(); ok {
+		_spec.AddField(campaign.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if cuo.mutation.RiskScoreCleared() {
+		_spec.ClearField(campaign.FieldRiskScore, field.TypeFloat64)
+	}
+	if value, ok := cuo.mutation.AiReviewedAt(); ok {
+		_spec.SetField(campaign.FieldAiReviewedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.AiReviewedAtCleared() {
+		_spec.ClearField(campaign.FieldAiReviewedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.AiReviewAttempts(); ok {
+		_spec.SetField(campaign.FieldAiReviewAttempts, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedAiReviewAttempts(); ok {
+		_spec.AddField(campaign.FieldAiReviewAttempts, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AiReviewRetryAt(); ok {
+		_spec.SetField(campaign.FieldAiReviewRetryAt, field.TypeTime, value)
+	}
+	if cuo.mutation.AiReviewRetryAtCleared() {
+		_spec.ClearField(campaign.FieldAiReviewRetryAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.Paused(); ok {
+		_spec.SetField(campaign.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(campaign.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(campaign.FieldDeletedAt, field.TypeTime)
+	}
 	if cuo.mutation.TargetingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// CampaignDailySpend is the model entity for the CampaignDailySpend schema.
+type CampaignDailySpend struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CampaignID holds the value of the "campaign_id" field.
+	CampaignID uuid.UUID `json:"campaign_id,omitempty"`
+	// AdvertiserID holds the value of the "advertiser_id" field.
+	AdvertiserID uuid.UUID `json:"advertiser_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day int `json:"day,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount       float64 `json:"amount,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CampaignDailySpend) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case campaigndailyspend.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case campaigndailyspend.FieldID, campaigndailyspend.FieldDay:
+			values[i] = new(sql.NullInt64)
+		case campaigndailyspend.FieldCampaignID, campaigndailyspend.FieldAdvertiserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CampaignDailySpend fields.
+func (cds *CampaignDailySpend) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case campaigndailyspend.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cds.ID = int(value.Int64)
+		case campaigndailyspend.FieldCampaignID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_id", values[i])
+			} else if value != nil {
+				cds.CampaignID = *value
+			}
+		case campaigndailyspend.FieldAdvertiserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field advertiser_id", values[i])
+			} else if value != nil {
+				cds.AdvertiserID = *value
+			}
+		case campaigndailyspend.FieldDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				cds.Day = int(value.Int64)
+			}
+		case campaigndailyspend.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				cds.Amount = value.Float64
+			}
+		default:
+			cds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CampaignDailySpend.
+// This includes values selected through modifiers, order, etc.
+func (cds *CampaignDailySpend) Value(name string) (ent.Value, error) {
+	return cds.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CampaignDailySpend.
+// Note that you need to call CampaignDailySpend.Unwrap() before calling this method if this CampaignDailySpend
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cds *CampaignDailySpend) Update() *CampaignDailySpendUpdateOne {
+	return NewCampaignDailySpendClient(cds.config).UpdateOne(cds)
+}
+
+// Unwrap unwraps the CampaignDailySpend entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cds *CampaignDailySpend) Unwrap() *CampaignDailySpend {
+	_tx, ok := cds.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CampaignDailySpend is not a transactional entity")
+	}
+	cds.config.driver = _tx.drv
+	return cds
+}
+
+// String implements the fmt.Stringer.
+func (cds *CampaignDailySpend) String() string {
+	var builder strings.Builder
+	builder.WriteString("CampaignDailySpend(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cds.ID))
+	builder.WriteString("campaign_id=")
+	builder.WriteString(fmt.Sprintf("%v", cds.CampaignID))
+	builder.WriteString(", ")
+	builder.WriteString("advertiser_id=")
+	builder.WriteString(fmt.Sprintf("%v", cds.AdvertiserID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(fmt.Sprintf("%v", cds.Day))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", cds.Amount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CampaignDailySpends is a parsable slice of CampaignDailySpend.
+type CampaignDailySpends []*CampaignDailySpend
@@ -0,0 +1,76 @@
+// Code generated by ent, DO NOT EDIT.
+
+package campaigndailyspend
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the campaigndailyspend type in the database.
+	Label = "campaign_daily_spend"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCampaignID holds the string denoting the campaign_id field in the database.
+	FieldCampaignID = "campaign_id"
+	// FieldAdvertiserID holds the string denoting the advertiser_id field in the database.
+	FieldAdvertiserID = "advertiser_id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// Table holds the table name of the campaigndailyspend in the database.
+	Table = "campaign_daily_spends"
+)
+
+// Columns holds all SQL columns for campaigndailyspend fields.
+var Columns = []string{
+	FieldID,
+	FieldCampaignID,
+	FieldAdvertiserID,
+	FieldDay,
+	FieldAmount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount float64
+)
+
+// OrderOption defines the ordering options for the CampaignDailySpend queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCampaignID orders the results by the campaign_id field.
+func ByCampaignID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaignID, opts...).ToFunc()
+}
+
+// ByAdvertiserID orders the results by the advertiser_id field.
+func ByAdvertiserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdvertiserID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
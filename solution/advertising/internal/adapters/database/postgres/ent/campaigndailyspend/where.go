@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package campaigndailyspend
+
+import (
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLTE(FieldID, id))
+}
+
+// CampaignID applies equality check predicate on the "campaign_id" field. It's identical to CampaignIDEQ.
+func CampaignID(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// AdvertiserID applies equality check predicate on the "advertiser_id" field. It's identical to AdvertiserIDEQ.
+func AdvertiserID(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldAdvertiserID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldDay, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldAmount, v))
+}
+
+// CampaignIDEQ applies the EQ predicate on the "campaign_id" field.
+func CampaignIDEQ(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// CampaignIDNEQ applies the NEQ predicate on the "campaign_id" field.
+func CampaignIDNEQ(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNEQ(FieldCampaignID, v))
+}
+
+// CampaignIDIn applies the In predicate on the "campaign_id" field.
+func CampaignIDIn(vs ...uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDNotIn applies the NotIn predicate on the "campaign_id" field.
+func CampaignIDNotIn(vs ...uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNotIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDGT applies the GT predicate on the "campaign_id" field.
+func CampaignIDGT(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGT(FieldCampaignID, v))
+}
+
+// CampaignIDGTE applies the GTE predicate on the "campaign_id" field.
+func CampaignIDGTE(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGTE(FieldCampaignID, v))
+}
+
+// CampaignIDLT applies the LT predicate on the "campaign_id" field.
+func CampaignIDLT(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLT(FieldCampaignID, v))
+}
+
+// CampaignIDLTE applies the LTE predicate on the "campaign_id" field.
+func CampaignIDLTE(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLTE(FieldCampaignID, v))
+}
+
+// AdvertiserIDEQ applies the EQ predicate on the "advertiser_id" field.
+func AdvertiserIDEQ(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDNEQ applies the NEQ predicate on the "advertiser_id" field.
+func AdvertiserIDNEQ(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNEQ(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDIn applies the In predicate on the "advertiser_id" field.
+func AdvertiserIDIn(vs ...uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldIn(FieldAdvertiserID, vs...))
+}
+
+// AdvertiserIDNotIn applies the NotIn predicate on the "advertiser_id" field.
+func AdvertiserIDNotIn(vs ...uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNotIn(FieldAdvertiserID, vs...))
+}
+
+// AdvertiserIDGT applies the GT predicate on the "advertiser_id" field.
+func AdvertiserIDGT(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGT(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDGTE applies the GTE predicate on the "advertiser_id" field.
+func AdvertiserIDGTE(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGTE(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDLT applies the LT predicate on the "advertiser_id" field.
+func AdvertiserIDLT(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLT(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDLTE applies the LTE predicate on the "advertiser_id" field.
+func AdvertiserIDLTE(v uuid.UUID) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLTE(FieldAdvertiserID, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v int) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLTE(FieldDay, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.FieldLTE(FieldAmount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CampaignDailySpend) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CampaignDailySpend) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CampaignDailySpend) predicate.CampaignDailySpend {
+	return predicate.CampaignDailySpend(sql.NotPredicates(p))
+}
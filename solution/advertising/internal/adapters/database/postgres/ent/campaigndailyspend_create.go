@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CampaignDailySpendCreate is the builder for creating a CampaignDailySpend entity.
+type CampaignDailySpendCreate struct {
+	config
+	mutation *CampaignDailySpendMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (cdsc *CampaignDailySpendCreate) SetCampaignID(u uuid.UUID) *CampaignDailySpendCreate {
+	cdsc.mutation.SetCampaignID(u)
+	return cdsc
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (cdsc *CampaignDailySpendCreate) SetAdvertiserID(u uuid.UUID) *CampaignDailySpendCreate {
+	cdsc.mutation.SetAdvertiserID(u)
+	return cdsc
+}
+
+// SetDay sets the "day" field.
+func (cdsc *CampaignDailySpendCreate) SetDay(i int) *CampaignDailySpendCreate {
+	cdsc.mutation.SetDay(i)
+	return cdsc
+}
+
+// SetAmount sets the "amount" field.
+func (cdsc *CampaignDailySpendCreate) SetAmount(f float64) *CampaignDailySpendCreate {
+	cdsc.mutation.SetAmount(f)
+	return cdsc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (cdsc *CampaignDailySpendCreate) SetNillableAmount(f *float64) *CampaignDailySpendCreate {
+	if f != nil {
+		cdsc.SetAmount(*f)
+	}
+	return cdsc
+}
+
+// Mutation returns the CampaignDailySpendMutation object of the builder.
+func (cdsc *CampaignDailySpendCreate) Mutation() *CampaignDailySpendMutation {
+	return cdsc.mutation
+}
+
+// Save creates the CampaignDailySpend in the database.
+func (cdsc *CampaignDailySpendCreate) Save(ctx context.Context) (*CampaignDailySpend, error) {
+	cdsc.defaults()
+	return withHooks(ctx, cdsc.sqlSave, cdsc.mutation, cdsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdsc *CampaignDailySpendCreate) SaveX(ctx context.Context) *CampaignDailySpend {
+	v, err := cdsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdsc *CampaignDailySpendCreate) Exec(ctx context.Context) error {
+	_, err := cdsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdsc *CampaignDailySpendCreate) ExecX(ctx context.Context) {
+	if err := cdsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdsc *CampaignDailySpendCreate) defaults() {
+	if _, ok := cdsc.mutation.Amount(); !ok {
+		v := campaigndailyspend.DefaultAmount
+		cdsc.mutation.SetAmount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdsc *CampaignDailySpendCreate) check() error {
+	if _, ok := cdsc.mutation.CampaignID(); !ok {
+		return &ValidationError{Name: "campaign_id", err: errors.New(`ent: missing required field "CampaignDailySpend.campaign_id"`)}
+	}
+	if _, ok := cdsc.mutation.AdvertiserID(); !ok {
+		return &ValidationError{Name: "advertiser_id", err: errors.New(`ent: missing required field "CampaignDailySpend.advertiser_id"`)}
+	}
+	if _, ok := cdsc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "CampaignDailySpend.day"`)}
+	}
+	if _, ok := cdsc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CampaignDailySpend.amount"`)}
+	}
+	return nil
+}
+
+func (cdsc *CampaignDailySpendCreate) sqlSave(ctx context.Context) (*CampaignDailySpend, error) {
+	if err := cdsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cdsc.mutation.id = &_node.ID
+	cdsc.mutation.done = true
+	return _node, nil
+}
+
+func (cdsc *CampaignDailySpendCreate) createSpec() (*CampaignDailySpend, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CampaignDailySpend{config: cdsc.config}
+		_spec = sqlgraph.NewCreateSpec(campaigndailyspend.Table, sqlgraph.NewFieldSpec(campaigndailyspend.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = cdsc.conflict
+	if value, ok := cdsc.mutation.CampaignID(); ok {
+		_spec.SetField(campaigndailyspend.FieldCampaignID, field.TypeUUID, value)
+		_node.CampaignID = value
+	}
+	if value, ok := cdsc.mutation.AdvertiserID(); ok {
+		_spec.SetField(campaigndailyspend.FieldAdvertiserID, field.TypeUUID, value)
+		_node.AdvertiserID = value
+	}
+	if value, ok := cdsc.mutation.Day(); ok {
+		_spec.SetField(campaigndailyspend.FieldDay, field.TypeInt, value)
+		_node.Day = value
+	}
+	if value, ok := cdsc.mutation.Amount(); ok {
+		_spec.SetField(campaigndailyspend.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CampaignDailySpend.Create().
+//		SetCampaignID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CampaignDailySpendUpsert) {
+//			SetCampaignID(v+v).
+//		}).
+//		Exec(ctx)
+func (cdsc *CampaignDailySpendCreate) OnConflict(opts ...sql.ConflictOption) *CampaignDailySpendUpsertOne {
+	cdsc.conflict = opts
+	return &CampaignDailySpendUpsertOne{
+		create: cdsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CampaignDailySpend.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cdsc *CampaignDailySpendCreate) OnConflictColumns(columns ...string) *CampaignDailySpendUpsertOne {
+	cdsc.conflict = append(cdsc.conflict, sql.ConflictColumns(columns...))
+	return &CampaignDailySpendUpsertOne{
+		create: cdsc,
+	}
+}
+
+type (
+	// CampaignDailySpendUpsertOne is the builder for "upsert"-ing
+	//  one CampaignDailySpend node.
+	CampaignDailySpendUpsertOne struct {
+		create *CampaignDailySpendCreate
+	}
+
+	// CampaignDailySpendUpsert is the "OnConflict" setter.
+	CampaignDailySpendUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAmount sets the "amount" field.
+func (u *CampaignDailySpendUpsert) SetAmount(v float64) *CampaignDailySpendUpsert {
+	u.Set(campaigndailyspend.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CampaignDailySpendUpsert) UpdateAmount() *CampaignDailySpendUpsert {
+	u.SetExcluded(campaigndailyspend.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CampaignDailySpendUpsert) AddAmount(v float64) *CampaignDailySpendUpsert {
+	u.Add(campaigndailyspend.FieldAmount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CampaignDailySpend.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CampaignDailySpendUpsertOne) UpdateNewValues() *CampaignDailySpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CampaignID(); exists {
+			s.SetIgnore(campaigndailyspend.FieldCampaignID)
+		}
+		if _, exists := u.create.mutation.AdvertiserID(); exists {
+			s.SetIgnore(campaigndailyspend.FieldAdvertiserID)
+		}
+		if _, exists := u.create.mutation.Day(); exists {
+			s.SetIgnore(campaigndailyspend.FieldDay)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CampaignDailySpend.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CampaignDailySpendUpsertOne) Ignore() *CampaignDailySpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CampaignDailySpendUpsertOne) DoNothing() *CampaignDailySpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CampaignDailySpendCreate.OnConflict
+// documentation for more info.
+func (u *CampaignDailySpendUpsertOne) Update(set func(*CampaignDailySpendUpsert)) *CampaignDailySpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CampaignDailySpendUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *CampaignDailySpendUpsertOne) SetAmount(v float64) *CampaignDailySpendUpsertOne {
+	return u.Update(func(s *CampaignDailySpendUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CampaignDailySpendUpsertOne) AddAmount(v float64) *CampaignDailySpendUpsertOne {
+	return u.Update(func(s *CampaignDailySpendUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CampaignDailySpendUpsertOne) UpdateAmount() *CampaignDailySpendUpsertOne {
+	return u.Update(func(s *CampaignDailySpendUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *CampaignDailySpendUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CampaignDailySpendCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CampaignDailySpendUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CampaignDailySpendUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CampaignDailySpendUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CampaignDailySpendCreateBulk is the builder for creating many CampaignDailySpend entities in bulk.
+type CampaignDailySpendCreateBulk struct {
+	config
+	err      error
+	builders []*CampaignDailySpendCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CampaignDailySpend entities in the database.
+func (cdscb *CampaignDailySpendCreateBulk) Save(ctx context.Context) ([]*CampaignDailySpend, error) {
+	if cdscb.err != nil {
+		return nil, cdscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdscb.builders))
+	nodes := make([]*CampaignDailySpend, len(cdscb.builders))
+	mutators := make([]Mutator, len(cdscb.builders))
+	for i := range cdscb.builders {
+		func(i int, root context.Context) {
+			builder := cdscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CampaignDailySpendMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cdscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdscb *CampaignDailySpendCreateBulk) SaveX(ctx context.Context) []*CampaignDailySpend {
+	v, err := cdscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdscb *CampaignDailySpendCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdscb *CampaignDailySpendCreateBulk) ExecX(ctx context.Context) {
+	if err := cdscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CampaignDailySpend.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CampaignDailySpendUpsert) {
+//			SetCampaignID(v+v).
+//		}).
+//		Exec(ctx)
+func (cdscb *CampaignDailySpendCreateBulk) OnConflict(opts ...sql.ConflictOption) *CampaignDailySpendUpsertBulk {
+	cdscb.conflict = opts
+	return &CampaignDailySpendUpsertBulk{
+		create: cdscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CampaignDailySpend.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cdscb *CampaignDailySpendCreateBulk) OnConflictColumns(columns ...string) *CampaignDailySpendUpsertBulk {
+	cdscb.conflict = append(cdscb.conflict, sql.ConflictColumns(columns...))
+	return &CampaignDailySpendUpsertBulk{
+		create: cdscb,
+	}
+}
+
+// CampaignDailySpendUpsertBulk is the builder for "upsert"-ing
+// a bulk of CampaignDailySpend nodes.
+type CampaignDailySpendUpsertBulk struct {
+	create *CampaignDailySpendCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CampaignDailySpend.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CampaignDailySpendUpsertBulk) UpdateNewValues() *CampaignDailySpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CampaignID(); exists {
+				s.SetIgnore(campaigndailyspend.FieldCampaignID)
+			}
+			if _, exists := b.mutation.AdvertiserID(); exists {
+				s.SetIgnore(campaigndailyspend.FieldAdvertiserID)
+			}
+			if _, exists := b.mutation.Day(); exists {
+				s.SetIgnore(campaigndailyspend.FieldDay)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CampaignDailySpend.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CampaignDailySpendUpsertBulk) Ignore() *CampaignDailySpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CampaignDailySpendUpsertBulk) DoNothing() *CampaignDailySpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CampaignDailySpendCreateBulk.OnConflict
+// documentation for more info.
+func (u *CampaignDailySpendUpsertBulk) Update(set func(*CampaignDailySpendUpsert)) *CampaignDailySpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CampaignDailySpendUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *CampaignDailySpendUpsertBulk) SetAmount(v float64) *CampaignDailySpendUpsertBulk {
+	return u.Update(func(s *CampaignDailySpendUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CampaignDailySpendUpsertBulk) AddAmount(v float64) *CampaignDailySpendUpsertBulk {
+	return u.Update(func(s *CampaignDailySpendUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CampaignDailySpendUpsertBulk) UpdateAmount() *CampaignDailySpendUpsertBulk {
+	return u.Update(func(s *CampaignDailySpendUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *CampaignDailySpendUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CampaignDailySpendCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CampaignDailySpendCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CampaignDailySpendUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CampaignDailySpendDelete is the builder for deleting a CampaignDailySpend entity.
+type CampaignDailySpendDelete struct {
+	config
+	hooks    []Hook
+	mutation *CampaignDailySpendMutation
+}
+
+// Where appends a list predicates to the CampaignDailySpendDelete builder.
+func (cdsd *CampaignDailySpendDelete) Where(ps ...predicate.CampaignDailySpend) *CampaignDailySpendDelete {
+	cdsd.mutation.Where(ps...)
+	return cdsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdsd *CampaignDailySpendDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdsd.sqlExec, cdsd.mutation, cdsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdsd *CampaignDailySpendDelete) ExecX(ctx context.Context) int {
+	n, err := cdsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdsd *CampaignDailySpendDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(campaigndailyspend.Table, sqlgraph.NewFieldSpec(campaigndailyspend.FieldID, field.TypeInt))
+	if ps := cdsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdsd.mutation.done = true
+	return affected, err
+}
+
+// CampaignDailySpendDeleteOne is the builder for deleting a single CampaignDailySpend entity.
+type CampaignDailySpendDeleteOne struct {
+	cdsd *CampaignDailySpendDelete
+}
+
+// Where appends a list predicates to the CampaignDailySpendDelete builder.
+func (cdsdo *CampaignDailySpendDeleteOne) Where(ps ...predicate.CampaignDailySpend) *CampaignDailySpendDeleteOne {
+	cdsdo.cdsd.mutation.Where(ps...)
+	return cdsdo
+}
+
+// Exec executes the deletion query.
+func (cdsdo *CampaignDailySpendDeleteOne) Exec(ctx context.Context) error {
+	n, err := cdsdo.cdsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{campaigndailyspend.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdsdo *CampaignDailySpendDeleteOne) ExecX(ctx context.Context) {
+	if err := cdsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
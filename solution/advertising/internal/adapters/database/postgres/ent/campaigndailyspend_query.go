@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CampaignDailySpendQuery is the builder for querying CampaignDailySpend entities.
+type CampaignDailySpendQuery struct {
+	config
+	ctx        *QueryContext
+	order      []campaigndailyspend.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CampaignDailySpend
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CampaignDailySpendQuery builder.
+func (cdsq *CampaignDailySpendQuery) Where(ps ...predicate.CampaignDailySpend) *CampaignDailySpendQuery {
+	cdsq.predicates = append(cdsq.predicates, ps...)
+	return cdsq
+}
+
+// Limit the number of records to be returned by this query.
+func (cdsq *CampaignDailySpendQuery) Limit(limit int) *CampaignDailySpendQuery {
+	cdsq.ctx.Limit = &limit
+	return cdsq
+}
+
+// Offset to start from.
+func (cdsq *CampaignDailySpendQuery) Offset(offset int) *CampaignDailySpendQuery {
+	cdsq.ctx.Offset = &offset
+	return cdsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cdsq *CampaignDailySpendQuery) Unique(unique bool) *CampaignDailySpendQuery {
+	cdsq.ctx.Unique = &unique
+	return cdsq
+}
+
+// Order specifies how the records should be ordered.
+func (cdsq *CampaignDailySpendQuery) Order(o ...campaigndailyspend.OrderOption) *CampaignDailySpendQuery {
+	cdsq.order = append(cdsq.order, o...)
+	return cdsq
+}
+
+// First returns the first CampaignDailySpend entity from the query.
+// Returns a *NotFoundError when no CampaignDailySpend was found.
+func (cdsq *CampaignDailySpendQuery) First(ctx context.Context) (*CampaignDailySpend, error) {
+	nodes, err := cdsq.Limit(1).All(setContextOp(ctx, cdsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{campaigndailyspend.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) FirstX(ctx context.Context) *CampaignDailySpend {
+	node, err := cdsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CampaignDailySpend ID from the query.
+// Returns a *NotFoundError when no CampaignDailySpend ID was found.
+func (cdsq *CampaignDailySpendQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdsq.Limit(1).IDs(setContextOp(ctx, cdsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{campaigndailyspend.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) FirstIDX(ctx context.Context) int {
+	id, err := cdsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CampaignDailySpend entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CampaignDailySpend entity is found.
+// Returns a *NotFoundError when no CampaignDailySpend entities are found.
+func (cdsq *CampaignDailySpendQuery) Only(ctx context.Context) (*CampaignDailySpend, error) {
+	nodes, err := cdsq.Limit(2).All(setContextOp(ctx, cdsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{campaigndailyspend.Label}
+	default:
+		return nil, &NotSingularError{campaigndailyspend.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) OnlyX(ctx context.Context) *CampaignDailySpend {
+	node, err := cdsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CampaignDailySpend ID in the query.
+// Returns a *NotSingularError when more than one CampaignDailySpend ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cdsq *CampaignDailySpendQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdsq.Limit(2).IDs(setContextOp(ctx, cdsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{campaigndailyspend.Label}
+	default:
+		err = &NotSingularError{campaigndailyspend.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cdsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CampaignDailySpends.
+func (cdsq *CampaignDailySpendQuery) All(ctx context.Context) ([]*CampaignDailySpend, error) {
+	ctx = setContextOp(ctx, cdsq.ctx, ent.OpQueryAll)
+	if err := cdsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CampaignDailySpend, *CampaignDailySpendQuery]()
+	return withInterceptors[[]*CampaignDailySpend](ctx, cdsq, qr, cdsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) AllX(ctx context.Context) []*CampaignDailySpend {
+	nodes, err := cdsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CampaignDailySpend IDs.
+func (cdsq *CampaignDailySpendQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cdsq.ctx.Unique == nil && cdsq.path != nil {
+		cdsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cdsq.ctx, ent.OpQueryIDs)
+	if err = cdsq.Select(campaigndailyspend.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) IDsX(ctx context.Context) []int {
+	ids, err := cdsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdsq *CampaignDailySpendQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cdsq.ctx, ent.OpQueryCount)
+	if err := cdsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cdsq, querierCount[*CampaignDailySpendQuery](), cdsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) CountX(ctx context.Context) int {
+	count, err := cdsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdsq *CampaignDailySpendQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cdsq.ctx, ent.OpQueryExist)
+	switch _, err := cdsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdsq *CampaignDailySpendQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CampaignDailySpendQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdsq *CampaignDailySpendQuery) Clone() *CampaignDailySpendQuery {
+	if cdsq == nil {
+		return nil
+	}
+	return &CampaignDailySpendQuery{
+		config:     cdsq.config,
+		ctx:        cdsq.ctx.Clone(),
+		order:      append([]campaigndailyspend.OrderOption{}, cdsq.order...),
+		inters:     append([]Interceptor{}, cdsq.inters...),
+		predicates: append([]predicate.CampaignDailySpend{}, cdsq.predicates...),
+		// clone intermediate query.
+		sql:  cdsq.sql.Clone(),
+		path: cdsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CampaignID uuid.UUID `json:"campaign_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CampaignDailySpend.Query().
+//		GroupBy(campaigndailyspend.FieldCampaignID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cdsq *CampaignDailySpendQuery) GroupBy(field string, fields ...string) *CampaignDailySpendGroupBy {
+	cdsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CampaignDailySpendGroupBy{build: cdsq}
+	grbuild.flds = &cdsq.ctx.Fields
+	grbuild.label = campaigndailyspend.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CampaignID uuid.UUID `json:"campaign_id,omitempty"`
+//	}
+//
+//	client.CampaignDailySpend.Query().
+//		Select(campaigndailyspend.FieldCampaignID).
+//		Scan(ctx, &v)
+func (cdsq *CampaignDailySpendQuery) Select(fields ...string) *CampaignDailySpendSelect {
+	cdsq.ctx.Fields = append(cdsq.ctx.Fields, fields...)
+	sbuild := &CampaignDailySpendSelect{CampaignDailySpendQuery: cdsq}
+	sbuild.label = campaigndailyspend.Label
+	sbuild.flds, sbuild.scan = &cdsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CampaignDailySpendSelect configured with the given aggregations.
+func (cdsq *CampaignDailySpendQuery) Aggregate(fns ...AggregateFunc) *CampaignDailySpendSelect {
+	return cdsq.Select().Aggregate(fns...)
+}
+
+func (cdsq *CampaignDailySpendQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cdsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cdsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cdsq.ctx.Fields {
+		if !campaigndailyspend.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cdsq.path != nil {
+		prev, err := cdsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdsq.sql = prev
+	}
+	return nil
+}
+
+func (cdsq *CampaignDailySpendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CampaignDailySpend, error) {
+	var (
+		nodes = []*CampaignDailySpend{}
+		_spec = cdsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CampaignDailySpend).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CampaignDailySpend{config: cdsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cdsq *CampaignDailySpendQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdsq.querySpec()
+	_spec.Node.Columns = cdsq.ctx.Fields
+	if len(cdsq.ctx.Fields) > 0 {
+		_spec.Unique = cdsq.ctx.Unique != nil && *cdsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cdsq.driver, _spec)
+}
+
+func (cdsq *CampaignDailySpendQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(campaigndailyspend.Table, campaigndailyspend.Columns, sqlgraph.NewFieldSpec(campaigndailyspend.FieldID, field.TypeInt))
+	_spec.From = cdsq.sql
+	if unique := cdsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cdsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cdsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, campaigndailyspend.FieldID)
+		for i := range fields {
+			if fields[i] != campaigndailyspend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cdsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdsq *CampaignDailySpendQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdsq.driver.Dialect())
+	t1 := builder.Table(campaigndailyspend.Table)
+	columns := cdsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = campaigndailyspend.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cdsq.sql != nil {
+		selector = cdsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cdsq.ctx.Unique != nil && *cdsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cdsq.predicates {
+		p(selector)
+	}
+	for _, p := range cdsq.order {
+		p(selector)
+	}
+	if offset := cdsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CampaignDailySpendGroupBy is the group-by builder for CampaignDailySpend entities.
+type CampaignDailySpendGroupBy struct {
+	selector
+	build *CampaignDailySpendQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdsgb *CampaignDailySpendGroupBy) Aggregate(fns ...AggregateFunc) *CampaignDailySpendGroupBy {
+	cdsgb.fns = append(cdsgb.fns, fns...)
+	return cdsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdsgb *CampaignDailySpendGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cdsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CampaignDailySpendQuery, *CampaignDailySpendGroupBy](ctx, cdsgb.build, cdsgb, cdsgb.build.inters, v)
+}
+
+func (cdsgb *CampaignDailySpendGroupBy) sqlScan(ctx context.Context, root *CampaignDailySpendQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cdsgb.fns))
+	for _, fn := range cdsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cdsgb.flds)+len(cdsgb.fns))
+		for _, f := range *cdsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cdsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CampaignDailySpendSelect is the builder for selecting fields of CampaignDailySpend entities.
+type CampaignDailySpendSelect struct {
+	*CampaignDailySpendQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cdss *CampaignDailySpendSelect) Aggregate(fns ...AggregateFunc) *CampaignDailySpendSelect {
+	cdss.fns = append(cdss.fns, fns...)
+	return cdss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdss *CampaignDailySpendSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdss.ctx, ent.OpQuerySelect)
+	if err := cdss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CampaignDailySpendQuery, *CampaignDailySpendSelect](ctx, cdss.CampaignDailySpendQuery, cdss, cdss.inters, v)
+}
+
+func (cdss *CampaignDailySpendSelect) sqlScan(ctx context.Context, root *CampaignDailySpendQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cdss.fns))
+	for _, fn := range cdss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cdss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
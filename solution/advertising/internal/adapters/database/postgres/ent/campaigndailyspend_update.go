@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CampaignDailySpendUpdate is the builder for updating CampaignDailySpend entities.
+type CampaignDailySpendUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CampaignDailySpendMutation
+}
+
+// Where appends a list predicates to the CampaignDailySpendUpdate builder.
+func (cdsu *CampaignDailySpendUpdate) Where(ps ...predicate.CampaignDailySpend) *CampaignDailySpendUpdate {
+	cdsu.mutation.Where(ps...)
+	return cdsu
+}
+
+// SetAmount sets the "amount" field.
+func (cdsu *CampaignDailySpendUpdate) SetAmount(f float64) *CampaignDailySpendUpdate {
+	cdsu.mutation.ResetAmount()
+	cdsu.mutation.SetAmount(f)
+	return cdsu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (cdsu *CampaignDailySpendUpdate) SetNillableAmount(f *float64) *CampaignDailySpendUpdate {
+	if f != nil {
+		cdsu.SetAmount(*f)
+	}
+	return cdsu
+}
+
+// AddAmount adds f to the "amount" field.
+func (cdsu *CampaignDailySpendUpdate) AddAmount(f float64) *CampaignDailySpendUpdate {
+	cdsu.mutation.AddAmount(f)
+	return cdsu
+}
+
+// Mutation returns the CampaignDailySpendMutation object of the builder.
+func (cdsu *CampaignDailySpendUpdate) Mutation() *CampaignDailySpendMutation {
+	return cdsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdsu *CampaignDailySpendUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdsu.sqlSave, cdsu.mutation, cdsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdsu *CampaignDailySpendUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdsu *CampaignDailySpendUpdate) Exec(ctx context.Context) error {
+	_, err := cdsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdsu *CampaignDailySpendUpdate) ExecX(ctx context.Context) {
+	if err := cdsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cdsu *CampaignDailySpendUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(campaigndailyspend.Table, campaigndailyspend.Columns, sqlgraph.NewFieldSpec(campaigndailyspend.FieldID, field.TypeInt))
+	if ps := cdsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdsu.mutation.Amount(); ok {
+		_spec.SetField(campaigndailyspend.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := cdsu.mutation.AddedAmount(); ok {
+		_spec.AddField(campaigndailyspend.FieldAmount, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{campaigndailyspend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdsu.mutation.done = true
+	return n, nil
+}
+
+// CampaignDailySpendUpdateOne is the builder for updating a single CampaignDailySpend entity.
+type CampaignDailySpendUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CampaignDailySpendMutation
+}
+
+// SetAmount sets the "amount" field.
+func (cdsuo *CampaignDailySpendUpdateOne) SetAmount(f float64) *CampaignDailySpendUpdateOne {
+	cdsuo.mutation.ResetAmount()
+	cdsuo.mutation.SetAmount(f)
+	return cdsuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (cdsuo *CampaignDailySpendUpdateOne) SetNillableAmount(f *float64) *CampaignDailySpendUpdateOne {
+	if f != nil {
+		cdsuo.SetAmount(*f)
+	}
+	return cdsuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (cdsuo *CampaignDailySpendUpdateOne) AddAmount(f float64) *CampaignDailySpendUpdateOne {
+	cdsuo.mutation.AddAmount(f)
+	return cdsuo
+}
+
+// Mutation returns the CampaignDailySpendMutation object of the builder.
+func (cdsuo *CampaignDailySpendUpdateOne) Mutation() *CampaignDailySpendMutation {
+	return cdsuo.mutation
+}
+
+// Where appends a list predicates to the CampaignDailySpendUpdate builder.
+func (cdsuo *CampaignDailySpendUpdateOne) Where(ps ...predicate.CampaignDailySpend) *CampaignDailySpendUpdateOne {
+	cdsuo.mutation.Where(ps...)
+	return cdsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cdsuo *CampaignDailySpendUpdateOne) Select(field string, fields ...string) *CampaignDailySpendUpdateOne {
+	cdsuo.fields = append([]string{field}, fields...)
+	return cdsuo
+}
+
+// Save executes the query and returns the updated CampaignDailySpend entity.
+func (cdsuo *CampaignDailySpendUpdateOne) Save(ctx context.Context) (*CampaignDailySpend, error) {
+	return withHooks(ctx, cdsuo.sqlSave, cdsuo.mutation, cdsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdsuo *CampaignDailySpendUpdateOne) SaveX(ctx context.Context) *CampaignDailySpend {
+	node, err := cdsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cdsuo *CampaignDailySpendUpdateOne) Exec(ctx context.Context) error {
+	_, err := cdsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdsuo *CampaignDailySpendUpdateOne) ExecX(ctx context.Context) {
+	if err := cdsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cdsuo *CampaignDailySpendUpdateOne) sqlSave(ctx context.Context) (_node *CampaignDailySpend, err error) {
+	_spec := sqlgraph.NewUpdateSpec(campaigndailyspend.Table, campaigndailyspend.Columns, sqlgraph.NewFieldSpec(campaigndailyspend.FieldID, field.TypeInt))
+	id, ok := cdsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CampaignDailySpend.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cdsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, campaigndailyspend.FieldID)
+		for _, f := range fields {
+			if !campaigndailyspend.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != campaigndailyspend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cdsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdsuo.mutation.Amount(); ok {
+		_spec.SetField(campaigndailyspend.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := cdsuo.mutation.AddedAmount(); ok {
+		_spec.AddField(campaigndailyspend.FieldAmount, field.TypeFloat64, value)
+	}
+	_node = &CampaignDailySpend{config: cdsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cdsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{campaigndailyspend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cdsuo.mutation.done = true
+	return _node, nil
+}
@@ -12,9 +12,16 @@ import (
 	"nlypage-final/internal/adapters/database/postgres/ent/migrate"
 
 	"nlypage-final/internal/adapters/database/postgres/ent/advertiser"
+	"nlypage-final/internal/adapters/database/postgres/ent/apikey"
+	"nlypage-final/internal/adapters/database/postgres/ent/auditentry"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/ledgerentry"
 	"nlypage-final/internal/adapters/database/postgres/ent/mlscore"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
 	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
 	"nlypage-final/internal/adapters/database/postgres/ent/user"
 
 	"entgo.io/ent"
@@ -29,14 +36,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Advertiser is the client for interacting with the Advertiser builders.
 	Advertiser *AdvertiserClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
 	// Campaign is the client for interacting with the Campaign builders.
 	Campaign *CampaignClient
+	// CampaignDailySpend is the client for interacting with the CampaignDailySpend builders.
+	CampaignDailySpend *CampaignDailySpendClient
+	// ClientErasure is the client for interacting with the ClientErasure builders.
+	ClientErasure *ClientErasureClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
 	// MlScore is the client for interacting with the MlScore builders.
 	MlScore *MlScoreClient
+	// ModerationDecision is the client for interacting with the ModerationDecision builders.
+	ModerationDecision *ModerationDecisionClient
 	// Targeting is the client for interacting with the Targeting builders.
 	Targeting *TargetingClient
+	// TelegramAccount is the client for interacting with the TelegramAccount builders.
+	TelegramAccount *TelegramAccountClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -50,10 +71,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
 	c.Advertiser = NewAdvertiserClient(c.config)
+	c.AuditEntry = NewAuditEntryClient(c.config)
 	c.Campaign = NewCampaignClient(c.config)
+	c.CampaignDailySpend = NewCampaignDailySpendClient(c.config)
+	c.ClientErasure = NewClientErasureClient(c.config)
+	c.LedgerEntry = NewLedgerEntryClient(c.config)
 	c.MlScore = NewMlScoreClient(c.config)
+	c.ModerationDecision = NewModerationDecisionClient(c.config)
 	c.Targeting = NewTargetingClient(c.config)
+	c.TelegramAccount = NewTelegramAccountClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -145,13 +173,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Advertiser: NewAdvertiserClient(cfg),
-		Campaign:   NewCampaignClient(cfg),
-		MlScore:    NewMlScoreClient(cfg),
-		Targeting:  NewTargetingClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		APIKey:             NewAPIKeyClient(cfg),
+		Advertiser:         NewAdvertiserClient(cfg),
+		AuditEntry:         NewAuditEntryClient(cfg),
+		Campaign:           NewCampaignClient(cfg),
+		CampaignDailySpend: NewCampaignDailySpendClient(cfg),
+		ClientErasure:      NewClientErasureClient(cfg),
+		LedgerEntry:        NewLedgerEntryClient(cfg),
+		MlScore:            NewMlScoreClient(cfg),
+		ModerationDecision: NewModerationDecisionClient(cfg),
+		Targeting:          NewTargetingClient(cfg),
+		TelegramAccount:    NewTelegramAccountClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
@@ -169,20 +204,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Advertiser: NewAdvertiserClient(cfg),
-		Campaign:   NewCampaignClient(cfg),
-		MlScore:    NewMlScoreClient(cfg),
-		Targeting:  NewTargetingClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		APIKey:             NewAPIKeyClient(cfg),
+		Advertiser:         NewAdvertiserClient(cfg),
+		AuditEntry:         NewAuditEntryClient(cfg),
+		Campaign:           NewCampaignClient(cfg),
+		CampaignDailySpend: NewCampaignDailySpendClient(cfg),
+		ClientErasure:      NewClientErasureClient(cfg),
+		LedgerEntry:        NewLedgerEntryClient(cfg),
+		MlScore:            NewMlScoreClient(cfg),
+		ModerationDecision: NewModerationDecisionClient(cfg),
+		Targeting:          NewTargetingClient(cfg),
+		TelegramAccount:    NewTelegramAccountClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Advertiser.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -204,34 +246,52 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Advertiser.Use(hooks...)
-	c.Campaign.Use(hooks...)
-	c.MlScore.Use(hooks...)
-	c.Targeting.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.APIKey, c.Advertiser, c.AuditEntry, c.Campaign, c.CampaignDailySpend,
+		c.ClientErasure, c.LedgerEntry, c.MlScore, c.ModerationDecision, c.Targeting,
+		c.TelegramAccount, c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Advertiser.Intercept(interceptors...)
-	c.Campaign.Intercept(interceptors...)
-	c.MlScore.Intercept(interceptors...)
-	c.Targeting.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.APIKey, c.Advertiser, c.AuditEntry, c.Campaign, c.CampaignDailySpend,
+		c.ClientErasure, c.LedgerEntry, c.MlScore, c.ModerationDecision, c.Targeting,
+		c.TelegramAccount, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
 	case *AdvertiserMutation:
 		return c.Advertiser.mutate(ctx, m)
+	case *AuditEntryMutation:
+		return c.AuditEntry.mutate(ctx, m)
 	case *CampaignMutation:
 		return c.Campaign.mutate(ctx, m)
+	case *CampaignDailySpendMutation:
+		return c.CampaignDailySpend.mutate(ctx, m)
+	case *ClientErasureMutation:
+		return c.ClientErasure.mutate(ctx, m)
+	case *LedgerEntryMutation:
+		return c.LedgerEntry.mutate(ctx, m)
 	case *MlScoreMutation:
 		return c.MlScore.mutate(ctx, m)
+	case *ModerationDecisionMutation:
+		return c.ModerationDecision.mutate(ctx, m)
 	case *TargetingMutation:
 		return c.Targeting.mutate(ctx, m)
+	case *TelegramAccountMutation:
+		return c.TelegramAccount.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -239,6 +299,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(ak *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(ak))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id uuid.UUID) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(ak *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id uuid.UUID) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id uuid.UUID) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id uuid.UUID) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
 // AdvertiserClient is a client for the Advertiser schema.
 type AdvertiserClient struct {
 	config
@@ -294,52 +487,600 @@ func (c *AdvertiserClient) Update() *AdvertiserUpdate {
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *AdvertiserClient) UpdateOne(a *Advertiser) *AdvertiserUpdateOne {
-	mutation := newAdvertiserMutation(c.config, OpUpdateOne, withAdvertiser(a))
-	return &AdvertiserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdvertiserClient) UpdateOne(a *Advertiser) *AdvertiserUpdateOne {
+	mutation := newAdvertiserMutation(c.config, OpUpdateOne, withAdvertiser(a))
+	return &AdvertiserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdvertiserClient) UpdateOneID(id uuid.UUID) *AdvertiserUpdateOne {
+	mutation := newAdvertiserMutation(c.config, OpUpdateOne, withAdvertiserID(id))
+	return &AdvertiserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Advertiser.
+func (c *AdvertiserClient) Delete() *AdvertiserDelete {
+	mutation := newAdvertiserMutation(c.config, OpDelete)
+	return &AdvertiserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AdvertiserClient) DeleteOne(a *Advertiser) *AdvertiserDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AdvertiserClient) DeleteOneID(id uuid.UUID) *AdvertiserDeleteOne {
+	builder := c.Delete().Where(advertiser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdvertiserDeleteOne{builder}
+}
+
+// Query returns a query builder for Advertiser.
+func (c *AdvertiserClient) Query() *AdvertiserQuery {
+	return &AdvertiserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAdvertiser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Advertiser entity by its id.
+func (c *AdvertiserClient) Get(ctx context.Context, id uuid.UUID) (*Advertiser, error) {
+	return c.Query().Where(advertiser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdvertiserClient) GetX(ctx context.Context, id uuid.UUID) *Advertiser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AdvertiserClient) Hooks() []Hook {
+	return c.hooks.Advertiser
+}
+
+// Interceptors returns the client interceptors.
+func (c *AdvertiserClient) Interceptors() []Interceptor {
+	return c.inters.Advertiser
+}
+
+func (c *AdvertiserClient) mutate(ctx context.Context, m *AdvertiserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AdvertiserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AdvertiserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AdvertiserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AdvertiserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Advertiser mutation op: %q", m.Op())
+	}
+}
+
+// AuditEntryClient is a client for the AuditEntry schema.
+type AuditEntryClient struct {
+	config
+}
+
+// NewAuditEntryClient returns a client for the AuditEntry from the given config.
+func NewAuditEntryClient(c config) *AuditEntryClient {
+	return &AuditEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditentry.Hooks(f(g(h())))`.
+func (c *AuditEntryClient) Use(hooks ...Hook) {
+	c.hooks.AuditEntry = append(c.hooks.AuditEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditentry.Intercept(f(g(h())))`.
+func (c *AuditEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEntry = append(c.inters.AuditEntry, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEntry entity.
+func (c *AuditEntryClient) Create() *AuditEntryCreate {
+	mutation := newAuditEntryMutation(c.config, OpCreate)
+	return &AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEntry entities.
+func (c *AuditEntryClient) CreateBulk(builders ...*AuditEntryCreate) *AuditEntryCreateBulk {
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEntryClient) MapCreateBulk(slice any, setFunc func(*AuditEntryCreate, int)) *AuditEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEntryCreateBulk{err: fmt.Errorf("calling to AuditEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEntry.
+func (c *AuditEntryClient) Update() *AuditEntryUpdate {
+	mutation := newAuditEntryMutation(c.config, OpUpdate)
+	return &AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEntryClient) UpdateOne(ae *AuditEntry) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntry(ae))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEntryClient) UpdateOneID(id int) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntryID(id))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEntry.
+func (c *AuditEntryClient) Delete() *AuditEntryDelete {
+	mutation := newAuditEntryMutation(c.config, OpDelete)
+	return &AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEntryClient) DeleteOne(ae *AuditEntry) *AuditEntryDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEntryClient) DeleteOneID(id int) *AuditEntryDeleteOne {
+	builder := c.Delete().Where(auditentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEntry.
+func (c *AuditEntryClient) Query() *AuditEntryQuery {
+	return &AuditEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEntry entity by its id.
+func (c *AuditEntryClient) Get(ctx context.Context, id int) (*AuditEntry, error) {
+	return c.Query().Where(auditentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEntryClient) GetX(ctx context.Context, id int) *AuditEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEntryClient) Hooks() []Hook {
+	return c.hooks.AuditEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEntryClient) Interceptors() []Interceptor {
+	return c.inters.AuditEntry
+}
+
+func (c *AuditEntryClient) mutate(ctx context.Context, m *AuditEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEntry mutation op: %q", m.Op())
+	}
+}
+
+// CampaignClient is a client for the Campaign schema.
+type CampaignClient struct {
+	config
+}
+
+// NewCampaignClient returns a client for the Campaign from the given config.
+func NewCampaignClient(c config) *CampaignClient {
+	return &CampaignClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `campaign.Hooks(f(g(h())))`.
+func (c *CampaignClient) Use(hooks ...Hook) {
+	c.hooks.Campaign = append(c.hooks.Campaign, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `campaign.Intercept(f(g(h())))`.
+func (c *CampaignClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Campaign = append(c.inters.Campaign, interceptors...)
+}
+
+// Create returns a builder for creating a Campaign entity.
+func (c *CampaignClient) Create() *CampaignCreate {
+	mutation := newCampaignMutation(c.config, OpCreate)
+	return &CampaignCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Campaign entities.
+func (c *CampaignClient) CreateBulk(builders ...*CampaignCreate) *CampaignCreateBulk {
+	return &CampaignCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CampaignClient) MapCreateBulk(slice any, setFunc func(*CampaignCreate, int)) *CampaignCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CampaignCreateBulk{err: fmt.Errorf("calling to CampaignClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CampaignCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CampaignCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Campaign.
+func (c *CampaignClient) Update() *CampaignUpdate {
+	mutation := newCampaignMutation(c.config, OpUpdate)
+	return &CampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CampaignClient) UpdateOne(ca *Campaign) *CampaignUpdateOne {
+	mutation := newCampaignMutation(c.config, OpUpdateOne, withCampaign(ca))
+	return &CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CampaignClient) UpdateOneID(id uuid.UUID) *CampaignUpdateOne {
+	mutation := newCampaignMutation(c.config, OpUpdateOne, withCampaignID(id))
+	return &CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Campaign.
+func (c *CampaignClient) Delete() *CampaignDelete {
+	mutation := newCampaignMutation(c.config, OpDelete)
+	return &CampaignDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CampaignClient) DeleteOne(ca *Campaign) *CampaignDeleteOne {
+	return c.DeleteOneID(ca.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CampaignClient) DeleteOneID(id uuid.UUID) *CampaignDeleteOne {
+	builder := c.Delete().Where(campaign.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CampaignDeleteOne{builder}
+}
+
+// Query returns a query builder for Campaign.
+func (c *CampaignClient) Query() *CampaignQuery {
+	return &CampaignQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCampaign},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Campaign entity by its id.
+func (c *CampaignClient) Get(ctx context.Context, id uuid.UUID) (*Campaign, error) {
+	return c.Query().Where(campaign.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CampaignClient) GetX(ctx context.Context, id uuid.UUID) *Campaign {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTargeting queries the targeting edge of a Campaign.
+func (c *CampaignClient) QueryTargeting(ca *Campaign) *TargetingQuery {
+	query := (&TargetingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(campaign.Table, campaign.FieldID, id),
+			sqlgraph.To(targeting.Table, targeting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, campaign.TargetingTable, campaign.TargetingColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CampaignClient) Hooks() []Hook {
+	return c.hooks.Campaign
+}
+
+// Interceptors returns the client interceptors.
+func (c *CampaignClient) Interceptors() []Interceptor {
+	return c.inters.Campaign
+}
+
+func (c *CampaignClient) mutate(ctx context.Context, m *CampaignMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CampaignCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CampaignDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Campaign mutation op: %q", m.Op())
+	}
+}
+
+// CampaignDailySpendClient is a client for the CampaignDailySpend schema.
+type CampaignDailySpendClient struct {
+	config
+}
+
+// NewCampaignDailySpendClient returns a client for the CampaignDailySpend from the given config.
+func NewCampaignDailySpendClient(c config) *CampaignDailySpendClient {
+	return &CampaignDailySpendClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `campaigndailyspend.Hooks(f(g(h())))`.
+func (c *CampaignDailySpendClient) Use(hooks ...Hook) {
+	c.hooks.CampaignDailySpend = append(c.hooks.CampaignDailySpend, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `campaigndailyspend.Intercept(f(g(h())))`.
+func (c *CampaignDailySpendClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CampaignDailySpend = append(c.inters.CampaignDailySpend, interceptors...)
+}
+
+// Create returns a builder for creating a CampaignDailySpend entity.
+func (c *CampaignDailySpendClient) Create() *CampaignDailySpendCreate {
+	mutation := newCampaignDailySpendMutation(c.config, OpCreate)
+	return &CampaignDailySpendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CampaignDailySpend entities.
+func (c *CampaignDailySpendClient) CreateBulk(builders ...*CampaignDailySpendCreate) *CampaignDailySpendCreateBulk {
+	return &CampaignDailySpendCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CampaignDailySpendClient) MapCreateBulk(slice any, setFunc func(*CampaignDailySpendCreate, int)) *CampaignDailySpendCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CampaignDailySpendCreateBulk{err: fmt.Errorf("calling to CampaignDailySpendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CampaignDailySpendCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CampaignDailySpendCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CampaignDailySpend.
+func (c *CampaignDailySpendClient) Update() *CampaignDailySpendUpdate {
+	mutation := newCampaignDailySpendMutation(c.config, OpUpdate)
+	return &CampaignDailySpendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CampaignDailySpendClient) UpdateOne(cds *CampaignDailySpend) *CampaignDailySpendUpdateOne {
+	mutation := newCampaignDailySpendMutation(c.config, OpUpdateOne, withCampaignDailySpend(cds))
+	return &CampaignDailySpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CampaignDailySpendClient) UpdateOneID(id int) *CampaignDailySpendUpdateOne {
+	mutation := newCampaignDailySpendMutation(c.config, OpUpdateOne, withCampaignDailySpendID(id))
+	return &CampaignDailySpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CampaignDailySpend.
+func (c *CampaignDailySpendClient) Delete() *CampaignDailySpendDelete {
+	mutation := newCampaignDailySpendMutation(c.config, OpDelete)
+	return &CampaignDailySpendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CampaignDailySpendClient) DeleteOne(cds *CampaignDailySpend) *CampaignDailySpendDeleteOne {
+	return c.DeleteOneID(cds.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CampaignDailySpendClient) DeleteOneID(id int) *CampaignDailySpendDeleteOne {
+	builder := c.Delete().Where(campaigndailyspend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CampaignDailySpendDeleteOne{builder}
+}
+
+// Query returns a query builder for CampaignDailySpend.
+func (c *CampaignDailySpendClient) Query() *CampaignDailySpendQuery {
+	return &CampaignDailySpendQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCampaignDailySpend},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CampaignDailySpend entity by its id.
+func (c *CampaignDailySpendClient) Get(ctx context.Context, id int) (*CampaignDailySpend, error) {
+	return c.Query().Where(campaigndailyspend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CampaignDailySpendClient) GetX(ctx context.Context, id int) *CampaignDailySpend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CampaignDailySpendClient) Hooks() []Hook {
+	return c.hooks.CampaignDailySpend
+}
+
+// Interceptors returns the client interceptors.
+func (c *CampaignDailySpendClient) Interceptors() []Interceptor {
+	return c.inters.CampaignDailySpend
+}
+
+func (c *CampaignDailySpendClient) mutate(ctx context.Context, m *CampaignDailySpendMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CampaignDailySpendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CampaignDailySpendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CampaignDailySpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CampaignDailySpendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CampaignDailySpend mutation op: %q", m.Op())
+	}
+}
+
+// ClientErasureClient is a client for the ClientErasure schema.
+type ClientErasureClient struct {
+	config
+}
+
+// NewClientErasureClient returns a client for the ClientErasure from the given config.
+func NewClientErasureClient(c config) *ClientErasureClient {
+	return &ClientErasureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clienterasure.Hooks(f(g(h())))`.
+func (c *ClientErasureClient) Use(hooks ...Hook) {
+	c.hooks.ClientErasure = append(c.hooks.ClientErasure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `clienterasure.Intercept(f(g(h())))`.
+func (c *ClientErasureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientErasure = append(c.inters.ClientErasure, interceptors...)
+}
+
+// Create returns a builder for creating a ClientErasure entity.
+func (c *ClientErasureClient) Create() *ClientErasureCreate {
+	mutation := newClientErasureMutation(c.config, OpCreate)
+	return &ClientErasureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClientErasure entities.
+func (c *ClientErasureClient) CreateBulk(builders ...*ClientErasureCreate) *ClientErasureCreateBulk {
+	return &ClientErasureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClientErasureClient) MapCreateBulk(slice any, setFunc func(*ClientErasureCreate, int)) *ClientErasureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClientErasureCreateBulk{err: fmt.Errorf("calling to ClientErasureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClientErasureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClientErasureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClientErasure.
+func (c *ClientErasureClient) Update() *ClientErasureUpdate {
+	mutation := newClientErasureMutation(c.config, OpUpdate)
+	return &ClientErasureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClientErasureClient) UpdateOne(ce *ClientErasure) *ClientErasureUpdateOne {
+	mutation := newClientErasureMutation(c.config, OpUpdateOne, withClientErasure(ce))
+	return &ClientErasureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *AdvertiserClient) UpdateOneID(id uuid.UUID) *AdvertiserUpdateOne {
-	mutation := newAdvertiserMutation(c.config, OpUpdateOne, withAdvertiserID(id))
-	return &AdvertiserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClientErasureClient) UpdateOneID(id int) *ClientErasureUpdateOne {
+	mutation := newClientErasureMutation(c.config, OpUpdateOne, withClientErasureID(id))
+	return &ClientErasureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Advertiser.
-func (c *AdvertiserClient) Delete() *AdvertiserDelete {
-	mutation := newAdvertiserMutation(c.config, OpDelete)
-	return &AdvertiserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ClientErasure.
+func (c *ClientErasureClient) Delete() *ClientErasureDelete {
+	mutation := newClientErasureMutation(c.config, OpDelete)
+	return &ClientErasureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *AdvertiserClient) DeleteOne(a *Advertiser) *AdvertiserDeleteOne {
-	return c.DeleteOneID(a.ID)
+func (c *ClientErasureClient) DeleteOne(ce *ClientErasure) *ClientErasureDeleteOne {
+	return c.DeleteOneID(ce.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AdvertiserClient) DeleteOneID(id uuid.UUID) *AdvertiserDeleteOne {
-	builder := c.Delete().Where(advertiser.ID(id))
+func (c *ClientErasureClient) DeleteOneID(id int) *ClientErasureDeleteOne {
+	builder := c.Delete().Where(clienterasure.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &AdvertiserDeleteOne{builder}
+	return &ClientErasureDeleteOne{builder}
 }
 
-// Query returns a query builder for Advertiser.
-func (c *AdvertiserClient) Query() *AdvertiserQuery {
-	return &AdvertiserQuery{
+// Query returns a query builder for ClientErasure.
+func (c *ClientErasureClient) Query() *ClientErasureQuery {
+	return &ClientErasureQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAdvertiser},
+		ctx:    &QueryContext{Type: TypeClientErasure},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Advertiser entity by its id.
-func (c *AdvertiserClient) Get(ctx context.Context, id uuid.UUID) (*Advertiser, error) {
-	return c.Query().Where(advertiser.ID(id)).Only(ctx)
+// Get returns a ClientErasure entity by its id.
+func (c *ClientErasureClient) Get(ctx context.Context, id int) (*ClientErasure, error) {
+	return c.Query().Where(clienterasure.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *AdvertiserClient) GetX(ctx context.Context, id uuid.UUID) *Advertiser {
+func (c *ClientErasureClient) GetX(ctx context.Context, id int) *ClientErasure {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -348,131 +1089,131 @@ func (c *AdvertiserClient) GetX(ctx context.Context, id uuid.UUID) *Advertiser {
 }
 
 // Hooks returns the client hooks.
-func (c *AdvertiserClient) Hooks() []Hook {
-	return c.hooks.Advertiser
+func (c *ClientErasureClient) Hooks() []Hook {
+	return c.hooks.ClientErasure
 }
 
 // Interceptors returns the client interceptors.
-func (c *AdvertiserClient) Interceptors() []Interceptor {
-	return c.inters.Advertiser
+func (c *ClientErasureClient) Interceptors() []Interceptor {
+	return c.inters.ClientErasure
 }
 
-func (c *AdvertiserClient) mutate(ctx context.Context, m *AdvertiserMutation) (Value, error) {
+func (c *ClientErasureClient) mutate(ctx context.Context, m *ClientErasureMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&AdvertiserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientErasureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&AdvertiserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientErasureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&AdvertiserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientErasureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&AdvertiserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ClientErasureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Advertiser mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ClientErasure mutation op: %q", m.Op())
 	}
 }
 
-// CampaignClient is a client for the Campaign schema.
-type CampaignClient struct {
+// LedgerEntryClient is a client for the LedgerEntry schema.
+type LedgerEntryClient struct {
 	config
 }
 
-// NewCampaignClient returns a client for the Campaign from the given config.
-func NewCampaignClient(c config) *CampaignClient {
-	return &CampaignClient{config: c}
+// NewLedgerEntryClient returns a client for the LedgerEntry from the given config.
+func NewLedgerEntryClient(c config) *LedgerEntryClient {
+	return &LedgerEntryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `campaign.Hooks(f(g(h())))`.
-func (c *CampaignClient) Use(hooks ...Hook) {
-	c.hooks.Campaign = append(c.hooks.Campaign, hooks...)
+// A call to `Use(f, g, h)` equals to `ledgerentry.Hooks(f(g(h())))`.
+func (c *LedgerEntryClient) Use(hooks ...Hook) {
+	c.hooks.LedgerEntry = append(c.hooks.LedgerEntry, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `campaign.Intercept(f(g(h())))`.
-func (c *CampaignClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Campaign = append(c.inters.Campaign, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ledgerentry.Intercept(f(g(h())))`.
+func (c *LedgerEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerEntry = append(c.inters.LedgerEntry, interceptors...)
 }
 
-// Create returns a builder for creating a Campaign entity.
-func (c *CampaignClient) Create() *CampaignCreate {
-	mutation := newCampaignMutation(c.config, OpCreate)
-	return &CampaignCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LedgerEntry entity.
+func (c *LedgerEntryClient) Create() *LedgerEntryCreate {
+	mutation := newLedgerEntryMutation(c.config, OpCreate)
+	return &LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Campaign entities.
-func (c *CampaignClient) CreateBulk(builders ...*CampaignCreate) *CampaignCreateBulk {
-	return &CampaignCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LedgerEntry entities.
+func (c *LedgerEntryClient) CreateBulk(builders ...*LedgerEntryCreate) *LedgerEntryCreateBulk {
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CampaignClient) MapCreateBulk(slice any, setFunc func(*CampaignCreate, int)) *CampaignCreateBulk {
+func (c *LedgerEntryClient) MapCreateBulk(slice any, setFunc func(*LedgerEntryCreate, int)) *LedgerEntryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CampaignCreateBulk{err: fmt.Errorf("calling to CampaignClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LedgerEntryCreateBulk{err: fmt.Errorf("calling to LedgerEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CampaignCreate, rv.Len())
+	builders := make([]*LedgerEntryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CampaignCreateBulk{config: c.config, builders: builders}
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Campaign.
-func (c *CampaignClient) Update() *CampaignUpdate {
-	mutation := newCampaignMutation(c.config, OpUpdate)
-	return &CampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LedgerEntry.
+func (c *LedgerEntryClient) Update() *LedgerEntryUpdate {
+	mutation := newLedgerEntryMutation(c.config, OpUpdate)
+	return &LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CampaignClient) UpdateOne(ca *Campaign) *CampaignUpdateOne {
-	mutation := newCampaignMutation(c.config, OpUpdateOne, withCampaign(ca))
-	return &CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LedgerEntryClient) UpdateOne(le *LedgerEntry) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntry(le))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CampaignClient) UpdateOneID(id uuid.UUID) *CampaignUpdateOne {
-	mutation := newCampaignMutation(c.config, OpUpdateOne, withCampaignID(id))
-	return &CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LedgerEntryClient) UpdateOneID(id int) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntryID(id))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Campaign.
-func (c *CampaignClient) Delete() *CampaignDelete {
-	mutation := newCampaignMutation(c.config, OpDelete)
-	return &CampaignDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LedgerEntry.
+func (c *LedgerEntryClient) Delete() *LedgerEntryDelete {
+	mutation := newLedgerEntryMutation(c.config, OpDelete)
+	return &LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CampaignClient) DeleteOne(ca *Campaign) *CampaignDeleteOne {
-	return c.DeleteOneID(ca.ID)
+func (c *LedgerEntryClient) DeleteOne(le *LedgerEntry) *LedgerEntryDeleteOne {
+	return c.DeleteOneID(le.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CampaignClient) DeleteOneID(id uuid.UUID) *CampaignDeleteOne {
-	builder := c.Delete().Where(campaign.ID(id))
+func (c *LedgerEntryClient) DeleteOneID(id int) *LedgerEntryDeleteOne {
+	builder := c.Delete().Where(ledgerentry.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CampaignDeleteOne{builder}
+	return &LedgerEntryDeleteOne{builder}
 }
 
-// Query returns a query builder for Campaign.
-func (c *CampaignClient) Query() *CampaignQuery {
-	return &CampaignQuery{
+// Query returns a query builder for LedgerEntry.
+func (c *LedgerEntryClient) Query() *LedgerEntryQuery {
+	return &LedgerEntryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCampaign},
+		ctx:    &QueryContext{Type: TypeLedgerEntry},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Campaign entity by its id.
-func (c *CampaignClient) Get(ctx context.Context, id uuid.UUID) (*Campaign, error) {
-	return c.Query().Where(campaign.ID(id)).Only(ctx)
+// Get returns a LedgerEntry entity by its id.
+func (c *LedgerEntryClient) Get(ctx context.Context, id int) (*LedgerEntry, error) {
+	return c.Query().Where(ledgerentry.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CampaignClient) GetX(ctx context.Context, id uuid.UUID) *Campaign {
+func (c *LedgerEntryClient) GetX(ctx context.Context, id int) *LedgerEntry {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -480,44 +1221,28 @@ func (c *CampaignClient) GetX(ctx context.Context, id uuid.UUID) *Campaign {
 	return obj
 }
 
-// QueryTargeting queries the targeting edge of a Campaign.
-func (c *CampaignClient) QueryTargeting(ca *Campaign) *TargetingQuery {
-	query := (&TargetingClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ca.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(campaign.Table, campaign.FieldID, id),
-			sqlgraph.To(targeting.Table, targeting.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, campaign.TargetingTable, campaign.TargetingColumn),
-		)
-		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CampaignClient) Hooks() []Hook {
-	return c.hooks.Campaign
+func (c *LedgerEntryClient) Hooks() []Hook {
+	return c.hooks.LedgerEntry
 }
 
 // Interceptors returns the client interceptors.
-func (c *CampaignClient) Interceptors() []Interceptor {
-	return c.inters.Campaign
+func (c *LedgerEntryClient) Interceptors() []Interceptor {
+	return c.inters.LedgerEntry
 }
 
-func (c *CampaignClient) mutate(ctx context.Context, m *CampaignMutation) (Value, error) {
+func (c *LedgerEntryClient) mutate(ctx context.Context, m *LedgerEntryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CampaignCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CampaignDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Campaign mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LedgerEntry mutation op: %q", m.Op())
 	}
 }
 
@@ -686,6 +1411,139 @@ func (c *MlScoreClient) mutate(ctx context.Context, m *MlScoreMutation) (Value,
 	}
 }
 
+// ModerationDecisionClient is a client for the ModerationDecision schema.
+type ModerationDecisionClient struct {
+	config
+}
+
+// NewModerationDecisionClient returns a client for the ModerationDecision from the given config.
+func NewModerationDecisionClient(c config) *ModerationDecisionClient {
+	return &ModerationDecisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moderationdecision.Hooks(f(g(h())))`.
+func (c *ModerationDecisionClient) Use(hooks ...Hook) {
+	c.hooks.ModerationDecision = append(c.hooks.ModerationDecision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moderationdecision.Intercept(f(g(h())))`.
+func (c *ModerationDecisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationDecision = append(c.inters.ModerationDecision, interceptors...)
+}
+
+// Create returns a builder for creating a ModerationDecision entity.
+func (c *ModerationDecisionClient) Create() *ModerationDecisionCreate {
+	mutation := newModerationDecisionMutation(c.config, OpCreate)
+	return &ModerationDecisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModerationDecision entities.
+func (c *ModerationDecisionClient) CreateBulk(builders ...*ModerationDecisionCreate) *ModerationDecisionCreateBulk {
+	return &ModerationDecisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModerationDecisionClient) MapCreateBulk(slice any, setFunc func(*ModerationDecisionCreate, int)) *ModerationDecisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModerationDecisionCreateBulk{err: fmt.Errorf("calling to ModerationDecisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModerationDecisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModerationDecisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModerationDecision.
+func (c *ModerationDecisionClient) Update() *ModerationDecisionUpdate {
+	mutation := newModerationDecisionMutation(c.config, OpUpdate)
+	return &ModerationDecisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModerationDecisionClient) UpdateOne(md *ModerationDecision) *ModerationDecisionUpdateOne {
+	mutation := newModerationDecisionMutation(c.config, OpUpdateOne, withModerationDecision(md))
+	return &ModerationDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModerationDecisionClient) UpdateOneID(id int) *ModerationDecisionUpdateOne {
+	mutation := newModerationDecisionMutation(c.config, OpUpdateOne, withModerationDecisionID(id))
+	return &ModerationDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModerationDecision.
+func (c *ModerationDecisionClient) Delete() *ModerationDecisionDelete {
+	mutation := newModerationDecisionMutation(c.config, OpDelete)
+	return &ModerationDecisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModerationDecisionClient) DeleteOne(md *ModerationDecision) *ModerationDecisionDeleteOne {
+	return c.DeleteOneID(md.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModerationDecisionClient) DeleteOneID(id int) *ModerationDecisionDeleteOne {
+	builder := c.Delete().Where(moderationdecision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModerationDecisionDeleteOne{builder}
+}
+
+// Query returns a query builder for ModerationDecision.
+func (c *ModerationDecisionClient) Query() *ModerationDecisionQuery {
+	return &ModerationDecisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModerationDecision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModerationDecision entity by its id.
+func (c *ModerationDecisionClient) Get(ctx context.Context, id int) (*ModerationDecision, error) {
+	return c.Query().Where(moderationdecision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModerationDecisionClient) GetX(ctx context.Context, id int) *ModerationDecision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModerationDecisionClient) Hooks() []Hook {
+	return c.hooks.ModerationDecision
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModerationDecisionClient) Interceptors() []Interceptor {
+	return c.inters.ModerationDecision
+}
+
+func (c *ModerationDecisionClient) mutate(ctx context.Context, m *ModerationDecisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModerationDecisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModerationDecisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModerationDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModerationDecisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModerationDecision mutation op: %q", m.Op())
+	}
+}
+
 // TargetingClient is a client for the Targeting schema.
 type TargetingClient struct {
 	config
@@ -835,6 +1693,139 @@ func (c *TargetingClient) mutate(ctx context.Context, m *TargetingMutation) (Val
 	}
 }
 
+// TelegramAccountClient is a client for the TelegramAccount schema.
+type TelegramAccountClient struct {
+	config
+}
+
+// NewTelegramAccountClient returns a client for the TelegramAccount from the given config.
+func NewTelegramAccountClient(c config) *TelegramAccountClient {
+	return &TelegramAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `telegramaccount.Hooks(f(g(h())))`.
+func (c *TelegramAccountClient) Use(hooks ...Hook) {
+	c.hooks.TelegramAccount = append(c.hooks.TelegramAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `telegramaccount.Intercept(f(g(h())))`.
+func (c *TelegramAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TelegramAccount = append(c.inters.TelegramAccount, interceptors...)
+}
+
+// Create returns a builder for creating a TelegramAccount entity.
+func (c *TelegramAccountClient) Create() *TelegramAccountCreate {
+	mutation := newTelegramAccountMutation(c.config, OpCreate)
+	return &TelegramAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TelegramAccount entities.
+func (c *TelegramAccountClient) CreateBulk(builders ...*TelegramAccountCreate) *TelegramAccountCreateBulk {
+	return &TelegramAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TelegramAccountClient) MapCreateBulk(slice any, setFunc func(*TelegramAccountCreate, int)) *TelegramAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TelegramAccountCreateBulk{err: fmt.Errorf("calling to TelegramAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TelegramAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TelegramAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TelegramAccount.
+func (c *TelegramAccountClient) Update() *TelegramAccountUpdate {
+	mutation := newTelegramAccountMutation(c.config, OpUpdate)
+	return &TelegramAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TelegramAccountClient) UpdateOne(ta *TelegramAccount) *TelegramAccountUpdateOne {
+	mutation := newTelegramAccountMutation(c.config, OpUpdateOne, withTelegramAccount(ta))
+	return &TelegramAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TelegramAccountClient) UpdateOneID(id int) *TelegramAccountUpdateOne {
+	mutation := newTelegramAccountMutation(c.config, OpUpdateOne, withTelegramAccountID(id))
+	return &TelegramAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TelegramAccount.
+func (c *TelegramAccountClient) Delete() *TelegramAccountDelete {
+	mutation := newTelegramAccountMutation(c.config, OpDelete)
+	return &TelegramAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TelegramAccountClient) DeleteOne(ta *TelegramAccount) *TelegramAccountDeleteOne {
+	return c.DeleteOneID(ta.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TelegramAccountClient) DeleteOneID(id int) *TelegramAccountDeleteOne {
+	builder := c.Delete().Where(telegramaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TelegramAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for TelegramAccount.
+func (c *TelegramAccountClient) Query() *TelegramAccountQuery {
+	return &TelegramAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTelegramAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TelegramAccount entity by its id.
+func (c *TelegramAccountClient) Get(ctx context.Context, id int) (*TelegramAccount, error) {
+	return c.Query().Where(telegramaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TelegramAccountClient) GetX(ctx context.Context, id int) *TelegramAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TelegramAccountClient) Hooks() []Hook {
+	return c.hooks.TelegramAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *TelegramAccountClient) Interceptors() []Interceptor {
+	return c.inters.TelegramAccount
+}
+
+func (c *TelegramAccountClient) mutate(ctx context.Context, m *TelegramAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TelegramAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TelegramAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TelegramAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TelegramAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TelegramAccount mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -971,9 +1962,13 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Advertiser, Campaign, MlScore, Targeting, User []ent.Hook
+		APIKey, Advertiser, AuditEntry, Campaign, CampaignDailySpend, ClientErasure,
+		LedgerEntry, MlScore, ModerationDecision, Targeting, TelegramAccount,
+		User []ent.Hook
 	}
 	inters struct {
-		Advertiser, Campaign, MlScore, Targeting, User []ent.Interceptor
+		APIKey, Advertiser, AuditEntry, Campaign, CampaignDailySpend, ClientErasure,
+		LedgerEntry, MlScore, ModerationDecision, Targeting, TelegramAccount,
+		User []ent.Interceptor
 	}
 )
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ClientErasure is the model entity for the ClientErasure schema.
+type ClientErasure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID uuid.UUID `json:"client_id,omitempty"`
+	// MlScores holds the value of the "ml_scores" field.
+	MlScores int `json:"ml_scores,omitempty"`
+	// CachedAds holds the value of the "cached_ads" field.
+	CachedAds int `json:"cached_ads,omitempty"`
+	// RequestedAt holds the value of the "requested_at" field.
+	RequestedAt time.Time `json:"requested_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientErasure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clienterasure.FieldID, clienterasure.FieldMlScores, clienterasure.FieldCachedAds:
+			values[i] = new(sql.NullInt64)
+		case clienterasure.FieldRequestedAt, clienterasure.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case clienterasure.FieldClientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientErasure fields.
+func (ce *ClientErasure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clienterasure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ce.ID = int(value.Int64)
+		case clienterasure.FieldClientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value != nil {
+				ce.ClientID = *value
+			}
+		case clienterasure.FieldMlScores:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ml_scores", values[i])
+			} else if value.Valid {
+				ce.MlScores = int(value.Int64)
+			}
+		case clienterasure.FieldCachedAds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cached_ads", values[i])
+			} else if value.Valid {
+				ce.CachedAds = int(value.Int64)
+			}
+		case clienterasure.FieldRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_at", values[i])
+			} else if value.Valid {
+				ce.RequestedAt = value.Time
+			}
+		case clienterasure.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				ce.CompletedAt = new(time.Time)
+				*ce.CompletedAt = value.Time
+			}
+		default:
+			ce.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientErasure.
+// This includes values selected through modifiers, order, etc.
+func (ce *ClientErasure) Value(name string) (ent.Value, error) {
+	return ce.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClientErasure.
+// Note that you need to call ClientErasure.Unwrap() before calling this method if this ClientErasure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ce *ClientErasure) Update() *ClientErasureUpdateOne {
+	return NewClientErasureClient(ce.config).UpdateOne(ce)
+}
+
+// Unwrap unwraps the ClientErasure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ce *ClientErasure) Unwrap() *ClientErasure {
+	_tx, ok := ce.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientErasure is not a transactional entity")
+	}
+	ce.config.driver = _tx.drv
+	return ce
+}
+
+// String implements the fmt.Stringer.
+func (ce *ClientErasure) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientErasure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ce.ID))
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", ce.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("ml_scores=")
+	builder.WriteString(fmt.Sprintf("%v", ce.MlScores))
+	builder.WriteString(", ")
+	builder.WriteString("cached_ads=")
+	builder.WriteString(fmt.Sprintf("%v", ce.CachedAds))
+	builder.WriteString(", ")
+	builder.WriteString("requested_at=")
+	builder.WriteString(ce.RequestedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ce.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientErasures is a parsable slice of ClientErasure.
+type ClientErasures []*ClientErasure
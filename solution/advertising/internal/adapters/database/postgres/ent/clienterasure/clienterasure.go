@@ -0,0 +1,90 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clienterasure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the clienterasure type in the database.
+	Label = "client_erasure"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldMlScores holds the string denoting the ml_scores field in the database.
+	FieldMlScores = "ml_scores"
+	// FieldCachedAds holds the string denoting the cached_ads field in the database.
+	FieldCachedAds = "cached_ads"
+	// FieldRequestedAt holds the string denoting the requested_at field in the database.
+	FieldRequestedAt = "requested_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// Table holds the table name of the clienterasure in the database.
+	Table = "client_erasures"
+)
+
+// Columns holds all SQL columns for clienterasure fields.
+var Columns = []string{
+	FieldID,
+	FieldClientID,
+	FieldMlScores,
+	FieldCachedAds,
+	FieldRequestedAt,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMlScores holds the default value on creation for the "ml_scores" field.
+	DefaultMlScores int
+	// DefaultCachedAds holds the default value on creation for the "cached_ads" field.
+	DefaultCachedAds int
+	// DefaultRequestedAt holds the default value on creation for the "requested_at" field.
+	DefaultRequestedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ClientErasure queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByMlScores orders the results by the ml_scores field.
+func ByMlScores(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMlScores, opts...).ToFunc()
+}
+
+// ByCachedAds orders the results by the cached_ads field.
+func ByCachedAds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCachedAds, opts...).ToFunc()
+}
+
+// ByRequestedAt orders the results by the requested_at field.
+func ByRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clienterasure
+
+import (
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLTE(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldClientID, v))
+}
+
+// MlScores applies equality check predicate on the "ml_scores" field. It's identical to MlScoresEQ.
+func MlScores(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldMlScores, v))
+}
+
+// CachedAds applies equality check predicate on the "cached_ads" field. It's identical to CachedAdsEQ.
+func CachedAds(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldCachedAds, v))
+}
+
+// RequestedAt applies equality check predicate on the "requested_at" field. It's identical to RequestedAtEQ.
+func RequestedAt(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldRequestedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v uuid.UUID) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLTE(FieldClientID, v))
+}
+
+// MlScoresEQ applies the EQ predicate on the "ml_scores" field.
+func MlScoresEQ(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldMlScores, v))
+}
+
+// MlScoresNEQ applies the NEQ predicate on the "ml_scores" field.
+func MlScoresNEQ(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNEQ(FieldMlScores, v))
+}
+
+// MlScoresIn applies the In predicate on the "ml_scores" field.
+func MlScoresIn(vs ...int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldIn(FieldMlScores, vs...))
+}
+
+// MlScoresNotIn applies the NotIn predicate on the "ml_scores" field.
+func MlScoresNotIn(vs ...int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNotIn(FieldMlScores, vs...))
+}
+
+// MlScoresGT applies the GT predicate on the "ml_scores" field.
+func MlScoresGT(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGT(FieldMlScores, v))
+}
+
+// MlScoresGTE applies the GTE predicate on the "ml_scores" field.
+func MlScoresGTE(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGTE(FieldMlScores, v))
+}
+
+// MlScoresLT applies the LT predicate on the "ml_scores" field.
+func MlScoresLT(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLT(FieldMlScores, v))
+}
+
+// MlScoresLTE applies the LTE predicate on the "ml_scores" field.
+func MlScoresLTE(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLTE(FieldMlScores, v))
+}
+
+// CachedAdsEQ applies the EQ predicate on the "cached_ads" field.
+func CachedAdsEQ(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldCachedAds, v))
+}
+
+// CachedAdsNEQ applies the NEQ predicate on the "cached_ads" field.
+func CachedAdsNEQ(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNEQ(FieldCachedAds, v))
+}
+
+// CachedAdsIn applies the In predicate on the "cached_ads" field.
+func CachedAdsIn(vs ...int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldIn(FieldCachedAds, vs...))
+}
+
+// CachedAdsNotIn applies the NotIn predicate on the "cached_ads" field.
+func CachedAdsNotIn(vs ...int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNotIn(FieldCachedAds, vs...))
+}
+
+// CachedAdsGT applies the GT predicate on the "cached_ads" field.
+func CachedAdsGT(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGT(FieldCachedAds, v))
+}
+
+// CachedAdsGTE applies the GTE predicate on the "cached_ads" field.
+func CachedAdsGTE(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGTE(FieldCachedAds, v))
+}
+
+// CachedAdsLT applies the LT predicate on the "cached_ads" field.
+func CachedAdsLT(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLT(FieldCachedAds, v))
+}
+
+// CachedAdsLTE applies the LTE predicate on the "cached_ads" field.
+func CachedAdsLTE(v int) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLTE(FieldCachedAds, v))
+}
+
+// RequestedAtEQ applies the EQ predicate on the "requested_at" field.
+func RequestedAtEQ(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldRequestedAt, v))
+}
+
+// RequestedAtNEQ applies the NEQ predicate on the "requested_at" field.
+func RequestedAtNEQ(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNEQ(FieldRequestedAt, v))
+}
+
+// RequestedAtIn applies the In predicate on the "requested_at" field.
+func RequestedAtIn(vs ...time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldIn(FieldRequestedAt, vs...))
+}
+
+// RequestedAtNotIn applies the NotIn predicate on the "requested_at" field.
+func RequestedAtNotIn(vs ...time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNotIn(FieldRequestedAt, vs...))
+}
+
+// RequestedAtGT applies the GT predicate on the "requested_at" field.
+func RequestedAtGT(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGT(FieldRequestedAt, v))
+}
+
+// RequestedAtGTE applies the GTE predicate on the "requested_at" field.
+func RequestedAtGTE(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGTE(FieldRequestedAt, v))
+}
+
+// RequestedAtLT applies the LT predicate on the "requested_at" field.
+func RequestedAtLT(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLT(FieldRequestedAt, v))
+}
+
+// RequestedAtLTE applies the LTE predicate on the "requested_at" field.
+func RequestedAtLTE(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLTE(FieldRequestedAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.ClientErasure {
+	return predicate.ClientErasure(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClientErasure) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClientErasure) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClientErasure) predicate.ClientErasure {
+	return predicate.ClientErasure(sql.NotPredicates(p))
+}
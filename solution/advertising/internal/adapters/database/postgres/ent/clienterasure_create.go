@@ -0,0 +1,747 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ClientErasureCreate is the builder for creating a ClientErasure entity.
+type ClientErasureCreate struct {
+	config
+	mutation *ClientErasureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetClientID sets the "client_id" field.
+func (cec *ClientErasureCreate) SetClientID(u uuid.UUID) *ClientErasureCreate {
+	cec.mutation.SetClientID(u)
+	return cec
+}
+
+// SetMlScores sets the "ml_scores" field.
+func (cec *ClientErasureCreate) SetMlScores(i int) *ClientErasureCreate {
+	cec.mutation.SetMlScores(i)
+	return cec
+}
+
+// SetNillableMlScores sets the "ml_scores" field if the given value is not nil.
+func (cec *ClientErasureCreate) SetNillableMlScores(i *int) *ClientErasureCreate {
+	if i != nil {
+		cec.SetMlScores(*i)
+	}
+	return cec
+}
+
+// SetCachedAds sets the "cached_ads" field.
+func (cec *ClientErasureCreate) SetCachedAds(i int) *ClientErasureCreate {
+	cec.mutation.SetCachedAds(i)
+	return cec
+}
+
+// SetNillableCachedAds sets the "cached_ads" field if the given value is not nil.
+func (cec *ClientErasureCreate) SetNillableCachedAds(i *int) *ClientErasureCreate {
+	if i != nil {
+		cec.SetCachedAds(*i)
+	}
+	return cec
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (cec *ClientErasureCreate) SetRequestedAt(t time.Time) *ClientErasureCreate {
+	cec.mutation.SetRequestedAt(t)
+	return cec
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (cec *ClientErasureCreate) SetNillableRequestedAt(t *time.Time) *ClientErasureCreate {
+	if t != nil {
+		cec.SetRequestedAt(*t)
+	}
+	return cec
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (cec *ClientErasureCreate) SetCompletedAt(t time.Time) *ClientErasureCreate {
+	cec.mutation.SetCompletedAt(t)
+	return cec
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (cec *ClientErasureCreate) SetNillableCompletedAt(t *time.Time) *ClientErasureCreate {
+	if t != nil {
+		cec.SetCompletedAt(*t)
+	}
+	return cec
+}
+
+// Mutation returns the ClientErasureMutation object of the builder.
+func (cec *ClientErasureCreate) Mutation() *ClientErasureMutation {
+	return cec.mutation
+}
+
+// Save creates the ClientErasure in the database.
+func (cec *ClientErasureCreate) Save(ctx context.Context) (*ClientErasure, error) {
+	cec.defaults()
+	return withHooks(ctx, cec.sqlSave, cec.mutation, cec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cec *ClientErasureCreate) SaveX(ctx context.Context) *ClientErasure {
+	v, err := cec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cec *ClientErasureCreate) Exec(ctx context.Context) error {
+	_, err := cec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cec *ClientErasureCreate) ExecX(ctx context.Context) {
+	if err := cec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cec *ClientErasureCreate) defaults() {
+	if _, ok := cec.mutation.MlScores(); !ok {
+		v := clienterasure.DefaultMlScores
+		cec.mutation.SetMlScores(v)
+	}
+	if _, ok := cec.mutation.CachedAds(); !ok {
+		v := clienterasure.DefaultCachedAds
+		cec.mutation.SetCachedAds(v)
+	}
+	if _, ok := cec.mutation.RequestedAt(); !ok {
+		v := clienterasure.DefaultRequestedAt()
+		cec.mutation.SetRequestedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cec *ClientErasureCreate) check() error {
+	if _, ok := cec.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "ClientErasure.client_id"`)}
+	}
+	if _, ok := cec.mutation.MlScores(); !ok {
+		return &ValidationError{Name: "ml_scores", err: errors.New(`ent: missing required field "ClientErasure.ml_scores"`)}
+	}
+	if _, ok := cec.mutation.CachedAds(); !ok {
+		return &ValidationError{Name: "cached_ads", err: errors.New(`ent: missing required field "ClientErasure.cached_ads"`)}
+	}
+	if _, ok := cec.mutation.RequestedAt(); !ok {
+		return &ValidationError{Name: "requested_at", err: errors.New(`ent: missing required field "ClientErasure.requested_at"`)}
+	}
+	return nil
+}
+
+func (cec *ClientErasureCreate) sqlSave(ctx context.Context) (*ClientErasure, error) {
+	if err := cec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cec.mutation.id = &_node.ID
+	cec.mutation.done = true
+	return _node, nil
+}
+
+func (cec *ClientErasureCreate) createSpec() (*ClientErasure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientErasure{config: cec.config}
+		_spec = sqlgraph.NewCreateSpec(clienterasure.Table, sqlgraph.NewFieldSpec(clienterasure.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = cec.conflict
+	if value, ok := cec.mutation.ClientID(); ok {
+		_spec.SetField(clienterasure.FieldClientID, field.TypeUUID, value)
+		_node.ClientID = value
+	}
+	if value, ok := cec.mutation.MlScores(); ok {
+		_spec.SetField(clienterasure.FieldMlScores, field.TypeInt, value)
+		_node.MlScores = value
+	}
+	if value, ok := cec.mutation.CachedAds(); ok {
+		_spec.SetField(clienterasure.FieldCachedAds, field.TypeInt, value)
+		_node.CachedAds = value
+	}
+	if value, ok := cec.mutation.RequestedAt(); ok {
+		_spec.SetField(clienterasure.FieldRequestedAt, field.TypeTime, value)
+		_node.RequestedAt = value
+	}
+	if value, ok := cec.mutation.CompletedAt(); ok {
+		_spec.SetField(clienterasure.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientErasure.Create().
+//		SetClientID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientErasureUpsert) {
+//			SetClientID(v+v).
+//		}).
+//		Exec(ctx)
+func (cec *ClientErasureCreate) OnConflict(opts ...sql.ConflictOption) *ClientErasureUpsertOne {
+	cec.conflict = opts
+	return &ClientErasureUpsertOne{
+		create: cec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientErasure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cec *ClientErasureCreate) OnConflictColumns(columns ...string) *ClientErasureUpsertOne {
+	cec.conflict = append(cec.conflict, sql.ConflictColumns(columns...))
+	return &ClientErasureUpsertOne{
+		create: cec,
+	}
+}
+
+type (
+	// ClientErasureUpsertOne is the builder for "upsert"-ing
+	//  one ClientErasure node.
+	ClientErasureUpsertOne struct {
+		create *ClientErasureCreate
+	}
+
+	// ClientErasureUpsert is the "OnConflict" setter.
+	ClientErasureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMlScores sets the "ml_scores" field.
+func (u *ClientErasureUpsert) SetMlScores(v int) *ClientErasureUpsert {
+	u.Set(clienterasure.FieldMlScores, v)
+	return u
+}
+
+// UpdateMlScores sets the "ml_scores" field to the value that was provided on create.
+func (u *ClientErasureUpsert) UpdateMlScores() *ClientErasureUpsert {
+	u.SetExcluded(clienterasure.FieldMlScores)
+	return u
+}
+
+// AddMlScores adds v to the "ml_scores" field.
+func (u *ClientErasureUpsert) AddMlScores(v int) *ClientErasureUpsert {
+	u.Add(clienterasure.FieldMlScores, v)
+	return u
+}
+
+// SetCachedAds sets the "cached_ads" field.
+func (u *ClientErasureUpsert) SetCachedAds(v int) *ClientErasureUpsert {
+	u.Set(clienterasure.FieldCachedAds, v)
+	return u
+}
+
+// UpdateCachedAds sets the "cached_ads" field to the value that was provided on create.
+func (u *ClientErasureUpsert) UpdateCachedAds() *ClientErasureUpsert {
+	u.SetExcluded(clienterasure.FieldCachedAds)
+	return u
+}
+
+// AddCachedAds adds v to the "cached_ads" field.
+func (u *ClientErasureUpsert) AddCachedAds(v int) *ClientErasureUpsert {
+	u.Add(clienterasure.FieldCachedAds, v)
+	return u
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *ClientErasureUpsert) SetRequestedAt(v time.Time) *ClientErasureUpsert {
+	u.Set(clienterasure.FieldRequestedAt, v)
+	return u
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *ClientErasureUpsert) UpdateRequestedAt() *ClientErasureUpsert {
+	u.SetExcluded(clienterasure.FieldRequestedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ClientErasureUpsert) SetCompletedAt(v time.Time) *ClientErasureUpsert {
+	u.Set(clienterasure.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ClientErasureUpsert) UpdateCompletedAt() *ClientErasureUpsert {
+	u.SetExcluded(clienterasure.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ClientErasureUpsert) ClearCompletedAt() *ClientErasureUpsert {
+	u.SetNull(clienterasure.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ClientErasure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ClientErasureUpsertOne) UpdateNewValues() *ClientErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ClientID(); exists {
+			s.SetIgnore(clienterasure.FieldClientID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientErasure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ClientErasureUpsertOne) Ignore() *ClientErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientErasureUpsertOne) DoNothing() *ClientErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientErasureCreate.OnConflict
+// documentation for more info.
+func (u *ClientErasureUpsertOne) Update(set func(*ClientErasureUpsert)) *ClientErasureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientErasureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMlScores sets the "ml_scores" field.
+func (u *ClientErasureUpsertOne) SetMlScores(v int) *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetMlScores(v)
+	})
+}
+
+// AddMlScores adds v to the "ml_scores" field.
+func (u *ClientErasureUpsertOne) AddMlScores(v int) *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.AddMlScores(v)
+	})
+}
+
+// UpdateMlScores sets the "ml_scores" field to the value that was provided on create.
+func (u *ClientErasureUpsertOne) UpdateMlScores() *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateMlScores()
+	})
+}
+
+// SetCachedAds sets the "cached_ads" field.
+func (u *ClientErasureUpsertOne) SetCachedAds(v int) *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetCachedAds(v)
+	})
+}
+
+// AddCachedAds adds v to the "cached_ads" field.
+func (u *ClientErasureUpsertOne) AddCachedAds(v int) *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.AddCachedAds(v)
+	})
+}
+
+// UpdateCachedAds sets the "cached_ads" field to the value that was provided on create.
+func (u *ClientErasureUpsertOne) UpdateCachedAds() *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateCachedAds()
+	})
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *ClientErasureUpsertOne) SetRequestedAt(v time.Time) *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetRequestedAt(v)
+	})
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *ClientErasureUpsertOne) UpdateRequestedAt() *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateRequestedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ClientErasureUpsertOne) SetCompletedAt(v time.Time) *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ClientErasureUpsertOne) UpdateCompletedAt() *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ClientErasureUpsertOne) ClearCompletedAt() *ClientErasureUpsertOne {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientErasureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientErasureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientErasureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ClientErasureUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ClientErasureUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ClientErasureCreateBulk is the builder for creating many ClientErasure entities in bulk.
+type ClientErasureCreateBulk struct {
+	config
+	err      error
+	builders []*ClientErasureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ClientErasure entities in the database.
+func (cecb *ClientErasureCreateBulk) Save(ctx context.Context) ([]*ClientErasure, error) {
+	if cecb.err != nil {
+		return nil, cecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cecb.builders))
+	nodes := make([]*ClientErasure, len(cecb.builders))
+	mutators := make([]Mutator, len(cecb.builders))
+	for i := range cecb.builders {
+		func(i int, root context.Context) {
+			builder := cecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientErasureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cecb *ClientErasureCreateBulk) SaveX(ctx context.Context) []*ClientErasure {
+	v, err := cecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cecb *ClientErasureCreateBulk) Exec(ctx context.Context) error {
+	_, err := cecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cecb *ClientErasureCreateBulk) ExecX(ctx context.Context) {
+	if err := cecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientErasure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientErasureUpsert) {
+//			SetClientID(v+v).
+//		}).
+//		Exec(ctx)
+func (cecb *ClientErasureCreateBulk) OnConflict(opts ...sql.ConflictOption) *ClientErasureUpsertBulk {
+	cecb.conflict = opts
+	return &ClientErasureUpsertBulk{
+		create: cecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientErasure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cecb *ClientErasureCreateBulk) OnConflictColumns(columns ...string) *ClientErasureUpsertBulk {
+	cecb.conflict = append(cecb.conflict, sql.ConflictColumns(columns...))
+	return &ClientErasureUpsertBulk{
+		create: cecb,
+	}
+}
+
+// ClientErasureUpsertBulk is the builder for "upsert"-ing
+// a bulk of ClientErasure nodes.
+type ClientErasureUpsertBulk struct {
+	create *ClientErasureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ClientErasure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ClientErasureUpsertBulk) UpdateNewValues() *ClientErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ClientID(); exists {
+				s.SetIgnore(clienterasure.FieldClientID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientErasure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ClientErasureUpsertBulk) Ignore() *ClientErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientErasureUpsertBulk) DoNothing() *ClientErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientErasureCreateBulk.OnConflict
+// documentation for more info.
+func (u *ClientErasureUpsertBulk) Update(set func(*ClientErasureUpsert)) *ClientErasureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientErasureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMlScores sets the "ml_scores" field.
+func (u *ClientErasureUpsertBulk) SetMlScores(v int) *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetMlScores(v)
+	})
+}
+
+// AddMlScores adds v to the "ml_scores" field.
+func (u *ClientErasureUpsertBulk) AddMlScores(v int) *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.AddMlScores(v)
+	})
+}
+
+// UpdateMlScores sets the "ml_scores" field to the value that was provided on create.
+func (u *ClientErasureUpsertBulk) UpdateMlScores() *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateMlScores()
+	})
+}
+
+// SetCachedAds sets the "cached_ads" field.
+func (u *ClientErasureUpsertBulk) SetCachedAds(v int) *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetCachedAds(v)
+	})
+}
+
+// AddCachedAds adds v to the "cached_ads" field.
+func (u *ClientErasureUpsertBulk) AddCachedAds(v int) *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.AddCachedAds(v)
+	})
+}
+
+// UpdateCachedAds sets the "cached_ads" field to the value that was provided on create.
+func (u *ClientErasureUpsertBulk) UpdateCachedAds() *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateCachedAds()
+	})
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *ClientErasureUpsertBulk) SetRequestedAt(v time.Time) *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetRequestedAt(v)
+	})
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *ClientErasureUpsertBulk) UpdateRequestedAt() *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateRequestedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ClientErasureUpsertBulk) SetCompletedAt(v time.Time) *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ClientErasureUpsertBulk) UpdateCompletedAt() *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ClientErasureUpsertBulk) ClearCompletedAt() *ClientErasureUpsertBulk {
+	return u.Update(func(s *ClientErasureUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientErasureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ClientErasureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientErasureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientErasureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
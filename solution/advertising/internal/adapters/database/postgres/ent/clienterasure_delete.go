@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClientErasureDelete is the builder for deleting a ClientErasure entity.
+type ClientErasureDelete struct {
+	config
+	hooks    []Hook
+	mutation *ClientErasureMutation
+}
+
+// Where appends a list predicates to the ClientErasureDelete builder.
+func (ced *ClientErasureDelete) Where(ps ...predicate.ClientErasure) *ClientErasureDelete {
+	ced.mutation.Where(ps...)
+	return ced
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ced *ClientErasureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ced.sqlExec, ced.mutation, ced.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ced *ClientErasureDelete) ExecX(ctx context.Context) int {
+	n, err := ced.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ced *ClientErasureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(clienterasure.Table, sqlgraph.NewFieldSpec(clienterasure.FieldID, field.TypeInt))
+	if ps := ced.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ced.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ced.mutation.done = true
+	return affected, err
+}
+
+// ClientErasureDeleteOne is the builder for deleting a single ClientErasure entity.
+type ClientErasureDeleteOne struct {
+	ced *ClientErasureDelete
+}
+
+// Where appends a list predicates to the ClientErasureDelete builder.
+func (cedo *ClientErasureDeleteOne) Where(ps ...predicate.ClientErasure) *ClientErasureDeleteOne {
+	cedo.ced.mutation.Where(ps...)
+	return cedo
+}
+
+// Exec executes the deletion query.
+func (cedo *ClientErasureDeleteOne) Exec(ctx context.Context) error {
+	n, err := cedo.ced.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{clienterasure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cedo *ClientErasureDeleteOne) ExecX(ctx context.Context) {
+	if err := cedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClientErasureQuery is the builder for querying ClientErasure entities.
+type ClientErasureQuery struct {
+	config
+	ctx        *QueryContext
+	order      []clienterasure.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ClientErasure
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ClientErasureQuery builder.
+func (ceq *ClientErasureQuery) Where(ps ...predicate.ClientErasure) *ClientErasureQuery {
+	ceq.predicates = append(ceq.predicates, ps...)
+	return ceq
+}
+
+// Limit the number of records to be returned by this query.
+func (ceq *ClientErasureQuery) Limit(limit int) *ClientErasureQuery {
+	ceq.ctx.Limit = &limit
+	return ceq
+}
+
+// Offset to start from.
+func (ceq *ClientErasureQuery) Offset(offset int) *ClientErasureQuery {
+	ceq.ctx.Offset = &offset
+	return ceq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ceq *ClientErasureQuery) Unique(unique bool) *ClientErasureQuery {
+	ceq.ctx.Unique = &unique
+	return ceq
+}
+
+// Order specifies how the records should be ordered.
+func (ceq *ClientErasureQuery) Order(o ...clienterasure.OrderOption) *ClientErasureQuery {
+	ceq.order = append(ceq.order, o...)
+	return ceq
+}
+
+// First returns the first ClientErasure entity from the query.
+// Returns a *NotFoundError when no ClientErasure was found.
+func (ceq *ClientErasureQuery) First(ctx context.Context) (*ClientErasure, error) {
+	nodes, err := ceq.Limit(1).All(setContextOp(ctx, ceq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{clienterasure.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ceq *ClientErasureQuery) FirstX(ctx context.Context) *ClientErasure {
+	node, err := ceq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ClientErasure ID from the query.
+// Returns a *NotFoundError when no ClientErasure ID was found.
+func (ceq *ClientErasureQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ceq.Limit(1).IDs(setContextOp(ctx, ceq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{clienterasure.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ceq *ClientErasureQuery) FirstIDX(ctx context.Context) int {
+	id, err := ceq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ClientErasure entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ClientErasure entity is found.
+// Returns a *NotFoundError when no ClientErasure entities are found.
+func (ceq *ClientErasureQuery) Only(ctx context.Context) (*ClientErasure, error) {
+	nodes, err := ceq.Limit(2).All(setContextOp(ctx, ceq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{clienterasure.Label}
+	default:
+		return nil, &NotSingularError{clienterasure.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ceq *ClientErasureQuery) OnlyX(ctx context.Context) *ClientErasure {
+	node, err := ceq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ClientErasure ID in the query.
+// Returns a *NotSingularError when more than one ClientErasure ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ceq *ClientErasureQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ceq.Limit(2).IDs(setContextOp(ctx, ceq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{clienterasure.Label}
+	default:
+		err = &NotSingularError{clienterasure.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ceq *ClientErasureQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ceq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ClientErasures.
+func (ceq *ClientErasureQuery) All(ctx context.Context) ([]*ClientErasure, error) {
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryAll)
+	if err := ceq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ClientErasure, *ClientErasureQuery]()
+	return withInterceptors[[]*ClientErasure](ctx, ceq, qr, ceq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ceq *ClientErasureQuery) AllX(ctx context.Context) []*ClientErasure {
+	nodes, err := ceq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ClientErasure IDs.
+func (ceq *ClientErasureQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ceq.ctx.Unique == nil && ceq.path != nil {
+		ceq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryIDs)
+	if err = ceq.Select(clienterasure.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ceq *ClientErasureQuery) IDsX(ctx context.Context) []int {
+	ids, err := ceq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ceq *ClientErasureQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryCount)
+	if err := ceq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ceq, querierCount[*ClientErasureQuery](), ceq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ceq *ClientErasureQuery) CountX(ctx context.Context) int {
+	count, err := ceq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ceq *ClientErasureQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ceq.ctx, ent.OpQueryExist)
+	switch _, err := ceq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ceq *ClientErasureQuery) ExistX(ctx context.Context) bool {
+	exist, err := ceq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ClientErasureQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ceq *ClientErasureQuery) Clone() *ClientErasureQuery {
+	if ceq == nil {
+		return nil
+	}
+	return &ClientErasureQuery{
+		config:     ceq.config,
+		ctx:        ceq.ctx.Clone(),
+		order:      append([]clienterasure.OrderOption{}, ceq.order...),
+		inters:     append([]Interceptor{}, ceq.inters...),
+		predicates: append([]predicate.ClientErasure{}, ceq.predicates...),
+		// clone intermediate query.
+		sql:  ceq.sql.Clone(),
+		path: ceq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID uuid.UUID `json:"client_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ClientErasure.Query().
+//		GroupBy(clienterasure.FieldClientID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ceq *ClientErasureQuery) GroupBy(field string, fields ...string) *ClientErasureGroupBy {
+	ceq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ClientErasureGroupBy{build: ceq}
+	grbuild.flds = &ceq.ctx.Fields
+	grbuild.label = clienterasure.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID uuid.UUID `json:"client_id,omitempty"`
+//	}
+//
+//	client.ClientErasure.Query().
+//		Select(clienterasure.FieldClientID).
+//		Scan(ctx, &v)
+func (ceq *ClientErasureQuery) Select(fields ...string) *ClientErasureSelect {
+	ceq.ctx.Fields = append(ceq.ctx.Fields, fields...)
+	sbuild := &ClientErasureSelect{ClientErasureQuery: ceq}
+	sbuild.label = clienterasure.Label
+	sbuild.flds, sbuild.scan = &ceq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ClientErasureSelect configured with the given aggregations.
+func (ceq *ClientErasureQuery) Aggregate(fns ...AggregateFunc) *ClientErasureSelect {
+	return ceq.Select().Aggregate(fns...)
+}
+
+func (ceq *ClientErasureQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ceq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ceq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ceq.ctx.Fields {
+		if !clienterasure.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ceq.path != nil {
+		prev, err := ceq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ceq.sql = prev
+	}
+	return nil
+}
+
+func (ceq *ClientErasureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ClientErasure, error) {
+	var (
+		nodes = []*ClientErasure{}
+		_spec = ceq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ClientErasure).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ClientErasure{config: ceq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ceq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ceq *ClientErasureQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ceq.querySpec()
+	_spec.Node.Columns = ceq.ctx.Fields
+	if len(ceq.ctx.Fields) > 0 {
+		_spec.Unique = ceq.ctx.Unique != nil && *ceq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ceq.driver, _spec)
+}
+
+func (ceq *ClientErasureQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(clienterasure.Table, clienterasure.Columns, sqlgraph.NewFieldSpec(clienterasure.FieldID, field.TypeInt))
+	_spec.From = ceq.sql
+	if unique := ceq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ceq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ceq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clienterasure.FieldID)
+		for i := range fields {
+			if fields[i] != clienterasure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ceq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ceq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ceq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ceq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ceq *ClientErasureQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ceq.driver.Dialect())
+	t1 := builder.Table(clienterasure.Table)
+	columns := ceq.ctx.Fields
+	if len(columns) == 0 {
+		columns = clienterasure.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ceq.sql != nil {
+		selector = ceq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ceq.ctx.Unique != nil && *ceq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ceq.predicates {
+		p(selector)
+	}
+	for _, p := range ceq.order {
+		p(selector)
+	}
+	if offset := ceq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ceq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ClientErasureGroupBy is the group-by builder for ClientErasure entities.
+type ClientErasureGroupBy struct {
+	selector
+	build *ClientErasureQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cegb *ClientErasureGroupBy) Aggregate(fns ...AggregateFunc) *ClientErasureGroupBy {
+	cegb.fns = append(cegb.fns, fns...)
+	return cegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cegb *ClientErasureGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cegb.build.ctx, ent.OpQueryGroupBy)
+	if err := cegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ClientErasureQuery, *ClientErasureGroupBy](ctx, cegb.build, cegb, cegb.build.inters, v)
+}
+
+func (cegb *ClientErasureGroupBy) sqlScan(ctx context.Context, root *ClientErasureQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cegb.fns))
+	for _, fn := range cegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cegb.flds)+len(cegb.fns))
+		for _, f := range *cegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ClientErasureSelect is the builder for selecting fields of ClientErasure entities.
+type ClientErasureSelect struct {
+	*ClientErasureQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ces *ClientErasureSelect) Aggregate(fns ...AggregateFunc) *ClientErasureSelect {
+	ces.fns = append(ces.fns, fns...)
+	return ces
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ces *ClientErasureSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ces.ctx, ent.OpQuerySelect)
+	if err := ces.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ClientErasureQuery, *ClientErasureSelect](ctx, ces.ClientErasureQuery, ces, ces.inters, v)
+}
+
+func (ces *ClientErasureSelect) sqlScan(ctx context.Context, root *ClientErasureQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ces.fns))
+	for _, fn := range ces.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ces.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ces.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
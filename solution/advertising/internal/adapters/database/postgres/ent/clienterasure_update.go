@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClientErasureUpdate is the builder for updating ClientErasure entities.
+type ClientErasureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClientErasureMutation
+}
+
+// Where appends a list predicates to the ClientErasureUpdate builder.
+func (ceu *ClientErasureUpdate) Where(ps ...predicate.ClientErasure) *ClientErasureUpdate {
+	ceu.mutation.Where(ps...)
+	return ceu
+}
+
+// SetMlScores sets the "ml_scores" field.
+func (ceu *ClientErasureUpdate) SetMlScores(i int) *ClientErasureUpdate {
+	ceu.mutation.ResetMlScores()
+	ceu.mutation.SetMlScores(i)
+	return ceu
+}
+
+// SetNillableMlScores sets the "ml_scores" field if the given value is not nil.
+func (ceu *ClientErasureUpdate) SetNillableMlScores(i *int) *ClientErasureUpdate {
+	if i != nil {
+		ceu.SetMlScores(*i)
+	}
+	return ceu
+}
+
+// AddMlScores adds i to the "ml_scores" field.
+func (ceu *ClientErasureUpdate) AddMlScores(i int) *ClientErasureUpdate {
+	ceu.mutation.AddMlScores(i)
+	return ceu
+}
+
+// SetCachedAds sets the "cached_ads" field.
+func (ceu *ClientErasureUpdate) SetCachedAds(i int) *ClientErasureUpdate {
+	ceu.mutation.ResetCachedAds()
+	ceu.mutation.SetCachedAds(i)
+	return ceu
+}
+
+// SetNillableCachedAds sets the "cached_ads" field if the given value is not nil.
+func (ceu *ClientErasureUpdate) SetNillableCachedAds(i *int) *ClientErasureUpdate {
+	if i != nil {
+		ceu.SetCachedAds(*i)
+	}
+	return ceu
+}
+
+// AddCachedAds adds i to the "cached_ads" field.
+func (ceu *ClientErasureUpdate) AddCachedAds(i int) *ClientErasureUpdate {
+	ceu.mutation.AddCachedAds(i)
+	return ceu
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (ceu *ClientErasureUpdate) SetRequestedAt(t time.Time) *ClientErasureUpdate {
+	ceu.mutation.SetRequestedAt(t)
+	return ceu
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (ceu *ClientErasureUpdate) SetNillableRequestedAt(t *time.Time) *ClientErasureUpdate {
+	if t != nil {
+		ceu.SetRequestedAt(*t)
+	}
+	return ceu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ceu *ClientErasureUpdate) SetCompletedAt(t time.Time) *ClientErasureUpdate {
+	ceu.mutation.SetCompletedAt(t)
+	return ceu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ceu *ClientErasureUpdate) SetNillableCompletedAt(t *time.Time) *ClientErasureUpdate {
+	if t != nil {
+		ceu.SetCompletedAt(*t)
+	}
+	return ceu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ceu *ClientErasureUpdate) ClearCompletedAt() *ClientErasureUpdate {
+	ceu.mutation.ClearCompletedAt()
+	return ceu
+}
+
+// Mutation returns the ClientErasureMutation object of the builder.
+func (ceu *ClientErasureUpdate) Mutation() *ClientErasureMutation {
+	return ceu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ceu *ClientErasureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ceu.sqlSave, ceu.mutation, ceu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ceu *ClientErasureUpdate) SaveX(ctx context.Context) int {
+	affected, err := ceu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ceu *ClientErasureUpdate) Exec(ctx context.Context) error {
+	_, err := ceu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ceu *ClientErasureUpdate) ExecX(ctx context.Context) {
+	if err := ceu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ceu *ClientErasureUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(clienterasure.Table, clienterasure.Columns, sqlgraph.NewFieldSpec(clienterasure.FieldID, field.TypeInt))
+	if ps := ceu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ceu.mutation.MlScores(); ok {
+		_spec.SetField(clienterasure.FieldMlScores, field.TypeInt, value)
+	}
+	if value, ok := ceu.mutation.AddedMlScores(); ok {
+		_spec.AddField(clienterasure.FieldMlScores, field.TypeInt, value)
+	}
+	if value, ok := ceu.mutation.CachedAds(); ok {
+		_spec.SetField(clienterasure.FieldCachedAds, field.TypeInt, value)
+	}
+	if value, ok := ceu.mutation.AddedCachedAds(); ok {
+		_spec.AddField(clienterasure.FieldCachedAds, field.TypeInt, value)
+	}
+	if value, ok := ceu.mutation.RequestedAt(); ok {
+		_spec.SetField(clienterasure.FieldRequestedAt, field.TypeTime, value)
+	}
+	if value, ok := ceu.mutation.CompletedAt(); ok {
+		_spec.SetField(clienterasure.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ceu.mutation.CompletedAtCleared() {
+		_spec.ClearField(clienterasure.FieldCompletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ceu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clienterasure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ceu.mutation.done = true
+	return n, nil
+}
+
+// ClientErasureUpdateOne is the builder for updating a single ClientErasure entity.
+type ClientErasureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClientErasureMutation
+}
+
+// SetMlScores sets the "ml_scores" field.
+func (ceuo *ClientErasureUpdateOne) SetMlScores(i int) *ClientErasureUpdateOne {
+	ceuo.mutation.ResetMlScores()
+	ceuo.mutation.SetMlScores(i)
+	return ceuo
+}
+
+// SetNillableMlScores sets the "ml_scores" field if the given value is not nil.
+func (ceuo *ClientErasureUpdateOne) SetNillableMlScores(i *int) *ClientErasureUpdateOne {
+	if i != nil {
+		ceuo.SetMlScores(*i)
+	}
+	return ceuo
+}
+
+// AddMlScores adds i to the "ml_scores" field.
+func (ceuo *ClientErasureUpdateOne) AddMlScores(i int) *ClientErasureUpdateOne {
+	ceuo.mutation.AddMlScores(i)
+	return ceuo
+}
+
+// SetCachedAds sets the "cached_ads" field.
+func (ceuo *ClientErasureUpdateOne) SetCachedAds(i int) *ClientErasureUpdateOne {
+	ceuo.mutation.ResetCachedAds()
+	ceuo.mutation.SetCachedAds(i)
+	return ceuo
+}
+
+// SetNillableCachedAds sets the "cached_ads" field if the given value is not nil.
+func (ceuo *ClientErasureUpdateOne) SetNillableCachedAds(i *int) *ClientErasureUpdateOne {
+	if i != nil {
+		ceuo.SetCachedAds(*i)
+	}
+	return ceuo
+}
+
+// AddCachedAds adds i to the "cached_ads" field.
+func (ceuo *ClientErasureUpdateOne) AddCachedAds(i int) *ClientErasureUpdateOne {
+	ceuo.mutation.AddCachedAds(i)
+	return ceuo
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (ceuo *ClientErasureUpdateOne) SetRequestedAt(t time.Time) *ClientErasureUpdateOne {
+	ceuo.mutation.SetRequestedAt(t)
+	return ceuo
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (ceuo *ClientErasureUpdateOne) SetNillableRequestedAt(t *time.Time) *ClientErasureUpdateOne {
+	if t != nil {
+		ceuo.SetRequestedAt(*t)
+	}
+	return ceuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ceuo *ClientErasureUpdateOne) SetCompletedAt(t time.Time) *ClientErasureUpdateOne {
+	ceuo.mutation.SetCompletedAt(t)
+	return ceuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ceuo *ClientErasureUpdateOne) SetNillableCompletedAt(t *time.Time) *ClientErasureUpdateOne {
+	if t != nil {
+		ceuo.SetCompletedAt(*t)
+	}
+	return ceuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ceuo *ClientErasureUpdateOne) ClearCompletedAt() *ClientErasureUpdateOne {
+	ceuo.mutation.ClearCompletedAt()
+	return ceuo
+}
+
+// Mutation returns the ClientErasureMutation object of the builder.
+func (ceuo *ClientErasureUpdateOne) Mutation() *ClientErasureMutation {
+	return ceuo.mutation
+}
+
+// Where appends a list predicates to the ClientErasureUpdate builder.
+func (ceuo *ClientErasureUpdateOne) Where(ps ...predicate.ClientErasure) *ClientErasureUpdateOne {
+	ceuo.mutation.Where(ps...)
+	return ceuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ceuo *ClientErasureUpdateOne) Select(field string, fields ...string) *ClientErasureUpdateOne {
+	ceuo.fields = append([]string{field}, fields...)
+	return ceuo
+}
+
+// Save executes the query and returns the updated ClientErasure entity.
+func (ceuo *ClientErasureUpdateOne) Save(ctx context.Context) (*ClientErasure, error) {
+	return withHooks(ctx, ceuo.sqlSave, ceuo.mutation, ceuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ceuo *ClientErasureUpdateOne) SaveX(ctx context.Context) *ClientErasure {
+	node, err := ceuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ceuo *ClientErasureUpdateOne) Exec(ctx context.Context) error {
+	_, err := ceuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ceuo *ClientErasureUpdateOne) ExecX(ctx context.Context) {
+	if err := ceuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ceuo *ClientErasureUpdateOne) sqlSave(ctx context.Context) (_node *ClientErasure, err error) {
+	_spec := sqlgraph.NewUpdateSpec(clienterasure.Table, clienterasure.Columns, sqlgraph.NewFieldSpec(clienterasure.FieldID, field.TypeInt))
+	id, ok := ceuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClientErasure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ceuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clienterasure.FieldID)
+		for _, f := range fields {
+			if !clienterasure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != clienterasure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ceuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ceuo.mutation.MlScores(); ok {
+		_spec.SetField(clienterasure.FieldMlScores, field.TypeInt, value)
+	}
+	if value, ok := ceuo.mutation.AddedMlScores(); ok {
+		_spec.AddField(clienterasure.FieldMlScores, field.TypeInt, value)
+	}
+	if value, ok := ceuo.mutation.CachedAds(); ok {
+		_spec.SetField(clienterasure.FieldCachedAds, field.TypeInt, value)
+	}
+	if value, ok := ceuo.mutation.AddedCachedAds(); ok {
+		_spec.AddField(clienterasure.FieldCachedAds, field.TypeInt, value)
+	}
+	if value, ok := ceuo.mutation.RequestedAt(); ok {
+		_spec.SetField(clienterasure.FieldRequestedAt, field.TypeTime, value)
+	}
+	if value, ok := ceuo.mutation.CompletedAt(); ok {
+		_spec.SetField(clienterasure.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ceuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(clienterasure.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &ClientErasure{config: ceuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ceuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clienterasure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ceuo.mutation.done = true
+	return _node, nil
+}
@@ -7,9 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"nlypage-final/internal/adapters/database/postgres/ent/advertiser"
+	"nlypage-final/internal/adapters/database/postgres/ent/apikey"
+	"nlypage-final/internal/adapters/database/postgres/ent/auditentry"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/ledgerentry"
 	"nlypage-final/internal/adapters/database/postgres/ent/mlscore"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
 	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
 	"nlypage-final/internal/adapters/database/postgres/ent/user"
 	"reflect"
 	"sync"
@@ -77,11 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			advertiser.Table: advertiser.ValidColumn,
-			campaign.Table:   campaign.ValidColumn,
-			mlscore.Table:    mlscore.ValidColumn,
-			targeting.Table:  targeting.ValidColumn,
-			user.Table:       user.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			advertiser.Table:         advertiser.ValidColumn,
+			auditentry.Table:         auditentry.ValidColumn,
+			campaign.Table:           campaign.ValidColumn,
+			campaigndailyspend.Table: campaigndailyspend.ValidColumn,
+			clienterasure.Table:      clienterasure.ValidColumn,
+			ledgerentry.Table:        ledgerentry.ValidColumn,
+			mlscore.Table:            mlscore.ValidColumn,
+			moderationdecision.Table: moderationdecision.ValidColumn,
+			targeting.Table:          targeting.ValidColumn,
+			telegramaccount.Table:    telegramaccount.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/upsert
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/upsert,sql/versioned-migration
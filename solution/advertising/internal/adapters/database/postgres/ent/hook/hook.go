@@ -8,6 +8,18 @@ import (
 	"nlypage-final/internal/adapters/database/postgres/ent"
 )
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary
+// function as APIKey mutator.
+type APIKeyFunc func(context.Context, *ent.APIKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
+}
+
 // The AdvertiserFunc type is an adapter to allow the use of ordinary
 // function as Advertiser mutator.
 type AdvertiserFunc func(context.Context, *ent.AdvertiserMutation) (ent.Value, error)
@@ -20,6 +32,18 @@ func (f AdvertiserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdvertiserMutation", m)
 }
 
+// The AuditEntryFunc type is an adapter to allow the use of ordinary
+// function as AuditEntry mutator.
+type AuditEntryFunc func(context.Context, *ent.AuditEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEntryMutation", m)
+}
+
 // The CampaignFunc type is an adapter to allow the use of ordinary
 // function as Campaign mutator.
 type CampaignFunc func(context.Context, *ent.CampaignMutation) (ent.Value, error)
@@ -32,6 +56,42 @@ func (f CampaignFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CampaignMutation", m)
 }
 
+// The CampaignDailySpendFunc type is an adapter to allow the use of ordinary
+// function as CampaignDailySpend mutator.
+type CampaignDailySpendFunc func(context.Context, *ent.CampaignDailySpendMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CampaignDailySpendFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CampaignDailySpendMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CampaignDailySpendMutation", m)
+}
+
+// The ClientErasureFunc type is an adapter to allow the use of ordinary
+// function as ClientErasure mutator.
+type ClientErasureFunc func(context.Context, *ent.ClientErasureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientErasureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClientErasureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientErasureMutation", m)
+}
+
+// The LedgerEntryFunc type is an adapter to allow the use of ordinary
+// function as LedgerEntry mutator.
+type LedgerEntryFunc func(context.Context, *ent.LedgerEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LedgerEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LedgerEntryMutation", m)
+}
+
 // The MlScoreFunc type is an adapter to allow the use of ordinary
 // function as MlScore mutator.
 type MlScoreFunc func(context.Context, *ent.MlScoreMutation) (ent.Value, error)
@@ -44,6 +104,18 @@ func (f MlScoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MlScoreMutation", m)
 }
 
+// The ModerationDecisionFunc type is an adapter to allow the use of ordinary
+// function as ModerationDecision mutator.
+type ModerationDecisionFunc func(context.Context, *ent.ModerationDecisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationDecisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ModerationDecisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ModerationDecisionMutation", m)
+}
+
 // The TargetingFunc type is an adapter to allow the use of ordinary
 // function as Targeting mutator.
 type TargetingFunc func(context.Context, *ent.TargetingMutation) (ent.Value, error)
@@ -56,6 +128,18 @@ func (f TargetingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TargetingMutation", m)
 }
 
+// The TelegramAccountFunc type is an adapter to allow the use of ordinary
+// function as TelegramAccount mutator.
+type TelegramAccountFunc func(context.Context, *ent.TelegramAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TelegramAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TelegramAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TelegramAccountMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
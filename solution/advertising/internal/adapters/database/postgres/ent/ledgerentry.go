@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/ledgerentry"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LedgerEntry is the model entity for the LedgerEntry schema.
+type LedgerEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AdvertiserID holds the value of the "advertiser_id" field.
+	AdvertiserID uuid.UUID `json:"advertiser_id,omitempty"`
+	// CampaignID holds the value of the "campaign_id" field.
+	CampaignID uuid.UUID `json:"campaign_id,omitempty"`
+	// CampaignTitle holds the value of the "campaign_title" field.
+	CampaignTitle string `json:"campaign_title,omitempty"`
+	// Day holds the value of the "day" field.
+	Day int `json:"day,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind ledgerentry.Kind `json:"kind,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int64 `json:"quantity,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case ledgerentry.FieldID, ledgerentry.FieldDay, ledgerentry.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case ledgerentry.FieldCampaignTitle, ledgerentry.FieldKind:
+			values[i] = new(sql.NullString)
+		case ledgerentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case ledgerentry.FieldAdvertiserID, ledgerentry.FieldCampaignID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerEntry fields.
+func (le *LedgerEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			le.ID = int(value.Int64)
+		case ledgerentry.FieldAdvertiserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field advertiser_id", values[i])
+			} else if value != nil {
+				le.AdvertiserID = *value
+			}
+		case ledgerentry.FieldCampaignID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_id", values[i])
+			} else if value != nil {
+				le.CampaignID = *value
+			}
+		case ledgerentry.FieldCampaignTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_title", values[i])
+			} else if value.Valid {
+				le.CampaignTitle = value.String
+			}
+		case ledgerentry.FieldDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				le.Day = int(value.Int64)
+			}
+		case ledgerentry.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				le.Kind = ledgerentry.Kind(value.String)
+			}
+		case ledgerentry.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				le.Quantity = value.Int64
+			}
+		case ledgerentry.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				le.Amount = value.Float64
+			}
+		case ledgerentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				le.CreatedAt = value.Time
+			}
+		default:
+			le.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerEntry.
+// This includes values selected through modifiers, order, etc.
+func (le *LedgerEntry) Value(name string) (ent.Value, error) {
+	return le.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LedgerEntry.
+// Note that you need to call LedgerEntry.Unwrap() before calling this method if this LedgerEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (le *LedgerEntry) Update() *LedgerEntryUpdateOne {
+	return NewLedgerEntryClient(le.config).UpdateOne(le)
+}
+
+// Unwrap unwraps the LedgerEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (le *LedgerEntry) Unwrap() *LedgerEntry {
+	_tx, ok := le.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LedgerEntry is not a transactional entity")
+	}
+	le.config.driver = _tx.drv
+	return le
+}
+
+// String implements the fmt.Stringer.
+func (le *LedgerEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", le.ID))
+	builder.WriteString("advertiser_id=")
+	builder.WriteString(fmt.Sprintf("%v", le.AdvertiserID))
+	builder.WriteString(", ")
+	builder.WriteString("campaign_id=")
+	builder.WriteString(fmt.Sprintf("%v", le.CampaignID))
+	builder.WriteString(", ")
+	builder.WriteString("campaign_title=")
+	builder.WriteString(le.CampaignTitle)
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(fmt.Sprintf("%v", le.Day))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", le.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", le.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", le.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(le.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerEntries is a parsable slice of LedgerEntry.
+type LedgerEntries []*LedgerEntry
@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgerentry
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ledgerentry type in the database.
+	Label = "ledger_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAdvertiserID holds the string denoting the advertiser_id field in the database.
+	FieldAdvertiserID = "advertiser_id"
+	// FieldCampaignID holds the string denoting the campaign_id field in the database.
+	FieldCampaignID = "campaign_id"
+	// FieldCampaignTitle holds the string denoting the campaign_title field in the database.
+	FieldCampaignTitle = "campaign_title"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the ledgerentry in the database.
+	Table = "ledger_entries"
+)
+
+// Columns holds all SQL columns for ledgerentry fields.
+var Columns = []string{
+	FieldID,
+	FieldAdvertiserID,
+	FieldCampaignID,
+	FieldCampaignTitle,
+	FieldDay,
+	FieldKind,
+	FieldQuantity,
+	FieldAmount,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindIMPRESSIONS Kind = "IMPRESSIONS"
+	KindCLICKS      Kind = "CLICKS"
+	KindCONVERSIONS Kind = "CONVERSIONS"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindIMPRESSIONS, KindCLICKS, KindCONVERSIONS:
+		return nil
+	default:
+		return fmt.Errorf("ledgerentry: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the LedgerEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAdvertiserID orders the results by the advertiser_id field.
+func ByAdvertiserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdvertiserID, opts...).ToFunc()
+}
+
+// ByCampaignID orders the results by the campaign_id field.
+func ByCampaignID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaignID, opts...).ToFunc()
+}
+
+// ByCampaignTitle orders the results by the campaign_title field.
+func ByCampaignTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaignTitle, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgerentry
+
+import (
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldID, id))
+}
+
+// AdvertiserID applies equality check predicate on the "advertiser_id" field. It's identical to AdvertiserIDEQ.
+func AdvertiserID(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldAdvertiserID, v))
+}
+
+// CampaignID applies equality check predicate on the "campaign_id" field. It's identical to CampaignIDEQ.
+func CampaignID(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// CampaignTitle applies equality check predicate on the "campaign_title" field. It's identical to CampaignTitleEQ.
+func CampaignTitle(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCampaignTitle, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldDay, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AdvertiserIDEQ applies the EQ predicate on the "advertiser_id" field.
+func AdvertiserIDEQ(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDNEQ applies the NEQ predicate on the "advertiser_id" field.
+func AdvertiserIDNEQ(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDIn applies the In predicate on the "advertiser_id" field.
+func AdvertiserIDIn(vs ...uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldAdvertiserID, vs...))
+}
+
+// AdvertiserIDNotIn applies the NotIn predicate on the "advertiser_id" field.
+func AdvertiserIDNotIn(vs ...uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldAdvertiserID, vs...))
+}
+
+// AdvertiserIDGT applies the GT predicate on the "advertiser_id" field.
+func AdvertiserIDGT(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDGTE applies the GTE predicate on the "advertiser_id" field.
+func AdvertiserIDGTE(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDLT applies the LT predicate on the "advertiser_id" field.
+func AdvertiserIDLT(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDLTE applies the LTE predicate on the "advertiser_id" field.
+func AdvertiserIDLTE(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldAdvertiserID, v))
+}
+
+// CampaignIDEQ applies the EQ predicate on the "campaign_id" field.
+func CampaignIDEQ(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// CampaignIDNEQ applies the NEQ predicate on the "campaign_id" field.
+func CampaignIDNEQ(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldCampaignID, v))
+}
+
+// CampaignIDIn applies the In predicate on the "campaign_id" field.
+func CampaignIDIn(vs ...uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDNotIn applies the NotIn predicate on the "campaign_id" field.
+func CampaignIDNotIn(vs ...uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDGT applies the GT predicate on the "campaign_id" field.
+func CampaignIDGT(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldCampaignID, v))
+}
+
+// CampaignIDGTE applies the GTE predicate on the "campaign_id" field.
+func CampaignIDGTE(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldCampaignID, v))
+}
+
+// CampaignIDLT applies the LT predicate on the "campaign_id" field.
+func CampaignIDLT(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldCampaignID, v))
+}
+
+// CampaignIDLTE applies the LTE predicate on the "campaign_id" field.
+func CampaignIDLTE(v uuid.UUID) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldCampaignID, v))
+}
+
+// CampaignTitleEQ applies the EQ predicate on the "campaign_title" field.
+func CampaignTitleEQ(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCampaignTitle, v))
+}
+
+// CampaignTitleNEQ applies the NEQ predicate on the "campaign_title" field.
+func CampaignTitleNEQ(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldCampaignTitle, v))
+}
+
+// CampaignTitleIn applies the In predicate on the "campaign_title" field.
+func CampaignTitleIn(vs ...string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldCampaignTitle, vs...))
+}
+
+// CampaignTitleNotIn applies the NotIn predicate on the "campaign_title" field.
+func CampaignTitleNotIn(vs ...string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldCampaignTitle, vs...))
+}
+
+// CampaignTitleGT applies the GT predicate on the "campaign_title" field.
+func CampaignTitleGT(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldCampaignTitle, v))
+}
+
+// CampaignTitleGTE applies the GTE predicate on the "campaign_title" field.
+func CampaignTitleGTE(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldCampaignTitle, v))
+}
+
+// CampaignTitleLT applies the LT predicate on the "campaign_title" field.
+func CampaignTitleLT(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldCampaignTitle, v))
+}
+
+// CampaignTitleLTE applies the LTE predicate on the "campaign_title" field.
+func CampaignTitleLTE(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldCampaignTitle, v))
+}
+
+// CampaignTitleContains applies the Contains predicate on the "campaign_title" field.
+func CampaignTitleContains(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldContains(FieldCampaignTitle, v))
+}
+
+// CampaignTitleHasPrefix applies the HasPrefix predicate on the "campaign_title" field.
+func CampaignTitleHasPrefix(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldHasPrefix(FieldCampaignTitle, v))
+}
+
+// CampaignTitleHasSuffix applies the HasSuffix predicate on the "campaign_title" field.
+func CampaignTitleHasSuffix(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldHasSuffix(FieldCampaignTitle, v))
+}
+
+// CampaignTitleIsNil applies the IsNil predicate on the "campaign_title" field.
+func CampaignTitleIsNil() predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIsNull(FieldCampaignTitle))
+}
+
+// CampaignTitleNotNil applies the NotNil predicate on the "campaign_title" field.
+func CampaignTitleNotNil() predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotNull(FieldCampaignTitle))
+}
+
+// CampaignTitleEqualFold applies the EqualFold predicate on the "campaign_title" field.
+func CampaignTitleEqualFold(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEqualFold(FieldCampaignTitle, v))
+}
+
+// CampaignTitleContainsFold applies the ContainsFold predicate on the "campaign_title" field.
+func CampaignTitleContainsFold(v string) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldContainsFold(FieldCampaignTitle, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v int) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldDay, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldQuantity, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LedgerEntry) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LedgerEntry) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LedgerEntry) predicate.LedgerEntry {
+	return predicate.LedgerEntry(sql.NotPredicates(p))
+}
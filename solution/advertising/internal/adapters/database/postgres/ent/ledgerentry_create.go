@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/ledgerentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LedgerEntryCreate is the builder for creating a LedgerEntry entity.
+type LedgerEntryCreate struct {
+	config
+	mutation *LedgerEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (lec *LedgerEntryCreate) SetAdvertiserID(u uuid.UUID) *LedgerEntryCreate {
+	lec.mutation.SetAdvertiserID(u)
+	return lec
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (lec *LedgerEntryCreate) SetCampaignID(u uuid.UUID) *LedgerEntryCreate {
+	lec.mutation.SetCampaignID(u)
+	return lec
+}
+
+// SetCampaignTitle sets the "campaign_title" field.
+func (lec *LedgerEntryCreate) SetCampaignTitle(s string) *LedgerEntryCreate {
+	lec.mutation.SetCampaignTitle(s)
+	return lec
+}
+
+// SetNillableCampaignTitle sets the "campaign_title" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableCampaignTitle(s *string) *LedgerEntryCreate {
+	if s != nil {
+		lec.SetCampaignTitle(*s)
+	}
+	return lec
+}
+
+// SetDay sets the "day" field.
+func (lec *LedgerEntryCreate) SetDay(i int) *LedgerEntryCreate {
+	lec.mutation.SetDay(i)
+	return lec
+}
+
+// SetKind sets the "kind" field.
+func (lec *LedgerEntryCreate) SetKind(l ledgerentry.Kind) *LedgerEntryCreate {
+	lec.mutation.SetKind(l)
+	return lec
+}
+
+// SetQuantity sets the "quantity" field.
+func (lec *LedgerEntryCreate) SetQuantity(i int64) *LedgerEntryCreate {
+	lec.mutation.SetQuantity(i)
+	return lec
+}
+
+// SetAmount sets the "amount" field.
+func (lec *LedgerEntryCreate) SetAmount(f float64) *LedgerEntryCreate {
+	lec.mutation.SetAmount(f)
+	return lec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lec *LedgerEntryCreate) SetCreatedAt(t time.Time) *LedgerEntryCreate {
+	lec.mutation.SetCreatedAt(t)
+	return lec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableCreatedAt(t *time.Time) *LedgerEntryCreate {
+	if t != nil {
+		lec.SetCreatedAt(*t)
+	}
+	return lec
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (lec *LedgerEntryCreate) Mutation() *LedgerEntryMutation {
+	return lec.mutation
+}
+
+// Save creates the LedgerEntry in the database.
+func (lec *LedgerEntryCreate) Save(ctx context.Context) (*LedgerEntry, error) {
+	lec.defaults()
+	return withHooks(ctx, lec.sqlSave, lec.mutation, lec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lec *LedgerEntryCreate) SaveX(ctx context.Context) *LedgerEntry {
+	v, err := lec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lec *LedgerEntryCreate) Exec(ctx context.Context) error {
+	_, err := lec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lec *LedgerEntryCreate) ExecX(ctx context.Context) {
+	if err := lec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lec *LedgerEntryCreate) defaults() {
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		v := ledgerentry.DefaultCreatedAt()
+		lec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lec *LedgerEntryCreate) check() error {
+	if _, ok := lec.mutation.AdvertiserID(); !ok {
+		return &ValidationError{Name: "advertiser_id", err: errors.New(`ent: missing required field "LedgerEntry.advertiser_id"`)}
+	}
+	if _, ok := lec.mutation.CampaignID(); !ok {
+		return &ValidationError{Name: "campaign_id", err: errors.New(`ent: missing required field "LedgerEntry.campaign_id"`)}
+	}
+	if _, ok := lec.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "LedgerEntry.day"`)}
+	}
+	if _, ok := lec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "LedgerEntry.kind"`)}
+	}
+	if v, ok := lec.mutation.Kind(); ok {
+		if err := ledgerentry.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LedgerEntry.kind": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "LedgerEntry.quantity"`)}
+	}
+	if _, ok := lec.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "LedgerEntry.amount"`)}
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LedgerEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (lec *LedgerEntryCreate) sqlSave(ctx context.Context) (*LedgerEntry, error) {
+	if err := lec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lec.mutation.id = &_node.ID
+	lec.mutation.done = true
+	return _node, nil
+}
+
+func (lec *LedgerEntryCreate) createSpec() (*LedgerEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerEntry{config: lec.config}
+		_spec = sqlgraph.NewCreateSpec(ledgerentry.Table, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = lec.conflict
+	if value, ok := lec.mutation.AdvertiserID(); ok {
+		_spec.SetField(ledgerentry.FieldAdvertiserID, field.TypeUUID, value)
+		_node.AdvertiserID = value
+	}
+	if value, ok := lec.mutation.CampaignID(); ok {
+		_spec.SetField(ledgerentry.FieldCampaignID, field.TypeUUID, value)
+		_node.CampaignID = value
+	}
+	if value, ok := lec.mutation.CampaignTitle(); ok {
+		_spec.SetField(ledgerentry.FieldCampaignTitle, field.TypeString, value)
+		_node.CampaignTitle = value
+	}
+	if value, ok := lec.mutation.Day(); ok {
+		_spec.SetField(ledgerentry.FieldDay, field.TypeInt, value)
+		_node.Day = value
+	}
+	if value, ok := lec.mutation.Kind(); ok {
+		_spec.SetField(ledgerentry.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := lec.mutation.Quantity(); ok {
+		_spec.SetField(ledgerentry.FieldQuantity, field.TypeInt64, value)
+		_node.Quantity = value
+	}
+	if value, ok := lec.mutation.Amount(); ok {
+		_spec.SetField(ledgerentry.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := lec.mutation.CreatedAt(); ok {
+		_spec.SetField(ledgerentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerEntry.Create().
+//		SetAdvertiserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerEntryUpsert) {
+//			SetAdvertiserID(v+v).
+//		}).
+//		Exec(ctx)
+func (lec *LedgerEntryCreate) OnConflict(opts ...sql.ConflictOption) *LedgerEntryUpsertOne {
+	lec.conflict = opts
+	return &LedgerEntryUpsertOne{
+		create: lec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lec *LedgerEntryCreate) OnConflictColumns(columns ...string) *LedgerEntryUpsertOne {
+	lec.conflict = append(lec.conflict, sql.ConflictColumns(columns...))
+	return &LedgerEntryUpsertOne{
+		create: lec,
+	}
+}
+
+type (
+	// LedgerEntryUpsertOne is the builder for "upsert"-ing
+	//  one LedgerEntry node.
+	LedgerEntryUpsertOne struct {
+		create *LedgerEntryCreate
+	}
+
+	// LedgerEntryUpsert is the "OnConflict" setter.
+	LedgerEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertOne) UpdateNewValues() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.AdvertiserID(); exists {
+			s.SetIgnore(ledgerentry.FieldAdvertiserID)
+		}
+		if _, exists := u.create.mutation.CampaignID(); exists {
+			s.SetIgnore(ledgerentry.FieldCampaignID)
+		}
+		if _, exists := u.create.mutation.CampaignTitle(); exists {
+			s.SetIgnore(ledgerentry.FieldCampaignTitle)
+		}
+		if _, exists := u.create.mutation.Day(); exists {
+			s.SetIgnore(ledgerentry.FieldDay)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(ledgerentry.FieldKind)
+		}
+		if _, exists := u.create.mutation.Quantity(); exists {
+			s.SetIgnore(ledgerentry.FieldQuantity)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(ledgerentry.FieldAmount)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ledgerentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerEntryUpsertOne) Ignore() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerEntryUpsertOne) DoNothing() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerEntryCreate.OnConflict
+// documentation for more info.
+func (u *LedgerEntryUpsertOne) Update(set func(*LedgerEntryUpsert)) *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LedgerEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerEntryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerEntryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerEntryCreateBulk is the builder for creating many LedgerEntry entities in bulk.
+type LedgerEntryCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LedgerEntry entities in the database.
+func (lecb *LedgerEntryCreateBulk) Save(ctx context.Context) ([]*LedgerEntry, error) {
+	if lecb.err != nil {
+		return nil, lecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lecb.builders))
+	nodes := make([]*LedgerEntry, len(lecb.builders))
+	mutators := make([]Mutator, len(lecb.builders))
+	for i := range lecb.builders {
+		func(i int, root context.Context) {
+			builder := lecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) SaveX(ctx context.Context) []*LedgerEntry {
+	v, err := lecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lecb *LedgerEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := lecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := lecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerEntryUpsert) {
+//			SetAdvertiserID(v+v).
+//		}).
+//		Exec(ctx)
+func (lecb *LedgerEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerEntryUpsertBulk {
+	lecb.conflict = opts
+	return &LedgerEntryUpsertBulk{
+		create: lecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lecb *LedgerEntryCreateBulk) OnConflictColumns(columns ...string) *LedgerEntryUpsertBulk {
+	lecb.conflict = append(lecb.conflict, sql.ConflictColumns(columns...))
+	return &LedgerEntryUpsertBulk{
+		create: lecb,
+	}
+}
+
+// LedgerEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of LedgerEntry nodes.
+type LedgerEntryUpsertBulk struct {
+	create *LedgerEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertBulk) UpdateNewValues() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.AdvertiserID(); exists {
+				s.SetIgnore(ledgerentry.FieldAdvertiserID)
+			}
+			if _, exists := b.mutation.CampaignID(); exists {
+				s.SetIgnore(ledgerentry.FieldCampaignID)
+			}
+			if _, exists := b.mutation.CampaignTitle(); exists {
+				s.SetIgnore(ledgerentry.FieldCampaignTitle)
+			}
+			if _, exists := b.mutation.Day(); exists {
+				s.SetIgnore(ledgerentry.FieldDay)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(ledgerentry.FieldKind)
+			}
+			if _, exists := b.mutation.Quantity(); exists {
+				s.SetIgnore(ledgerentry.FieldQuantity)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(ledgerentry.FieldAmount)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ledgerentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertBulk) Ignore() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerEntryUpsertBulk) DoNothing() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerEntryUpsertBulk) Update(set func(*LedgerEntryUpsert)) *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LedgerEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LedgerEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
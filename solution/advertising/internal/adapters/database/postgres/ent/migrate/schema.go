@@ -8,10 +8,35 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"ADMIN", "MODERATOR", "ADVERTISER", "CLIENT"}},
+		{Name: "advertiser_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_advertiser_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[5]},
+			},
+		},
+	}
 	// AdvertisersColumns holds the columns for the "advertisers" table.
 	AdvertisersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "balance", Type: field.TypeFloat64, Default: 0},
 	}
 	// AdvertisersTable holds the schema information for the "advertisers" table.
 	AdvertisersTable = &schema.Table{
@@ -19,6 +44,41 @@ var (
 		Columns:    AdvertisersColumns,
 		PrimaryKey: []*schema.Column{AdvertisersColumns[0]},
 	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor_key_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor_name", Type: field.TypeString, Nullable: true},
+		{Name: "actor_role", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "entity", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeString},
+		{Name: "diff", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_entity_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[5], AuditEntriesColumns[6]},
+			},
+			{
+				Name:    "auditentry_actor_key_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[1]},
+			},
+			{
+				Name:    "auditentry_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[8]},
+			},
+		},
+	}
 	// CampaignsColumns holds the columns for the "campaigns" table.
 	CampaignsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -27,12 +87,32 @@ var (
 		{Name: "clicks_limit", Type: field.TypeInt},
 		{Name: "cost_per_impression", Type: field.TypeFloat64},
 		{Name: "cost_per_click", Type: field.TypeFloat64},
+		{Name: "cost_per_action", Type: field.TypeFloat64, Default: 0},
+		{Name: "daily_budget", Type: field.TypeFloat64, Nullable: true},
+		{Name: "total_budget", Type: field.TypeFloat64, Nullable: true},
+		{Name: "spent", Type: field.TypeFloat64, Default: 0},
 		{Name: "ad_title", Type: field.TypeString},
 		{Name: "ad_text", Type: field.TypeString},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "image_hash", Type: field.TypeInt64, Nullable: true},
 		{Name: "start_date", Type: field.TypeInt},
 		{Name: "end_date", Type: field.TypeInt},
 		{Name: "moderated", Type: field.TypeBool},
+		{Name: "moderation_status", Type: field.TypeEnum, Enums: []string{"PENDING", "APPROVED", "REJECTED"}, Default: "PENDING"},
+		{Name: "rejection_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"PROHIBITED_CONTENT", "MISLEADING_CLAIMS", "INAPPROPRIATE_LANGUAGE", "LOW_QUALITY_CREATIVE", "TARGETING_VIOLATION", "OTHER"}},
+		{Name: "moderation_comment", Type: field.TypeString, Nullable: true},
+		{Name: "approved_ad_title", Type: field.TypeString, Nullable: true},
+		{Name: "approved_ad_text", Type: field.TypeString, Nullable: true},
+		{Name: "approved_image_url", Type: field.TypeString, Nullable: true},
+		{Name: "ai_category", Type: field.TypeString, Nullable: true},
+		{Name: "ai_violations", Type: field.TypeJSON, Nullable: true},
+		{Name: "ai_confidence", Type: field.TypeFloat64, Nullable: true},
+		{Name: "risk_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "ai_reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ai_review_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "ai_review_retry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// CampaignsTable holds the schema information for the "campaigns" table.
 	CampaignsTable = &schema.Table{
@@ -43,7 +123,78 @@ var (
 			{
 				Name:    "campaign_start_date_end_date",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[9], CampaignsColumns[10]},
+				Columns: []*schema.Column{CampaignsColumns[14], CampaignsColumns[15]},
+			},
+		},
+	}
+	// CampaignDailySpendsColumns holds the columns for the "campaign_daily_spends" table.
+	CampaignDailySpendsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "campaign_id", Type: field.TypeUUID},
+		{Name: "advertiser_id", Type: field.TypeUUID},
+		{Name: "day", Type: field.TypeInt},
+		{Name: "amount", Type: field.TypeFloat64, Default: 0},
+	}
+	// CampaignDailySpendsTable holds the schema information for the "campaign_daily_spends" table.
+	CampaignDailySpendsTable = &schema.Table{
+		Name:       "campaign_daily_spends",
+		Columns:    CampaignDailySpendsColumns,
+		PrimaryKey: []*schema.Column{CampaignDailySpendsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "campaigndailyspend_campaign_id_day",
+				Unique:  true,
+				Columns: []*schema.Column{CampaignDailySpendsColumns[1], CampaignDailySpendsColumns[3]},
+			},
+			{
+				Name:    "campaigndailyspend_advertiser_id_day",
+				Unique:  false,
+				Columns: []*schema.Column{CampaignDailySpendsColumns[2], CampaignDailySpendsColumns[3]},
+			},
+		},
+	}
+	// ClientErasuresColumns holds the columns for the "client_erasures" table.
+	ClientErasuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "client_id", Type: field.TypeUUID, Unique: true},
+		{Name: "ml_scores", Type: field.TypeInt, Default: 0},
+		{Name: "cached_ads", Type: field.TypeInt, Default: 0},
+		{Name: "requested_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ClientErasuresTable holds the schema information for the "client_erasures" table.
+	ClientErasuresTable = &schema.Table{
+		Name:       "client_erasures",
+		Columns:    ClientErasuresColumns,
+		PrimaryKey: []*schema.Column{ClientErasuresColumns[0]},
+	}
+	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
+	LedgerEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "advertiser_id", Type: field.TypeUUID},
+		{Name: "campaign_id", Type: field.TypeUUID},
+		{Name: "campaign_title", Type: field.TypeString, Nullable: true},
+		{Name: "day", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"IMPRESSIONS", "CLICKS", "CONVERSIONS"}},
+		{Name: "quantity", Type: field.TypeInt64},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LedgerEntriesTable holds the schema information for the "ledger_entries" table.
+	LedgerEntriesTable = &schema.Table{
+		Name:       "ledger_entries",
+		Columns:    LedgerEntriesColumns,
+		PrimaryKey: []*schema.Column{LedgerEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerentry_campaign_id_day_kind",
+				Unique:  true,
+				Columns: []*schema.Column{LedgerEntriesColumns[2], LedgerEntriesColumns[4], LedgerEntriesColumns[5]},
+			},
+			{
+				Name:    "ledgerentry_advertiser_id_day",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[1], LedgerEntriesColumns[4]},
 			},
 		},
 	}
@@ -81,6 +232,31 @@ var (
 			},
 		},
 	}
+	// ModerationDecisionsColumns holds the columns for the "moderation_decisions" table.
+	ModerationDecisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "campaign_id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"SUBMITTED", "APPROVED", "REJECTED", "RESUBMITTED"}},
+		{Name: "reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"PROHIBITED_CONTENT", "MISLEADING_CLAIMS", "INAPPROPRIATE_LANGUAGE", "LOW_QUALITY_CREATIVE", "TARGETING_VIOLATION", "OTHER"}},
+		{Name: "comment", Type: field.TypeString, Nullable: true},
+		{Name: "moderator", Type: field.TypeString, Nullable: true},
+		{Name: "image_hash", Type: field.TypeInt64, Nullable: true},
+		{Name: "day", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ModerationDecisionsTable holds the schema information for the "moderation_decisions" table.
+	ModerationDecisionsTable = &schema.Table{
+		Name:       "moderation_decisions",
+		Columns:    ModerationDecisionsColumns,
+		PrimaryKey: []*schema.Column{ModerationDecisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationdecision_campaign_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationDecisionsColumns[1], ModerationDecisionsColumns[8]},
+			},
+		},
+	}
 	// TargetingsColumns holds the columns for the "targetings" table.
 	TargetingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -116,6 +292,28 @@ var (
 			},
 		},
 	}
+	// TelegramAccountsColumns holds the columns for the "telegram_accounts" table.
+	TelegramAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "telegram_id", Type: field.TypeInt64, Unique: true},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "advertiser_id", Type: field.TypeUUID},
+		{Name: "api_key_id", Type: field.TypeUUID},
+		{Name: "linked_at", Type: field.TypeTime},
+	}
+	// TelegramAccountsTable holds the schema information for the "telegram_accounts" table.
+	TelegramAccountsTable = &schema.Table{
+		Name:       "telegram_accounts",
+		Columns:    TelegramAccountsColumns,
+		PrimaryKey: []*schema.Column{TelegramAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "telegramaccount_advertiser_id",
+				Unique:  false,
+				Columns: []*schema.Column{TelegramAccountsColumns[3]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -132,10 +330,17 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		AdvertisersTable,
+		AuditEntriesTable,
 		CampaignsTable,
+		CampaignDailySpendsTable,
+		ClientErasuresTable,
+		LedgerEntriesTable,
 		MlScoresTable,
+		ModerationDecisionsTable,
 		TargetingsTable,
+		TelegramAccountsTable,
 		UsersTable,
 	}
 )
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ModerationDecision is the model entity for the ModerationDecision schema.
+type ModerationDecision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CampaignID holds the value of the "campaign_id" field.
+	CampaignID uuid.UUID `json:"campaign_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action moderationdecision.Action `json:"action,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *moderationdecision.Reason `json:"reason,omitempty"`
+	// Comment holds the value of the "comment" field.
+	Comment *string `json:"comment,omitempty"`
+	// Moderator holds the value of the "moderator" field.
+	Moderator *string `json:"moderator,omitempty"`
+	// ImageHash holds the value of the "image_hash" field.
+	ImageHash *int64 `json:"image_hash,omitempty"`
+	// Day holds the value of the "day" field.
+	Day int `json:"day,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationDecision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationdecision.FieldID, moderationdecision.FieldImageHash, moderationdecision.FieldDay:
+			values[i] = new(sql.NullInt64)
+		case moderationdecision.FieldAction, moderationdecision.FieldReason, moderationdecision.FieldComment, moderationdecision.FieldModerator:
+			values[i] = new(sql.NullString)
+		case moderationdecision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case moderationdecision.FieldCampaignID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationDecision fields.
+func (md *ModerationDecision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationdecision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			md.ID = int(value.Int64)
+		case moderationdecision.FieldCampaignID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_id", values[i])
+			} else if value != nil {
+				md.CampaignID = *value
+			}
+		case moderationdecision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				md.Action = moderationdecision.Action(value.String)
+			}
+		case moderationdecision.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				md.Reason = new(moderationdecision.Reason)
+				*md.Reason = moderationdecision.Reason(value.String)
+			}
+		case moderationdecision.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				md.Comment = new(string)
+				*md.Comment = value.String
+			}
+		case moderationdecision.FieldModerator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderator", values[i])
+			} else if value.Valid {
+				md.Moderator = new(string)
+				*md.Moderator = value.String
+			}
+		case moderationdecision.FieldImageHash:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field image_hash", values[i])
+			} else if value.Valid {
+				md.ImageHash = new(int64)
+				*md.ImageHash = value.Int64
+			}
+		case moderationdecision.FieldDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				md.Day = int(value.Int64)
+			}
+		case moderationdecision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				md.CreatedAt = value.Time
+			}
+		default:
+			md.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationDecision.
+// This includes values selected through modifiers, order, etc.
+func (md *ModerationDecision) Value(name string) (ent.Value, error) {
+	return md.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ModerationDecision.
+// Note that you need to call ModerationDecision.Unwrap() before calling this method if this ModerationDecision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (md *ModerationDecision) Update() *ModerationDecisionUpdateOne {
+	return NewModerationDecisionClient(md.config).UpdateOne(md)
+}
+
+// Unwrap unwraps the ModerationDecision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (md *ModerationDecision) Unwrap() *ModerationDecision {
+	_tx, ok := md.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModerationDecision is not a transactional entity")
+	}
+	md.config.driver = _tx.drv
+	return md
+}
+
+// String implements the fmt.Stringer.
+func (md *ModerationDecision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationDecision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", md.ID))
+	builder.WriteString("campaign_id=")
+	builder.WriteString(fmt.Sprintf("%v", md.CampaignID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", md.Action))
+	builder.WriteString(", ")
+	if v := md.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := md.Comment; v != nil {
+		builder.WriteString("comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := md.Moderator; v != nil {
+		builder.WriteString("moderator=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := md.ImageHash; v != nil {
+		builder.WriteString("image_hash=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(fmt.Sprintf("%v", md.Day))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(md.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationDecisions is a parsable slice of ModerationDecision.
+type ModerationDecisions []*ModerationDecision
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationdecision
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the moderationdecision type in the database.
+	Label = "moderation_decision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCampaignID holds the string denoting the campaign_id field in the database.
+	FieldCampaignID = "campaign_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldComment holds the string denoting the comment field in the database.
+	FieldComment = "comment"
+	// FieldModerator holds the string denoting the moderator field in the database.
+	FieldModerator = "moderator"
+	// FieldImageHash holds the string denoting the image_hash field in the database.
+	FieldImageHash = "image_hash"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the moderationdecision in the database.
+	Table = "moderation_decisions"
+)
+
+// Columns holds all SQL columns for moderationdecision fields.
+var Columns = []string{
+	FieldID,
+	FieldCampaignID,
+	FieldAction,
+	FieldReason,
+	FieldComment,
+	FieldModerator,
+	FieldImageHash,
+	FieldDay,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionSUBMITTED   Action = "SUBMITTED"
+	ActionAPPROVED    Action = "APPROVED"
+	ActionREJECTED    Action = "REJECTED"
+	ActionRESUBMITTED Action = "RESUBMITTED"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionSUBMITTED, ActionAPPROVED, ActionREJECTED, ActionRESUBMITTED:
+		return nil
+	default:
+		return fmt.Errorf("moderationdecision: invalid enum value for action field: %q", a)
+	}
+}
+
+// Reason defines the type for the "reason" enum field.
+type Reason string
+
+// Reason values.
+const (
+	ReasonPROHIBITED_CONTENT     Reason = "PROHIBITED_CONTENT"
+	ReasonMISLEADING_CLAIMS      Reason = "MISLEADING_CLAIMS"
+	ReasonINAPPROPRIATE_LANGUAGE Reason = "INAPPROPRIATE_LANGUAGE"
+	ReasonLOW_QUALITY_CREATIVE   Reason = "LOW_QUALITY_CREATIVE"
+	ReasonTARGETING_VIOLATION    Reason = "TARGETING_VIOLATION"
+	ReasonOTHER                  Reason = "OTHER"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ReasonValidator is a validator for the "reason" field enum values. It is called by the builders before save.
+func ReasonValidator(r Reason) error {
+	switch r {
+	case ReasonPROHIBITED_CONTENT, ReasonMISLEADING_CLAIMS, ReasonINAPPROPRIATE_LANGUAGE, ReasonLOW_QUALITY_CREATIVE, ReasonTARGETING_VIOLATION, ReasonOTHER:
+		return nil
+	default:
+		return fmt.Errorf("moderationdecision: invalid enum value for reason field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the ModerationDecision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCampaignID orders the results by the campaign_id field.
+func ByCampaignID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaignID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByComment orders the results by the comment field.
+func ByComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComment, opts...).ToFunc()
+}
+
+// ByModerator orders the results by the moderator field.
+func ByModerator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerator, opts...).ToFunc()
+}
+
+// ByImageHash orders the results by the image_hash field.
+func ByImageHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageHash, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationdecision
+
+import (
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLTE(FieldID, id))
+}
+
+// CampaignID applies equality check predicate on the "campaign_id" field. It's identical to CampaignIDEQ.
+func CampaignID(v uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// Comment applies equality check predicate on the "comment" field. It's identical to CommentEQ.
+func Comment(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldComment, v))
+}
+
+// Moderator applies equality check predicate on the "moderator" field. It's identical to ModeratorEQ.
+func Moderator(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldModerator, v))
+}
+
+// ImageHash applies equality check predicate on the "image_hash" field. It's identical to ImageHashEQ.
+func ImageHash(v int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldImageHash, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldDay, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CampaignIDEQ applies the EQ predicate on the "campaign_id" field.
+func CampaignIDEQ(v uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// CampaignIDNEQ applies the NEQ predicate on the "campaign_id" field.
+func CampaignIDNEQ(v uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldCampaignID, v))
+}
+
+// CampaignIDIn applies the In predicate on the "campaign_id" field.
+func CampaignIDIn(vs ...uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDNotIn applies the NotIn predicate on the "campaign_id" field.
+func CampaignIDNotIn(vs ...uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDGT applies the GT predicate on the "campaign_id" field.
+func CampaignIDGT(v uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGT(FieldCampaignID, v))
+}
+
+// CampaignIDGTE applies the GTE predicate on the "campaign_id" field.
+func CampaignIDGTE(v uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGTE(FieldCampaignID, v))
+}
+
+// CampaignIDLT applies the LT predicate on the "campaign_id" field.
+func CampaignIDLT(v uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLT(FieldCampaignID, v))
+}
+
+// CampaignIDLTE applies the LTE predicate on the "campaign_id" field.
+func CampaignIDLTE(v uuid.UUID) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLTE(FieldCampaignID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotNull(FieldReason))
+}
+
+// CommentEQ applies the EQ predicate on the "comment" field.
+func CommentEQ(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldComment, v))
+}
+
+// CommentNEQ applies the NEQ predicate on the "comment" field.
+func CommentNEQ(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldComment, v))
+}
+
+// CommentIn applies the In predicate on the "comment" field.
+func CommentIn(vs ...string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldComment, vs...))
+}
+
+// CommentNotIn applies the NotIn predicate on the "comment" field.
+func CommentNotIn(vs ...string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldComment, vs...))
+}
+
+// CommentGT applies the GT predicate on the "comment" field.
+func CommentGT(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGT(FieldComment, v))
+}
+
+// CommentGTE applies the GTE predicate on the "comment" field.
+func CommentGTE(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGTE(FieldComment, v))
+}
+
+// CommentLT applies the LT predicate on the "comment" field.
+func CommentLT(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLT(FieldComment, v))
+}
+
+// CommentLTE applies the LTE predicate on the "comment" field.
+func CommentLTE(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLTE(FieldComment, v))
+}
+
+// CommentContains applies the Contains predicate on the "comment" field.
+func CommentContains(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldContains(FieldComment, v))
+}
+
+// CommentHasPrefix applies the HasPrefix predicate on the "comment" field.
+func CommentHasPrefix(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldHasPrefix(FieldComment, v))
+}
+
+// CommentHasSuffix applies the HasSuffix predicate on the "comment" field.
+func CommentHasSuffix(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldHasSuffix(FieldComment, v))
+}
+
+// CommentIsNil applies the IsNil predicate on the "comment" field.
+func CommentIsNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIsNull(FieldComment))
+}
+
+// CommentNotNil applies the NotNil predicate on the "comment" field.
+func CommentNotNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotNull(FieldComment))
+}
+
+// CommentEqualFold applies the EqualFold predicate on the "comment" field.
+func CommentEqualFold(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEqualFold(FieldComment, v))
+}
+
+// CommentContainsFold applies the ContainsFold predicate on the "comment" field.
+func CommentContainsFold(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldContainsFold(FieldComment, v))
+}
+
+// ModeratorEQ applies the EQ predicate on the "moderator" field.
+func ModeratorEQ(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldModerator, v))
+}
+
+// ModeratorNEQ applies the NEQ predicate on the "moderator" field.
+func ModeratorNEQ(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldModerator, v))
+}
+
+// ModeratorIn applies the In predicate on the "moderator" field.
+func ModeratorIn(vs ...string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldModerator, vs...))
+}
+
+// ModeratorNotIn applies the NotIn predicate on the "moderator" field.
+func ModeratorNotIn(vs ...string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldModerator, vs...))
+}
+
+// ModeratorGT applies the GT predicate on the "moderator" field.
+func ModeratorGT(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGT(FieldModerator, v))
+}
+
+// ModeratorGTE applies the GTE predicate on the "moderator" field.
+func ModeratorGTE(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGTE(FieldModerator, v))
+}
+
+// ModeratorLT applies the LT predicate on the "moderator" field.
+func ModeratorLT(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLT(FieldModerator, v))
+}
+
+// ModeratorLTE applies the LTE predicate on the "moderator" field.
+func ModeratorLTE(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLTE(FieldModerator, v))
+}
+
+// ModeratorContains applies the Contains predicate on the "moderator" field.
+func ModeratorContains(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldContains(FieldModerator, v))
+}
+
+// ModeratorHasPrefix applies the HasPrefix predicate on the "moderator" field.
+func ModeratorHasPrefix(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldHasPrefix(FieldModerator, v))
+}
+
+// ModeratorHasSuffix applies the HasSuffix predicate on the "moderator" field.
+func ModeratorHasSuffix(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldHasSuffix(FieldModerator, v))
+}
+
+// ModeratorIsNil applies the IsNil predicate on the "moderator" field.
+func ModeratorIsNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIsNull(FieldModerator))
+}
+
+// ModeratorNotNil applies the NotNil predicate on the "moderator" field.
+func ModeratorNotNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotNull(FieldModerator))
+}
+
+// ModeratorEqualFold applies the EqualFold predicate on the "moderator" field.
+func ModeratorEqualFold(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEqualFold(FieldModerator, v))
+}
+
+// ModeratorContainsFold applies the ContainsFold predicate on the "moderator" field.
+func ModeratorContainsFold(v string) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldContainsFold(FieldModerator, v))
+}
+
+// ImageHashEQ applies the EQ predicate on the "image_hash" field.
+func ImageHashEQ(v int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldImageHash, v))
+}
+
+// ImageHashNEQ applies the NEQ predicate on the "image_hash" field.
+func ImageHashNEQ(v int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldImageHash, v))
+}
+
+// ImageHashIn applies the In predicate on the "image_hash" field.
+func ImageHashIn(vs ...int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldImageHash, vs...))
+}
+
+// ImageHashNotIn applies the NotIn predicate on the "image_hash" field.
+func ImageHashNotIn(vs ...int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldImageHash, vs...))
+}
+
+// ImageHashGT applies the GT predicate on the "image_hash" field.
+func ImageHashGT(v int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGT(FieldImageHash, v))
+}
+
+// ImageHashGTE applies the GTE predicate on the "image_hash" field.
+func ImageHashGTE(v int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGTE(FieldImageHash, v))
+}
+
+// ImageHashLT applies the LT predicate on the "image_hash" field.
+func ImageHashLT(v int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLT(FieldImageHash, v))
+}
+
+// ImageHashLTE applies the LTE predicate on the "image_hash" field.
+func ImageHashLTE(v int64) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLTE(FieldImageHash, v))
+}
+
+// ImageHashIsNil applies the IsNil predicate on the "image_hash" field.
+func ImageHashIsNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIsNull(FieldImageHash))
+}
+
+// ImageHashNotNil applies the NotNil predicate on the "image_hash" field.
+func ImageHashNotNil() predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotNull(FieldImageHash))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v int) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLTE(FieldDay, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModerationDecision) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModerationDecision) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModerationDecision) predicate.ModerationDecision {
+	return predicate.ModerationDecision(sql.NotPredicates(p))
+}
@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ModerationDecisionCreate is the builder for creating a ModerationDecision entity.
+type ModerationDecisionCreate struct {
+	config
+	mutation *ModerationDecisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (mdc *ModerationDecisionCreate) SetCampaignID(u uuid.UUID) *ModerationDecisionCreate {
+	mdc.mutation.SetCampaignID(u)
+	return mdc
+}
+
+// SetAction sets the "action" field.
+func (mdc *ModerationDecisionCreate) SetAction(m moderationdecision.Action) *ModerationDecisionCreate {
+	mdc.mutation.SetAction(m)
+	return mdc
+}
+
+// SetReason sets the "reason" field.
+func (mdc *ModerationDecisionCreate) SetReason(m moderationdecision.Reason) *ModerationDecisionCreate {
+	mdc.mutation.SetReason(m)
+	return mdc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mdc *ModerationDecisionCreate) SetNillableReason(m *moderationdecision.Reason) *ModerationDecisionCreate {
+	if m != nil {
+		mdc.SetReason(*m)
+	}
+	return mdc
+}
+
+// SetComment sets the "comment" field.
+func (mdc *ModerationDecisionCreate) SetComment(s string) *ModerationDecisionCreate {
+	mdc.mutation.SetComment(s)
+	return mdc
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (mdc *ModerationDecisionCreate) SetNillableComment(s *string) *ModerationDecisionCreate {
+	if s != nil {
+		mdc.SetComment(*s)
+	}
+	return mdc
+}
+
+// SetModerator sets the "moderator" field.
+func (mdc *ModerationDecisionCreate) SetModerator(s string) *ModerationDecisionCreate {
+	mdc.mutation.SetModerator(s)
+	return mdc
+}
+
+// SetNillableModerator sets the "moderator" field if the given value is not nil.
+func (mdc *ModerationDecisionCreate) SetNillableModerator(s *string) *ModerationDecisionCreate {
+	if s != nil {
+		mdc.SetModerator(*s)
+	}
+	return mdc
+}
+
+// SetImageHash sets the "image_hash" field.
+func (mdc *ModerationDecisionCreate) SetImageHash(i int64) *ModerationDecisionCreate {
+	mdc.mutation.SetImageHash(i)
+	return mdc
+}
+
+// SetNillableImageHash sets the "image_hash" field if the given value is not nil.
+func (mdc *ModerationDecisionCreate) SetNillableImageHash(i *int64) *ModerationDecisionCreate {
+	if i != nil {
+		mdc.SetImageHash(*i)
+	}
+	return mdc
+}
+
+// SetDay sets the "day" field.
+func (mdc *ModerationDecisionCreate) SetDay(i int) *ModerationDecisionCreate {
+	mdc.mutation.SetDay(i)
+	return mdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mdc *ModerationDecisionCreate) SetCreatedAt(t time.Time) *ModerationDecisionCreate {
+	mdc.mutation.SetCreatedAt(t)
+	return mdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mdc *ModerationDecisionCreate) SetNillableCreatedAt(t *time.Time) *ModerationDecisionCreate {
+	if t != nil {
+		mdc.SetCreatedAt(*t)
+	}
+	return mdc
+}
+
+// Mutation returns the ModerationDecisionMutation object of the builder.
+func (mdc *ModerationDecisionCreate) Mutation() *ModerationDecisionMutation {
+	return mdc.mutation
+}
+
+// Save creates the ModerationDecision in the database.
+func (mdc *ModerationDecisionCreate) Save(ctx context.Context) (*ModerationDecision, error) {
+	mdc.defaults()
+	return withHooks(ctx, mdc.sqlSave, mdc.mutation, mdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mdc *ModerationDecisionCreate) SaveX(ctx context.Context) *ModerationDecision {
+	v, err := mdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mdc *ModerationDecisionCreate) Exec(ctx context.Context) error {
+	_, err := mdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdc *ModerationDecisionCreate) ExecX(ctx context.Context) {
+	if err := mdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mdc *ModerationDecisionCreate) defaults() {
+	if _, ok := mdc.mutation.CreatedAt(); !ok {
+		v := moderationdecision.DefaultCreatedAt()
+		mdc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mdc *ModerationDecisionCreate) check() error {
+	if _, ok := mdc.mutation.CampaignID(); !ok {
+		return &ValidationError{Name: "campaign_id", err: errors.New(`ent: missing required field "ModerationDecision.campaign_id"`)}
+	}
+	if _, ok := mdc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ModerationDecision.action"`)}
+	}
+	if v, ok := mdc.mutation.Action(); ok {
+		if err := moderationdecision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModerationDecision.action": %w`, err)}
+		}
+	}
+	if v, ok := mdc.mutation.Reason(); ok {
+		if err := moderationdecision.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ModerationDecision.reason": %w`, err)}
+		}
+	}
+	if _, ok := mdc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "ModerationDecision.day"`)}
+	}
+	if _, ok := mdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModerationDecision.created_at"`)}
+	}
+	return nil
+}
+
+func (mdc *ModerationDecisionCreate) sqlSave(ctx context.Context) (*ModerationDecision, error) {
+	if err := mdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mdc.mutation.id = &_node.ID
+	mdc.mutation.done = true
+	return _node, nil
+}
+
+func (mdc *ModerationDecisionCreate) createSpec() (*ModerationDecision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationDecision{config: mdc.config}
+		_spec = sqlgraph.NewCreateSpec(moderationdecision.Table, sqlgraph.NewFieldSpec(moderationdecision.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = mdc.conflict
+	if value, ok := mdc.mutation.CampaignID(); ok {
+		_spec.SetField(moderationdecision.FieldCampaignID, field.TypeUUID, value)
+		_node.CampaignID = value
+	}
+	if value, ok := mdc.mutation.Action(); ok {
+		_spec.SetField(moderationdecision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := mdc.mutation.Reason(); ok {
+		_spec.SetField(moderationdecision.FieldReason, field.TypeEnum, value)
+		_node.Reason = &value
+	}
+	if value, ok := mdc.mutation.Comment(); ok {
+		_spec.SetField(moderationdecision.FieldComment, field.TypeString, value)
+		_node.Comment = &value
+	}
+	if value, ok := mdc.mutation.Moderator(); ok {
+		_spec.SetField(moderationdecision.FieldModerator, field.TypeString, value)
+		_node.Moderator = &value
+	}
+	if value, ok := mdc.mutation.ImageHash(); ok {
+		_spec.SetField(moderationdecision.FieldImageHash, field.TypeInt64, value)
+		_node.ImageHash = &value
+	}
+	if value, ok := mdc.mutation.Day(); ok {
+		_spec.SetField(moderationdecision.FieldDay, field.TypeInt, value)
+		_node.Day = value
+	}
+	if value, ok := mdc.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationdecision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationDecision.Create().
+//		SetCampaignID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationDecisionUpsert) {
+//			SetCampaignID(v+v).
+//		}).
+//		Exec(ctx)
+func (mdc *ModerationDecisionCreate) OnConflict(opts ...sql.ConflictOption) *ModerationDecisionUpsertOne {
+	mdc.conflict = opts
+	return &ModerationDecisionUpsertOne{
+		create: mdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationDecision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mdc *ModerationDecisionCreate) OnConflictColumns(columns ...string) *ModerationDecisionUpsertOne {
+	mdc.conflict = append(mdc.conflict, sql.ConflictColumns(columns...))
+	return &ModerationDecisionUpsertOne{
+		create: mdc,
+	}
+}
+
+type (
+	// ModerationDecisionUpsertOne is the builder for "upsert"-ing
+	//  one ModerationDecision node.
+	ModerationDecisionUpsertOne struct {
+		create *ModerationDecisionCreate
+	}
+
+	// ModerationDecisionUpsert is the "OnConflict" setter.
+	ModerationDecisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ModerationDecision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationDecisionUpsertOne) UpdateNewValues() *ModerationDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CampaignID(); exists {
+			s.SetIgnore(moderationdecision.FieldCampaignID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(moderationdecision.FieldAction)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(moderationdecision.FieldReason)
+		}
+		if _, exists := u.create.mutation.Comment(); exists {
+			s.SetIgnore(moderationdecision.FieldComment)
+		}
+		if _, exists := u.create.mutation.Moderator(); exists {
+			s.SetIgnore(moderationdecision.FieldModerator)
+		}
+		if _, exists := u.create.mutation.ImageHash(); exists {
+			s.SetIgnore(moderationdecision.FieldImageHash)
+		}
+		if _, exists := u.create.mutation.Day(); exists {
+			s.SetIgnore(moderationdecision.FieldDay)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationdecision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationDecision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationDecisionUpsertOne) Ignore() *ModerationDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationDecisionUpsertOne) DoNothing() *ModerationDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationDecisionCreate.OnConflict
+// documentation for more info.
+func (u *ModerationDecisionUpsertOne) Update(set func(*ModerationDecisionUpsert)) *ModerationDecisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationDecisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationDecisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationDecisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationDecisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationDecisionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationDecisionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationDecisionCreateBulk is the builder for creating many ModerationDecision entities in bulk.
+type ModerationDecisionCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationDecisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationDecision entities in the database.
+func (mdcb *ModerationDecisionCreateBulk) Save(ctx context.Context) ([]*ModerationDecision, error) {
+	if mdcb.err != nil {
+		return nil, mdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mdcb.builders))
+	nodes := make([]*ModerationDecision, len(mdcb.builders))
+	mutators := make([]Mutator, len(mdcb.builders))
+	for i := range mdcb.builders {
+		func(i int, root context.Context) {
+			builder := mdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationDecisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mdcb *ModerationDecisionCreateBulk) SaveX(ctx context.Context) []*ModerationDecision {
+	v, err := mdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mdcb *ModerationDecisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdcb *ModerationDecisionCreateBulk) ExecX(ctx context.Context) {
+	if err := mdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationDecision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationDecisionUpsert) {
+//			SetCampaignID(v+v).
+//		}).
+//		Exec(ctx)
+func (mdcb *ModerationDecisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationDecisionUpsertBulk {
+	mdcb.conflict = opts
+	return &ModerationDecisionUpsertBulk{
+		create: mdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationDecision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mdcb *ModerationDecisionCreateBulk) OnConflictColumns(columns ...string) *ModerationDecisionUpsertBulk {
+	mdcb.conflict = append(mdcb.conflict, sql.ConflictColumns(columns...))
+	return &ModerationDecisionUpsertBulk{
+		create: mdcb,
+	}
+}
+
+// ModerationDecisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationDecision nodes.
+type ModerationDecisionUpsertBulk struct {
+	create *ModerationDecisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationDecision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationDecisionUpsertBulk) UpdateNewValues() *ModerationDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CampaignID(); exists {
+				s.SetIgnore(moderationdecision.FieldCampaignID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(moderationdecision.FieldAction)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(moderationdecision.FieldReason)
+			}
+			if _, exists := b.mutation.Comment(); exists {
+				s.SetIgnore(moderationdecision.FieldComment)
+			}
+			if _, exists := b.mutation.Moderator(); exists {
+				s.SetIgnore(moderationdecision.FieldModerator)
+			}
+			if _, exists := b.mutation.ImageHash(); exists {
+				s.SetIgnore(moderationdecision.FieldImageHash)
+			}
+			if _, exists := b.mutation.Day(); exists {
+				s.SetIgnore(moderationdecision.FieldDay)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationdecision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationDecision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationDecisionUpsertBulk) Ignore() *ModerationDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationDecisionUpsertBulk) DoNothing() *ModerationDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationDecisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationDecisionUpsertBulk) Update(set func(*ModerationDecisionUpsert)) *ModerationDecisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationDecisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationDecisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationDecisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationDecisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationDecisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationDecisionDelete is the builder for deleting a ModerationDecision entity.
+type ModerationDecisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModerationDecisionMutation
+}
+
+// Where appends a list predicates to the ModerationDecisionDelete builder.
+func (mdd *ModerationDecisionDelete) Where(ps ...predicate.ModerationDecision) *ModerationDecisionDelete {
+	mdd.mutation.Where(ps...)
+	return mdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mdd *ModerationDecisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mdd.sqlExec, mdd.mutation, mdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdd *ModerationDecisionDelete) ExecX(ctx context.Context) int {
+	n, err := mdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mdd *ModerationDecisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(moderationdecision.Table, sqlgraph.NewFieldSpec(moderationdecision.FieldID, field.TypeInt))
+	if ps := mdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mdd.mutation.done = true
+	return affected, err
+}
+
+// ModerationDecisionDeleteOne is the builder for deleting a single ModerationDecision entity.
+type ModerationDecisionDeleteOne struct {
+	mdd *ModerationDecisionDelete
+}
+
+// Where appends a list predicates to the ModerationDecisionDelete builder.
+func (mddo *ModerationDecisionDeleteOne) Where(ps ...predicate.ModerationDecision) *ModerationDecisionDeleteOne {
+	mddo.mdd.mutation.Where(ps...)
+	return mddo
+}
+
+// Exec executes the deletion query.
+func (mddo *ModerationDecisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := mddo.mdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{moderationdecision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mddo *ModerationDecisionDeleteOne) ExecX(ctx context.Context) {
+	if err := mddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationDecisionQuery is the builder for querying ModerationDecision entities.
+type ModerationDecisionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []moderationdecision.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ModerationDecision
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ModerationDecisionQuery builder.
+func (mdq *ModerationDecisionQuery) Where(ps ...predicate.ModerationDecision) *ModerationDecisionQuery {
+	mdq.predicates = append(mdq.predicates, ps...)
+	return mdq
+}
+
+// Limit the number of records to be returned by this query.
+func (mdq *ModerationDecisionQuery) Limit(limit int) *ModerationDecisionQuery {
+	mdq.ctx.Limit = &limit
+	return mdq
+}
+
+// Offset to start from.
+func (mdq *ModerationDecisionQuery) Offset(offset int) *ModerationDecisionQuery {
+	mdq.ctx.Offset = &offset
+	return mdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mdq *ModerationDecisionQuery) Unique(unique bool) *ModerationDecisionQuery {
+	mdq.ctx.Unique = &unique
+	return mdq
+}
+
+// Order specifies how the records should be ordered.
+func (mdq *ModerationDecisionQuery) Order(o ...moderationdecision.OrderOption) *ModerationDecisionQuery {
+	mdq.order = append(mdq.order, o...)
+	return mdq
+}
+
+// First returns the first ModerationDecision entity from the query.
+// Returns a *NotFoundError when no ModerationDecision was found.
+func (mdq *ModerationDecisionQuery) First(ctx context.Context) (*ModerationDecision, error) {
+	nodes, err := mdq.Limit(1).All(setContextOp(ctx, mdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{moderationdecision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) FirstX(ctx context.Context) *ModerationDecision {
+	node, err := mdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ModerationDecision ID from the query.
+// Returns a *NotFoundError when no ModerationDecision ID was found.
+func (mdq *ModerationDecisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mdq.Limit(1).IDs(setContextOp(ctx, mdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{moderationdecision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) FirstIDX(ctx context.Context) int {
+	id, err := mdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ModerationDecision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ModerationDecision entity is found.
+// Returns a *NotFoundError when no ModerationDecision entities are found.
+func (mdq *ModerationDecisionQuery) Only(ctx context.Context) (*ModerationDecision, error) {
+	nodes, err := mdq.Limit(2).All(setContextOp(ctx, mdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{moderationdecision.Label}
+	default:
+		return nil, &NotSingularError{moderationdecision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) OnlyX(ctx context.Context) *ModerationDecision {
+	node, err := mdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ModerationDecision ID in the query.
+// Returns a *NotSingularError when more than one ModerationDecision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mdq *ModerationDecisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mdq.Limit(2).IDs(setContextOp(ctx, mdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{moderationdecision.Label}
+	default:
+		err = &NotSingularError{moderationdecision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ModerationDecisions.
+func (mdq *ModerationDecisionQuery) All(ctx context.Context) ([]*ModerationDecision, error) {
+	ctx = setContextOp(ctx, mdq.ctx, ent.OpQueryAll)
+	if err := mdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ModerationDecision, *ModerationDecisionQuery]()
+	return withInterceptors[[]*ModerationDecision](ctx, mdq, qr, mdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) AllX(ctx context.Context) []*ModerationDecision {
+	nodes, err := mdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ModerationDecision IDs.
+func (mdq *ModerationDecisionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mdq.ctx.Unique == nil && mdq.path != nil {
+		mdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mdq.ctx, ent.OpQueryIDs)
+	if err = mdq.Select(moderationdecision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := mdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mdq *ModerationDecisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mdq.ctx, ent.OpQueryCount)
+	if err := mdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mdq, querierCount[*ModerationDecisionQuery](), mdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) CountX(ctx context.Context) int {
+	count, err := mdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mdq *ModerationDecisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mdq.ctx, ent.OpQueryExist)
+	switch _, err := mdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mdq *ModerationDecisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := mdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ModerationDecisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mdq *ModerationDecisionQuery) Clone() *ModerationDecisionQuery {
+	if mdq == nil {
+		return nil
+	}
+	return &ModerationDecisionQuery{
+		config:     mdq.config,
+		ctx:        mdq.ctx.Clone(),
+		order:      append([]moderationdecision.OrderOption{}, mdq.order...),
+		inters:     append([]Interceptor{}, mdq.inters...),
+		predicates: append([]predicate.ModerationDecision{}, mdq.predicates...),
+		// clone intermediate query.
+		sql:  mdq.sql.Clone(),
+		path: mdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CampaignID uuid.UUID `json:"campaign_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ModerationDecision.Query().
+//		GroupBy(moderationdecision.FieldCampaignID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mdq *ModerationDecisionQuery) GroupBy(field string, fields ...string) *ModerationDecisionGroupBy {
+	mdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ModerationDecisionGroupBy{build: mdq}
+	grbuild.flds = &mdq.ctx.Fields
+	grbuild.label = moderationdecision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CampaignID uuid.UUID `json:"campaign_id,omitempty"`
+//	}
+//
+//	client.ModerationDecision.Query().
+//		Select(moderationdecision.FieldCampaignID).
+//		Scan(ctx, &v)
+func (mdq *ModerationDecisionQuery) Select(fields ...string) *ModerationDecisionSelect {
+	mdq.ctx.Fields = append(mdq.ctx.Fields, fields...)
+	sbuild := &ModerationDecisionSelect{ModerationDecisionQuery: mdq}
+	sbuild.label = moderationdecision.Label
+	sbuild.flds, sbuild.scan = &mdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ModerationDecisionSelect configured with the given aggregations.
+func (mdq *ModerationDecisionQuery) Aggregate(fns ...AggregateFunc) *ModerationDecisionSelect {
+	return mdq.Select().Aggregate(fns...)
+}
+
+func (mdq *ModerationDecisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mdq.ctx.Fields {
+		if !moderationdecision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mdq.path != nil {
+		prev, err := mdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mdq.sql = prev
+	}
+	return nil
+}
+
+func (mdq *ModerationDecisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ModerationDecision, error) {
+	var (
+		nodes = []*ModerationDecision{}
+		_spec = mdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ModerationDecision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ModerationDecision{config: mdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mdq *ModerationDecisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mdq.querySpec()
+	_spec.Node.Columns = mdq.ctx.Fields
+	if len(mdq.ctx.Fields) > 0 {
+		_spec.Unique = mdq.ctx.Unique != nil && *mdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mdq.driver, _spec)
+}
+
+func (mdq *ModerationDecisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(moderationdecision.Table, moderationdecision.Columns, sqlgraph.NewFieldSpec(moderationdecision.FieldID, field.TypeInt))
+	_spec.From = mdq.sql
+	if unique := mdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationdecision.FieldID)
+		for i := range fields {
+			if fields[i] != moderationdecision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mdq *ModerationDecisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mdq.driver.Dialect())
+	t1 := builder.Table(moderationdecision.Table)
+	columns := mdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = moderationdecision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mdq.sql != nil {
+		selector = mdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mdq.ctx.Unique != nil && *mdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mdq.predicates {
+		p(selector)
+	}
+	for _, p := range mdq.order {
+		p(selector)
+	}
+	if offset := mdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ModerationDecisionGroupBy is the group-by builder for ModerationDecision entities.
+type ModerationDecisionGroupBy struct {
+	selector
+	build *ModerationDecisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mdgb *ModerationDecisionGroupBy) Aggregate(fns ...AggregateFunc) *ModerationDecisionGroupBy {
+	mdgb.fns = append(mdgb.fns, fns...)
+	return mdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mdgb *ModerationDecisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModerationDecisionQuery, *ModerationDecisionGroupBy](ctx, mdgb.build, mdgb, mdgb.build.inters, v)
+}
+
+func (mdgb *ModerationDecisionGroupBy) sqlScan(ctx context.Context, root *ModerationDecisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mdgb.fns))
+	for _, fn := range mdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mdgb.flds)+len(mdgb.fns))
+		for _, f := range *mdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ModerationDecisionSelect is the builder for selecting fields of ModerationDecision entities.
+type ModerationDecisionSelect struct {
+	*ModerationDecisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mds *ModerationDecisionSelect) Aggregate(fns ...AggregateFunc) *ModerationDecisionSelect {
+	mds.fns = append(mds.fns, fns...)
+	return mds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mds *ModerationDecisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mds.ctx, ent.OpQuerySelect)
+	if err := mds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModerationDecisionQuery, *ModerationDecisionSelect](ctx, mds.ModerationDecisionQuery, mds, mds.inters, v)
+}
+
+func (mds *ModerationDecisionSelect) sqlScan(ctx context.Context, root *ModerationDecisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mds.fns))
+	for _, fn := range mds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationDecisionUpdate is the builder for updating ModerationDecision entities.
+type ModerationDecisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationDecisionMutation
+}
+
+// Where appends a list predicates to the ModerationDecisionUpdate builder.
+func (mdu *ModerationDecisionUpdate) Where(ps ...predicate.ModerationDecision) *ModerationDecisionUpdate {
+	mdu.mutation.Where(ps...)
+	return mdu
+}
+
+// Mutation returns the ModerationDecisionMutation object of the builder.
+func (mdu *ModerationDecisionUpdate) Mutation() *ModerationDecisionMutation {
+	return mdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mdu *ModerationDecisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mdu.sqlSave, mdu.mutation, mdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mdu *ModerationDecisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mdu *ModerationDecisionUpdate) Exec(ctx context.Context) error {
+	_, err := mdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mdu *ModerationDecisionUpdate) ExecX(ctx context.Context) {
+	if err := mdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mdu *ModerationDecisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationdecision.Table, moderationdecision.Columns, sqlgraph.NewFieldSpec(moderationdecision.FieldID, field.TypeInt))
+	if ps := mdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if mdu.mutation.ReasonCleared() {
+		_spec.ClearField(moderationdecision.FieldReason, field.TypeEnum)
+	}
+	if mdu.mutation.CommentCleared() {
+		_spec.ClearField(moderationdecision.FieldComment, field.TypeString)
+	}
+	if mdu.mutation.ModeratorCleared() {
+		_spec.ClearField(moderationdecision.FieldModerator, field.TypeString)
+	}
+	if mdu.mutation.ImageHashCleared() {
+		_spec.ClearField(moderationdecision.FieldImageHash, field.TypeInt64)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mdu.mutation.done = true
+	return n, nil
+}
+
+// ModerationDecisionUpdateOne is the builder for updating a single ModerationDecision entity.
+type ModerationDecisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationDecisionMutation
+}
+
+// Mutation returns the ModerationDecisionMutation object of the builder.
+func (mduo *ModerationDecisionUpdateOne) Mutation() *ModerationDecisionMutation {
+	return mduo.mutation
+}
+
+// Where appends a list predicates to the ModerationDecisionUpdate builder.
+func (mduo *ModerationDecisionUpdateOne) Where(ps ...predicate.ModerationDecision) *ModerationDecisionUpdateOne {
+	mduo.mutation.Where(ps...)
+	return mduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mduo *ModerationDecisionUpdateOne) Select(field string, fields ...string) *ModerationDecisionUpdateOne {
+	mduo.fields = append([]string{field}, fields...)
+	return mduo
+}
+
+// Save executes the query and returns the updated ModerationDecision entity.
+func (mduo *ModerationDecisionUpdateOne) Save(ctx context.Context) (*ModerationDecision, error) {
+	return withHooks(ctx, mduo.sqlSave, mduo.mutation, mduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mduo *ModerationDecisionUpdateOne) SaveX(ctx context.Context) *ModerationDecision {
+	node, err := mduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mduo *ModerationDecisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mduo *ModerationDecisionUpdateOne) ExecX(ctx context.Context) {
+	if err := mduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mduo *ModerationDecisionUpdateOne) sqlSave(ctx context.Context) (_node *ModerationDecision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationdecision.Table, moderationdecision.Columns, sqlgraph.NewFieldSpec(moderationdecision.FieldID, field.TypeInt))
+	id, ok := mduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModerationDecision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationdecision.FieldID)
+		for _, f := range fields {
+			if !moderationdecision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderationdecision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if mduo.mutation.ReasonCleared() {
+		_spec.ClearField(moderationdecision.FieldReason, field.TypeEnum)
+	}
+	if mduo.mutation.CommentCleared() {
+		_spec.ClearField(moderationdecision.FieldComment, field.TypeString)
+	}
+	if mduo.mutation.ModeratorCleared() {
+		_spec.ClearField(moderationdecision.FieldModerator, field.TypeString)
+	}
+	if mduo.mutation.ImageHashCleared() {
+		_spec.ClearField(moderationdecision.FieldImageHash, field.TypeInt64)
+	}
+	_node = &ModerationDecision{config: mduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mduo.mutation.done = true
+	return _node, nil
+}
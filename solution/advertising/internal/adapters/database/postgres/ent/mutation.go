@@ -7,12 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"nlypage-final/internal/adapters/database/postgres/ent/advertiser"
+	"nlypage-final/internal/adapters/database/postgres/ent/apikey"
+	"nlypage-final/internal/adapters/database/postgres/ent/auditentry"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/ledgerentry"
 	"nlypage-final/internal/adapters/database/postgres/ent/mlscore"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
 	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"nlypage-final/internal/adapters/database/postgres/ent/schema"
 	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
 	"nlypage-final/internal/adapters/database/postgres/ent/user"
+	"nlypage-final/pkg/jsondiff"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -28,37 +38,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAdvertiser = "Advertiser"
-	TypeCampaign   = "Campaign"
-	TypeMlScore    = "MlScore"
-	TypeTargeting  = "Targeting"
-	TypeUser       = "User"
+	TypeAPIKey             = "APIKey"
+	TypeAdvertiser         = "Advertiser"
+	TypeAuditEntry         = "AuditEntry"
+	TypeCampaign           = "Campaign"
+	TypeCampaignDailySpend = "CampaignDailySpend"
+	TypeClientErasure      = "ClientErasure"
+	TypeLedgerEntry        = "LedgerEntry"
+	TypeMlScore            = "MlScore"
+	TypeModerationDecision = "ModerationDecision"
+	TypeTargeting          = "Targeting"
+	TypeTelegramAccount    = "TelegramAccount"
+	TypeUser               = "User"
 )
 
-// AdvertiserMutation represents an operation that mutates the Advertiser nodes in the graph.
-type AdvertiserMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	name          *string
+	key_hash      *string
+	prefix        *string
+	role          *apikey.Role
+	advertiser_id *uuid.UUID
+	created_at    *time.Time
+	revoked_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Advertiser, error)
-	predicates    []predicate.Advertiser
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
 }
 
-var _ ent.Mutation = (*AdvertiserMutation)(nil)
+var _ ent.Mutation = (*APIKeyMutation)(nil)
 
-// advertiserOption allows management of the mutation configuration using functional options.
-type advertiserOption func(*AdvertiserMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
 
-// newAdvertiserMutation creates new mutation for the Advertiser entity.
-func newAdvertiserMutation(c config, op Op, opts ...advertiserOption) *AdvertiserMutation {
-	m := &AdvertiserMutation{
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAdvertiser,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -67,20 +90,20 @@ func newAdvertiserMutation(c config, op Op, opts ...advertiserOption) *Advertise
 	return m
 }
 
-// withAdvertiserID sets the ID field of the mutation.
-func withAdvertiserID(id uuid.UUID) advertiserOption {
-	return func(m *AdvertiserMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id uuid.UUID) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Advertiser
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Advertiser, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Advertiser.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -89,10 +112,10 @@ func withAdvertiserID(id uuid.UUID) advertiserOption {
 	}
 }
 
-// withAdvertiser sets the old Advertiser of the mutation.
-func withAdvertiser(node *Advertiser) advertiserOption {
-	return func(m *AdvertiserMutation) {
-		m.oldValue = func(context.Context) (*Advertiser, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -101,7 +124,7 @@ func withAdvertiser(node *Advertiser) advertiserOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AdvertiserMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -109,7 +132,7 @@ func (m AdvertiserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AdvertiserMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -119,14 +142,14 @@ func (m AdvertiserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Advertiser entities.
-func (m *AdvertiserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AdvertiserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *APIKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -137,7 +160,7 @@ func (m *AdvertiserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AdvertiserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -146,19 +169,19 @@ func (m *AdvertiserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Advertiser.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *AdvertiserMutation) SetName(s string) {
+func (m *APIKeyMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *AdvertiserMutation) Name() (r string, exists bool) {
+func (m *APIKeyMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -166,10 +189,10 @@ func (m *AdvertiserMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Advertiser entity.
-// If the Advertiser object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AdvertiserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -184,19 +207,261 @@ func (m *AdvertiserMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *AdvertiserMutation) ResetName() {
+func (m *APIKeyMutation) ResetName() {
 	m.name = nil
 }
 
-// Where appends a list predicates to the AdvertiserMutation builder.
-func (m *AdvertiserMutation) Where(ps ...predicate.Advertiser) {
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
+}
+
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyHash returns the old "key_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
+	}
+	return oldValue.KeyHash, nil
+}
+
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
+}
+
+// SetRole sets the "role" field.
+func (m *APIKeyMutation) SetRole(a apikey.Role) {
+	m.role = &a
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *APIKeyMutation) Role() (r apikey.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldRole(ctx context.Context) (v apikey.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *APIKeyMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (m *APIKeyMutation) SetAdvertiserID(u uuid.UUID) {
+	m.advertiser_id = &u
+}
+
+// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
+func (m *APIKeyMutation) AdvertiserID() (r uuid.UUID, exists bool) {
+	v := m.advertiser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvertiserID returns the old "advertiser_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldAdvertiserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
+	}
+	return oldValue.AdvertiserID, nil
+}
+
+// ClearAdvertiserID clears the value of the "advertiser_id" field.
+func (m *APIKeyMutation) ClearAdvertiserID() {
+	m.advertiser_id = nil
+	m.clearedFields[apikey.FieldAdvertiserID] = struct{}{}
+}
+
+// AdvertiserIDCleared returns if the "advertiser_id" field was cleared in this mutation.
+func (m *APIKeyMutation) AdvertiserIDCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldAdvertiserID]
+	return ok
+}
+
+// ResetAdvertiserID resets all changes to the "advertiser_id" field.
+func (m *APIKeyMutation) ResetAdvertiserID() {
+	m.advertiser_id = nil
+	delete(m.clearedFields, apikey.FieldAdvertiserID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
+}
+
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AdvertiserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AdvertiserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Advertiser, len(ps))
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -204,27 +469,45 @@ func (m *AdvertiserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AdvertiserMutation) Op() Op {
+func (m *APIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AdvertiserMutation) SetOp(op Op) {
+func (m *APIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Advertiser).
-func (m *AdvertiserMutation) Type() string {
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AdvertiserMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.name != nil {
-		fields = append(fields, advertiser.FieldName)
+		fields = append(fields, apikey.FieldName)
+	}
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
+	}
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
+	}
+	if m.role != nil {
+		fields = append(fields, apikey.FieldRole)
+	}
+	if m.advertiser_id != nil {
+		fields = append(fields, apikey.FieldAdvertiserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -232,10 +515,22 @@ func (m *AdvertiserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AdvertiserMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case advertiser.FieldName:
+	case apikey.FieldName:
 		return m.Name()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldRole:
+		return m.Role()
+	case apikey.FieldAdvertiserID:
+		return m.AdvertiserID()
+	case apikey.FieldCreatedAt:
+		return m.CreatedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -243,172 +538,243 @@ func (m *AdvertiserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AdvertiserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case advertiser.FieldName:
+	case apikey.FieldName:
 		return m.OldName(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldRole:
+		return m.OldRole(ctx)
+	case apikey.FieldAdvertiserID:
+		return m.OldAdvertiserID(ctx)
+	case apikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Advertiser field %s", name)
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AdvertiserMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case advertiser.FieldName:
+	case apikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
+	case apikey.FieldKeyHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyHash(v)
+		return nil
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldRole:
+		v, ok := value.(apikey.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case apikey.FieldAdvertiserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvertiserID(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Advertiser field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AdvertiserMutation) AddedFields() []string {
+func (m *APIKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AdvertiserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AdvertiserMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Advertiser numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AdvertiserMutation) ClearedFields() []string {
-	return nil
+func (m *APIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apikey.FieldAdvertiserID) {
+		fields = append(fields, apikey.FieldAdvertiserID)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AdvertiserMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AdvertiserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Advertiser nullable field %s", name)
+func (m *APIKeyMutation) ClearField(name string) error {
+	switch name {
+	case apikey.FieldAdvertiserID:
+		m.ClearAdvertiserID()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AdvertiserMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case advertiser.FieldName:
+	case apikey.FieldName:
 		m.ResetName()
 		return nil
-	}
-	return fmt.Errorf("unknown Advertiser field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AdvertiserMutation) AddedEdges() []string {
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
+		return nil
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apikey.FieldRole:
+		m.ResetRole()
+		return nil
+	case apikey.FieldAdvertiserID:
+		m.ResetAdvertiserID()
+		return nil
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIKeyMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AdvertiserMutation) AddedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AdvertiserMutation) RemovedEdges() []string {
+func (m *APIKeyMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AdvertiserMutation) RemovedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AdvertiserMutation) ClearedEdges() []string {
+func (m *APIKeyMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AdvertiserMutation) EdgeCleared(name string) bool {
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AdvertiserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Advertiser unique edge %s", name)
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AdvertiserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Advertiser edge %s", name)
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// CampaignMutation represents an operation that mutates the Campaign nodes in the graph.
-type CampaignMutation struct {
+// AdvertiserMutation represents an operation that mutates the Advertiser nodes in the graph.
+type AdvertiserMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	advertiser_id          *uuid.UUID
-	impressions_limit      *int
-	addimpressions_limit   *int
-	clicks_limit           *int
-	addclicks_limit        *int
-	cost_per_impression    *float64
-	addcost_per_impression *float64
-	cost_per_click         *float64
-	addcost_per_click      *float64
-	ad_title               *string
-	ad_text                *string
-	image_url              *string
-	start_date             *int
-	addstart_date          *int
-	end_date               *int
-	addend_date            *int
-	moderated              *bool
-	clearedFields          map[string]struct{}
-	targeting              *int
-	clearedtargeting       bool
-	done                   bool
-	oldValue               func(context.Context) (*Campaign, error)
-	predicates             []predicate.Campaign
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	balance       *float64
+	addbalance    *float64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Advertiser, error)
+	predicates    []predicate.Advertiser
 }
 
-var _ ent.Mutation = (*CampaignMutation)(nil)
+var _ ent.Mutation = (*AdvertiserMutation)(nil)
 
-// campaignOption allows management of the mutation configuration using functional options.
-type campaignOption func(*CampaignMutation)
+// advertiserOption allows management of the mutation configuration using functional options.
+type advertiserOption func(*AdvertiserMutation)
 
-// newCampaignMutation creates new mutation for the Campaign entity.
-func newCampaignMutation(c config, op Op, opts ...campaignOption) *CampaignMutation {
-	m := &CampaignMutation{
+// newAdvertiserMutation creates new mutation for the Advertiser entity.
+func newAdvertiserMutation(c config, op Op, opts ...advertiserOption) *AdvertiserMutation {
+	m := &AdvertiserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCampaign,
+		typ:           TypeAdvertiser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -417,20 +783,20 @@ func newCampaignMutation(c config, op Op, opts ...campaignOption) *CampaignMutat
 	return m
 }
 
-// withCampaignID sets the ID field of the mutation.
-func withCampaignID(id uuid.UUID) campaignOption {
-	return func(m *CampaignMutation) {
+// withAdvertiserID sets the ID field of the mutation.
+func withAdvertiserID(id uuid.UUID) advertiserOption {
+	return func(m *AdvertiserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Campaign
+			value *Advertiser
 		)
-		m.oldValue = func(ctx context.Context) (*Campaign, error) {
+		m.oldValue = func(ctx context.Context) (*Advertiser, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Campaign.Get(ctx, id)
+					value, err = m.Client().Advertiser.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -439,10 +805,10 @@ func withCampaignID(id uuid.UUID) campaignOption {
 	}
 }
 
-// withCampaign sets the old Campaign of the mutation.
-func withCampaign(node *Campaign) campaignOption {
-	return func(m *CampaignMutation) {
-		m.oldValue = func(context.Context) (*Campaign, error) {
+// withAdvertiser sets the old Advertiser of the mutation.
+func withAdvertiser(node *Advertiser) advertiserOption {
+	return func(m *AdvertiserMutation) {
+		m.oldValue = func(context.Context) (*Advertiser, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -451,7 +817,7 @@ func withCampaign(node *Campaign) campaignOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CampaignMutation) Client() *Client {
+func (m AdvertiserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -459,7 +825,7 @@ func (m CampaignMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CampaignMutation) Tx() (*Tx, error) {
+func (m AdvertiserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -469,14 +835,14 @@ func (m CampaignMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Campaign entities.
-func (m *CampaignMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Advertiser entities.
+func (m *AdvertiserMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CampaignMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AdvertiserMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -487,7 +853,7 @@ func (m *CampaignMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CampaignMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AdvertiserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -496,589 +862,7009 @@ func (m *CampaignMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Campaign.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Advertiser.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAdvertiserID sets the "advertiser_id" field.
-func (m *CampaignMutation) SetAdvertiserID(u uuid.UUID) {
-	m.advertiser_id = &u
+// SetName sets the "name" field.
+func (m *AdvertiserMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
-func (m *CampaignMutation) AdvertiserID() (r uuid.UUID, exists bool) {
-	v := m.advertiser_id
+// Name returns the value of the "name" field in the mutation.
+func (m *AdvertiserMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdvertiserID returns the old "advertiser_id" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Advertiser entity.
+// If the Advertiser object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldAdvertiserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AdvertiserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.AdvertiserID, nil
+	return oldValue.Name, nil
 }
 
-// ResetAdvertiserID resets all changes to the "advertiser_id" field.
-func (m *CampaignMutation) ResetAdvertiserID() {
-	m.advertiser_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *AdvertiserMutation) ResetName() {
+	m.name = nil
 }
 
-// SetImpressionsLimit sets the "impressions_limit" field.
-func (m *CampaignMutation) SetImpressionsLimit(i int) {
-	m.impressions_limit = &i
-	m.addimpressions_limit = nil
+// SetBalance sets the "balance" field.
+func (m *AdvertiserMutation) SetBalance(f float64) {
+	m.balance = &f
+	m.addbalance = nil
 }
 
-// ImpressionsLimit returns the value of the "impressions_limit" field in the mutation.
-func (m *CampaignMutation) ImpressionsLimit() (r int, exists bool) {
-	v := m.impressions_limit
+// Balance returns the value of the "balance" field in the mutation.
+func (m *AdvertiserMutation) Balance() (r float64, exists bool) {
+	v := m.balance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImpressionsLimit returns the old "impressions_limit" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldBalance returns the old "balance" field's value of the Advertiser entity.
+// If the Advertiser object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldImpressionsLimit(ctx context.Context) (v int, err error) {
+func (m *AdvertiserMutation) OldBalance(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImpressionsLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImpressionsLimit requires an ID field in the mutation")
+		return v, errors.New("OldBalance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImpressionsLimit: %w", err)
+		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
 	}
-	return oldValue.ImpressionsLimit, nil
+	return oldValue.Balance, nil
 }
 
-// AddImpressionsLimit adds i to the "impressions_limit" field.
-func (m *CampaignMutation) AddImpressionsLimit(i int) {
-	if m.addimpressions_limit != nil {
-		*m.addimpressions_limit += i
+// AddBalance adds f to the "balance" field.
+func (m *AdvertiserMutation) AddBalance(f float64) {
+	if m.addbalance != nil {
+		*m.addbalance += f
 	} else {
-		m.addimpressions_limit = &i
+		m.addbalance = &f
 	}
 }
 
-// AddedImpressionsLimit returns the value that was added to the "impressions_limit" field in this mutation.
-func (m *CampaignMutation) AddedImpressionsLimit() (r int, exists bool) {
-	v := m.addimpressions_limit
+// AddedBalance returns the value that was added to the "balance" field in this mutation.
+func (m *AdvertiserMutation) AddedBalance() (r float64, exists bool) {
+	v := m.addbalance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetImpressionsLimit resets all changes to the "impressions_limit" field.
-func (m *CampaignMutation) ResetImpressionsLimit() {
-	m.impressions_limit = nil
-	m.addimpressions_limit = nil
-}
-
-// SetClicksLimit sets the "clicks_limit" field.
-func (m *CampaignMutation) SetClicksLimit(i int) {
-	m.clicks_limit = &i
-	m.addclicks_limit = nil
+// ResetBalance resets all changes to the "balance" field.
+func (m *AdvertiserMutation) ResetBalance() {
+	m.balance = nil
+	m.addbalance = nil
 }
 
-// ClicksLimit returns the value of the "clicks_limit" field in the mutation.
-func (m *CampaignMutation) ClicksLimit() (r int, exists bool) {
-	v := m.clicks_limit
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the AdvertiserMutation builder.
+func (m *AdvertiserMutation) Where(ps ...predicate.Advertiser) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldClicksLimit returns the old "clicks_limit" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldClicksLimit(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClicksLimit is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClicksLimit requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClicksLimit: %w", err)
+// WhereP appends storage-level predicates to the AdvertiserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AdvertiserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Advertiser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.ClicksLimit, nil
+	m.Where(p...)
 }
 
-// AddClicksLimit adds i to the "clicks_limit" field.
-func (m *CampaignMutation) AddClicksLimit(i int) {
-	if m.addclicks_limit != nil {
-		*m.addclicks_limit += i
-	} else {
-		m.addclicks_limit = &i
-	}
+// Op returns the operation name.
+func (m *AdvertiserMutation) Op() Op {
+	return m.op
 }
 
-// AddedClicksLimit returns the value that was added to the "clicks_limit" field in this mutation.
-func (m *CampaignMutation) AddedClicksLimit() (r int, exists bool) {
-	v := m.addclicks_limit
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *AdvertiserMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetClicksLimit resets all changes to the "clicks_limit" field.
-func (m *CampaignMutation) ResetClicksLimit() {
-	m.clicks_limit = nil
-	m.addclicks_limit = nil
+// Type returns the node type of this mutation (Advertiser).
+func (m *AdvertiserMutation) Type() string {
+	return m.typ
 }
 
-// SetCostPerImpression sets the "cost_per_impression" field.
-func (m *CampaignMutation) SetCostPerImpression(f float64) {
-	m.cost_per_impression = &f
-	m.addcost_per_impression = nil
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AdvertiserMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, advertiser.FieldName)
+	}
+	if m.balance != nil {
+		fields = append(fields, advertiser.FieldBalance)
+	}
+	return fields
 }
 
-// CostPerImpression returns the value of the "cost_per_impression" field in the mutation.
-func (m *CampaignMutation) CostPerImpression() (r float64, exists bool) {
-	v := m.cost_per_impression
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AdvertiserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case advertiser.FieldName:
+		return m.Name()
+	case advertiser.FieldBalance:
+		return m.Balance()
 	}
-	return *v, true
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AdvertiserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case advertiser.FieldName:
+		return m.OldName(ctx)
+	case advertiser.FieldBalance:
+		return m.OldBalance(ctx)
+	}
+	return nil, fmt.Errorf("unknown Advertiser field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AdvertiserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case advertiser.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case advertiser.FieldBalance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBalance(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Advertiser field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AdvertiserMutation) AddedFields() []string {
+	var fields []string
+	if m.addbalance != nil {
+		fields = append(fields, advertiser.FieldBalance)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AdvertiserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case advertiser.FieldBalance:
+		return m.AddedBalance()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AdvertiserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case advertiser.FieldBalance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBalance(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Advertiser numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AdvertiserMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AdvertiserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AdvertiserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Advertiser nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AdvertiserMutation) ResetField(name string) error {
+	switch name {
+	case advertiser.FieldName:
+		m.ResetName()
+		return nil
+	case advertiser.FieldBalance:
+		m.ResetBalance()
+		return nil
+	}
+	return fmt.Errorf("unknown Advertiser field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AdvertiserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AdvertiserMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AdvertiserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AdvertiserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AdvertiserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AdvertiserMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AdvertiserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Advertiser unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AdvertiserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Advertiser edge %s", name)
+}
+
+// AuditEntryMutation represents an operation that mutates the AuditEntry nodes in the graph.
+type AuditEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	actor_key_id  *uuid.UUID
+	actor_name    *string
+	actor_role    *string
+	action        *string
+	entity        *string
+	entity_id     *string
+	diff          *map[string]jsondiff.Change
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditEntry, error)
+	predicates    []predicate.AuditEntry
+}
+
+var _ ent.Mutation = (*AuditEntryMutation)(nil)
+
+// auditentryOption allows management of the mutation configuration using functional options.
+type auditentryOption func(*AuditEntryMutation)
+
+// newAuditEntryMutation creates new mutation for the AuditEntry entity.
+func newAuditEntryMutation(c config, op Op, opts ...auditentryOption) *AuditEntryMutation {
+	m := &AuditEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditEntryID sets the ID field of the mutation.
+func withAuditEntryID(id int) auditentryOption {
+	return func(m *AuditEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditEntry
+		)
+		m.oldValue = func(ctx context.Context) (*AuditEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditEntry sets the old AuditEntry of the mutation.
+func withAuditEntry(node *AuditEntry) auditentryOption {
+	return func(m *AuditEntryMutation) {
+		m.oldValue = func(context.Context) (*AuditEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetActorKeyID sets the "actor_key_id" field.
+func (m *AuditEntryMutation) SetActorKeyID(u uuid.UUID) {
+	m.actor_key_id = &u
+}
+
+// ActorKeyID returns the value of the "actor_key_id" field in the mutation.
+func (m *AuditEntryMutation) ActorKeyID() (r uuid.UUID, exists bool) {
+	v := m.actor_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorKeyID returns the old "actor_key_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldActorKeyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorKeyID: %w", err)
+	}
+	return oldValue.ActorKeyID, nil
+}
+
+// ClearActorKeyID clears the value of the "actor_key_id" field.
+func (m *AuditEntryMutation) ClearActorKeyID() {
+	m.actor_key_id = nil
+	m.clearedFields[auditentry.FieldActorKeyID] = struct{}{}
+}
+
+// ActorKeyIDCleared returns if the "actor_key_id" field was cleared in this mutation.
+func (m *AuditEntryMutation) ActorKeyIDCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldActorKeyID]
+	return ok
+}
+
+// ResetActorKeyID resets all changes to the "actor_key_id" field.
+func (m *AuditEntryMutation) ResetActorKeyID() {
+	m.actor_key_id = nil
+	delete(m.clearedFields, auditentry.FieldActorKeyID)
+}
+
+// SetActorName sets the "actor_name" field.
+func (m *AuditEntryMutation) SetActorName(s string) {
+	m.actor_name = &s
+}
+
+// ActorName returns the value of the "actor_name" field in the mutation.
+func (m *AuditEntryMutation) ActorName() (r string, exists bool) {
+	v := m.actor_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorName returns the old "actor_name" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldActorName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorName: %w", err)
+	}
+	return oldValue.ActorName, nil
+}
+
+// ClearActorName clears the value of the "actor_name" field.
+func (m *AuditEntryMutation) ClearActorName() {
+	m.actor_name = nil
+	m.clearedFields[auditentry.FieldActorName] = struct{}{}
+}
+
+// ActorNameCleared returns if the "actor_name" field was cleared in this mutation.
+func (m *AuditEntryMutation) ActorNameCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldActorName]
+	return ok
+}
+
+// ResetActorName resets all changes to the "actor_name" field.
+func (m *AuditEntryMutation) ResetActorName() {
+	m.actor_name = nil
+	delete(m.clearedFields, auditentry.FieldActorName)
+}
+
+// SetActorRole sets the "actor_role" field.
+func (m *AuditEntryMutation) SetActorRole(s string) {
+	m.actor_role = &s
+}
+
+// ActorRole returns the value of the "actor_role" field in the mutation.
+func (m *AuditEntryMutation) ActorRole() (r string, exists bool) {
+	v := m.actor_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorRole returns the old "actor_role" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldActorRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorRole: %w", err)
+	}
+	return oldValue.ActorRole, nil
+}
+
+// ClearActorRole clears the value of the "actor_role" field.
+func (m *AuditEntryMutation) ClearActorRole() {
+	m.actor_role = nil
+	m.clearedFields[auditentry.FieldActorRole] = struct{}{}
+}
+
+// ActorRoleCleared returns if the "actor_role" field was cleared in this mutation.
+func (m *AuditEntryMutation) ActorRoleCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldActorRole]
+	return ok
+}
+
+// ResetActorRole resets all changes to the "actor_role" field.
+func (m *AuditEntryMutation) ResetActorRole() {
+	m.actor_role = nil
+	delete(m.clearedFields, auditentry.FieldActorRole)
+}
+
+// SetAction sets the "action" field.
+func (m *AuditEntryMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEntryMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEntryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetEntity sets the "entity" field.
+func (m *AuditEntryMutation) SetEntity(s string) {
+	m.entity = &s
+}
+
+// Entity returns the value of the "entity" field in the mutation.
+func (m *AuditEntryMutation) Entity() (r string, exists bool) {
+	v := m.entity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntity returns the old "entity" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldEntity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntity: %w", err)
+	}
+	return oldValue.Entity, nil
+}
+
+// ResetEntity resets all changes to the "entity" field.
+func (m *AuditEntryMutation) ResetEntity() {
+	m.entity = nil
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *AuditEntryMutation) SetEntityID(s string) {
+	m.entity_id = &s
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *AuditEntryMutation) EntityID() (r string, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldEntityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *AuditEntryMutation) ResetEntityID() {
+	m.entity_id = nil
+}
+
+// SetDiff sets the "diff" field.
+func (m *AuditEntryMutation) SetDiff(value map[string]jsondiff.Change) {
+	m.diff = &value
+}
+
+// Diff returns the value of the "diff" field in the mutation.
+func (m *AuditEntryMutation) Diff() (r map[string]jsondiff.Change, exists bool) {
+	v := m.diff
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiff returns the old "diff" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldDiff(ctx context.Context) (v map[string]jsondiff.Change, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff: %w", err)
+	}
+	return oldValue.Diff, nil
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (m *AuditEntryMutation) ClearDiff() {
+	m.diff = nil
+	m.clearedFields[auditentry.FieldDiff] = struct{}{}
+}
+
+// DiffCleared returns if the "diff" field was cleared in this mutation.
+func (m *AuditEntryMutation) DiffCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldDiff]
+	return ok
+}
+
+// ResetDiff resets all changes to the "diff" field.
+func (m *AuditEntryMutation) ResetDiff() {
+	m.diff = nil
+	delete(m.clearedFields, auditentry.FieldDiff)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditEntryMutation builder.
+func (m *AuditEntryMutation) Where(ps ...predicate.AuditEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditEntry).
+func (m *AuditEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEntryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.actor_key_id != nil {
+		fields = append(fields, auditentry.FieldActorKeyID)
+	}
+	if m.actor_name != nil {
+		fields = append(fields, auditentry.FieldActorName)
+	}
+	if m.actor_role != nil {
+		fields = append(fields, auditentry.FieldActorRole)
+	}
+	if m.action != nil {
+		fields = append(fields, auditentry.FieldAction)
+	}
+	if m.entity != nil {
+		fields = append(fields, auditentry.FieldEntity)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, auditentry.FieldEntityID)
+	}
+	if m.diff != nil {
+		fields = append(fields, auditentry.FieldDiff)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditentry.FieldActorKeyID:
+		return m.ActorKeyID()
+	case auditentry.FieldActorName:
+		return m.ActorName()
+	case auditentry.FieldActorRole:
+		return m.ActorRole()
+	case auditentry.FieldAction:
+		return m.Action()
+	case auditentry.FieldEntity:
+		return m.Entity()
+	case auditentry.FieldEntityID:
+		return m.EntityID()
+	case auditentry.FieldDiff:
+		return m.Diff()
+	case auditentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditentry.FieldActorKeyID:
+		return m.OldActorKeyID(ctx)
+	case auditentry.FieldActorName:
+		return m.OldActorName(ctx)
+	case auditentry.FieldActorRole:
+		return m.OldActorRole(ctx)
+	case auditentry.FieldAction:
+		return m.OldAction(ctx)
+	case auditentry.FieldEntity:
+		return m.OldEntity(ctx)
+	case auditentry.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case auditentry.FieldDiff:
+		return m.OldDiff(ctx)
+	case auditentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditentry.FieldActorKeyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorKeyID(v)
+		return nil
+	case auditentry.FieldActorName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorName(v)
+		return nil
+	case auditentry.FieldActorRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorRole(v)
+		return nil
+	case auditentry.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditentry.FieldEntity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntity(v)
+		return nil
+	case auditentry.FieldEntityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case auditentry.FieldDiff:
+		v, ok := value.(map[string]jsondiff.Change)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiff(v)
+		return nil
+	case auditentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEntryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditEntryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuditEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditentry.FieldActorKeyID) {
+		fields = append(fields, auditentry.FieldActorKeyID)
+	}
+	if m.FieldCleared(auditentry.FieldActorName) {
+		fields = append(fields, auditentry.FieldActorName)
+	}
+	if m.FieldCleared(auditentry.FieldActorRole) {
+		fields = append(fields, auditentry.FieldActorRole)
+	}
+	if m.FieldCleared(auditentry.FieldDiff) {
+		fields = append(fields, auditentry.FieldDiff)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditEntryMutation) ClearField(name string) error {
+	switch name {
+	case auditentry.FieldActorKeyID:
+		m.ClearActorKeyID()
+		return nil
+	case auditentry.FieldActorName:
+		m.ClearActorName()
+		return nil
+	case auditentry.FieldActorRole:
+		m.ClearActorRole()
+		return nil
+	case auditentry.FieldDiff:
+		m.ClearDiff()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditEntryMutation) ResetField(name string) error {
+	switch name {
+	case auditentry.FieldActorKeyID:
+		m.ResetActorKeyID()
+		return nil
+	case auditentry.FieldActorName:
+		m.ResetActorName()
+		return nil
+	case auditentry.FieldActorRole:
+		m.ResetActorRole()
+		return nil
+	case auditentry.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditentry.FieldEntity:
+		m.ResetEntity()
+		return nil
+	case auditentry.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case auditentry.FieldDiff:
+		m.ResetDiff()
+		return nil
+	case auditentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEntry edge %s", name)
+}
+
+// CampaignMutation represents an operation that mutates the Campaign nodes in the graph.
+type CampaignMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	advertiser_id          *uuid.UUID
+	impressions_limit      *int
+	addimpressions_limit   *int
+	clicks_limit           *int
+	addclicks_limit        *int
+	cost_per_impression    *float64
+	addcost_per_impression *float64
+	cost_per_click         *float64
+	addcost_per_click      *float64
+	cost_per_action        *float64
+	addcost_per_action     *float64
+	daily_budget           *float64
+	adddaily_budget        *float64
+	total_budget           *float64
+	addtotal_budget        *float64
+	spent                  *float64
+	addspent               *float64
+	ad_title               *string
+	ad_text                *string
+	image_url              *string
+	image_hash             *int64
+	addimage_hash          *int64
+	start_date             *int
+	addstart_date          *int
+	end_date               *int
+	addend_date            *int
+	moderated              *bool
+	moderation_status      *campaign.ModerationStatus
+	rejection_reason       *campaign.RejectionReason
+	moderation_comment     *string
+	approved_ad_title      *string
+	approved_ad_text       *string
+	approved_image_url     *string
+	ai_category            *string
+	ai_violations          *[]schema.AIViolation
+	appendai_violations    []schema.AIViolation
+	ai_confidence          *float64
+	addai_confidence       *float64
+	risk_score             *float64
+	addrisk_score          *float64
+	ai_reviewed_at         *time.Time
+	ai_review_attempts     *int
+	addai_review_attempts  *int
+	ai_review_retry_at     *time.Time
+	paused                 *bool
+	deleted_at             *time.Time
+	clearedFields          map[string]struct{}
+	targeting              *int
+	clearedtargeting       bool
+	done                   bool
+	oldValue               func(context.Context) (*Campaign, error)
+	predicates             []predicate.Campaign
+}
+
+var _ ent.Mutation = (*CampaignMutation)(nil)
+
+// campaignOption allows management of the mutation configuration using functional options.
+type campaignOption func(*CampaignMutation)
+
+// newCampaignMutation creates new mutation for the Campaign entity.
+func newCampaignMutation(c config, op Op, opts ...campaignOption) *CampaignMutation {
+	m := &CampaignMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCampaign,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCampaignID sets the ID field of the mutation.
+func withCampaignID(id uuid.UUID) campaignOption {
+	return func(m *CampaignMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Campaign
+		)
+		m.oldValue = func(ctx context.Context) (*Campaign, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Campaign.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCampaign sets the old Campaign of the mutation.
+func withCampaign(node *Campaign) campaignOption {
+	return func(m *CampaignMutation) {
+		m.oldValue = func(context.Context) (*Campaign, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CampaignMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CampaignMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Campaign entities.
+func (m *CampaignMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CampaignMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CampaignMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Campaign.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (m *CampaignMutation) SetAdvertiserID(u uuid.UUID) {
+	m.advertiser_id = &u
+}
+
+// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
+func (m *CampaignMutation) AdvertiserID() (r uuid.UUID, exists bool) {
+	v := m.advertiser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvertiserID returns the old "advertiser_id" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAdvertiserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
+	}
+	return oldValue.AdvertiserID, nil
+}
+
+// ResetAdvertiserID resets all changes to the "advertiser_id" field.
+func (m *CampaignMutation) ResetAdvertiserID() {
+	m.advertiser_id = nil
+}
+
+// SetImpressionsLimit sets the "impressions_limit" field.
+func (m *CampaignMutation) SetImpressionsLimit(i int) {
+	m.impressions_limit = &i
+	m.addimpressions_limit = nil
+}
+
+// ImpressionsLimit returns the value of the "impressions_limit" field in the mutation.
+func (m *CampaignMutation) ImpressionsLimit() (r int, exists bool) {
+	v := m.impressions_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImpressionsLimit returns the old "impressions_limit" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldImpressionsLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImpressionsLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImpressionsLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImpressionsLimit: %w", err)
+	}
+	return oldValue.ImpressionsLimit, nil
+}
+
+// AddImpressionsLimit adds i to the "impressions_limit" field.
+func (m *CampaignMutation) AddImpressionsLimit(i int) {
+	if m.addimpressions_limit != nil {
+		*m.addimpressions_limit += i
+	} else {
+		m.addimpressions_limit = &i
+	}
+}
+
+// AddedImpressionsLimit returns the value that was added to the "impressions_limit" field in this mutation.
+func (m *CampaignMutation) AddedImpressionsLimit() (r int, exists bool) {
+	v := m.addimpressions_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetImpressionsLimit resets all changes to the "impressions_limit" field.
+func (m *CampaignMutation) ResetImpressionsLimit() {
+	m.impressions_limit = nil
+	m.addimpressions_limit = nil
+}
+
+// SetClicksLimit sets the "clicks_limit" field.
+func (m *CampaignMutation) SetClicksLimit(i int) {
+	m.clicks_limit = &i
+	m.addclicks_limit = nil
+}
+
+// ClicksLimit returns the value of the "clicks_limit" field in the mutation.
+func (m *CampaignMutation) ClicksLimit() (r int, exists bool) {
+	v := m.clicks_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClicksLimit returns the old "clicks_limit" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldClicksLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClicksLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClicksLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClicksLimit: %w", err)
+	}
+	return oldValue.ClicksLimit, nil
+}
+
+// AddClicksLimit adds i to the "clicks_limit" field.
+func (m *CampaignMutation) AddClicksLimit(i int) {
+	if m.addclicks_limit != nil {
+		*m.addclicks_limit += i
+	} else {
+		m.addclicks_limit = &i
+	}
+}
+
+// AddedClicksLimit returns the value that was added to the "clicks_limit" field in this mutation.
+func (m *CampaignMutation) AddedClicksLimit() (r int, exists bool) {
+	v := m.addclicks_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClicksLimit resets all changes to the "clicks_limit" field.
+func (m *CampaignMutation) ResetClicksLimit() {
+	m.clicks_limit = nil
+	m.addclicks_limit = nil
+}
+
+// SetCostPerImpression sets the "cost_per_impression" field.
+func (m *CampaignMutation) SetCostPerImpression(f float64) {
+	m.cost_per_impression = &f
+	m.addcost_per_impression = nil
+}
+
+// CostPerImpression returns the value of the "cost_per_impression" field in the mutation.
+func (m *CampaignMutation) CostPerImpression() (r float64, exists bool) {
+	v := m.cost_per_impression
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
 // OldCostPerImpression returns the old "cost_per_impression" field's value of the Campaign entity.
 // If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldCostPerImpression(ctx context.Context) (v float64, err error) {
+func (m *CampaignMutation) OldCostPerImpression(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostPerImpression is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostPerImpression requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostPerImpression: %w", err)
+	}
+	return oldValue.CostPerImpression, nil
+}
+
+// AddCostPerImpression adds f to the "cost_per_impression" field.
+func (m *CampaignMutation) AddCostPerImpression(f float64) {
+	if m.addcost_per_impression != nil {
+		*m.addcost_per_impression += f
+	} else {
+		m.addcost_per_impression = &f
+	}
+}
+
+// AddedCostPerImpression returns the value that was added to the "cost_per_impression" field in this mutation.
+func (m *CampaignMutation) AddedCostPerImpression() (r float64, exists bool) {
+	v := m.addcost_per_impression
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCostPerImpression resets all changes to the "cost_per_impression" field.
+func (m *CampaignMutation) ResetCostPerImpression() {
+	m.cost_per_impression = nil
+	m.addcost_per_impression = nil
+}
+
+// SetCostPerClick sets the "cost_per_click" field.
+func (m *CampaignMutation) SetCostPerClick(f float64) {
+	m.cost_per_click = &f
+	m.addcost_per_click = nil
+}
+
+// CostPerClick returns the value of the "cost_per_click" field in the mutation.
+func (m *CampaignMutation) CostPerClick() (r float64, exists bool) {
+	v := m.cost_per_click
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCostPerClick returns the old "cost_per_click" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldCostPerClick(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostPerClick is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostPerClick requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostPerClick: %w", err)
+	}
+	return oldValue.CostPerClick, nil
+}
+
+// AddCostPerClick adds f to the "cost_per_click" field.
+func (m *CampaignMutation) AddCostPerClick(f float64) {
+	if m.addcost_per_click != nil {
+		*m.addcost_per_click += f
+	} else {
+		m.addcost_per_click = &f
+	}
+}
+
+// AddedCostPerClick returns the value that was added to the "cost_per_click" field in this mutation.
+func (m *CampaignMutation) AddedCostPerClick() (r float64, exists bool) {
+	v := m.addcost_per_click
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCostPerClick resets all changes to the "cost_per_click" field.
+func (m *CampaignMutation) ResetCostPerClick() {
+	m.cost_per_click = nil
+	m.addcost_per_click = nil
+}
+
+// SetCostPerAction sets the "cost_per_action" field.
+func (m *CampaignMutation) SetCostPerAction(f float64) {
+	m.cost_per_action = &f
+	m.addcost_per_action = nil
+}
+
+// CostPerAction returns the value of the "cost_per_action" field in the mutation.
+func (m *CampaignMutation) CostPerAction() (r float64, exists bool) {
+	v := m.cost_per_action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCostPerAction returns the old "cost_per_action" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldCostPerAction(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostPerAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostPerAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostPerAction: %w", err)
+	}
+	return oldValue.CostPerAction, nil
+}
+
+// AddCostPerAction adds f to the "cost_per_action" field.
+func (m *CampaignMutation) AddCostPerAction(f float64) {
+	if m.addcost_per_action != nil {
+		*m.addcost_per_action += f
+	} else {
+		m.addcost_per_action = &f
+	}
+}
+
+// AddedCostPerAction returns the value that was added to the "cost_per_action" field in this mutation.
+func (m *CampaignMutation) AddedCostPerAction() (r float64, exists bool) {
+	v := m.addcost_per_action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCostPerAction resets all changes to the "cost_per_action" field.
+func (m *CampaignMutation) ResetCostPerAction() {
+	m.cost_per_action = nil
+	m.addcost_per_action = nil
+}
+
+// SetDailyBudget sets the "daily_budget" field.
+func (m *CampaignMutation) SetDailyBudget(f float64) {
+	m.daily_budget = &f
+	m.adddaily_budget = nil
+}
+
+// DailyBudget returns the value of the "daily_budget" field in the mutation.
+func (m *CampaignMutation) DailyBudget() (r float64, exists bool) {
+	v := m.daily_budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDailyBudget returns the old "daily_budget" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldDailyBudget(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDailyBudget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDailyBudget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDailyBudget: %w", err)
+	}
+	return oldValue.DailyBudget, nil
+}
+
+// AddDailyBudget adds f to the "daily_budget" field.
+func (m *CampaignMutation) AddDailyBudget(f float64) {
+	if m.adddaily_budget != nil {
+		*m.adddaily_budget += f
+	} else {
+		m.adddaily_budget = &f
+	}
+}
+
+// AddedDailyBudget returns the value that was added to the "daily_budget" field in this mutation.
+func (m *CampaignMutation) AddedDailyBudget() (r float64, exists bool) {
+	v := m.adddaily_budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDailyBudget clears the value of the "daily_budget" field.
+func (m *CampaignMutation) ClearDailyBudget() {
+	m.daily_budget = nil
+	m.adddaily_budget = nil
+	m.clearedFields[campaign.FieldDailyBudget] = struct{}{}
+}
+
+// DailyBudgetCleared returns if the "daily_budget" field was cleared in this mutation.
+func (m *CampaignMutation) DailyBudgetCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldDailyBudget]
+	return ok
+}
+
+// ResetDailyBudget resets all changes to the "daily_budget" field.
+func (m *CampaignMutation) ResetDailyBudget() {
+	m.daily_budget = nil
+	m.adddaily_budget = nil
+	delete(m.clearedFields, campaign.FieldDailyBudget)
+}
+
+// SetTotalBudget sets the "total_budget" field.
+func (m *CampaignMutation) SetTotalBudget(f float64) {
+	m.total_budget = &f
+	m.addtotal_budget = nil
+}
+
+// TotalBudget returns the value of the "total_budget" field in the mutation.
+func (m *CampaignMutation) TotalBudget() (r float64, exists bool) {
+	v := m.total_budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalBudget returns the old "total_budget" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldTotalBudget(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalBudget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalBudget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalBudget: %w", err)
+	}
+	return oldValue.TotalBudget, nil
+}
+
+// AddTotalBudget adds f to the "total_budget" field.
+func (m *CampaignMutation) AddTotalBudget(f float64) {
+	if m.addtotal_budget != nil {
+		*m.addtotal_budget += f
+	} else {
+		m.addtotal_budget = &f
+	}
+}
+
+// AddedTotalBudget returns the value that was added to the "total_budget" field in this mutation.
+func (m *CampaignMutation) AddedTotalBudget() (r float64, exists bool) {
+	v := m.addtotal_budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalBudget clears the value of the "total_budget" field.
+func (m *CampaignMutation) ClearTotalBudget() {
+	m.total_budget = nil
+	m.addtotal_budget = nil
+	m.clearedFields[campaign.FieldTotalBudget] = struct{}{}
+}
+
+// TotalBudgetCleared returns if the "total_budget" field was cleared in this mutation.
+func (m *CampaignMutation) TotalBudgetCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldTotalBudget]
+	return ok
+}
+
+// ResetTotalBudget resets all changes to the "total_budget" field.
+func (m *CampaignMutation) ResetTotalBudget() {
+	m.total_budget = nil
+	m.addtotal_budget = nil
+	delete(m.clearedFields, campaign.FieldTotalBudget)
+}
+
+// SetSpent sets the "spent" field.
+func (m *CampaignMutation) SetSpent(f float64) {
+	m.spent = &f
+	m.addspent = nil
+}
+
+// Spent returns the value of the "spent" field in the mutation.
+func (m *CampaignMutation) Spent() (r float64, exists bool) {
+	v := m.spent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpent returns the old "spent" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldSpent(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpent: %w", err)
+	}
+	return oldValue.Spent, nil
+}
+
+// AddSpent adds f to the "spent" field.
+func (m *CampaignMutation) AddSpent(f float64) {
+	if m.addspent != nil {
+		*m.addspent += f
+	} else {
+		m.addspent = &f
+	}
+}
+
+// AddedSpent returns the value that was added to the "spent" field in this mutation.
+func (m *CampaignMutation) AddedSpent() (r float64, exists bool) {
+	v := m.addspent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpent resets all changes to the "spent" field.
+func (m *CampaignMutation) ResetSpent() {
+	m.spent = nil
+	m.addspent = nil
+}
+
+// SetAdTitle sets the "ad_title" field.
+func (m *CampaignMutation) SetAdTitle(s string) {
+	m.ad_title = &s
+}
+
+// AdTitle returns the value of the "ad_title" field in the mutation.
+func (m *CampaignMutation) AdTitle() (r string, exists bool) {
+	v := m.ad_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdTitle returns the old "ad_title" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAdTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdTitle: %w", err)
+	}
+	return oldValue.AdTitle, nil
+}
+
+// ResetAdTitle resets all changes to the "ad_title" field.
+func (m *CampaignMutation) ResetAdTitle() {
+	m.ad_title = nil
+}
+
+// SetAdText sets the "ad_text" field.
+func (m *CampaignMutation) SetAdText(s string) {
+	m.ad_text = &s
+}
+
+// AdText returns the value of the "ad_text" field in the mutation.
+func (m *CampaignMutation) AdText() (r string, exists bool) {
+	v := m.ad_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdText returns the old "ad_text" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAdText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdText: %w", err)
+	}
+	return oldValue.AdText, nil
+}
+
+// ResetAdText resets all changes to the "ad_text" field.
+func (m *CampaignMutation) ResetAdText() {
+	m.ad_text = nil
+}
+
+// SetImageURL sets the "image_url" field.
+func (m *CampaignMutation) SetImageURL(s string) {
+	m.image_url = &s
+}
+
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *CampaignMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageURL returns the old "image_url" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldImageURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	}
+	return oldValue.ImageURL, nil
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (m *CampaignMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[campaign.FieldImageURL] = struct{}{}
+}
+
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *CampaignMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldImageURL]
+	return ok
+}
+
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *CampaignMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, campaign.FieldImageURL)
+}
+
+// SetImageHash sets the "image_hash" field.
+func (m *CampaignMutation) SetImageHash(i int64) {
+	m.image_hash = &i
+	m.addimage_hash = nil
+}
+
+// ImageHash returns the value of the "image_hash" field in the mutation.
+func (m *CampaignMutation) ImageHash() (r int64, exists bool) {
+	v := m.image_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageHash returns the old "image_hash" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldImageHash(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageHash: %w", err)
+	}
+	return oldValue.ImageHash, nil
+}
+
+// AddImageHash adds i to the "image_hash" field.
+func (m *CampaignMutation) AddImageHash(i int64) {
+	if m.addimage_hash != nil {
+		*m.addimage_hash += i
+	} else {
+		m.addimage_hash = &i
+	}
+}
+
+// AddedImageHash returns the value that was added to the "image_hash" field in this mutation.
+func (m *CampaignMutation) AddedImageHash() (r int64, exists bool) {
+	v := m.addimage_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearImageHash clears the value of the "image_hash" field.
+func (m *CampaignMutation) ClearImageHash() {
+	m.image_hash = nil
+	m.addimage_hash = nil
+	m.clearedFields[campaign.FieldImageHash] = struct{}{}
+}
+
+// ImageHashCleared returns if the "image_hash" field was cleared in this mutation.
+func (m *CampaignMutation) ImageHashCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldImageHash]
+	return ok
+}
+
+// ResetImageHash resets all changes to the "image_hash" field.
+func (m *CampaignMutation) ResetImageHash() {
+	m.image_hash = nil
+	m.addimage_hash = nil
+	delete(m.clearedFields, campaign.FieldImageHash)
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *CampaignMutation) SetStartDate(i int) {
+	m.start_date = &i
+	m.addstart_date = nil
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *CampaignMutation) StartDate() (r int, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldStartDate(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// AddStartDate adds i to the "start_date" field.
+func (m *CampaignMutation) AddStartDate(i int) {
+	if m.addstart_date != nil {
+		*m.addstart_date += i
+	} else {
+		m.addstart_date = &i
+	}
+}
+
+// AddedStartDate returns the value that was added to the "start_date" field in this mutation.
+func (m *CampaignMutation) AddedStartDate() (r int, exists bool) {
+	v := m.addstart_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *CampaignMutation) ResetStartDate() {
+	m.start_date = nil
+	m.addstart_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *CampaignMutation) SetEndDate(i int) {
+	m.end_date = &i
+	m.addend_date = nil
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *CampaignMutation) EndDate() (r int, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldEndDate(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// AddEndDate adds i to the "end_date" field.
+func (m *CampaignMutation) AddEndDate(i int) {
+	if m.addend_date != nil {
+		*m.addend_date += i
+	} else {
+		m.addend_date = &i
+	}
+}
+
+// AddedEndDate returns the value that was added to the "end_date" field in this mutation.
+func (m *CampaignMutation) AddedEndDate() (r int, exists bool) {
+	v := m.addend_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *CampaignMutation) ResetEndDate() {
+	m.end_date = nil
+	m.addend_date = nil
+}
+
+// SetModerated sets the "moderated" field.
+func (m *CampaignMutation) SetModerated(b bool) {
+	m.moderated = &b
+}
+
+// Moderated returns the value of the "moderated" field in the mutation.
+func (m *CampaignMutation) Moderated() (r bool, exists bool) {
+	v := m.moderated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerated returns the old "moderated" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldModerated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerated: %w", err)
+	}
+	return oldValue.Moderated, nil
+}
+
+// ResetModerated resets all changes to the "moderated" field.
+func (m *CampaignMutation) ResetModerated() {
+	m.moderated = nil
+}
+
+// SetModerationStatus sets the "moderation_status" field.
+func (m *CampaignMutation) SetModerationStatus(cs campaign.ModerationStatus) {
+	m.moderation_status = &cs
+}
+
+// ModerationStatus returns the value of the "moderation_status" field in the mutation.
+func (m *CampaignMutation) ModerationStatus() (r campaign.ModerationStatus, exists bool) {
+	v := m.moderation_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationStatus returns the old "moderation_status" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldModerationStatus(ctx context.Context) (v campaign.ModerationStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationStatus: %w", err)
+	}
+	return oldValue.ModerationStatus, nil
+}
+
+// ResetModerationStatus resets all changes to the "moderation_status" field.
+func (m *CampaignMutation) ResetModerationStatus() {
+	m.moderation_status = nil
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (m *CampaignMutation) SetRejectionReason(cr campaign.RejectionReason) {
+	m.rejection_reason = &cr
+}
+
+// RejectionReason returns the value of the "rejection_reason" field in the mutation.
+func (m *CampaignMutation) RejectionReason() (r campaign.RejectionReason, exists bool) {
+	v := m.rejection_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRejectionReason returns the old "rejection_reason" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldRejectionReason(ctx context.Context) (v *campaign.RejectionReason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRejectionReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRejectionReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRejectionReason: %w", err)
+	}
+	return oldValue.RejectionReason, nil
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (m *CampaignMutation) ClearRejectionReason() {
+	m.rejection_reason = nil
+	m.clearedFields[campaign.FieldRejectionReason] = struct{}{}
+}
+
+// RejectionReasonCleared returns if the "rejection_reason" field was cleared in this mutation.
+func (m *CampaignMutation) RejectionReasonCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldRejectionReason]
+	return ok
+}
+
+// ResetRejectionReason resets all changes to the "rejection_reason" field.
+func (m *CampaignMutation) ResetRejectionReason() {
+	m.rejection_reason = nil
+	delete(m.clearedFields, campaign.FieldRejectionReason)
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (m *CampaignMutation) SetModerationComment(s string) {
+	m.moderation_comment = &s
+}
+
+// ModerationComment returns the value of the "moderation_comment" field in the mutation.
+func (m *CampaignMutation) ModerationComment() (r string, exists bool) {
+	v := m.moderation_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationComment returns the old "moderation_comment" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldModerationComment(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationComment: %w", err)
+	}
+	return oldValue.ModerationComment, nil
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (m *CampaignMutation) ClearModerationComment() {
+	m.moderation_comment = nil
+	m.clearedFields[campaign.FieldModerationComment] = struct{}{}
+}
+
+// ModerationCommentCleared returns if the "moderation_comment" field was cleared in this mutation.
+func (m *CampaignMutation) ModerationCommentCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldModerationComment]
+	return ok
+}
+
+// ResetModerationComment resets all changes to the "moderation_comment" field.
+func (m *CampaignMutation) ResetModerationComment() {
+	m.moderation_comment = nil
+	delete(m.clearedFields, campaign.FieldModerationComment)
+}
+
+// SetApprovedAdTitle sets the "approved_ad_title" field.
+func (m *CampaignMutation) SetApprovedAdTitle(s string) {
+	m.approved_ad_title = &s
+}
+
+// ApprovedAdTitle returns the value of the "approved_ad_title" field in the mutation.
+func (m *CampaignMutation) ApprovedAdTitle() (r string, exists bool) {
+	v := m.approved_ad_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedAdTitle returns the old "approved_ad_title" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldApprovedAdTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedAdTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedAdTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedAdTitle: %w", err)
+	}
+	return oldValue.ApprovedAdTitle, nil
+}
+
+// ClearApprovedAdTitle clears the value of the "approved_ad_title" field.
+func (m *CampaignMutation) ClearApprovedAdTitle() {
+	m.approved_ad_title = nil
+	m.clearedFields[campaign.FieldApprovedAdTitle] = struct{}{}
+}
+
+// ApprovedAdTitleCleared returns if the "approved_ad_title" field was cleared in this mutation.
+func (m *CampaignMutation) ApprovedAdTitleCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldApprovedAdTitle]
+	return ok
+}
+
+// ResetApprovedAdTitle resets all changes to the "approved_ad_title" field.
+func (m *CampaignMutation) ResetApprovedAdTitle() {
+	m.approved_ad_title = nil
+	delete(m.clearedFields, campaign.FieldApprovedAdTitle)
+}
+
+// SetApprovedAdText sets the "approved_ad_text" field.
+func (m *CampaignMutation) SetApprovedAdText(s string) {
+	m.approved_ad_text = &s
+}
+
+// ApprovedAdText returns the value of the "approved_ad_text" field in the mutation.
+func (m *CampaignMutation) ApprovedAdText() (r string, exists bool) {
+	v := m.approved_ad_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedAdText returns the old "approved_ad_text" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldApprovedAdText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedAdText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedAdText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedAdText: %w", err)
+	}
+	return oldValue.ApprovedAdText, nil
+}
+
+// ClearApprovedAdText clears the value of the "approved_ad_text" field.
+func (m *CampaignMutation) ClearApprovedAdText() {
+	m.approved_ad_text = nil
+	m.clearedFields[campaign.FieldApprovedAdText] = struct{}{}
+}
+
+// ApprovedAdTextCleared returns if the "approved_ad_text" field was cleared in this mutation.
+func (m *CampaignMutation) ApprovedAdTextCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldApprovedAdText]
+	return ok
+}
+
+// ResetApprovedAdText resets all changes to the "approved_ad_text" field.
+func (m *CampaignMutation) ResetApprovedAdText() {
+	m.approved_ad_text = nil
+	delete(m.clearedFields, campaign.FieldApprovedAdText)
+}
+
+// SetApprovedImageURL sets the "approved_image_url" field.
+func (m *CampaignMutation) SetApprovedImageURL(s string) {
+	m.approved_image_url = &s
+}
+
+// ApprovedImageURL returns the value of the "approved_image_url" field in the mutation.
+func (m *CampaignMutation) ApprovedImageURL() (r string, exists bool) {
+	v := m.approved_image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedImageURL returns the old "approved_image_url" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldApprovedImageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedImageURL: %w", err)
+	}
+	return oldValue.ApprovedImageURL, nil
+}
+
+// ClearApprovedImageURL clears the value of the "approved_image_url" field.
+func (m *CampaignMutation) ClearApprovedImageURL() {
+	m.approved_image_url = nil
+	m.clearedFields[campaign.FieldApprovedImageURL] = struct{}{}
+}
+
+// ApprovedImageURLCleared returns if the "approved_image_url" field was cleared in this mutation.
+func (m *CampaignMutation) ApprovedImageURLCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldApprovedImageURL]
+	return ok
+}
+
+// ResetApprovedImageURL resets all changes to the "approved_image_url" field.
+func (m *CampaignMutation) ResetApprovedImageURL() {
+	m.approved_image_url = nil
+	delete(m.clearedFields, campaign.FieldApprovedImageURL)
+}
+
+// SetAiCategory sets the "ai_category" field.
+func (m *CampaignMutation) SetAiCategory(s string) {
+	m.ai_category = &s
+}
+
+// AiCategory returns the value of the "ai_category" field in the mutation.
+func (m *CampaignMutation) AiCategory() (r string, exists bool) {
+	v := m.ai_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiCategory returns the old "ai_category" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAiCategory(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiCategory: %w", err)
+	}
+	return oldValue.AiCategory, nil
+}
+
+// ClearAiCategory clears the value of the "ai_category" field.
+func (m *CampaignMutation) ClearAiCategory() {
+	m.ai_category = nil
+	m.clearedFields[campaign.FieldAiCategory] = struct{}{}
+}
+
+// AiCategoryCleared returns if the "ai_category" field was cleared in this mutation.
+func (m *CampaignMutation) AiCategoryCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldAiCategory]
+	return ok
+}
+
+// ResetAiCategory resets all changes to the "ai_category" field.
+func (m *CampaignMutation) ResetAiCategory() {
+	m.ai_category = nil
+	delete(m.clearedFields, campaign.FieldAiCategory)
+}
+
+// SetAiViolations sets the "ai_violations" field.
+func (m *CampaignMutation) SetAiViolations(sv []schema.AIViolation) {
+	m.ai_violations = &sv
+	m.appendai_violations = nil
+}
+
+// AiViolations returns the value of the "ai_violations" field in the mutation.
+func (m *CampaignMutation) AiViolations() (r []schema.AIViolation, exists bool) {
+	v := m.ai_violations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiViolations returns the old "ai_violations" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAiViolations(ctx context.Context) (v []schema.AIViolation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiViolations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiViolations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiViolations: %w", err)
+	}
+	return oldValue.AiViolations, nil
+}
+
+// AppendAiViolations adds sv to the "ai_violations" field.
+func (m *CampaignMutation) AppendAiViolations(sv []schema.AIViolation) {
+	m.appendai_violations = append(m.appendai_violations, sv...)
+}
+
+// AppendedAiViolations returns the list of values that were appended to the "ai_violations" field in this mutation.
+func (m *CampaignMutation) AppendedAiViolations() ([]schema.AIViolation, bool) {
+	if len(m.appendai_violations) == 0 {
+		return nil, false
+	}
+	return m.appendai_violations, true
+}
+
+// ClearAiViolations clears the value of the "ai_violations" field.
+func (m *CampaignMutation) ClearAiViolations() {
+	m.ai_violations = nil
+	m.appendai_violations = nil
+	m.clearedFields[campaign.FieldAiViolations] = struct{}{}
+}
+
+// AiViolationsCleared returns if the "ai_violations" field was cleared in this mutation.
+func (m *CampaignMutation) AiViolationsCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldAiViolations]
+	return ok
+}
+
+// ResetAiViolations resets all changes to the "ai_violations" field.
+func (m *CampaignMutation) ResetAiViolations() {
+	m.ai_violations = nil
+	m.appendai_violations = nil
+	delete(m.clearedFields, campaign.FieldAiViolations)
+}
+
+// SetAiConfidence sets the "ai_confidence" field.
+func (m *CampaignMutation) SetAiConfidence(f float64) {
+	m.ai_confidence = &f
+	m.addai_confidence = nil
+}
+
+// AiConfidence returns the value of the "ai_confidence" field in the mutation.
+func (m *CampaignMutation) AiConfidence() (r float64, exists bool) {
+	v := m.ai_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiConfidence returns the old "ai_confidence" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAiConfidence(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiConfidence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiConfidence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiConfidence: %w", err)
+	}
+	return oldValue.AiConfidence, nil
+}
+
+// AddAiConfidence adds f to the "ai_confidence" field.
+func (m *CampaignMutation) AddAiConfidence(f float64) {
+	if m.addai_confidence != nil {
+		*m.addai_confidence += f
+	} else {
+		m.addai_confidence = &f
+	}
+}
+
+// AddedAiConfidence returns the value that was added to the "ai_confidence" field in this mutation.
+func (m *CampaignMutation) AddedAiConfidence() (r float64, exists bool) {
+	v := m.addai_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAiConfidence clears the value of the "ai_confidence" field.
+func (m *CampaignMutation) ClearAiConfidence() {
+	m.ai_confidence = nil
+	m.addai_confidence = nil
+	m.clearedFields[campaign.FieldAiConfidence] = struct{}{}
+}
+
+// AiConfidenceCleared returns if the "ai_confidence" field was cleared in this mutation.
+func (m *CampaignMutation) AiConfidenceCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldAiConfidence]
+	return ok
+}
+
+// ResetAiConfidence resets all changes to the "ai_confidence" field.
+func (m *CampaignMutation) ResetAiConfidence() {
+	m.ai_confidence = nil
+	m.addai_confidence = nil
+	delete(m.clearedFields, campaign.FieldAiConfidence)
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (m *CampaignMutation) SetRiskScore(f float64) {
+	m.risk_score = &f
+	m.addrisk_score = nil
+}
+
+// RiskScore returns the value of the "risk_score" field in the mutation.
+func (m *CampaignMutation) RiskScore() (r float64, exists bool) {
+	v := m.risk_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRiskScore returns the old "risk_score" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldRiskScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRiskScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRiskScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRiskScore: %w", err)
+	}
+	return oldValue.RiskScore, nil
+}
+
+// AddRiskScore adds f to the "risk_score" field.
+func (m *CampaignMutation) AddRiskScore(f float64) {
+	if m.addrisk_score != nil {
+		*m.addrisk_score += f
+	} else {
+		m.addrisk_score = &f
+	}
+}
+
+// AddedRiskScore returns the value that was added to the "risk_score" field in this mutation.
+func (m *CampaignMutation) AddedRiskScore() (r float64, exists bool) {
+	v := m.addrisk_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRiskScore clears the value of the "risk_score" field.
+func (m *CampaignMutation) ClearRiskScore() {
+	m.risk_score = nil
+	m.addrisk_score = nil
+	m.clearedFields[campaign.FieldRiskScore] = struct{}{}
+}
+
+// RiskScoreCleared returns if the "risk_score" field was cleared in this mutation.
+func (m *CampaignMutation) RiskScoreCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldRiskScore]
+	return ok
+}
+
+// ResetRiskScore resets all changes to the "risk_score" field.
+func (m *CampaignMutation) ResetRiskScore() {
+	m.risk_score = nil
+	m.addrisk_score = nil
+	delete(m.clearedFields, campaign.FieldRiskScore)
+}
+
+// SetAiReviewedAt sets the "ai_reviewed_at" field.
+func (m *CampaignMutation) SetAiReviewedAt(t time.Time) {
+	m.ai_reviewed_at = &t
+}
+
+// AiReviewedAt returns the value of the "ai_reviewed_at" field in the mutation.
+func (m *CampaignMutation) AiReviewedAt() (r time.Time, exists bool) {
+	v := m.ai_reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiReviewedAt returns the old "ai_reviewed_at" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAiReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiReviewedAt: %w", err)
+	}
+	return oldValue.AiReviewedAt, nil
+}
+
+// ClearAiReviewedAt clears the value of the "ai_reviewed_at" field.
+func (m *CampaignMutation) ClearAiReviewedAt() {
+	m.ai_reviewed_at = nil
+	m.clearedFields[campaign.FieldAiReviewedAt] = struct{}{}
+}
+
+// AiReviewedAtCleared returns if the "ai_reviewed_at" field was cleared in this mutation.
+func (m *CampaignMutation) AiReviewedAtCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldAiReviewedAt]
+	return ok
+}
+
+// ResetAiReviewedAt resets all changes to the "ai_reviewed_at" field.
+func (m *CampaignMutation) ResetAiReviewedAt() {
+	m.ai_reviewed_at = nil
+	delete(m.clearedFields, campaign.FieldAiReviewedAt)
+}
+
+// SetAiReviewAttempts sets the "ai_review_attempts" field.
+func (m *CampaignMutation) SetAiReviewAttempts(i int) {
+	m.ai_review_attempts = &i
+	m.addai_review_attempts = nil
+}
+
+// AiReviewAttempts returns the value of the "ai_review_attempts" field in the mutation.
+func (m *CampaignMutation) AiReviewAttempts() (r int, exists bool) {
+	v := m.ai_review_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiReviewAttempts returns the old "ai_review_attempts" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAiReviewAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiReviewAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiReviewAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiReviewAttempts: %w", err)
+	}
+	return oldValue.AiReviewAttempts, nil
+}
+
+// AddAiReviewAttempts adds i to the "ai_review_attempts" field.
+func (m *CampaignMutation) AddAiReviewAttempts(i int) {
+	if m.addai_review_attempts != nil {
+		*m.addai_review_attempts += i
+	} else {
+		m.addai_review_attempts = &i
+	}
+}
+
+// AddedAiReviewAttempts returns the value that was added to the "ai_review_attempts" field in this mutation.
+func (m *CampaignMutation) AddedAiReviewAttempts() (r int, exists bool) {
+	v := m.addai_review_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAiReviewAttempts resets all changes to the "ai_review_attempts" field.
+func (m *CampaignMutation) ResetAiReviewAttempts() {
+	m.ai_review_attempts = nil
+	m.addai_review_attempts = nil
+}
+
+// SetAiReviewRetryAt sets the "ai_review_retry_at" field.
+func (m *CampaignMutation) SetAiReviewRetryAt(t time.Time) {
+	m.ai_review_retry_at = &t
+}
+
+// AiReviewRetryAt returns the value of the "ai_review_retry_at" field in the mutation.
+func (m *CampaignMutation) AiReviewRetryAt() (r time.Time, exists bool) {
+	v := m.ai_review_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiReviewRetryAt returns the old "ai_review_retry_at" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldAiReviewRetryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiReviewRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiReviewRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiReviewRetryAt: %w", err)
+	}
+	return oldValue.AiReviewRetryAt, nil
+}
+
+// ClearAiReviewRetryAt clears the value of the "ai_review_retry_at" field.
+func (m *CampaignMutation) ClearAiReviewRetryAt() {
+	m.ai_review_retry_at = nil
+	m.clearedFields[campaign.FieldAiReviewRetryAt] = struct{}{}
+}
+
+// AiReviewRetryAtCleared returns if the "ai_review_retry_at" field was cleared in this mutation.
+func (m *CampaignMutation) AiReviewRetryAtCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldAiReviewRetryAt]
+	return ok
+}
+
+// ResetAiReviewRetryAt resets all changes to the "ai_review_retry_at" field.
+func (m *CampaignMutation) ResetAiReviewRetryAt() {
+	m.ai_review_retry_at = nil
+	delete(m.clearedFields, campaign.FieldAiReviewRetryAt)
+}
+
+// SetPaused sets the "paused" field.
+func (m *CampaignMutation) SetPaused(b bool) {
+	m.paused = &b
+}
+
+// Paused returns the value of the "paused" field in the mutation.
+func (m *CampaignMutation) Paused() (r bool, exists bool) {
+	v := m.paused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaused returns the old "paused" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaused: %w", err)
+	}
+	return oldValue.Paused, nil
+}
+
+// ResetPaused resets all changes to the "paused" field.
+func (m *CampaignMutation) ResetPaused() {
+	m.paused = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CampaignMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CampaignMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CampaignMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[campaign.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CampaignMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CampaignMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, campaign.FieldDeletedAt)
+}
+
+// SetTargetingID sets the "targeting" edge to the Targeting entity by id.
+func (m *CampaignMutation) SetTargetingID(id int) {
+	m.targeting = &id
+}
+
+// ClearTargeting clears the "targeting" edge to the Targeting entity.
+func (m *CampaignMutation) ClearTargeting() {
+	m.clearedtargeting = true
+}
+
+// TargetingCleared reports if the "targeting" edge to the Targeting entity was cleared.
+func (m *CampaignMutation) TargetingCleared() bool {
+	return m.clearedtargeting
+}
+
+// TargetingID returns the "targeting" edge ID in the mutation.
+func (m *CampaignMutation) TargetingID() (id int, exists bool) {
+	if m.targeting != nil {
+		return *m.targeting, true
+	}
+	return
+}
+
+// TargetingIDs returns the "targeting" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetingID instead. It exists only for internal usage by the builders.
+func (m *CampaignMutation) TargetingIDs() (ids []int) {
+	if id := m.targeting; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTargeting resets all changes to the "targeting" edge.
+func (m *CampaignMutation) ResetTargeting() {
+	m.targeting = nil
+	m.clearedtargeting = false
+}
+
+// Where appends a list predicates to the CampaignMutation builder.
+func (m *CampaignMutation) Where(ps ...predicate.Campaign) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CampaignMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CampaignMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Campaign, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CampaignMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CampaignMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Campaign).
+func (m *CampaignMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CampaignMutation) Fields() []string {
+	fields := make([]string, 0, 31)
+	if m.advertiser_id != nil {
+		fields = append(fields, campaign.FieldAdvertiserID)
+	}
+	if m.impressions_limit != nil {
+		fields = append(fields, campaign.FieldImpressionsLimit)
+	}
+	if m.clicks_limit != nil {
+		fields = append(fields, campaign.FieldClicksLimit)
+	}
+	if m.cost_per_impression != nil {
+		fields = append(fields, campaign.FieldCostPerImpression)
+	}
+	if m.cost_per_click != nil {
+		fields = append(fields, campaign.FieldCostPerClick)
+	}
+	if m.cost_per_action != nil {
+		fields = append(fields, campaign.FieldCostPerAction)
+	}
+	if m.daily_budget != nil {
+		fields = append(fields, campaign.FieldDailyBudget)
+	}
+	if m.total_budget != nil {
+		fields = append(fields, campaign.FieldTotalBudget)
+	}
+	if m.spent != nil {
+		fields = append(fields, campaign.FieldSpent)
+	}
+	if m.ad_title != nil {
+		fields = append(fields, campaign.FieldAdTitle)
+	}
+	if m.ad_text != nil {
+		fields = append(fields, campaign.FieldAdText)
+	}
+	if m.image_url != nil {
+		fields = append(fields, campaign.FieldImageURL)
+	}
+	if m.image_hash != nil {
+		fields = append(fields, campaign.FieldImageHash)
+	}
+	if m.start_date != nil {
+		fields = append(fields, campaign.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, campaign.FieldEndDate)
+	}
+	if m.moderated != nil {
+		fields = append(fields, campaign.FieldModerated)
+	}
+	if m.moderation_status != nil {
+		fields = append(fields, campaign.FieldModerationStatus)
+	}
+	if m.rejection_reason != nil {
+		fields = append(fields, campaign.FieldRejectionReason)
+	}
+	if m.moderation_comment != nil {
+		fields = append(fields, campaign.FieldModerationComment)
+	}
+	if m.approved_ad_title != nil {
+		fields = append(fields, campaign.FieldApprovedAdTitle)
+	}
+	if m.approved_ad_text != nil {
+		fields = append(fields, campaign.FieldApprovedAdText)
+	}
+	if m.approved_image_url != nil {
+		fields = append(fields, campaign.FieldApprovedImageURL)
+	}
+	if m.ai_category != nil {
+		fields = append(fields, campaign.FieldAiCategory)
+	}
+	if m.ai_violations != nil {
+		fields = append(fields, campaign.FieldAiViolations)
+	}
+	if m.ai_confidence != nil {
+		fields = append(fields, campaign.FieldAiConfidence)
+	}
+	if m.risk_score != nil {
+		fields = append(fields, campaign.FieldRiskScore)
+	}
+	if m.ai_reviewed_at != nil {
+		fields = append(fields, campaign.FieldAiReviewedAt)
+	}
+	if m.ai_review_attempts != nil {
+		fields = append(fields, campaign.FieldAiReviewAttempts)
+	}
+	if m.ai_review_retry_at != nil {
+		fields = append(fields, campaign.FieldAiReviewRetryAt)
+	}
+	if m.paused != nil {
+		fields = append(fields, campaign.FieldPaused)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, campaign.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CampaignMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case campaign.FieldAdvertiserID:
+		return m.AdvertiserID()
+	case campaign.FieldImpressionsLimit:
+		return m.ImpressionsLimit()
+	case campaign.FieldClicksLimit:
+		return m.ClicksLimit()
+	case campaign.FieldCostPerImpression:
+		return m.CostPerImpression()
+	case campaign.FieldCostPerClick:
+		return m.CostPerClick()
+	case campaign.FieldCostPerAction:
+		return m.CostPerAction()
+	case campaign.FieldDailyBudget:
+		return m.DailyBudget()
+	case campaign.FieldTotalBudget:
+		return m.TotalBudget()
+	case campaign.FieldSpent:
+		return m.Spent()
+	case campaign.FieldAdTitle:
+		return m.AdTitle()
+	case campaign.FieldAdText:
+		return m.AdText()
+	case campaign.FieldImageURL:
+		return m.ImageURL()
+	case campaign.FieldImageHash:
+		return m.ImageHash()
+	case campaign.FieldStartDate:
+		return m.StartDate()
+	case campaign.FieldEndDate:
+		return m.EndDate()
+	case campaign.FieldModerated:
+		return m.Moderated()
+	case campaign.FieldModerationStatus:
+		return m.ModerationStatus()
+	case campaign.FieldRejectionReason:
+		return m.RejectionReason()
+	case campaign.FieldModerationComment:
+		return m.ModerationComment()
+	case campaign.FieldApprovedAdTitle:
+		return m.ApprovedAdTitle()
+	case campaign.FieldApprovedAdText:
+		return m.ApprovedAdText()
+	case campaign.FieldApprovedImageURL:
+		return m.ApprovedImageURL()
+	case campaign.FieldAiCategory:
+		return m.AiCategory()
+	case campaign.FieldAiViolations:
+		return m.AiViolations()
+	case campaign.FieldAiConfidence:
+		return m.AiConfidence()
+	case campaign.FieldRiskScore:
+		return m.RiskScore()
+	case campaign.FieldAiReviewedAt:
+		return m.AiReviewedAt()
+	case campaign.FieldAiReviewAttempts:
+		return m.AiReviewAttempts()
+	case campaign.FieldAiReviewRetryAt:
+		return m.AiReviewRetryAt()
+	case campaign.FieldPaused:
+		return m.Paused()
+	case campaign.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CampaignMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case campaign.FieldAdvertiserID:
+		return m.OldAdvertiserID(ctx)
+	case campaign.FieldImpressionsLimit:
+		return m.OldImpressionsLimit(ctx)
+	case campaign.FieldClicksLimit:
+		return m.OldClicksLimit(ctx)
+	case campaign.FieldCostPerImpression:
+		return m.OldCostPerImpression(ctx)
+	case campaign.FieldCostPerClick:
+		return m.OldCostPerClick(ctx)
+	case campaign.FieldCostPerAction:
+		return m.OldCostPerAction(ctx)
+	case campaign.FieldDailyBudget:
+		return m.OldDailyBudget(ctx)
+	case campaign.FieldTotalBudget:
+		return m.OldTotalBudget(ctx)
+	case campaign.FieldSpent:
+		return m.OldSpent(ctx)
+	case campaign.FieldAdTitle:
+		return m.OldAdTitle(ctx)
+	case campaign.FieldAdText:
+		return m.OldAdText(ctx)
+	case campaign.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case campaign.FieldImageHash:
+		return m.OldImageHash(ctx)
+	case campaign.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case campaign.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case campaign.FieldModerated:
+		return m.OldModerated(ctx)
+	case campaign.FieldModerationStatus:
+		return m.OldModerationStatus(ctx)
+	case campaign.FieldRejectionReason:
+		return m.OldRejectionReason(ctx)
+	case campaign.FieldModerationComment:
+		return m.OldModerationComment(ctx)
+	case campaign.FieldApprovedAdTitle:
+		return m.OldApprovedAdTitle(ctx)
+	case campaign.FieldApprovedAdText:
+		return m.OldApprovedAdText(ctx)
+	case campaign.FieldApprovedImageURL:
+		return m.OldApprovedImageURL(ctx)
+	case campaign.FieldAiCategory:
+		return m.OldAiCategory(ctx)
+	case campaign.FieldAiViolations:
+		return m.OldAiViolations(ctx)
+	case campaign.FieldAiConfidence:
+		return m.OldAiConfidence(ctx)
+	case campaign.FieldRiskScore:
+		return m.OldRiskScore(ctx)
+	case campaign.FieldAiReviewedAt:
+		return m.OldAiReviewedAt(ctx)
+	case campaign.FieldAiReviewAttempts:
+		return m.OldAiReviewAttempts(ctx)
+	case campaign.FieldAiReviewRetryAt:
+		return m.OldAiReviewRetryAt(ctx)
+	case campaign.FieldPaused:
+		return m.OldPaused(ctx)
+	case campaign.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Campaign field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CampaignMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case campaign.FieldAdvertiserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvertiserID(v)
+		return nil
+	case campaign.FieldImpressionsLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImpressionsLimit(v)
+		return nil
+	case campaign.FieldClicksLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClicksLimit(v)
+		return nil
+	case campaign.FieldCostPerImpression:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostPerImpression(v)
+		return nil
+	case campaign.FieldCostPerClick:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostPerClick(v)
+		return nil
+	case campaign.FieldCostPerAction:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostPerAction(v)
+		return nil
+	case campaign.FieldDailyBudget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyBudget(v)
+		return nil
+	case campaign.FieldTotalBudget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalBudget(v)
+		return nil
+	case campaign.FieldSpent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpent(v)
+		return nil
+	case campaign.FieldAdTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdTitle(v)
+		return nil
+	case campaign.FieldAdText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdText(v)
+		return nil
+	case campaign.FieldImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageURL(v)
+		return nil
+	case campaign.FieldImageHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageHash(v)
+		return nil
+	case campaign.FieldStartDate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case campaign.FieldEndDate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case campaign.FieldModerated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerated(v)
+		return nil
+	case campaign.FieldModerationStatus:
+		v, ok := value.(campaign.ModerationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationStatus(v)
+		return nil
+	case campaign.FieldRejectionReason:
+		v, ok := value.(campaign.RejectionReason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRejectionReason(v)
+		return nil
+	case campaign.FieldModerationComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationComment(v)
+		return nil
+	case campaign.FieldApprovedAdTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedAdTitle(v)
+		return nil
+	case campaign.FieldApprovedAdText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedAdText(v)
+		return nil
+	case campaign.FieldApprovedImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedImageURL(v)
+		return nil
+	case campaign.FieldAiCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiCategory(v)
+		return nil
+	case campaign.FieldAiViolations:
+		v, ok := value.([]schema.AIViolation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiViolations(v)
+		return nil
+	case campaign.FieldAiConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiConfidence(v)
+		return nil
+	case campaign.FieldRiskScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRiskScore(v)
+		return nil
+	case campaign.FieldAiReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiReviewedAt(v)
+		return nil
+	case campaign.FieldAiReviewAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiReviewAttempts(v)
+		return nil
+	case campaign.FieldAiReviewRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiReviewRetryAt(v)
+		return nil
+	case campaign.FieldPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaused(v)
+		return nil
+	case campaign.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CampaignMutation) AddedFields() []string {
+	var fields []string
+	if m.addimpressions_limit != nil {
+		fields = append(fields, campaign.FieldImpressionsLimit)
+	}
+	if m.addclicks_limit != nil {
+		fields = append(fields, campaign.FieldClicksLimit)
+	}
+	if m.addcost_per_impression != nil {
+		fields = append(fields, campaign.FieldCostPerImpression)
+	}
+	if m.addcost_per_click != nil {
+		fields = append(fields, campaign.FieldCostPerClick)
+	}
+	if m.addcost_per_action != nil {
+		fields = append(fields, campaign.FieldCostPerAction)
+	}
+	if m.adddaily_budget != nil {
+		fields = append(fields, campaign.FieldDailyBudget)
+	}
+	if m.addtotal_budget != nil {
+		fields = append(fields, campaign.FieldTotalBudget)
+	}
+	if m.addspent != nil {
+		fields = append(fields, campaign.FieldSpent)
+	}
+	if m.addimage_hash != nil {
+		fields = append(fields, campaign.FieldImageHash)
+	}
+	if m.addstart_date != nil {
+		fields = append(fields, campaign.FieldStartDate)
+	}
+	if m.addend_date != nil {
+		fields = append(fields, campaign.FieldEndDate)
+	}
+	if m.addai_confidence != nil {
+		fields = append(fields, campaign.FieldAiConfidence)
+	}
+	if m.addrisk_score != nil {
+		fields = append(fields, campaign.FieldRiskScore)
+	}
+	if m.addai_review_attempts != nil {
+		fields = append(fields, campaign.FieldAiReviewAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CampaignMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case campaign.FieldImpressionsLimit:
+		return m.AddedImpressionsLimit()
+	case campaign.FieldClicksLimit:
+		return m.AddedClicksLimit()
+	case campaign.FieldCostPerImpression:
+		return m.AddedCostPerImpression()
+	case campaign.FieldCostPerClick:
+		return m.AddedCostPerClick()
+	case campaign.FieldCostPerAction:
+		return m.AddedCostPerAction()
+	case campaign.FieldDailyBudget:
+		return m.AddedDailyBudget()
+	case campaign.FieldTotalBudget:
+		return m.AddedTotalBudget()
+	case campaign.FieldSpent:
+		return m.AddedSpent()
+	case campaign.FieldImageHash:
+		return m.AddedImageHash()
+	case campaign.FieldStartDate:
+		return m.AddedStartDate()
+	case campaign.FieldEndDate:
+		return m.AddedEndDate()
+	case campaign.FieldAiConfidence:
+		return m.AddedAiConfidence()
+	case campaign.FieldRiskScore:
+		return m.AddedRiskScore()
+	case campaign.FieldAiReviewAttempts:
+		return m.AddedAiReviewAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CampaignMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case campaign.FieldImpressionsLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImpressionsLimit(v)
+		return nil
+	case campaign.FieldClicksLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClicksLimit(v)
+		return nil
+	case campaign.FieldCostPerImpression:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostPerImpression(v)
+		return nil
+	case campaign.FieldCostPerClick:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostPerClick(v)
+		return nil
+	case campaign.FieldCostPerAction:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostPerAction(v)
+		return nil
+	case campaign.FieldDailyBudget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDailyBudget(v)
+		return nil
+	case campaign.FieldTotalBudget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalBudget(v)
+		return nil
+	case campaign.FieldSpent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpent(v)
+		return nil
+	case campaign.FieldImageHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImageHash(v)
+		return nil
+	case campaign.FieldStartDate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartDate(v)
+		return nil
+	case campaign.FieldEndDate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndDate(v)
+		return nil
+	case campaign.FieldAiConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAiConfidence(v)
+		return nil
+	case campaign.FieldRiskScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRiskScore(v)
+		return nil
+	case campaign.FieldAiReviewAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAiReviewAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CampaignMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(campaign.FieldDailyBudget) {
+		fields = append(fields, campaign.FieldDailyBudget)
+	}
+	if m.FieldCleared(campaign.FieldTotalBudget) {
+		fields = append(fields, campaign.FieldTotalBudget)
+	}
+	if m.FieldCleared(campaign.FieldImageURL) {
+		fields = append(fields, campaign.FieldImageURL)
+	}
+	if m.FieldCleared(campaign.FieldImageHash) {
+		fields = append(fields, campaign.FieldImageHash)
+	}
+	if m.FieldCleared(campaign.FieldRejectionReason) {
+		fields = append(fields, campaign.FieldRejectionReason)
+	}
+	if m.FieldCleared(campaign.FieldModerationComment) {
+		fields = append(fields, campaign.FieldModerationComment)
+	}
+	if m.FieldCleared(campaign.FieldApprovedAdTitle) {
+		fields = append(fields, campaign.FieldApprovedAdTitle)
+	}
+	if m.FieldCleared(campaign.FieldApprovedAdText) {
+		fields = append(fields, campaign.FieldApprovedAdText)
+	}
+	if m.FieldCleared(campaign.FieldApprovedImageURL) {
+		fields = append(fields, campaign.FieldApprovedImageURL)
+	}
+	if m.FieldCleared(campaign.FieldAiCategory) {
+		fields = append(fields, campaign.FieldAiCategory)
+	}
+	if m.FieldCleared(campaign.FieldAiViolations) {
+		fields = append(fields, campaign.FieldAiViolations)
+	}
+	if m.FieldCleared(campaign.FieldAiConfidence) {
+		fields = append(fields, campaign.FieldAiConfidence)
+	}
+	if m.FieldCleared(campaign.FieldRiskScore) {
+		fields = append(fields, campaign.FieldRiskScore)
+	}
+	if m.FieldCleared(campaign.FieldAiReviewedAt) {
+		fields = append(fields, campaign.FieldAiReviewedAt)
+	}
+	if m.FieldCleared(campaign.FieldAiReviewRetryAt) {
+		fields = append(fields, campaign.FieldAiReviewRetryAt)
+	}
+	if m.FieldCleared(campaign.FieldDeletedAt) {
+		fields = append(fields, campaign.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CampaignMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CampaignMutation) ClearField(name string) error {
+	switch name {
+	case campaign.FieldDailyBudget:
+		m.ClearDailyBudget()
+		return nil
+	case campaign.FieldTotalBudget:
+		m.ClearTotalBudget()
+		return nil
+	case campaign.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	case campaign.FieldImageHash:
+		m.ClearImageHash()
+		return nil
+	case campaign.FieldRejectionReason:
+		m.ClearRejectionReason()
+		return nil
+	case campaign.FieldModerationComment:
+		m.ClearModerationComment()
+		return nil
+	case campaign.FieldApprovedAdTitle:
+		m.ClearApprovedAdTitle()
+		return nil
+	case campaign.FieldApprovedAdText:
+		m.ClearApprovedAdText()
+		return nil
+	case campaign.FieldApprovedImageURL:
+		m.ClearApprovedImageURL()
+		return nil
+	case campaign.FieldAiCategory:
+		m.ClearAiCategory()
+		return nil
+	case campaign.FieldAiViolations:
+		m.ClearAiViolations()
+		return nil
+	case campaign.FieldAiConfidence:
+		m.ClearAiConfidence()
+		return nil
+	case campaign.FieldRiskScore:
+		m.ClearRiskScore()
+		return nil
+	case campaign.FieldAiReviewedAt:
+		m.ClearAiReviewedAt()
+		return nil
+	case campaign.FieldAiReviewRetryAt:
+		m.ClearAiReviewRetryAt()
+		return nil
+	case campaign.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CampaignMutation) ResetField(name string) error {
+	switch name {
+	case campaign.FieldAdvertiserID:
+		m.ResetAdvertiserID()
+		return nil
+	case campaign.FieldImpressionsLimit:
+		m.ResetImpressionsLimit()
+		return nil
+	case campaign.FieldClicksLimit:
+		m.ResetClicksLimit()
+		return nil
+	case campaign.FieldCostPerImpression:
+		m.ResetCostPerImpression()
+		return nil
+	case campaign.FieldCostPerClick:
+		m.ResetCostPerClick()
+		return nil
+	case campaign.FieldCostPerAction:
+		m.ResetCostPerAction()
+		return nil
+	case campaign.FieldDailyBudget:
+		m.ResetDailyBudget()
+		return nil
+	case campaign.FieldTotalBudget:
+		m.ResetTotalBudget()
+		return nil
+	case campaign.FieldSpent:
+		m.ResetSpent()
+		return nil
+	case campaign.FieldAdTitle:
+		m.ResetAdTitle()
+		return nil
+	case campaign.FieldAdText:
+		m.ResetAdText()
+		return nil
+	case campaign.FieldImageURL:
+		m.ResetImageURL()
+		return nil
+	case campaign.FieldImageHash:
+		m.ResetImageHash()
+		return nil
+	case campaign.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case campaign.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case campaign.FieldModerated:
+		m.ResetModerated()
+		return nil
+	case campaign.FieldModerationStatus:
+		m.ResetModerationStatus()
+		return nil
+	case campaign.FieldRejectionReason:
+		m.ResetRejectionReason()
+		return nil
+	case campaign.FieldModerationComment:
+		m.ResetModerationComment()
+		return nil
+	case campaign.FieldApprovedAdTitle:
+		m.ResetApprovedAdTitle()
+		return nil
+	case campaign.FieldApprovedAdText:
+		m.ResetApprovedAdText()
+		return nil
+	case campaign.FieldApprovedImageURL:
+		m.ResetApprovedImageURL()
+		return nil
+	case campaign.FieldAiCategory:
+		m.ResetAiCategory()
+		return nil
+	case campaign.FieldAiViolations:
+		m.ResetAiViolations()
+		return nil
+	case campaign.FieldAiConfidence:
+		m.ResetAiConfidence()
+		return nil
+	case campaign.FieldRiskScore:
+		m.ResetRiskScore()
+		return nil
+	case campaign.FieldAiReviewedAt:
+		m.ResetAiReviewedAt()
+		return nil
+	case campaign.FieldAiReviewAttempts:
+		m.ResetAiReviewAttempts()
+		return nil
+	case campaign.FieldAiReviewRetryAt:
+		m.ResetAiReviewRetryAt()
+		return nil
+	case campaign.FieldPaused:
+		m.ResetPaused()
+		return nil
+	case campaign.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CampaignMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.targeting != nil {
+		edges = append(edges, campaign.EdgeTargeting)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CampaignMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case campaign.EdgeTargeting:
+		if id := m.targeting; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CampaignMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CampaignMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CampaignMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtargeting {
+		edges = append(edges, campaign.EdgeTargeting)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CampaignMutation) EdgeCleared(name string) bool {
+	switch name {
+	case campaign.EdgeTargeting:
+		return m.clearedtargeting
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CampaignMutation) ClearEdge(name string) error {
+	switch name {
+	case campaign.EdgeTargeting:
+		m.ClearTargeting()
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CampaignMutation) ResetEdge(name string) error {
+	switch name {
+	case campaign.EdgeTargeting:
+		m.ResetTargeting()
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign edge %s", name)
+}
+
+// CampaignDailySpendMutation represents an operation that mutates the CampaignDailySpend nodes in the graph.
+type CampaignDailySpendMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	campaign_id   *uuid.UUID
+	advertiser_id *uuid.UUID
+	day           *int
+	addday        *int
+	amount        *float64
+	addamount     *float64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CampaignDailySpend, error)
+	predicates    []predicate.CampaignDailySpend
+}
+
+var _ ent.Mutation = (*CampaignDailySpendMutation)(nil)
+
+// campaigndailyspendOption allows management of the mutation configuration using functional options.
+type campaigndailyspendOption func(*CampaignDailySpendMutation)
+
+// newCampaignDailySpendMutation creates new mutation for the CampaignDailySpend entity.
+func newCampaignDailySpendMutation(c config, op Op, opts ...campaigndailyspendOption) *CampaignDailySpendMutation {
+	m := &CampaignDailySpendMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCampaignDailySpend,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCampaignDailySpendID sets the ID field of the mutation.
+func withCampaignDailySpendID(id int) campaigndailyspendOption {
+	return func(m *CampaignDailySpendMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CampaignDailySpend
+		)
+		m.oldValue = func(ctx context.Context) (*CampaignDailySpend, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CampaignDailySpend.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCampaignDailySpend sets the old CampaignDailySpend of the mutation.
+func withCampaignDailySpend(node *CampaignDailySpend) campaigndailyspendOption {
+	return func(m *CampaignDailySpendMutation) {
+		m.oldValue = func(context.Context) (*CampaignDailySpend, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CampaignDailySpendMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CampaignDailySpendMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CampaignDailySpendMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CampaignDailySpendMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CampaignDailySpend.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (m *CampaignDailySpendMutation) SetCampaignID(u uuid.UUID) {
+	m.campaign_id = &u
+}
+
+// CampaignID returns the value of the "campaign_id" field in the mutation.
+func (m *CampaignDailySpendMutation) CampaignID() (r uuid.UUID, exists bool) {
+	v := m.campaign_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaignID returns the old "campaign_id" field's value of the CampaignDailySpend entity.
+// If the CampaignDailySpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignDailySpendMutation) OldCampaignID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignID: %w", err)
+	}
+	return oldValue.CampaignID, nil
+}
+
+// ResetCampaignID resets all changes to the "campaign_id" field.
+func (m *CampaignDailySpendMutation) ResetCampaignID() {
+	m.campaign_id = nil
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (m *CampaignDailySpendMutation) SetAdvertiserID(u uuid.UUID) {
+	m.advertiser_id = &u
+}
+
+// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
+func (m *CampaignDailySpendMutation) AdvertiserID() (r uuid.UUID, exists bool) {
+	v := m.advertiser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvertiserID returns the old "advertiser_id" field's value of the CampaignDailySpend entity.
+// If the CampaignDailySpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignDailySpendMutation) OldAdvertiserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
+	}
+	return oldValue.AdvertiserID, nil
+}
+
+// ResetAdvertiserID resets all changes to the "advertiser_id" field.
+func (m *CampaignDailySpendMutation) ResetAdvertiserID() {
+	m.advertiser_id = nil
+}
+
+// SetDay sets the "day" field.
+func (m *CampaignDailySpendMutation) SetDay(i int) {
+	m.day = &i
+	m.addday = nil
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *CampaignDailySpendMutation) Day() (r int, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the CampaignDailySpend entity.
+// If the CampaignDailySpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignDailySpendMutation) OldDay(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// AddDay adds i to the "day" field.
+func (m *CampaignDailySpendMutation) AddDay(i int) {
+	if m.addday != nil {
+		*m.addday += i
+	} else {
+		m.addday = &i
+	}
+}
+
+// AddedDay returns the value that was added to the "day" field in this mutation.
+func (m *CampaignDailySpendMutation) AddedDay() (r int, exists bool) {
+	v := m.addday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *CampaignDailySpendMutation) ResetDay() {
+	m.day = nil
+	m.addday = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *CampaignDailySpendMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *CampaignDailySpendMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the CampaignDailySpend entity.
+// If the CampaignDailySpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignDailySpendMutation) OldAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *CampaignDailySpendMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *CampaignDailySpendMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *CampaignDailySpendMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// Where appends a list predicates to the CampaignDailySpendMutation builder.
+func (m *CampaignDailySpendMutation) Where(ps ...predicate.CampaignDailySpend) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CampaignDailySpendMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CampaignDailySpendMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CampaignDailySpend, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CampaignDailySpendMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CampaignDailySpendMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CampaignDailySpend).
+func (m *CampaignDailySpendMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CampaignDailySpendMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.campaign_id != nil {
+		fields = append(fields, campaigndailyspend.FieldCampaignID)
+	}
+	if m.advertiser_id != nil {
+		fields = append(fields, campaigndailyspend.FieldAdvertiserID)
+	}
+	if m.day != nil {
+		fields = append(fields, campaigndailyspend.FieldDay)
+	}
+	if m.amount != nil {
+		fields = append(fields, campaigndailyspend.FieldAmount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CampaignDailySpendMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case campaigndailyspend.FieldCampaignID:
+		return m.CampaignID()
+	case campaigndailyspend.FieldAdvertiserID:
+		return m.AdvertiserID()
+	case campaigndailyspend.FieldDay:
+		return m.Day()
+	case campaigndailyspend.FieldAmount:
+		return m.Amount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CampaignDailySpendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case campaigndailyspend.FieldCampaignID:
+		return m.OldCampaignID(ctx)
+	case campaigndailyspend.FieldAdvertiserID:
+		return m.OldAdvertiserID(ctx)
+	case campaigndailyspend.FieldDay:
+		return m.OldDay(ctx)
+	case campaigndailyspend.FieldAmount:
+		return m.OldAmount(ctx)
+	}
+	return nil, fmt.Errorf("unknown CampaignDailySpend field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CampaignDailySpendMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case campaigndailyspend.FieldCampaignID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaignID(v)
+		return nil
+	case campaigndailyspend.FieldAdvertiserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvertiserID(v)
+		return nil
+	case campaigndailyspend.FieldDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case campaigndailyspend.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CampaignDailySpend field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CampaignDailySpendMutation) AddedFields() []string {
+	var fields []string
+	if m.addday != nil {
+		fields = append(fields, campaigndailyspend.FieldDay)
+	}
+	if m.addamount != nil {
+		fields = append(fields, campaigndailyspend.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CampaignDailySpendMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case campaigndailyspend.FieldDay:
+		return m.AddedDay()
+	case campaigndailyspend.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CampaignDailySpendMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case campaigndailyspend.FieldDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDay(v)
+		return nil
+	case campaigndailyspend.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CampaignDailySpend numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CampaignDailySpendMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CampaignDailySpendMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CampaignDailySpendMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CampaignDailySpend nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CampaignDailySpendMutation) ResetField(name string) error {
+	switch name {
+	case campaigndailyspend.FieldCampaignID:
+		m.ResetCampaignID()
+		return nil
+	case campaigndailyspend.FieldAdvertiserID:
+		m.ResetAdvertiserID()
+		return nil
+	case campaigndailyspend.FieldDay:
+		m.ResetDay()
+		return nil
+	case campaigndailyspend.FieldAmount:
+		m.ResetAmount()
+		return nil
+	}
+	return fmt.Errorf("unknown CampaignDailySpend field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CampaignDailySpendMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CampaignDailySpendMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CampaignDailySpendMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CampaignDailySpendMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CampaignDailySpendMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CampaignDailySpendMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CampaignDailySpendMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CampaignDailySpend unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CampaignDailySpendMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CampaignDailySpend edge %s", name)
+}
+
+// ClientErasureMutation represents an operation that mutates the ClientErasure nodes in the graph.
+type ClientErasureMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	client_id     *uuid.UUID
+	ml_scores     *int
+	addml_scores  *int
+	cached_ads    *int
+	addcached_ads *int
+	requested_at  *time.Time
+	completed_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ClientErasure, error)
+	predicates    []predicate.ClientErasure
+}
+
+var _ ent.Mutation = (*ClientErasureMutation)(nil)
+
+// clienterasureOption allows management of the mutation configuration using functional options.
+type clienterasureOption func(*ClientErasureMutation)
+
+// newClientErasureMutation creates new mutation for the ClientErasure entity.
+func newClientErasureMutation(c config, op Op, opts ...clienterasureOption) *ClientErasureMutation {
+	m := &ClientErasureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeClientErasure,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withClientErasureID sets the ID field of the mutation.
+func withClientErasureID(id int) clienterasureOption {
+	return func(m *ClientErasureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ClientErasure
+		)
+		m.oldValue = func(ctx context.Context) (*ClientErasure, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ClientErasure.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withClientErasure sets the old ClientErasure of the mutation.
+func withClientErasure(node *ClientErasure) clienterasureOption {
+	return func(m *ClientErasureMutation) {
+		m.oldValue = func(context.Context) (*ClientErasure, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ClientErasureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ClientErasureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ClientErasureMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ClientErasureMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ClientErasure.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetClientID sets the "client_id" field.
+func (m *ClientErasureMutation) SetClientID(u uuid.UUID) {
+	m.client_id = &u
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *ClientErasureMutation) ClientID() (r uuid.UUID, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the ClientErasure entity.
+// If the ClientErasure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientErasureMutation) OldClientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *ClientErasureMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetMlScores sets the "ml_scores" field.
+func (m *ClientErasureMutation) SetMlScores(i int) {
+	m.ml_scores = &i
+	m.addml_scores = nil
+}
+
+// MlScores returns the value of the "ml_scores" field in the mutation.
+func (m *ClientErasureMutation) MlScores() (r int, exists bool) {
+	v := m.ml_scores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMlScores returns the old "ml_scores" field's value of the ClientErasure entity.
+// If the ClientErasure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientErasureMutation) OldMlScores(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMlScores is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMlScores requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMlScores: %w", err)
+	}
+	return oldValue.MlScores, nil
+}
+
+// AddMlScores adds i to the "ml_scores" field.
+func (m *ClientErasureMutation) AddMlScores(i int) {
+	if m.addml_scores != nil {
+		*m.addml_scores += i
+	} else {
+		m.addml_scores = &i
+	}
+}
+
+// AddedMlScores returns the value that was added to the "ml_scores" field in this mutation.
+func (m *ClientErasureMutation) AddedMlScores() (r int, exists bool) {
+	v := m.addml_scores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMlScores resets all changes to the "ml_scores" field.
+func (m *ClientErasureMutation) ResetMlScores() {
+	m.ml_scores = nil
+	m.addml_scores = nil
+}
+
+// SetCachedAds sets the "cached_ads" field.
+func (m *ClientErasureMutation) SetCachedAds(i int) {
+	m.cached_ads = &i
+	m.addcached_ads = nil
+}
+
+// CachedAds returns the value of the "cached_ads" field in the mutation.
+func (m *ClientErasureMutation) CachedAds() (r int, exists bool) {
+	v := m.cached_ads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCachedAds returns the old "cached_ads" field's value of the ClientErasure entity.
+// If the ClientErasure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientErasureMutation) OldCachedAds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCachedAds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCachedAds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCachedAds: %w", err)
+	}
+	return oldValue.CachedAds, nil
+}
+
+// AddCachedAds adds i to the "cached_ads" field.
+func (m *ClientErasureMutation) AddCachedAds(i int) {
+	if m.addcached_ads != nil {
+		*m.addcached_ads += i
+	} else {
+		m.addcached_ads = &i
+	}
+}
+
+// AddedCachedAds returns the value that was added to the "cached_ads" field in this mutation.
+func (m *ClientErasureMutation) AddedCachedAds() (r int, exists bool) {
+	v := m.addcached_ads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCachedAds resets all changes to the "cached_ads" field.
+func (m *ClientErasureMutation) ResetCachedAds() {
+	m.cached_ads = nil
+	m.addcached_ads = nil
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (m *ClientErasureMutation) SetRequestedAt(t time.Time) {
+	m.requested_at = &t
+}
+
+// RequestedAt returns the value of the "requested_at" field in the mutation.
+func (m *ClientErasureMutation) RequestedAt() (r time.Time, exists bool) {
+	v := m.requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedAt returns the old "requested_at" field's value of the ClientErasure entity.
+// If the ClientErasure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientErasureMutation) OldRequestedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+	}
+	return oldValue.RequestedAt, nil
+}
+
+// ResetRequestedAt resets all changes to the "requested_at" field.
+func (m *ClientErasureMutation) ResetRequestedAt() {
+	m.requested_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ClientErasureMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ClientErasureMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the ClientErasure entity.
+// If the ClientErasure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientErasureMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ClientErasureMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[clienterasure.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ClientErasureMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[clienterasure.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ClientErasureMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, clienterasure.FieldCompletedAt)
+}
+
+// Where appends a list predicates to the ClientErasureMutation builder.
+func (m *ClientErasureMutation) Where(ps ...predicate.ClientErasure) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClientErasureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClientErasureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ClientErasure, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClientErasureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ClientErasureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ClientErasure).
+func (m *ClientErasureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ClientErasureMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.client_id != nil {
+		fields = append(fields, clienterasure.FieldClientID)
+	}
+	if m.ml_scores != nil {
+		fields = append(fields, clienterasure.FieldMlScores)
+	}
+	if m.cached_ads != nil {
+		fields = append(fields, clienterasure.FieldCachedAds)
+	}
+	if m.requested_at != nil {
+		fields = append(fields, clienterasure.FieldRequestedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, clienterasure.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ClientErasureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case clienterasure.FieldClientID:
+		return m.ClientID()
+	case clienterasure.FieldMlScores:
+		return m.MlScores()
+	case clienterasure.FieldCachedAds:
+		return m.CachedAds()
+	case clienterasure.FieldRequestedAt:
+		return m.RequestedAt()
+	case clienterasure.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ClientErasureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case clienterasure.FieldClientID:
+		return m.OldClientID(ctx)
+	case clienterasure.FieldMlScores:
+		return m.OldMlScores(ctx)
+	case clienterasure.FieldCachedAds:
+		return m.OldCachedAds(ctx)
+	case clienterasure.FieldRequestedAt:
+		return m.OldRequestedAt(ctx)
+	case clienterasure.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ClientErasure field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientErasureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case clienterasure.FieldClientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case clienterasure.FieldMlScores:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMlScores(v)
+		return nil
+	case clienterasure.FieldCachedAds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCachedAds(v)
+		return nil
+	case clienterasure.FieldRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedAt(v)
+		return nil
+	case clienterasure.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ClientErasure field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ClientErasureMutation) AddedFields() []string {
+	var fields []string
+	if m.addml_scores != nil {
+		fields = append(fields, clienterasure.FieldMlScores)
+	}
+	if m.addcached_ads != nil {
+		fields = append(fields, clienterasure.FieldCachedAds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ClientErasureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case clienterasure.FieldMlScores:
+		return m.AddedMlScores()
+	case clienterasure.FieldCachedAds:
+		return m.AddedCachedAds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientErasureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case clienterasure.FieldMlScores:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMlScores(v)
+		return nil
+	case clienterasure.FieldCachedAds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCachedAds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ClientErasure numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ClientErasureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(clienterasure.FieldCompletedAt) {
+		fields = append(fields, clienterasure.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ClientErasureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ClientErasureMutation) ClearField(name string) error {
+	switch name {
+	case clienterasure.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ClientErasure nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ClientErasureMutation) ResetField(name string) error {
+	switch name {
+	case clienterasure.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case clienterasure.FieldMlScores:
+		m.ResetMlScores()
+		return nil
+	case clienterasure.FieldCachedAds:
+		m.ResetCachedAds()
+		return nil
+	case clienterasure.FieldRequestedAt:
+		m.ResetRequestedAt()
+		return nil
+	case clienterasure.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ClientErasure field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ClientErasureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ClientErasureMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ClientErasureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ClientErasureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ClientErasureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ClientErasureMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ClientErasureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ClientErasure unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ClientErasureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ClientErasure edge %s", name)
+}
+
+// LedgerEntryMutation represents an operation that mutates the LedgerEntry nodes in the graph.
+type LedgerEntryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	advertiser_id  *uuid.UUID
+	campaign_id    *uuid.UUID
+	campaign_title *string
+	day            *int
+	addday         *int
+	kind           *ledgerentry.Kind
+	quantity       *int64
+	addquantity    *int64
+	amount         *float64
+	addamount      *float64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*LedgerEntry, error)
+	predicates     []predicate.LedgerEntry
+}
+
+var _ ent.Mutation = (*LedgerEntryMutation)(nil)
+
+// ledgerentryOption allows management of the mutation configuration using functional options.
+type ledgerentryOption func(*LedgerEntryMutation)
+
+// newLedgerEntryMutation creates new mutation for the LedgerEntry entity.
+func newLedgerEntryMutation(c config, op Op, opts ...ledgerentryOption) *LedgerEntryMutation {
+	m := &LedgerEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLedgerEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLedgerEntryID sets the ID field of the mutation.
+func withLedgerEntryID(id int) ledgerentryOption {
+	return func(m *LedgerEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LedgerEntry
+		)
+		m.oldValue = func(ctx context.Context) (*LedgerEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LedgerEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLedgerEntry sets the old LedgerEntry of the mutation.
+func withLedgerEntry(node *LedgerEntry) ledgerentryOption {
+	return func(m *LedgerEntryMutation) {
+		m.oldValue = func(context.Context) (*LedgerEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LedgerEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LedgerEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LedgerEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LedgerEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LedgerEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (m *LedgerEntryMutation) SetAdvertiserID(u uuid.UUID) {
+	m.advertiser_id = &u
+}
+
+// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
+func (m *LedgerEntryMutation) AdvertiserID() (r uuid.UUID, exists bool) {
+	v := m.advertiser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvertiserID returns the old "advertiser_id" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldAdvertiserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
+	}
+	return oldValue.AdvertiserID, nil
+}
+
+// ResetAdvertiserID resets all changes to the "advertiser_id" field.
+func (m *LedgerEntryMutation) ResetAdvertiserID() {
+	m.advertiser_id = nil
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (m *LedgerEntryMutation) SetCampaignID(u uuid.UUID) {
+	m.campaign_id = &u
+}
+
+// CampaignID returns the value of the "campaign_id" field in the mutation.
+func (m *LedgerEntryMutation) CampaignID() (r uuid.UUID, exists bool) {
+	v := m.campaign_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaignID returns the old "campaign_id" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldCampaignID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignID: %w", err)
+	}
+	return oldValue.CampaignID, nil
+}
+
+// ResetCampaignID resets all changes to the "campaign_id" field.
+func (m *LedgerEntryMutation) ResetCampaignID() {
+	m.campaign_id = nil
+}
+
+// SetCampaignTitle sets the "campaign_title" field.
+func (m *LedgerEntryMutation) SetCampaignTitle(s string) {
+	m.campaign_title = &s
+}
+
+// CampaignTitle returns the value of the "campaign_title" field in the mutation.
+func (m *LedgerEntryMutation) CampaignTitle() (r string, exists bool) {
+	v := m.campaign_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaignTitle returns the old "campaign_title" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldCampaignTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignTitle: %w", err)
+	}
+	return oldValue.CampaignTitle, nil
+}
+
+// ClearCampaignTitle clears the value of the "campaign_title" field.
+func (m *LedgerEntryMutation) ClearCampaignTitle() {
+	m.campaign_title = nil
+	m.clearedFields[ledgerentry.FieldCampaignTitle] = struct{}{}
+}
+
+// CampaignTitleCleared returns if the "campaign_title" field was cleared in this mutation.
+func (m *LedgerEntryMutation) CampaignTitleCleared() bool {
+	_, ok := m.clearedFields[ledgerentry.FieldCampaignTitle]
+	return ok
+}
+
+// ResetCampaignTitle resets all changes to the "campaign_title" field.
+func (m *LedgerEntryMutation) ResetCampaignTitle() {
+	m.campaign_title = nil
+	delete(m.clearedFields, ledgerentry.FieldCampaignTitle)
+}
+
+// SetDay sets the "day" field.
+func (m *LedgerEntryMutation) SetDay(i int) {
+	m.day = &i
+	m.addday = nil
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *LedgerEntryMutation) Day() (r int, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldDay(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// AddDay adds i to the "day" field.
+func (m *LedgerEntryMutation) AddDay(i int) {
+	if m.addday != nil {
+		*m.addday += i
+	} else {
+		m.addday = &i
+	}
+}
+
+// AddedDay returns the value that was added to the "day" field in this mutation.
+func (m *LedgerEntryMutation) AddedDay() (r int, exists bool) {
+	v := m.addday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *LedgerEntryMutation) ResetDay() {
+	m.day = nil
+	m.addday = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *LedgerEntryMutation) SetKind(l ledgerentry.Kind) {
+	m.kind = &l
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *LedgerEntryMutation) Kind() (r ledgerentry.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldKind(ctx context.Context) (v ledgerentry.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *LedgerEntryMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *LedgerEntryMutation) SetQuantity(i int64) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *LedgerEntryMutation) Quantity() (r int64, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldQuantity(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *LedgerEntryMutation) AddQuantity(i int64) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *LedgerEntryMutation) AddedQuantity() (r int64, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *LedgerEntryMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *LedgerEntryMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *LedgerEntryMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *LedgerEntryMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *LedgerEntryMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *LedgerEntryMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LedgerEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LedgerEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LedgerEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the LedgerEntryMutation builder.
+func (m *LedgerEntryMutation) Where(ps ...predicate.LedgerEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LedgerEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LedgerEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LedgerEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LedgerEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LedgerEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LedgerEntry).
+func (m *LedgerEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LedgerEntryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.advertiser_id != nil {
+		fields = append(fields, ledgerentry.FieldAdvertiserID)
+	}
+	if m.campaign_id != nil {
+		fields = append(fields, ledgerentry.FieldCampaignID)
+	}
+	if m.campaign_title != nil {
+		fields = append(fields, ledgerentry.FieldCampaignTitle)
+	}
+	if m.day != nil {
+		fields = append(fields, ledgerentry.FieldDay)
+	}
+	if m.kind != nil {
+		fields = append(fields, ledgerentry.FieldKind)
+	}
+	if m.quantity != nil {
+		fields = append(fields, ledgerentry.FieldQuantity)
+	}
+	if m.amount != nil {
+		fields = append(fields, ledgerentry.FieldAmount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ledgerentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LedgerEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ledgerentry.FieldAdvertiserID:
+		return m.AdvertiserID()
+	case ledgerentry.FieldCampaignID:
+		return m.CampaignID()
+	case ledgerentry.FieldCampaignTitle:
+		return m.CampaignTitle()
+	case ledgerentry.FieldDay:
+		return m.Day()
+	case ledgerentry.FieldKind:
+		return m.Kind()
+	case ledgerentry.FieldQuantity:
+		return m.Quantity()
+	case ledgerentry.FieldAmount:
+		return m.Amount()
+	case ledgerentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LedgerEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ledgerentry.FieldAdvertiserID:
+		return m.OldAdvertiserID(ctx)
+	case ledgerentry.FieldCampaignID:
+		return m.OldCampaignID(ctx)
+	case ledgerentry.FieldCampaignTitle:
+		return m.OldCampaignTitle(ctx)
+	case ledgerentry.FieldDay:
+		return m.OldDay(ctx)
+	case ledgerentry.FieldKind:
+		return m.OldKind(ctx)
+	case ledgerentry.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case ledgerentry.FieldAmount:
+		return m.OldAmount(ctx)
+	case ledgerentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LedgerEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ledgerentry.FieldAdvertiserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvertiserID(v)
+		return nil
+	case ledgerentry.FieldCampaignID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaignID(v)
+		return nil
+	case ledgerentry.FieldCampaignTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaignTitle(v)
+		return nil
+	case ledgerentry.FieldDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case ledgerentry.FieldKind:
+		v, ok := value.(ledgerentry.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case ledgerentry.FieldQuantity:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case ledgerentry.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case ledgerentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LedgerEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addday != nil {
+		fields = append(fields, ledgerentry.FieldDay)
+	}
+	if m.addquantity != nil {
+		fields = append(fields, ledgerentry.FieldQuantity)
+	}
+	if m.addamount != nil {
+		fields = append(fields, ledgerentry.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LedgerEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ledgerentry.FieldDay:
+		return m.AddedDay()
+	case ledgerentry.FieldQuantity:
+		return m.AddedQuantity()
+	case ledgerentry.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ledgerentry.FieldDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDay(v)
+		return nil
+	case ledgerentry.FieldQuantity:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case ledgerentry.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LedgerEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ledgerentry.FieldCampaignTitle) {
+		fields = append(fields, ledgerentry.FieldCampaignTitle)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LedgerEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LedgerEntryMutation) ClearField(name string) error {
+	switch name {
+	case ledgerentry.FieldCampaignTitle:
+		m.ClearCampaignTitle()
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LedgerEntryMutation) ResetField(name string) error {
+	switch name {
+	case ledgerentry.FieldAdvertiserID:
+		m.ResetAdvertiserID()
+		return nil
+	case ledgerentry.FieldCampaignID:
+		m.ResetCampaignID()
+		return nil
+	case ledgerentry.FieldCampaignTitle:
+		m.ResetCampaignTitle()
+		return nil
+	case ledgerentry.FieldDay:
+		m.ResetDay()
+		return nil
+	case ledgerentry.FieldKind:
+		m.ResetKind()
+		return nil
+	case ledgerentry.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case ledgerentry.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case ledgerentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LedgerEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LedgerEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LedgerEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LedgerEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LedgerEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LedgerEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LedgerEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LedgerEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LedgerEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LedgerEntry edge %s", name)
+}
+
+// MlScoreMutation represents an operation that mutates the MlScore nodes in the graph.
+type MlScoreMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	score             *int64
+	addscore          *int64
+	clearedFields     map[string]struct{}
+	user              *uuid.UUID
+	cleareduser       bool
+	advertiser        *uuid.UUID
+	clearedadvertiser bool
+	done              bool
+	oldValue          func(context.Context) (*MlScore, error)
+	predicates        []predicate.MlScore
+}
+
+var _ ent.Mutation = (*MlScoreMutation)(nil)
+
+// mlscoreOption allows management of the mutation configuration using functional options.
+type mlscoreOption func(*MlScoreMutation)
+
+// newMlScoreMutation creates new mutation for the MlScore entity.
+func newMlScoreMutation(c config, op Op, opts ...mlscoreOption) *MlScoreMutation {
+	m := &MlScoreMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMlScore,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMlScoreID sets the ID field of the mutation.
+func withMlScoreID(id int) mlscoreOption {
+	return func(m *MlScoreMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MlScore
+		)
+		m.oldValue = func(ctx context.Context) (*MlScore, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MlScore.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMlScore sets the old MlScore of the mutation.
+func withMlScore(node *MlScore) mlscoreOption {
+	return func(m *MlScoreMutation) {
+		m.oldValue = func(context.Context) (*MlScore, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MlScoreMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MlScoreMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MlScoreMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MlScoreMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MlScore.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MlScoreMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MlScoreMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MlScore entity.
+// If the MlScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlScoreMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MlScoreMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (m *MlScoreMutation) SetAdvertiserID(u uuid.UUID) {
+	m.advertiser = &u
+}
+
+// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
+func (m *MlScoreMutation) AdvertiserID() (r uuid.UUID, exists bool) {
+	v := m.advertiser
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvertiserID returns the old "advertiser_id" field's value of the MlScore entity.
+// If the MlScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlScoreMutation) OldAdvertiserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
+	}
+	return oldValue.AdvertiserID, nil
+}
+
+// ResetAdvertiserID resets all changes to the "advertiser_id" field.
+func (m *MlScoreMutation) ResetAdvertiserID() {
+	m.advertiser = nil
+}
+
+// SetScore sets the "score" field.
+func (m *MlScoreMutation) SetScore(i int64) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *MlScoreMutation) Score() (r int64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the MlScore entity.
+// If the MlScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlScoreMutation) OldScore(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCostPerImpression is only allowed on UpdateOne operations")
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *MlScoreMutation) AddScore(i int64) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *MlScoreMutation) AddedScore() (r int64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *MlScoreMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MlScoreMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[mlscore.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MlScoreMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MlScoreMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MlScoreMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearAdvertiser clears the "advertiser" edge to the Advertiser entity.
+func (m *MlScoreMutation) ClearAdvertiser() {
+	m.clearedadvertiser = true
+	m.clearedFields[mlscore.FieldAdvertiserID] = struct{}{}
+}
+
+// AdvertiserCleared reports if the "advertiser" edge to the Advertiser entity was cleared.
+func (m *MlScoreMutation) AdvertiserCleared() bool {
+	return m.clearedadvertiser
+}
+
+// AdvertiserIDs returns the "advertiser" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AdvertiserID instead. It exists only for internal usage by the builders.
+func (m *MlScoreMutation) AdvertiserIDs() (ids []uuid.UUID) {
+	if id := m.advertiser; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAdvertiser resets all changes to the "advertiser" edge.
+func (m *MlScoreMutation) ResetAdvertiser() {
+	m.advertiser = nil
+	m.clearedadvertiser = false
+}
+
+// Where appends a list predicates to the MlScoreMutation builder.
+func (m *MlScoreMutation) Where(ps ...predicate.MlScore) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MlScoreMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MlScoreMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MlScore, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MlScoreMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MlScoreMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MlScore).
+func (m *MlScoreMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MlScoreMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user != nil {
+		fields = append(fields, mlscore.FieldUserID)
+	}
+	if m.advertiser != nil {
+		fields = append(fields, mlscore.FieldAdvertiserID)
+	}
+	if m.score != nil {
+		fields = append(fields, mlscore.FieldScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MlScoreMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mlscore.FieldUserID:
+		return m.UserID()
+	case mlscore.FieldAdvertiserID:
+		return m.AdvertiserID()
+	case mlscore.FieldScore:
+		return m.Score()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MlScoreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mlscore.FieldUserID:
+		return m.OldUserID(ctx)
+	case mlscore.FieldAdvertiserID:
+		return m.OldAdvertiserID(ctx)
+	case mlscore.FieldScore:
+		return m.OldScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown MlScore field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MlScoreMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mlscore.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case mlscore.FieldAdvertiserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvertiserID(v)
+		return nil
+	case mlscore.FieldScore:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MlScore field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MlScoreMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, mlscore.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MlScoreMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mlscore.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MlScoreMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mlscore.FieldScore:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MlScore numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MlScoreMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MlScoreMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MlScoreMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MlScore nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MlScoreMutation) ResetField(name string) error {
+	switch name {
+	case mlscore.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case mlscore.FieldAdvertiserID:
+		m.ResetAdvertiserID()
+		return nil
+	case mlscore.FieldScore:
+		m.ResetScore()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCostPerImpression requires an ID field in the mutation")
+	return fmt.Errorf("unknown MlScore field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MlScoreMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, mlscore.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCostPerImpression: %w", err)
+	if m.advertiser != nil {
+		edges = append(edges, mlscore.EdgeAdvertiser)
 	}
-	return oldValue.CostPerImpression, nil
+	return edges
 }
 
-// AddCostPerImpression adds f to the "cost_per_impression" field.
-func (m *CampaignMutation) AddCostPerImpression(f float64) {
-	if m.addcost_per_impression != nil {
-		*m.addcost_per_impression += f
-	} else {
-		m.addcost_per_impression = &f
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MlScoreMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mlscore.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case mlscore.EdgeAdvertiser:
+		if id := m.advertiser; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
 }
 
-// AddedCostPerImpression returns the value that was added to the "cost_per_impression" field in this mutation.
-func (m *CampaignMutation) AddedCostPerImpression() (r float64, exists bool) {
-	v := m.addcost_per_impression
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MlScoreMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MlScoreMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MlScoreMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, mlscore.EdgeUser)
 	}
-	return *v, true
+	if m.clearedadvertiser {
+		edges = append(edges, mlscore.EdgeAdvertiser)
+	}
+	return edges
 }
 
-// ResetCostPerImpression resets all changes to the "cost_per_impression" field.
-func (m *CampaignMutation) ResetCostPerImpression() {
-	m.cost_per_impression = nil
-	m.addcost_per_impression = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MlScoreMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mlscore.EdgeUser:
+		return m.cleareduser
+	case mlscore.EdgeAdvertiser:
+		return m.clearedadvertiser
+	}
+	return false
 }
 
-// SetCostPerClick sets the "cost_per_click" field.
-func (m *CampaignMutation) SetCostPerClick(f float64) {
-	m.cost_per_click = &f
-	m.addcost_per_click = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MlScoreMutation) ClearEdge(name string) error {
+	switch name {
+	case mlscore.EdgeUser:
+		m.ClearUser()
+		return nil
+	case mlscore.EdgeAdvertiser:
+		m.ClearAdvertiser()
+		return nil
+	}
+	return fmt.Errorf("unknown MlScore unique edge %s", name)
 }
 
-// CostPerClick returns the value of the "cost_per_click" field in the mutation.
-func (m *CampaignMutation) CostPerClick() (r float64, exists bool) {
-	v := m.cost_per_click
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MlScoreMutation) ResetEdge(name string) error {
+	switch name {
+	case mlscore.EdgeUser:
+		m.ResetUser()
+		return nil
+	case mlscore.EdgeAdvertiser:
+		m.ResetAdvertiser()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown MlScore edge %s", name)
 }
 
-// OldCostPerClick returns the old "cost_per_click" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldCostPerClick(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCostPerClick is only allowed on UpdateOne operations")
+// ModerationDecisionMutation represents an operation that mutates the ModerationDecision nodes in the graph.
+type ModerationDecisionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	campaign_id   *uuid.UUID
+	action        *moderationdecision.Action
+	reason        *moderationdecision.Reason
+	comment       *string
+	moderator     *string
+	image_hash    *int64
+	addimage_hash *int64
+	day           *int
+	addday        *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ModerationDecision, error)
+	predicates    []predicate.ModerationDecision
+}
+
+var _ ent.Mutation = (*ModerationDecisionMutation)(nil)
+
+// moderationdecisionOption allows management of the mutation configuration using functional options.
+type moderationdecisionOption func(*ModerationDecisionMutation)
+
+// newModerationDecisionMutation creates new mutation for the ModerationDecision entity.
+func newModerationDecisionMutation(c config, op Op, opts ...moderationdecisionOption) *ModerationDecisionMutation {
+	m := &ModerationDecisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModerationDecision,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCostPerClick requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCostPerClick: %w", err)
+	return m
+}
+
+// withModerationDecisionID sets the ID field of the mutation.
+func withModerationDecisionID(id int) moderationdecisionOption {
+	return func(m *ModerationDecisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModerationDecision
+		)
+		m.oldValue = func(ctx context.Context) (*ModerationDecision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModerationDecision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.CostPerClick, nil
 }
 
-// AddCostPerClick adds f to the "cost_per_click" field.
-func (m *CampaignMutation) AddCostPerClick(f float64) {
-	if m.addcost_per_click != nil {
-		*m.addcost_per_click += f
-	} else {
-		m.addcost_per_click = &f
+// withModerationDecision sets the old ModerationDecision of the mutation.
+func withModerationDecision(node *ModerationDecision) moderationdecisionOption {
+	return func(m *ModerationDecisionMutation) {
+		m.oldValue = func(context.Context) (*ModerationDecision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedCostPerClick returns the value that was added to the "cost_per_click" field in this mutation.
-func (m *CampaignMutation) AddedCostPerClick() (r float64, exists bool) {
-	v := m.addcost_per_click
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModerationDecisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModerationDecisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModerationDecisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModerationDecisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModerationDecision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (m *ModerationDecisionMutation) SetCampaignID(u uuid.UUID) {
+	m.campaign_id = &u
+}
+
+// CampaignID returns the value of the "campaign_id" field in the mutation.
+func (m *ModerationDecisionMutation) CampaignID() (r uuid.UUID, exists bool) {
+	v := m.campaign_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCostPerClick resets all changes to the "cost_per_click" field.
-func (m *CampaignMutation) ResetCostPerClick() {
-	m.cost_per_click = nil
-	m.addcost_per_click = nil
+// OldCampaignID returns the old "campaign_id" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationDecisionMutation) OldCampaignID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignID: %w", err)
+	}
+	return oldValue.CampaignID, nil
 }
 
-// SetAdTitle sets the "ad_title" field.
-func (m *CampaignMutation) SetAdTitle(s string) {
-	m.ad_title = &s
+// ResetCampaignID resets all changes to the "campaign_id" field.
+func (m *ModerationDecisionMutation) ResetCampaignID() {
+	m.campaign_id = nil
 }
 
-// AdTitle returns the value of the "ad_title" field in the mutation.
-func (m *CampaignMutation) AdTitle() (r string, exists bool) {
-	v := m.ad_title
+// SetAction sets the "action" field.
+func (m *ModerationDecisionMutation) SetAction(value moderationdecision.Action) {
+	m.action = &value
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ModerationDecisionMutation) Action() (r moderationdecision.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdTitle returns the old "ad_title" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldAdTitle(ctx context.Context) (v string, err error) {
+func (m *ModerationDecisionMutation) OldAction(ctx context.Context) (v moderationdecision.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdTitle requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.AdTitle, nil
+	return oldValue.Action, nil
 }
 
-// ResetAdTitle resets all changes to the "ad_title" field.
-func (m *CampaignMutation) ResetAdTitle() {
-	m.ad_title = nil
+// ResetAction resets all changes to the "action" field.
+func (m *ModerationDecisionMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetAdText sets the "ad_text" field.
-func (m *CampaignMutation) SetAdText(s string) {
-	m.ad_text = &s
+// SetReason sets the "reason" field.
+func (m *ModerationDecisionMutation) SetReason(value moderationdecision.Reason) {
+	m.reason = &value
 }
 
-// AdText returns the value of the "ad_text" field in the mutation.
-func (m *CampaignMutation) AdText() (r string, exists bool) {
-	v := m.ad_text
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ModerationDecisionMutation) Reason() (r moderationdecision.Reason, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdText returns the old "ad_text" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldAdText(ctx context.Context) (v string, err error) {
+func (m *ModerationDecisionMutation) OldReason(ctx context.Context) (v *moderationdecision.Reason, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdText is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdText requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdText: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.AdText, nil
+	return oldValue.Reason, nil
 }
 
-// ResetAdText resets all changes to the "ad_text" field.
-func (m *CampaignMutation) ResetAdText() {
-	m.ad_text = nil
+// ClearReason clears the value of the "reason" field.
+func (m *ModerationDecisionMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[moderationdecision.FieldReason] = struct{}{}
 }
 
-// SetImageURL sets the "image_url" field.
-func (m *CampaignMutation) SetImageURL(s string) {
-	m.image_url = &s
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ModerationDecisionMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[moderationdecision.FieldReason]
+	return ok
 }
 
-// ImageURL returns the value of the "image_url" field in the mutation.
-func (m *CampaignMutation) ImageURL() (r string, exists bool) {
-	v := m.image_url
+// ResetReason resets all changes to the "reason" field.
+func (m *ModerationDecisionMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, moderationdecision.FieldReason)
+}
+
+// SetComment sets the "comment" field.
+func (m *ModerationDecisionMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *ModerationDecisionMutation) Comment() (r string, exists bool) {
+	v := m.comment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageURL returns the old "image_url" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldComment returns the old "comment" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldImageURL(ctx context.Context) (v string, err error) {
+func (m *ModerationDecisionMutation) OldComment(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageURL requires an ID field in the mutation")
+		return v, errors.New("OldComment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
 	}
-	return oldValue.ImageURL, nil
+	return oldValue.Comment, nil
 }
 
-// ClearImageURL clears the value of the "image_url" field.
-func (m *CampaignMutation) ClearImageURL() {
-	m.image_url = nil
-	m.clearedFields[campaign.FieldImageURL] = struct{}{}
+// ClearComment clears the value of the "comment" field.
+func (m *ModerationDecisionMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[moderationdecision.FieldComment] = struct{}{}
 }
 
-// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
-func (m *CampaignMutation) ImageURLCleared() bool {
-	_, ok := m.clearedFields[campaign.FieldImageURL]
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *ModerationDecisionMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[moderationdecision.FieldComment]
 	return ok
 }
 
-// ResetImageURL resets all changes to the "image_url" field.
-func (m *CampaignMutation) ResetImageURL() {
-	m.image_url = nil
-	delete(m.clearedFields, campaign.FieldImageURL)
+// ResetComment resets all changes to the "comment" field.
+func (m *ModerationDecisionMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, moderationdecision.FieldComment)
 }
 
-// SetStartDate sets the "start_date" field.
-func (m *CampaignMutation) SetStartDate(i int) {
-	m.start_date = &i
-	m.addstart_date = nil
+// SetModerator sets the "moderator" field.
+func (m *ModerationDecisionMutation) SetModerator(s string) {
+	m.moderator = &s
 }
 
-// StartDate returns the value of the "start_date" field in the mutation.
-func (m *CampaignMutation) StartDate() (r int, exists bool) {
-	v := m.start_date
+// Moderator returns the value of the "moderator" field in the mutation.
+func (m *ModerationDecisionMutation) Moderator() (r string, exists bool) {
+	v := m.moderator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartDate returns the old "start_date" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldModerator returns the old "moderator" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldStartDate(ctx context.Context) (v int, err error) {
+func (m *ModerationDecisionMutation) OldModerator(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldModerator is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartDate requires an ID field in the mutation")
+		return v, errors.New("OldModerator requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldModerator: %w", err)
 	}
-	return oldValue.StartDate, nil
+	return oldValue.Moderator, nil
 }
 
-// AddStartDate adds i to the "start_date" field.
-func (m *CampaignMutation) AddStartDate(i int) {
-	if m.addstart_date != nil {
-		*m.addstart_date += i
-	} else {
-		m.addstart_date = &i
-	}
+// ClearModerator clears the value of the "moderator" field.
+func (m *ModerationDecisionMutation) ClearModerator() {
+	m.moderator = nil
+	m.clearedFields[moderationdecision.FieldModerator] = struct{}{}
 }
 
-// AddedStartDate returns the value that was added to the "start_date" field in this mutation.
-func (m *CampaignMutation) AddedStartDate() (r int, exists bool) {
-	v := m.addstart_date
-	if v == nil {
-		return
-	}
-	return *v, true
+// ModeratorCleared returns if the "moderator" field was cleared in this mutation.
+func (m *ModerationDecisionMutation) ModeratorCleared() bool {
+	_, ok := m.clearedFields[moderationdecision.FieldModerator]
+	return ok
 }
 
-// ResetStartDate resets all changes to the "start_date" field.
-func (m *CampaignMutation) ResetStartDate() {
-	m.start_date = nil
-	m.addstart_date = nil
+// ResetModerator resets all changes to the "moderator" field.
+func (m *ModerationDecisionMutation) ResetModerator() {
+	m.moderator = nil
+	delete(m.clearedFields, moderationdecision.FieldModerator)
 }
 
-// SetEndDate sets the "end_date" field.
-func (m *CampaignMutation) SetEndDate(i int) {
-	m.end_date = &i
-	m.addend_date = nil
+// SetImageHash sets the "image_hash" field.
+func (m *ModerationDecisionMutation) SetImageHash(i int64) {
+	m.image_hash = &i
+	m.addimage_hash = nil
 }
 
-// EndDate returns the value of the "end_date" field in the mutation.
-func (m *CampaignMutation) EndDate() (r int, exists bool) {
-	v := m.end_date
+// ImageHash returns the value of the "image_hash" field in the mutation.
+func (m *ModerationDecisionMutation) ImageHash() (r int64, exists bool) {
+	v := m.image_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndDate returns the old "end_date" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldImageHash returns the old "image_hash" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldEndDate(ctx context.Context) (v int, err error) {
+func (m *ModerationDecisionMutation) OldImageHash(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldImageHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndDate requires an ID field in the mutation")
+		return v, errors.New("OldImageHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldImageHash: %w", err)
 	}
-	return oldValue.EndDate, nil
+	return oldValue.ImageHash, nil
 }
 
-// AddEndDate adds i to the "end_date" field.
-func (m *CampaignMutation) AddEndDate(i int) {
-	if m.addend_date != nil {
-		*m.addend_date += i
+// AddImageHash adds i to the "image_hash" field.
+func (m *ModerationDecisionMutation) AddImageHash(i int64) {
+	if m.addimage_hash != nil {
+		*m.addimage_hash += i
 	} else {
-		m.addend_date = &i
+		m.addimage_hash = &i
 	}
 }
 
-// AddedEndDate returns the value that was added to the "end_date" field in this mutation.
-func (m *CampaignMutation) AddedEndDate() (r int, exists bool) {
-	v := m.addend_date
+// AddedImageHash returns the value that was added to the "image_hash" field in this mutation.
+func (m *ModerationDecisionMutation) AddedImageHash() (r int64, exists bool) {
+	v := m.addimage_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetEndDate resets all changes to the "end_date" field.
-func (m *CampaignMutation) ResetEndDate() {
-	m.end_date = nil
-	m.addend_date = nil
+// ClearImageHash clears the value of the "image_hash" field.
+func (m *ModerationDecisionMutation) ClearImageHash() {
+	m.image_hash = nil
+	m.addimage_hash = nil
+	m.clearedFields[moderationdecision.FieldImageHash] = struct{}{}
 }
 
-// SetModerated sets the "moderated" field.
-func (m *CampaignMutation) SetModerated(b bool) {
-	m.moderated = &b
+// ImageHashCleared returns if the "image_hash" field was cleared in this mutation.
+func (m *ModerationDecisionMutation) ImageHashCleared() bool {
+	_, ok := m.clearedFields[moderationdecision.FieldImageHash]
+	return ok
 }
 
-// Moderated returns the value of the "moderated" field in the mutation.
-func (m *CampaignMutation) Moderated() (r bool, exists bool) {
-	v := m.moderated
+// ResetImageHash resets all changes to the "image_hash" field.
+func (m *ModerationDecisionMutation) ResetImageHash() {
+	m.image_hash = nil
+	m.addimage_hash = nil
+	delete(m.clearedFields, moderationdecision.FieldImageHash)
+}
+
+// SetDay sets the "day" field.
+func (m *ModerationDecisionMutation) SetDay(i int) {
+	m.day = &i
+	m.addday = nil
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *ModerationDecisionMutation) Day() (r int, exists bool) {
+	v := m.day
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModerated returns the old "moderated" field's value of the Campaign entity.
-// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// OldDay returns the old "day" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CampaignMutation) OldModerated(ctx context.Context) (v bool, err error) {
+func (m *ModerationDecisionMutation) OldDay(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModerated is only allowed on UpdateOne operations")
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModerated requires an ID field in the mutation")
+		return v, errors.New("OldDay requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModerated: %w", err)
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
 	}
-	return oldValue.Moderated, nil
+	return oldValue.Day, nil
 }
 
-// ResetModerated resets all changes to the "moderated" field.
-func (m *CampaignMutation) ResetModerated() {
-	m.moderated = nil
+// AddDay adds i to the "day" field.
+func (m *ModerationDecisionMutation) AddDay(i int) {
+	if m.addday != nil {
+		*m.addday += i
+	} else {
+		m.addday = &i
+	}
 }
 
-// SetTargetingID sets the "targeting" edge to the Targeting entity by id.
-func (m *CampaignMutation) SetTargetingID(id int) {
-	m.targeting = &id
+// AddedDay returns the value that was added to the "day" field in this mutation.
+func (m *ModerationDecisionMutation) AddedDay() (r int, exists bool) {
+	v := m.addday
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearTargeting clears the "targeting" edge to the Targeting entity.
-func (m *CampaignMutation) ClearTargeting() {
-	m.clearedtargeting = true
+// ResetDay resets all changes to the "day" field.
+func (m *ModerationDecisionMutation) ResetDay() {
+	m.day = nil
+	m.addday = nil
 }
 
-// TargetingCleared reports if the "targeting" edge to the Targeting entity was cleared.
-func (m *CampaignMutation) TargetingCleared() bool {
-	return m.clearedtargeting
+// SetCreatedAt sets the "created_at" field.
+func (m *ModerationDecisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// TargetingID returns the "targeting" edge ID in the mutation.
-func (m *CampaignMutation) TargetingID() (id int, exists bool) {
-	if m.targeting != nil {
-		return *m.targeting, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModerationDecisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TargetingIDs returns the "targeting" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TargetingID instead. It exists only for internal usage by the builders.
-func (m *CampaignMutation) TargetingIDs() (ids []int) {
-	if id := m.targeting; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the ModerationDecision entity.
+// If the ModerationDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationDecisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTargeting resets all changes to the "targeting" edge.
-func (m *CampaignMutation) ResetTargeting() {
-	m.targeting = nil
-	m.clearedtargeting = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModerationDecisionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the CampaignMutation builder.
-func (m *CampaignMutation) Where(ps ...predicate.Campaign) {
+// Where appends a list predicates to the ModerationDecisionMutation builder.
+func (m *ModerationDecisionMutation) Where(ps ...predicate.ModerationDecision) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CampaignMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ModerationDecisionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CampaignMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Campaign, len(ps))
+func (m *ModerationDecisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationDecision, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1086,88 +7872,73 @@ func (m *CampaignMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CampaignMutation) Op() Op {
+func (m *ModerationDecisionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CampaignMutation) SetOp(op Op) {
+func (m *ModerationDecisionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Campaign).
-func (m *CampaignMutation) Type() string {
+// Type returns the node type of this mutation (ModerationDecision).
+func (m *ModerationDecisionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CampaignMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.advertiser_id != nil {
-		fields = append(fields, campaign.FieldAdvertiserID)
-	}
-	if m.impressions_limit != nil {
-		fields = append(fields, campaign.FieldImpressionsLimit)
-	}
-	if m.clicks_limit != nil {
-		fields = append(fields, campaign.FieldClicksLimit)
-	}
-	if m.cost_per_impression != nil {
-		fields = append(fields, campaign.FieldCostPerImpression)
-	}
-	if m.cost_per_click != nil {
-		fields = append(fields, campaign.FieldCostPerClick)
-	}
-	if m.ad_title != nil {
-		fields = append(fields, campaign.FieldAdTitle)
-	}
-	if m.ad_text != nil {
-		fields = append(fields, campaign.FieldAdText)
-	}
-	if m.image_url != nil {
-		fields = append(fields, campaign.FieldImageURL)
-	}
-	if m.start_date != nil {
-		fields = append(fields, campaign.FieldStartDate)
-	}
-	if m.end_date != nil {
-		fields = append(fields, campaign.FieldEndDate)
-	}
-	if m.moderated != nil {
-		fields = append(fields, campaign.FieldModerated)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CampaignMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case campaign.FieldAdvertiserID:
-		return m.AdvertiserID()
-	case campaign.FieldImpressionsLimit:
-		return m.ImpressionsLimit()
-	case campaign.FieldClicksLimit:
-		return m.ClicksLimit()
-	case campaign.FieldCostPerImpression:
-		return m.CostPerImpression()
-	case campaign.FieldCostPerClick:
-		return m.CostPerClick()
-	case campaign.FieldAdTitle:
-		return m.AdTitle()
-	case campaign.FieldAdText:
-		return m.AdText()
-	case campaign.FieldImageURL:
-		return m.ImageURL()
-	case campaign.FieldStartDate:
-		return m.StartDate()
-	case campaign.FieldEndDate:
-		return m.EndDate()
-	case campaign.FieldModerated:
-		return m.Moderated()
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModerationDecisionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.campaign_id != nil {
+		fields = append(fields, moderationdecision.FieldCampaignID)
+	}
+	if m.action != nil {
+		fields = append(fields, moderationdecision.FieldAction)
+	}
+	if m.reason != nil {
+		fields = append(fields, moderationdecision.FieldReason)
+	}
+	if m.comment != nil {
+		fields = append(fields, moderationdecision.FieldComment)
+	}
+	if m.moderator != nil {
+		fields = append(fields, moderationdecision.FieldModerator)
+	}
+	if m.image_hash != nil {
+		fields = append(fields, moderationdecision.FieldImageHash)
+	}
+	if m.day != nil {
+		fields = append(fields, moderationdecision.FieldDay)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moderationdecision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModerationDecisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moderationdecision.FieldCampaignID:
+		return m.CampaignID()
+	case moderationdecision.FieldAction:
+		return m.Action()
+	case moderationdecision.FieldReason:
+		return m.Reason()
+	case moderationdecision.FieldComment:
+		return m.Comment()
+	case moderationdecision.FieldModerator:
+		return m.Moderator()
+	case moderationdecision.FieldImageHash:
+		return m.ImageHash()
+	case moderationdecision.FieldDay:
+		return m.Day()
+	case moderationdecision.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1175,141 +7946,102 @@ func (m *CampaignMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CampaignMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ModerationDecisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case campaign.FieldAdvertiserID:
-		return m.OldAdvertiserID(ctx)
-	case campaign.FieldImpressionsLimit:
-		return m.OldImpressionsLimit(ctx)
-	case campaign.FieldClicksLimit:
-		return m.OldClicksLimit(ctx)
-	case campaign.FieldCostPerImpression:
-		return m.OldCostPerImpression(ctx)
-	case campaign.FieldCostPerClick:
-		return m.OldCostPerClick(ctx)
-	case campaign.FieldAdTitle:
-		return m.OldAdTitle(ctx)
-	case campaign.FieldAdText:
-		return m.OldAdText(ctx)
-	case campaign.FieldImageURL:
-		return m.OldImageURL(ctx)
-	case campaign.FieldStartDate:
-		return m.OldStartDate(ctx)
-	case campaign.FieldEndDate:
-		return m.OldEndDate(ctx)
-	case campaign.FieldModerated:
-		return m.OldModerated(ctx)
-	}
-	return nil, fmt.Errorf("unknown Campaign field %s", name)
+	case moderationdecision.FieldCampaignID:
+		return m.OldCampaignID(ctx)
+	case moderationdecision.FieldAction:
+		return m.OldAction(ctx)
+	case moderationdecision.FieldReason:
+		return m.OldReason(ctx)
+	case moderationdecision.FieldComment:
+		return m.OldComment(ctx)
+	case moderationdecision.FieldModerator:
+		return m.OldModerator(ctx)
+	case moderationdecision.FieldImageHash:
+		return m.OldImageHash(ctx)
+	case moderationdecision.FieldDay:
+		return m.OldDay(ctx)
+	case moderationdecision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModerationDecision field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CampaignMutation) SetField(name string, value ent.Value) error {
+func (m *ModerationDecisionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case campaign.FieldAdvertiserID:
+	case moderationdecision.FieldCampaignID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAdvertiserID(v)
-		return nil
-	case campaign.FieldImpressionsLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImpressionsLimit(v)
-		return nil
-	case campaign.FieldClicksLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClicksLimit(v)
-		return nil
-	case campaign.FieldCostPerImpression:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCostPerImpression(v)
+		m.SetCampaignID(v)
 		return nil
-	case campaign.FieldCostPerClick:
-		v, ok := value.(float64)
+	case moderationdecision.FieldAction:
+		v, ok := value.(moderationdecision.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCostPerClick(v)
+		m.SetAction(v)
 		return nil
-	case campaign.FieldAdTitle:
-		v, ok := value.(string)
+	case moderationdecision.FieldReason:
+		v, ok := value.(moderationdecision.Reason)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAdTitle(v)
+		m.SetReason(v)
 		return nil
-	case campaign.FieldAdText:
+	case moderationdecision.FieldComment:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAdText(v)
+		m.SetComment(v)
 		return nil
-	case campaign.FieldImageURL:
+	case moderationdecision.FieldModerator:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageURL(v)
+		m.SetModerator(v)
 		return nil
-	case campaign.FieldStartDate:
-		v, ok := value.(int)
+	case moderationdecision.FieldImageHash:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartDate(v)
+		m.SetImageHash(v)
 		return nil
-	case campaign.FieldEndDate:
+	case moderationdecision.FieldDay:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndDate(v)
+		m.SetDay(v)
 		return nil
-	case campaign.FieldModerated:
-		v, ok := value.(bool)
+	case moderationdecision.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModerated(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Campaign field %s", name)
+	return fmt.Errorf("unknown ModerationDecision field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CampaignMutation) AddedFields() []string {
+func (m *ModerationDecisionMutation) AddedFields() []string {
 	var fields []string
-	if m.addimpressions_limit != nil {
-		fields = append(fields, campaign.FieldImpressionsLimit)
-	}
-	if m.addclicks_limit != nil {
-		fields = append(fields, campaign.FieldClicksLimit)
-	}
-	if m.addcost_per_impression != nil {
-		fields = append(fields, campaign.FieldCostPerImpression)
-	}
-	if m.addcost_per_click != nil {
-		fields = append(fields, campaign.FieldCostPerClick)
-	}
-	if m.addstart_date != nil {
-		fields = append(fields, campaign.FieldStartDate)
+	if m.addimage_hash != nil {
+		fields = append(fields, moderationdecision.FieldImageHash)
 	}
-	if m.addend_date != nil {
-		fields = append(fields, campaign.FieldEndDate)
+	if m.addday != nil {
+		fields = append(fields, moderationdecision.FieldDay)
 	}
 	return fields
 }
@@ -1317,20 +8049,12 @@ func (m *CampaignMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CampaignMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ModerationDecisionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case campaign.FieldImpressionsLimit:
-		return m.AddedImpressionsLimit()
-	case campaign.FieldClicksLimit:
-		return m.AddedClicksLimit()
-	case campaign.FieldCostPerImpression:
-		return m.AddedCostPerImpression()
-	case campaign.FieldCostPerClick:
-		return m.AddedCostPerClick()
-	case campaign.FieldStartDate:
-		return m.AddedStartDate()
-	case campaign.FieldEndDate:
-		return m.AddedEndDate()
+	case moderationdecision.FieldImageHash:
+		return m.AddedImageHash()
+	case moderationdecision.FieldDay:
+		return m.AddedDay()
 	}
 	return nil, false
 }
@@ -1338,226 +8062,183 @@ func (m *CampaignMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CampaignMutation) AddField(name string, value ent.Value) error {
+func (m *ModerationDecisionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case campaign.FieldImpressionsLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddImpressionsLimit(v)
-		return nil
-	case campaign.FieldClicksLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddClicksLimit(v)
-		return nil
-	case campaign.FieldCostPerImpression:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCostPerImpression(v)
-		return nil
-	case campaign.FieldCostPerClick:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCostPerClick(v)
-		return nil
-	case campaign.FieldStartDate:
-		v, ok := value.(int)
+	case moderationdecision.FieldImageHash:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStartDate(v)
+		m.AddImageHash(v)
 		return nil
-	case campaign.FieldEndDate:
+	case moderationdecision.FieldDay:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddEndDate(v)
+		m.AddDay(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Campaign numeric field %s", name)
+	return fmt.Errorf("unknown ModerationDecision numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CampaignMutation) ClearedFields() []string {
+func (m *ModerationDecisionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(campaign.FieldImageURL) {
-		fields = append(fields, campaign.FieldImageURL)
+	if m.FieldCleared(moderationdecision.FieldReason) {
+		fields = append(fields, moderationdecision.FieldReason)
+	}
+	if m.FieldCleared(moderationdecision.FieldComment) {
+		fields = append(fields, moderationdecision.FieldComment)
+	}
+	if m.FieldCleared(moderationdecision.FieldModerator) {
+		fields = append(fields, moderationdecision.FieldModerator)
+	}
+	if m.FieldCleared(moderationdecision.FieldImageHash) {
+		fields = append(fields, moderationdecision.FieldImageHash)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CampaignMutation) FieldCleared(name string) bool {
+func (m *ModerationDecisionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CampaignMutation) ClearField(name string) error {
+func (m *ModerationDecisionMutation) ClearField(name string) error {
 	switch name {
-	case campaign.FieldImageURL:
-		m.ClearImageURL()
+	case moderationdecision.FieldReason:
+		m.ClearReason()
+		return nil
+	case moderationdecision.FieldComment:
+		m.ClearComment()
+		return nil
+	case moderationdecision.FieldModerator:
+		m.ClearModerator()
+		return nil
+	case moderationdecision.FieldImageHash:
+		m.ClearImageHash()
 		return nil
 	}
-	return fmt.Errorf("unknown Campaign nullable field %s", name)
+	return fmt.Errorf("unknown ModerationDecision nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CampaignMutation) ResetField(name string) error {
+func (m *ModerationDecisionMutation) ResetField(name string) error {
 	switch name {
-	case campaign.FieldAdvertiserID:
-		m.ResetAdvertiserID()
-		return nil
-	case campaign.FieldImpressionsLimit:
-		m.ResetImpressionsLimit()
-		return nil
-	case campaign.FieldClicksLimit:
-		m.ResetClicksLimit()
-		return nil
-	case campaign.FieldCostPerImpression:
-		m.ResetCostPerImpression()
+	case moderationdecision.FieldCampaignID:
+		m.ResetCampaignID()
 		return nil
-	case campaign.FieldCostPerClick:
-		m.ResetCostPerClick()
+	case moderationdecision.FieldAction:
+		m.ResetAction()
 		return nil
-	case campaign.FieldAdTitle:
-		m.ResetAdTitle()
+	case moderationdecision.FieldReason:
+		m.ResetReason()
 		return nil
-	case campaign.FieldAdText:
-		m.ResetAdText()
+	case moderationdecision.FieldComment:
+		m.ResetComment()
 		return nil
-	case campaign.FieldImageURL:
-		m.ResetImageURL()
+	case moderationdecision.FieldModerator:
+		m.ResetModerator()
 		return nil
-	case campaign.FieldStartDate:
-		m.ResetStartDate()
+	case moderationdecision.FieldImageHash:
+		m.ResetImageHash()
 		return nil
-	case campaign.FieldEndDate:
-		m.ResetEndDate()
+	case moderationdecision.FieldDay:
+		m.ResetDay()
 		return nil
-	case campaign.FieldModerated:
-		m.ResetModerated()
+	case moderationdecision.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Campaign field %s", name)
+	return fmt.Errorf("unknown ModerationDecision field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CampaignMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.targeting != nil {
-		edges = append(edges, campaign.EdgeTargeting)
-	}
+func (m *ModerationDecisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CampaignMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case campaign.EdgeTargeting:
-		if id := m.targeting; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ModerationDecisionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CampaignMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ModerationDecisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CampaignMutation) RemovedIDs(name string) []ent.Value {
+func (m *ModerationDecisionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CampaignMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtargeting {
-		edges = append(edges, campaign.EdgeTargeting)
-	}
+func (m *ModerationDecisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CampaignMutation) EdgeCleared(name string) bool {
-	switch name {
-	case campaign.EdgeTargeting:
-		return m.clearedtargeting
-	}
+func (m *ModerationDecisionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CampaignMutation) ClearEdge(name string) error {
-	switch name {
-	case campaign.EdgeTargeting:
-		m.ClearTargeting()
-		return nil
-	}
-	return fmt.Errorf("unknown Campaign unique edge %s", name)
+func (m *ModerationDecisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ModerationDecision unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CampaignMutation) ResetEdge(name string) error {
-	switch name {
-	case campaign.EdgeTargeting:
-		m.ResetTargeting()
-		return nil
-	}
-	return fmt.Errorf("unknown Campaign edge %s", name)
+func (m *ModerationDecisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ModerationDecision edge %s", name)
 }
 
-// MlScoreMutation represents an operation that mutates the MlScore nodes in the graph.
-type MlScoreMutation struct {
+// TargetingMutation represents an operation that mutates the Targeting nodes in the graph.
+type TargetingMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	score             *int64
-	addscore          *int64
-	clearedFields     map[string]struct{}
-	user              *uuid.UUID
-	cleareduser       bool
-	advertiser        *uuid.UUID
-	clearedadvertiser bool
-	done              bool
-	oldValue          func(context.Context) (*MlScore, error)
-	predicates        []predicate.MlScore
+	op              Op
+	typ             string
+	id              *int
+	gender          *targeting.Gender
+	age_from        *int
+	addage_from     *int
+	age_to          *int
+	addage_to       *int
+	location        *string
+	clearedFields   map[string]struct{}
+	campaign        *uuid.UUID
+	clearedcampaign bool
+	done            bool
+	oldValue        func(context.Context) (*Targeting, error)
+	predicates      []predicate.Targeting
 }
 
-var _ ent.Mutation = (*MlScoreMutation)(nil)
+var _ ent.Mutation = (*TargetingMutation)(nil)
 
-// mlscoreOption allows management of the mutation configuration using functional options.
-type mlscoreOption func(*MlScoreMutation)
+// targetingOption allows management of the mutation configuration using functional options.
+type targetingOption func(*TargetingMutation)
 
-// newMlScoreMutation creates new mutation for the MlScore entity.
-func newMlScoreMutation(c config, op Op, opts ...mlscoreOption) *MlScoreMutation {
-	m := &MlScoreMutation{
+// newTargetingMutation creates new mutation for the Targeting entity.
+func newTargetingMutation(c config, op Op, opts ...targetingOption) *TargetingMutation {
+	m := &TargetingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMlScore,
+		typ:           TypeTargeting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1566,20 +8247,20 @@ func newMlScoreMutation(c config, op Op, opts ...mlscoreOption) *MlScoreMutation
 	return m
 }
 
-// withMlScoreID sets the ID field of the mutation.
-func withMlScoreID(id int) mlscoreOption {
-	return func(m *MlScoreMutation) {
+// withTargetingID sets the ID field of the mutation.
+func withTargetingID(id int) targetingOption {
+	return func(m *TargetingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MlScore
+			value *Targeting
 		)
-		m.oldValue = func(ctx context.Context) (*MlScore, error) {
+		m.oldValue = func(ctx context.Context) (*Targeting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MlScore.Get(ctx, id)
+					value, err = m.Client().Targeting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1588,10 +8269,10 @@ func withMlScoreID(id int) mlscoreOption {
 	}
 }
 
-// withMlScore sets the old MlScore of the mutation.
-func withMlScore(node *MlScore) mlscoreOption {
-	return func(m *MlScoreMutation) {
-		m.oldValue = func(context.Context) (*MlScore, error) {
+// withTargeting sets the old Targeting of the mutation.
+func withTargeting(node *Targeting) targetingOption {
+	return func(m *TargetingMutation) {
+		m.oldValue = func(context.Context) (*Targeting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1600,7 +8281,7 @@ func withMlScore(node *MlScore) mlscoreOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MlScoreMutation) Client() *Client {
+func (m TargetingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1608,7 +8289,7 @@ func (m MlScoreMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MlScoreMutation) Tx() (*Tx, error) {
+func (m TargetingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1619,7 +8300,7 @@ func (m MlScoreMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MlScoreMutation) ID() (id int, exists bool) {
+func (m *TargetingMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1630,7 +8311,7 @@ func (m *MlScoreMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MlScoreMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TargetingMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1639,203 +8320,298 @@ func (m *MlScoreMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MlScore.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Targeting.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *MlScoreMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetGender sets the "gender" field.
+func (m *TargetingMutation) SetGender(t targeting.Gender) {
+	m.gender = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *MlScoreMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// Gender returns the value of the "gender" field in the mutation.
+func (m *TargetingMutation) Gender() (r targeting.Gender, exists bool) {
+	v := m.gender
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the MlScore entity.
-// If the MlScore object wasn't provided to the builder, the object is fetched from the database.
+// OldGender returns the old "gender" field's value of the Targeting entity.
+// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MlScoreMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TargetingMutation) OldGender(ctx context.Context) (v *targeting.Gender, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGender is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldGender requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGender: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Gender, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *MlScoreMutation) ResetUserID() {
-	m.user = nil
+// ClearGender clears the value of the "gender" field.
+func (m *TargetingMutation) ClearGender() {
+	m.gender = nil
+	m.clearedFields[targeting.FieldGender] = struct{}{}
 }
 
-// SetAdvertiserID sets the "advertiser_id" field.
-func (m *MlScoreMutation) SetAdvertiserID(u uuid.UUID) {
-	m.advertiser = &u
+// GenderCleared returns if the "gender" field was cleared in this mutation.
+func (m *TargetingMutation) GenderCleared() bool {
+	_, ok := m.clearedFields[targeting.FieldGender]
+	return ok
 }
 
-// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
-func (m *MlScoreMutation) AdvertiserID() (r uuid.UUID, exists bool) {
-	v := m.advertiser
+// ResetGender resets all changes to the "gender" field.
+func (m *TargetingMutation) ResetGender() {
+	m.gender = nil
+	delete(m.clearedFields, targeting.FieldGender)
+}
+
+// SetAgeFrom sets the "age_from" field.
+func (m *TargetingMutation) SetAgeFrom(i int) {
+	m.age_from = &i
+	m.addage_from = nil
+}
+
+// AgeFrom returns the value of the "age_from" field in the mutation.
+func (m *TargetingMutation) AgeFrom() (r int, exists bool) {
+	v := m.age_from
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdvertiserID returns the old "advertiser_id" field's value of the MlScore entity.
-// If the MlScore object wasn't provided to the builder, the object is fetched from the database.
+// OldAgeFrom returns the old "age_from" field's value of the Targeting entity.
+// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MlScoreMutation) OldAdvertiserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TargetingMutation) OldAgeFrom(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAgeFrom is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
+		return v, errors.New("OldAgeFrom requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAgeFrom: %w", err)
 	}
-	return oldValue.AdvertiserID, nil
+	return oldValue.AgeFrom, nil
 }
 
-// ResetAdvertiserID resets all changes to the "advertiser_id" field.
-func (m *MlScoreMutation) ResetAdvertiserID() {
-	m.advertiser = nil
+// AddAgeFrom adds i to the "age_from" field.
+func (m *TargetingMutation) AddAgeFrom(i int) {
+	if m.addage_from != nil {
+		*m.addage_from += i
+	} else {
+		m.addage_from = &i
+	}
 }
 
-// SetScore sets the "score" field.
-func (m *MlScoreMutation) SetScore(i int64) {
-	m.score = &i
-	m.addscore = nil
+// AddedAgeFrom returns the value that was added to the "age_from" field in this mutation.
+func (m *TargetingMutation) AddedAgeFrom() (r int, exists bool) {
+	v := m.addage_from
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *MlScoreMutation) Score() (r int64, exists bool) {
-	v := m.score
+// ClearAgeFrom clears the value of the "age_from" field.
+func (m *TargetingMutation) ClearAgeFrom() {
+	m.age_from = nil
+	m.addage_from = nil
+	m.clearedFields[targeting.FieldAgeFrom] = struct{}{}
+}
+
+// AgeFromCleared returns if the "age_from" field was cleared in this mutation.
+func (m *TargetingMutation) AgeFromCleared() bool {
+	_, ok := m.clearedFields[targeting.FieldAgeFrom]
+	return ok
+}
+
+// ResetAgeFrom resets all changes to the "age_from" field.
+func (m *TargetingMutation) ResetAgeFrom() {
+	m.age_from = nil
+	m.addage_from = nil
+	delete(m.clearedFields, targeting.FieldAgeFrom)
+}
+
+// SetAgeTo sets the "age_to" field.
+func (m *TargetingMutation) SetAgeTo(i int) {
+	m.age_to = &i
+	m.addage_to = nil
+}
+
+// AgeTo returns the value of the "age_to" field in the mutation.
+func (m *TargetingMutation) AgeTo() (r int, exists bool) {
+	v := m.age_to
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScore returns the old "score" field's value of the MlScore entity.
-// If the MlScore object wasn't provided to the builder, the object is fetched from the database.
+// OldAgeTo returns the old "age_to" field's value of the Targeting entity.
+// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MlScoreMutation) OldScore(ctx context.Context) (v int64, err error) {
+func (m *TargetingMutation) OldAgeTo(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldAgeTo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
+		return v, errors.New("OldAgeTo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldAgeTo: %w", err)
 	}
-	return oldValue.Score, nil
+	return oldValue.AgeTo, nil
 }
 
-// AddScore adds i to the "score" field.
-func (m *MlScoreMutation) AddScore(i int64) {
-	if m.addscore != nil {
-		*m.addscore += i
+// AddAgeTo adds i to the "age_to" field.
+func (m *TargetingMutation) AddAgeTo(i int) {
+	if m.addage_to != nil {
+		*m.addage_to += i
 	} else {
-		m.addscore = &i
+		m.addage_to = &i
 	}
 }
 
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *MlScoreMutation) AddedScore() (r int64, exists bool) {
-	v := m.addscore
+// AddedAgeTo returns the value that was added to the "age_to" field in this mutation.
+func (m *TargetingMutation) AddedAgeTo() (r int, exists bool) {
+	v := m.addage_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAgeTo clears the value of the "age_to" field.
+func (m *TargetingMutation) ClearAgeTo() {
+	m.age_to = nil
+	m.addage_to = nil
+	m.clearedFields[targeting.FieldAgeTo] = struct{}{}
+}
+
+// AgeToCleared returns if the "age_to" field was cleared in this mutation.
+func (m *TargetingMutation) AgeToCleared() bool {
+	_, ok := m.clearedFields[targeting.FieldAgeTo]
+	return ok
+}
+
+// ResetAgeTo resets all changes to the "age_to" field.
+func (m *TargetingMutation) ResetAgeTo() {
+	m.age_to = nil
+	m.addage_to = nil
+	delete(m.clearedFields, targeting.FieldAgeTo)
+}
+
+// SetLocation sets the "location" field.
+func (m *TargetingMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *TargetingMutation) Location() (r string, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Targeting entity.
+// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetingMutation) OldLocation(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *MlScoreMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
+// ClearLocation clears the value of the "location" field.
+func (m *TargetingMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[targeting.FieldLocation] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *MlScoreMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[mlscore.FieldUserID] = struct{}{}
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *TargetingMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[targeting.FieldLocation]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MlScoreMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetLocation resets all changes to the "location" field.
+func (m *TargetingMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, targeting.FieldLocation)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MlScoreMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetCampaignID sets the "campaign" edge to the Campaign entity by id.
+func (m *TargetingMutation) SetCampaignID(id uuid.UUID) {
+	m.campaign = &id
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *MlScoreMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearCampaign clears the "campaign" edge to the Campaign entity.
+func (m *TargetingMutation) ClearCampaign() {
+	m.clearedcampaign = true
 }
 
-// ClearAdvertiser clears the "advertiser" edge to the Advertiser entity.
-func (m *MlScoreMutation) ClearAdvertiser() {
-	m.clearedadvertiser = true
-	m.clearedFields[mlscore.FieldAdvertiserID] = struct{}{}
+// CampaignCleared reports if the "campaign" edge to the Campaign entity was cleared.
+func (m *TargetingMutation) CampaignCleared() bool {
+	return m.clearedcampaign
 }
 
-// AdvertiserCleared reports if the "advertiser" edge to the Advertiser entity was cleared.
-func (m *MlScoreMutation) AdvertiserCleared() bool {
-	return m.clearedadvertiser
+// CampaignID returns the "campaign" edge ID in the mutation.
+func (m *TargetingMutation) CampaignID() (id uuid.UUID, exists bool) {
+	if m.campaign != nil {
+		return *m.campaign, true
+	}
+	return
 }
 
-// AdvertiserIDs returns the "advertiser" edge IDs in the mutation.
+// CampaignIDs returns the "campaign" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AdvertiserID instead. It exists only for internal usage by the builders.
-func (m *MlScoreMutation) AdvertiserIDs() (ids []uuid.UUID) {
-	if id := m.advertiser; id != nil {
+// CampaignID instead. It exists only for internal usage by the builders.
+func (m *TargetingMutation) CampaignIDs() (ids []uuid.UUID) {
+	if id := m.campaign; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAdvertiser resets all changes to the "advertiser" edge.
-func (m *MlScoreMutation) ResetAdvertiser() {
-	m.advertiser = nil
-	m.clearedadvertiser = false
+// ResetCampaign resets all changes to the "campaign" edge.
+func (m *TargetingMutation) ResetCampaign() {
+	m.campaign = nil
+	m.clearedcampaign = false
 }
 
-// Where appends a list predicates to the MlScoreMutation builder.
-func (m *MlScoreMutation) Where(ps ...predicate.MlScore) {
+// Where appends a list predicates to the TargetingMutation builder.
+func (m *TargetingMutation) Where(ps ...predicate.Targeting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MlScoreMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TargetingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MlScoreMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MlScore, len(ps))
+func (m *TargetingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Targeting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1843,33 +8619,36 @@ func (m *MlScoreMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MlScoreMutation) Op() Op {
+func (m *TargetingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MlScoreMutation) SetOp(op Op) {
+func (m *TargetingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MlScore).
-func (m *MlScoreMutation) Type() string {
+// Type returns the node type of this mutation (Targeting).
+func (m *TargetingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MlScoreMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.user != nil {
-		fields = append(fields, mlscore.FieldUserID)
+func (m *TargetingMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.gender != nil {
+		fields = append(fields, targeting.FieldGender)
 	}
-	if m.advertiser != nil {
-		fields = append(fields, mlscore.FieldAdvertiserID)
+	if m.age_from != nil {
+		fields = append(fields, targeting.FieldAgeFrom)
 	}
-	if m.score != nil {
-		fields = append(fields, mlscore.FieldScore)
+	if m.age_to != nil {
+		fields = append(fields, targeting.FieldAgeTo)
+	}
+	if m.location != nil {
+		fields = append(fields, targeting.FieldLocation)
 	}
 	return fields
 }
@@ -1877,14 +8656,16 @@ func (m *MlScoreMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MlScoreMutation) Field(name string) (ent.Value, bool) {
+func (m *TargetingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case mlscore.FieldUserID:
-		return m.UserID()
-	case mlscore.FieldAdvertiserID:
-		return m.AdvertiserID()
-	case mlscore.FieldScore:
-		return m.Score()
+	case targeting.FieldGender:
+		return m.Gender()
+	case targeting.FieldAgeFrom:
+		return m.AgeFrom()
+	case targeting.FieldAgeTo:
+		return m.AgeTo()
+	case targeting.FieldLocation:
+		return m.Location()
 	}
 	return nil, false
 }
@@ -1892,54 +8673,66 @@ func (m *MlScoreMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MlScoreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TargetingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case mlscore.FieldUserID:
-		return m.OldUserID(ctx)
-	case mlscore.FieldAdvertiserID:
-		return m.OldAdvertiserID(ctx)
-	case mlscore.FieldScore:
-		return m.OldScore(ctx)
+	case targeting.FieldGender:
+		return m.OldGender(ctx)
+	case targeting.FieldAgeFrom:
+		return m.OldAgeFrom(ctx)
+	case targeting.FieldAgeTo:
+		return m.OldAgeTo(ctx)
+	case targeting.FieldLocation:
+		return m.OldLocation(ctx)
 	}
-	return nil, fmt.Errorf("unknown MlScore field %s", name)
+	return nil, fmt.Errorf("unknown Targeting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MlScoreMutation) SetField(name string, value ent.Value) error {
+func (m *TargetingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case mlscore.FieldUserID:
-		v, ok := value.(uuid.UUID)
+	case targeting.FieldGender:
+		v, ok := value.(targeting.Gender)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetGender(v)
 		return nil
-	case mlscore.FieldAdvertiserID:
-		v, ok := value.(uuid.UUID)
+	case targeting.FieldAgeFrom:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAdvertiserID(v)
+		m.SetAgeFrom(v)
 		return nil
-	case mlscore.FieldScore:
-		v, ok := value.(int64)
+	case targeting.FieldAgeTo:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScore(v)
+		m.SetAgeTo(v)
+		return nil
+	case targeting.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MlScore field %s", name)
+	return fmt.Errorf("unknown Targeting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MlScoreMutation) AddedFields() []string {
+func (m *TargetingMutation) AddedFields() []string {
 	var fields []string
-	if m.addscore != nil {
-		fields = append(fields, mlscore.FieldScore)
+	if m.addage_from != nil {
+		fields = append(fields, targeting.FieldAgeFrom)
+	}
+	if m.addage_to != nil {
+		fields = append(fields, targeting.FieldAgeTo)
 	}
 	return fields
 }
@@ -1947,10 +8740,12 @@ func (m *MlScoreMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MlScoreMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TargetingMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case mlscore.FieldScore:
-		return m.AddedScore()
+	case targeting.FieldAgeFrom:
+		return m.AddedAgeFrom()
+	case targeting.FieldAgeTo:
+		return m.AddedAgeTo()
 	}
 	return nil, false
 }
@@ -1958,77 +8753,107 @@ func (m *MlScoreMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MlScoreMutation) AddField(name string, value ent.Value) error {
+func (m *TargetingMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case mlscore.FieldScore:
-		v, ok := value.(int64)
+	case targeting.FieldAgeFrom:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddScore(v)
+		m.AddAgeFrom(v)
+		return nil
+	case targeting.FieldAgeTo:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAgeTo(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MlScore numeric field %s", name)
+	return fmt.Errorf("unknown Targeting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MlScoreMutation) ClearedFields() []string {
-	return nil
+func (m *TargetingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(targeting.FieldGender) {
+		fields = append(fields, targeting.FieldGender)
+	}
+	if m.FieldCleared(targeting.FieldAgeFrom) {
+		fields = append(fields, targeting.FieldAgeFrom)
+	}
+	if m.FieldCleared(targeting.FieldAgeTo) {
+		fields = append(fields, targeting.FieldAgeTo)
+	}
+	if m.FieldCleared(targeting.FieldLocation) {
+		fields = append(fields, targeting.FieldLocation)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MlScoreMutation) FieldCleared(name string) bool {
+func (m *TargetingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MlScoreMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MlScore nullable field %s", name)
+func (m *TargetingMutation) ClearField(name string) error {
+	switch name {
+	case targeting.FieldGender:
+		m.ClearGender()
+		return nil
+	case targeting.FieldAgeFrom:
+		m.ClearAgeFrom()
+		return nil
+	case targeting.FieldAgeTo:
+		m.ClearAgeTo()
+		return nil
+	case targeting.FieldLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown Targeting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MlScoreMutation) ResetField(name string) error {
+func (m *TargetingMutation) ResetField(name string) error {
 	switch name {
-	case mlscore.FieldUserID:
-		m.ResetUserID()
+	case targeting.FieldGender:
+		m.ResetGender()
 		return nil
-	case mlscore.FieldAdvertiserID:
-		m.ResetAdvertiserID()
+	case targeting.FieldAgeFrom:
+		m.ResetAgeFrom()
 		return nil
-	case mlscore.FieldScore:
-		m.ResetScore()
+	case targeting.FieldAgeTo:
+		m.ResetAgeTo()
+		return nil
+	case targeting.FieldLocation:
+		m.ResetLocation()
 		return nil
 	}
-	return fmt.Errorf("unknown MlScore field %s", name)
+	return fmt.Errorf("unknown Targeting field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MlScoreMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, mlscore.EdgeUser)
-	}
-	if m.advertiser != nil {
-		edges = append(edges, mlscore.EdgeAdvertiser)
+func (m *TargetingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.campaign != nil {
+		edges = append(edges, targeting.EdgeCampaign)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MlScoreMutation) AddedIDs(name string) []ent.Value {
+func (m *TargetingMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case mlscore.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case mlscore.EdgeAdvertiser:
-		if id := m.advertiser; id != nil {
+	case targeting.EdgeCampaign:
+		if id := m.campaign; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2036,100 +8861,88 @@ func (m *MlScoreMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MlScoreMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TargetingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MlScoreMutation) RemovedIDs(name string) []ent.Value {
+func (m *TargetingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MlScoreMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, mlscore.EdgeUser)
-	}
-	if m.clearedadvertiser {
-		edges = append(edges, mlscore.EdgeAdvertiser)
+func (m *TargetingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcampaign {
+		edges = append(edges, targeting.EdgeCampaign)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MlScoreMutation) EdgeCleared(name string) bool {
+func (m *TargetingMutation) EdgeCleared(name string) bool {
 	switch name {
-	case mlscore.EdgeUser:
-		return m.cleareduser
-	case mlscore.EdgeAdvertiser:
-		return m.clearedadvertiser
+	case targeting.EdgeCampaign:
+		return m.clearedcampaign
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MlScoreMutation) ClearEdge(name string) error {
+func (m *TargetingMutation) ClearEdge(name string) error {
 	switch name {
-	case mlscore.EdgeUser:
-		m.ClearUser()
-		return nil
-	case mlscore.EdgeAdvertiser:
-		m.ClearAdvertiser()
+	case targeting.EdgeCampaign:
+		m.ClearCampaign()
 		return nil
 	}
-	return fmt.Errorf("unknown MlScore unique edge %s", name)
+	return fmt.Errorf("unknown Targeting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MlScoreMutation) ResetEdge(name string) error {
+func (m *TargetingMutation) ResetEdge(name string) error {
 	switch name {
-	case mlscore.EdgeUser:
-		m.ResetUser()
-		return nil
-	case mlscore.EdgeAdvertiser:
-		m.ResetAdvertiser()
+	case targeting.EdgeCampaign:
+		m.ResetCampaign()
 		return nil
 	}
-	return fmt.Errorf("unknown MlScore edge %s", name)
+	return fmt.Errorf("unknown Targeting edge %s", name)
 }
 
-// TargetingMutation represents an operation that mutates the Targeting nodes in the graph.
-type TargetingMutation struct {
+// TelegramAccountMutation represents an operation that mutates the TelegramAccount nodes in the graph.
+type TelegramAccountMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	gender          *targeting.Gender
-	age_from        *int
-	addage_from     *int
-	age_to          *int
-	addage_to       *int
-	location        *string
-	clearedFields   map[string]struct{}
-	campaign        *uuid.UUID
-	clearedcampaign bool
-	done            bool
-	oldValue        func(context.Context) (*Targeting, error)
-	predicates      []predicate.Targeting
-}
-
-var _ ent.Mutation = (*TargetingMutation)(nil)
-
-// targetingOption allows management of the mutation configuration using functional options.
-type targetingOption func(*TargetingMutation)
-
-// newTargetingMutation creates new mutation for the Targeting entity.
-func newTargetingMutation(c config, op Op, opts ...targetingOption) *TargetingMutation {
-	m := &TargetingMutation{
+	op             Op
+	typ            string
+	id             *int
+	telegram_id    *int64
+	addtelegram_id *int64
+	chat_id        *int64
+	addchat_id     *int64
+	advertiser_id  *uuid.UUID
+	api_key_id     *uuid.UUID
+	linked_at      *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*TelegramAccount, error)
+	predicates     []predicate.TelegramAccount
+}
+
+var _ ent.Mutation = (*TelegramAccountMutation)(nil)
+
+// telegramaccountOption allows management of the mutation configuration using functional options.
+type telegramaccountOption func(*TelegramAccountMutation)
+
+// newTelegramAccountMutation creates new mutation for the TelegramAccount entity.
+func newTelegramAccountMutation(c config, op Op, opts ...telegramaccountOption) *TelegramAccountMutation {
+	m := &TelegramAccountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTargeting,
+		typ:           TypeTelegramAccount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2138,20 +8951,20 @@ func newTargetingMutation(c config, op Op, opts ...targetingOption) *TargetingMu
 	return m
 }
 
-// withTargetingID sets the ID field of the mutation.
-func withTargetingID(id int) targetingOption {
-	return func(m *TargetingMutation) {
+// withTelegramAccountID sets the ID field of the mutation.
+func withTelegramAccountID(id int) telegramaccountOption {
+	return func(m *TelegramAccountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Targeting
+			value *TelegramAccount
 		)
-		m.oldValue = func(ctx context.Context) (*Targeting, error) {
+		m.oldValue = func(ctx context.Context) (*TelegramAccount, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Targeting.Get(ctx, id)
+					value, err = m.Client().TelegramAccount.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2160,10 +8973,10 @@ func withTargetingID(id int) targetingOption {
 	}
 }
 
-// withTargeting sets the old Targeting of the mutation.
-func withTargeting(node *Targeting) targetingOption {
-	return func(m *TargetingMutation) {
-		m.oldValue = func(context.Context) (*Targeting, error) {
+// withTelegramAccount sets the old TelegramAccount of the mutation.
+func withTelegramAccount(node *TelegramAccount) telegramaccountOption {
+	return func(m *TelegramAccountMutation) {
+		m.oldValue = func(context.Context) (*TelegramAccount, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2172,7 +8985,7 @@ func withTargeting(node *Targeting) targetingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TargetingMutation) Client() *Client {
+func (m TelegramAccountMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2180,7 +8993,7 @@ func (m TargetingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TargetingMutation) Tx() (*Tx, error) {
+func (m TelegramAccountMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2191,7 +9004,7 @@ func (m TargetingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TargetingMutation) ID() (id int, exists bool) {
+func (m *TelegramAccountMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2202,7 +9015,7 @@ func (m *TargetingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TargetingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TelegramAccountMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2211,298 +9024,241 @@ func (m *TargetingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Targeting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TelegramAccount.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetGender sets the "gender" field.
-func (m *TargetingMutation) SetGender(t targeting.Gender) {
-	m.gender = &t
-}
-
-// Gender returns the value of the "gender" field in the mutation.
-func (m *TargetingMutation) Gender() (r targeting.Gender, exists bool) {
-	v := m.gender
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldGender returns the old "gender" field's value of the Targeting entity.
-// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetingMutation) OldGender(ctx context.Context) (v *targeting.Gender, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGender is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGender requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGender: %w", err)
-	}
-	return oldValue.Gender, nil
-}
-
-// ClearGender clears the value of the "gender" field.
-func (m *TargetingMutation) ClearGender() {
-	m.gender = nil
-	m.clearedFields[targeting.FieldGender] = struct{}{}
-}
-
-// GenderCleared returns if the "gender" field was cleared in this mutation.
-func (m *TargetingMutation) GenderCleared() bool {
-	_, ok := m.clearedFields[targeting.FieldGender]
-	return ok
-}
-
-// ResetGender resets all changes to the "gender" field.
-func (m *TargetingMutation) ResetGender() {
-	m.gender = nil
-	delete(m.clearedFields, targeting.FieldGender)
-}
-
-// SetAgeFrom sets the "age_from" field.
-func (m *TargetingMutation) SetAgeFrom(i int) {
-	m.age_from = &i
-	m.addage_from = nil
+// SetTelegramID sets the "telegram_id" field.
+func (m *TelegramAccountMutation) SetTelegramID(i int64) {
+	m.telegram_id = &i
+	m.addtelegram_id = nil
 }
 
-// AgeFrom returns the value of the "age_from" field in the mutation.
-func (m *TargetingMutation) AgeFrom() (r int, exists bool) {
-	v := m.age_from
+// TelegramID returns the value of the "telegram_id" field in the mutation.
+func (m *TelegramAccountMutation) TelegramID() (r int64, exists bool) {
+	v := m.telegram_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgeFrom returns the old "age_from" field's value of the Targeting entity.
-// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
+// OldTelegramID returns the old "telegram_id" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetingMutation) OldAgeFrom(ctx context.Context) (v *int, err error) {
+func (m *TelegramAccountMutation) OldTelegramID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgeFrom is only allowed on UpdateOne operations")
+		return v, errors.New("OldTelegramID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgeFrom requires an ID field in the mutation")
+		return v, errors.New("OldTelegramID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgeFrom: %w", err)
+		return v, fmt.Errorf("querying old value for OldTelegramID: %w", err)
 	}
-	return oldValue.AgeFrom, nil
+	return oldValue.TelegramID, nil
 }
 
-// AddAgeFrom adds i to the "age_from" field.
-func (m *TargetingMutation) AddAgeFrom(i int) {
-	if m.addage_from != nil {
-		*m.addage_from += i
+// AddTelegramID adds i to the "telegram_id" field.
+func (m *TelegramAccountMutation) AddTelegramID(i int64) {
+	if m.addtelegram_id != nil {
+		*m.addtelegram_id += i
 	} else {
-		m.addage_from = &i
+		m.addtelegram_id = &i
 	}
 }
 
-// AddedAgeFrom returns the value that was added to the "age_from" field in this mutation.
-func (m *TargetingMutation) AddedAgeFrom() (r int, exists bool) {
-	v := m.addage_from
+// AddedTelegramID returns the value that was added to the "telegram_id" field in this mutation.
+func (m *TelegramAccountMutation) AddedTelegramID() (r int64, exists bool) {
+	v := m.addtelegram_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearAgeFrom clears the value of the "age_from" field.
-func (m *TargetingMutation) ClearAgeFrom() {
-	m.age_from = nil
-	m.addage_from = nil
-	m.clearedFields[targeting.FieldAgeFrom] = struct{}{}
-}
-
-// AgeFromCleared returns if the "age_from" field was cleared in this mutation.
-func (m *TargetingMutation) AgeFromCleared() bool {
-	_, ok := m.clearedFields[targeting.FieldAgeFrom]
-	return ok
-}
-
-// ResetAgeFrom resets all changes to the "age_from" field.
-func (m *TargetingMutation) ResetAgeFrom() {
-	m.age_from = nil
-	m.addage_from = nil
-	delete(m.clearedFields, targeting.FieldAgeFrom)
+// ResetTelegramID resets all changes to the "telegram_id" field.
+func (m *TelegramAccountMutation) ResetTelegramID() {
+	m.telegram_id = nil
+	m.addtelegram_id = nil
 }
 
-// SetAgeTo sets the "age_to" field.
-func (m *TargetingMutation) SetAgeTo(i int) {
-	m.age_to = &i
-	m.addage_to = nil
+// SetChatID sets the "chat_id" field.
+func (m *TelegramAccountMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
 }
 
-// AgeTo returns the value of the "age_to" field in the mutation.
-func (m *TargetingMutation) AgeTo() (r int, exists bool) {
-	v := m.age_to
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *TelegramAccountMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgeTo returns the old "age_to" field's value of the Targeting entity.
-// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetingMutation) OldAgeTo(ctx context.Context) (v *int, err error) {
+func (m *TelegramAccountMutation) OldChatID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgeTo is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgeTo requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgeTo: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.AgeTo, nil
+	return oldValue.ChatID, nil
 }
 
-// AddAgeTo adds i to the "age_to" field.
-func (m *TargetingMutation) AddAgeTo(i int) {
-	if m.addage_to != nil {
-		*m.addage_to += i
+// AddChatID adds i to the "chat_id" field.
+func (m *TelegramAccountMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
 	} else {
-		m.addage_to = &i
+		m.addchat_id = &i
 	}
 }
 
-// AddedAgeTo returns the value that was added to the "age_to" field in this mutation.
-func (m *TargetingMutation) AddedAgeTo() (r int, exists bool) {
-	v := m.addage_to
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *TelegramAccountMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearAgeTo clears the value of the "age_to" field.
-func (m *TargetingMutation) ClearAgeTo() {
-	m.age_to = nil
-	m.addage_to = nil
-	m.clearedFields[targeting.FieldAgeTo] = struct{}{}
-}
-
-// AgeToCleared returns if the "age_to" field was cleared in this mutation.
-func (m *TargetingMutation) AgeToCleared() bool {
-	_, ok := m.clearedFields[targeting.FieldAgeTo]
-	return ok
-}
-
-// ResetAgeTo resets all changes to the "age_to" field.
-func (m *TargetingMutation) ResetAgeTo() {
-	m.age_to = nil
-	m.addage_to = nil
-	delete(m.clearedFields, targeting.FieldAgeTo)
-}
-
-// SetLocation sets the "location" field.
-func (m *TargetingMutation) SetLocation(s string) {
-	m.location = &s
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *TelegramAccountMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *TargetingMutation) Location() (r string, exists bool) {
-	v := m.location
+// SetAdvertiserID sets the "advertiser_id" field.
+func (m *TelegramAccountMutation) SetAdvertiserID(u uuid.UUID) {
+	m.advertiser_id = &u
+}
+
+// AdvertiserID returns the value of the "advertiser_id" field in the mutation.
+func (m *TelegramAccountMutation) AdvertiserID() (r uuid.UUID, exists bool) {
+	v := m.advertiser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the Targeting entity.
-// If the Targeting object wasn't provided to the builder, the object is fetched from the database.
+// OldAdvertiserID returns the old "advertiser_id" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetingMutation) OldLocation(ctx context.Context) (v *string, err error) {
+func (m *TelegramAccountMutation) OldAdvertiserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdvertiserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+		return v, errors.New("OldAdvertiserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdvertiserID: %w", err)
 	}
-	return oldValue.Location, nil
+	return oldValue.AdvertiserID, nil
 }
 
-// ClearLocation clears the value of the "location" field.
-func (m *TargetingMutation) ClearLocation() {
-	m.location = nil
-	m.clearedFields[targeting.FieldLocation] = struct{}{}
+// ResetAdvertiserID resets all changes to the "advertiser_id" field.
+func (m *TelegramAccountMutation) ResetAdvertiserID() {
+	m.advertiser_id = nil
 }
 
-// LocationCleared returns if the "location" field was cleared in this mutation.
-func (m *TargetingMutation) LocationCleared() bool {
-	_, ok := m.clearedFields[targeting.FieldLocation]
-	return ok
+// SetAPIKeyID sets the "api_key_id" field.
+func (m *TelegramAccountMutation) SetAPIKeyID(u uuid.UUID) {
+	m.api_key_id = &u
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *TargetingMutation) ResetLocation() {
-	m.location = nil
-	delete(m.clearedFields, targeting.FieldLocation)
+// APIKeyID returns the value of the "api_key_id" field in the mutation.
+func (m *TelegramAccountMutation) APIKeyID() (r uuid.UUID, exists bool) {
+	v := m.api_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCampaignID sets the "campaign" edge to the Campaign entity by id.
-func (m *TargetingMutation) SetCampaignID(id uuid.UUID) {
-	m.campaign = &id
+// OldAPIKeyID returns the old "api_key_id" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldAPIKeyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyID: %w", err)
+	}
+	return oldValue.APIKeyID, nil
 }
 
-// ClearCampaign clears the "campaign" edge to the Campaign entity.
-func (m *TargetingMutation) ClearCampaign() {
-	m.clearedcampaign = true
+// ResetAPIKeyID resets all changes to the "api_key_id" field.
+func (m *TelegramAccountMutation) ResetAPIKeyID() {
+	m.api_key_id = nil
 }
 
-// CampaignCleared reports if the "campaign" edge to the Campaign entity was cleared.
-func (m *TargetingMutation) CampaignCleared() bool {
-	return m.clearedcampaign
+// SetLinkedAt sets the "linked_at" field.
+func (m *TelegramAccountMutation) SetLinkedAt(t time.Time) {
+	m.linked_at = &t
 }
 
-// CampaignID returns the "campaign" edge ID in the mutation.
-func (m *TargetingMutation) CampaignID() (id uuid.UUID, exists bool) {
-	if m.campaign != nil {
-		return *m.campaign, true
+// LinkedAt returns the value of the "linked_at" field in the mutation.
+func (m *TelegramAccountMutation) LinkedAt() (r time.Time, exists bool) {
+	v := m.linked_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CampaignIDs returns the "campaign" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CampaignID instead. It exists only for internal usage by the builders.
-func (m *TargetingMutation) CampaignIDs() (ids []uuid.UUID) {
-	if id := m.campaign; id != nil {
-		ids = append(ids, *id)
+// OldLinkedAt returns the old "linked_at" field's value of the TelegramAccount entity.
+// If the TelegramAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramAccountMutation) OldLinkedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkedAt: %w", err)
+	}
+	return oldValue.LinkedAt, nil
 }
 
-// ResetCampaign resets all changes to the "campaign" edge.
-func (m *TargetingMutation) ResetCampaign() {
-	m.campaign = nil
-	m.clearedcampaign = false
+// ResetLinkedAt resets all changes to the "linked_at" field.
+func (m *TelegramAccountMutation) ResetLinkedAt() {
+	m.linked_at = nil
 }
 
-// Where appends a list predicates to the TargetingMutation builder.
-func (m *TargetingMutation) Where(ps ...predicate.Targeting) {
+// Where appends a list predicates to the TelegramAccountMutation builder.
+func (m *TelegramAccountMutation) Where(ps ...predicate.TelegramAccount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TargetingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TelegramAccountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TargetingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Targeting, len(ps))
+func (m *TelegramAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramAccount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2510,36 +9266,39 @@ func (m *TargetingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TargetingMutation) Op() Op {
+func (m *TelegramAccountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TargetingMutation) SetOp(op Op) {
+func (m *TelegramAccountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Targeting).
-func (m *TargetingMutation) Type() string {
+// Type returns the node type of this mutation (TelegramAccount).
+func (m *TelegramAccountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TargetingMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.gender != nil {
-		fields = append(fields, targeting.FieldGender)
+func (m *TelegramAccountMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.telegram_id != nil {
+		fields = append(fields, telegramaccount.FieldTelegramID)
 	}
-	if m.age_from != nil {
-		fields = append(fields, targeting.FieldAgeFrom)
+	if m.chat_id != nil {
+		fields = append(fields, telegramaccount.FieldChatID)
 	}
-	if m.age_to != nil {
-		fields = append(fields, targeting.FieldAgeTo)
+	if m.advertiser_id != nil {
+		fields = append(fields, telegramaccount.FieldAdvertiserID)
 	}
-	if m.location != nil {
-		fields = append(fields, targeting.FieldLocation)
+	if m.api_key_id != nil {
+		fields = append(fields, telegramaccount.FieldAPIKeyID)
+	}
+	if m.linked_at != nil {
+		fields = append(fields, telegramaccount.FieldLinkedAt)
 	}
 	return fields
 }
@@ -2547,16 +9306,18 @@ func (m *TargetingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TargetingMutation) Field(name string) (ent.Value, bool) {
+func (m *TelegramAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case targeting.FieldGender:
-		return m.Gender()
-	case targeting.FieldAgeFrom:
-		return m.AgeFrom()
-	case targeting.FieldAgeTo:
-		return m.AgeTo()
-	case targeting.FieldLocation:
-		return m.Location()
+	case telegramaccount.FieldTelegramID:
+		return m.TelegramID()
+	case telegramaccount.FieldChatID:
+		return m.ChatID()
+	case telegramaccount.FieldAdvertiserID:
+		return m.AdvertiserID()
+	case telegramaccount.FieldAPIKeyID:
+		return m.APIKeyID()
+	case telegramaccount.FieldLinkedAt:
+		return m.LinkedAt()
 	}
 	return nil, false
 }
@@ -2564,66 +9325,75 @@ func (m *TargetingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TargetingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TelegramAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case targeting.FieldGender:
-		return m.OldGender(ctx)
-	case targeting.FieldAgeFrom:
-		return m.OldAgeFrom(ctx)
-	case targeting.FieldAgeTo:
-		return m.OldAgeTo(ctx)
-	case targeting.FieldLocation:
-		return m.OldLocation(ctx)
+	case telegramaccount.FieldTelegramID:
+		return m.OldTelegramID(ctx)
+	case telegramaccount.FieldChatID:
+		return m.OldChatID(ctx)
+	case telegramaccount.FieldAdvertiserID:
+		return m.OldAdvertiserID(ctx)
+	case telegramaccount.FieldAPIKeyID:
+		return m.OldAPIKeyID(ctx)
+	case telegramaccount.FieldLinkedAt:
+		return m.OldLinkedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Targeting field %s", name)
+	return nil, fmt.Errorf("unknown TelegramAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TargetingMutation) SetField(name string, value ent.Value) error {
+func (m *TelegramAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case targeting.FieldGender:
-		v, ok := value.(targeting.Gender)
+	case telegramaccount.FieldTelegramID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGender(v)
+		m.SetTelegramID(v)
 		return nil
-	case targeting.FieldAgeFrom:
-		v, ok := value.(int)
+	case telegramaccount.FieldChatID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgeFrom(v)
+		m.SetChatID(v)
 		return nil
-	case targeting.FieldAgeTo:
-		v, ok := value.(int)
+	case telegramaccount.FieldAdvertiserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgeTo(v)
+		m.SetAdvertiserID(v)
 		return nil
-	case targeting.FieldLocation:
-		v, ok := value.(string)
+	case telegramaccount.FieldAPIKeyID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetAPIKeyID(v)
+		return nil
+	case telegramaccount.FieldLinkedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Targeting field %s", name)
+	return fmt.Errorf("unknown TelegramAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TargetingMutation) AddedFields() []string {
+func (m *TelegramAccountMutation) AddedFields() []string {
 	var fields []string
-	if m.addage_from != nil {
-		fields = append(fields, targeting.FieldAgeFrom)
+	if m.addtelegram_id != nil {
+		fields = append(fields, telegramaccount.FieldTelegramID)
 	}
-	if m.addage_to != nil {
-		fields = append(fields, targeting.FieldAgeTo)
+	if m.addchat_id != nil {
+		fields = append(fields, telegramaccount.FieldChatID)
 	}
 	return fields
 }
@@ -2631,12 +9401,12 @@ func (m *TargetingMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TargetingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TelegramAccountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case targeting.FieldAgeFrom:
-		return m.AddedAgeFrom()
-	case targeting.FieldAgeTo:
-		return m.AddedAgeTo()
+	case telegramaccount.FieldTelegramID:
+		return m.AddedTelegramID()
+	case telegramaccount.FieldChatID:
+		return m.AddedChatID()
 	}
 	return nil, false
 }
@@ -2644,164 +9414,114 @@ func (m *TargetingMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TargetingMutation) AddField(name string, value ent.Value) error {
+func (m *TelegramAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case targeting.FieldAgeFrom:
-		v, ok := value.(int)
+	case telegramaccount.FieldTelegramID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAgeFrom(v)
+		m.AddTelegramID(v)
 		return nil
-	case targeting.FieldAgeTo:
-		v, ok := value.(int)
+	case telegramaccount.FieldChatID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAgeTo(v)
+		m.AddChatID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Targeting numeric field %s", name)
+	return fmt.Errorf("unknown TelegramAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TargetingMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(targeting.FieldGender) {
-		fields = append(fields, targeting.FieldGender)
-	}
-	if m.FieldCleared(targeting.FieldAgeFrom) {
-		fields = append(fields, targeting.FieldAgeFrom)
-	}
-	if m.FieldCleared(targeting.FieldAgeTo) {
-		fields = append(fields, targeting.FieldAgeTo)
-	}
-	if m.FieldCleared(targeting.FieldLocation) {
-		fields = append(fields, targeting.FieldLocation)
-	}
-	return fields
+func (m *TelegramAccountMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TargetingMutation) FieldCleared(name string) bool {
+func (m *TelegramAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TargetingMutation) ClearField(name string) error {
-	switch name {
-	case targeting.FieldGender:
-		m.ClearGender()
-		return nil
-	case targeting.FieldAgeFrom:
-		m.ClearAgeFrom()
-		return nil
-	case targeting.FieldAgeTo:
-		m.ClearAgeTo()
-		return nil
-	case targeting.FieldLocation:
-		m.ClearLocation()
-		return nil
-	}
-	return fmt.Errorf("unknown Targeting nullable field %s", name)
+func (m *TelegramAccountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TargetingMutation) ResetField(name string) error {
+func (m *TelegramAccountMutation) ResetField(name string) error {
 	switch name {
-	case targeting.FieldGender:
-		m.ResetGender()
+	case telegramaccount.FieldTelegramID:
+		m.ResetTelegramID()
 		return nil
-	case targeting.FieldAgeFrom:
-		m.ResetAgeFrom()
+	case telegramaccount.FieldChatID:
+		m.ResetChatID()
 		return nil
-	case targeting.FieldAgeTo:
-		m.ResetAgeTo()
+	case telegramaccount.FieldAdvertiserID:
+		m.ResetAdvertiserID()
 		return nil
-	case targeting.FieldLocation:
-		m.ResetLocation()
+	case telegramaccount.FieldAPIKeyID:
+		m.ResetAPIKeyID()
+		return nil
+	case telegramaccount.FieldLinkedAt:
+		m.ResetLinkedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Targeting field %s", name)
+	return fmt.Errorf("unknown TelegramAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TargetingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.campaign != nil {
-		edges = append(edges, targeting.EdgeCampaign)
-	}
+func (m *TelegramAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TargetingMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case targeting.EdgeCampaign:
-		if id := m.campaign; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TelegramAccountMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TargetingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TelegramAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TargetingMutation) RemovedIDs(name string) []ent.Value {
+func (m *TelegramAccountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TargetingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcampaign {
-		edges = append(edges, targeting.EdgeCampaign)
-	}
+func (m *TelegramAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TargetingMutation) EdgeCleared(name string) bool {
-	switch name {
-	case targeting.EdgeCampaign:
-		return m.clearedcampaign
-	}
+func (m *TelegramAccountMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TargetingMutation) ClearEdge(name string) error {
-	switch name {
-	case targeting.EdgeCampaign:
-		m.ClearCampaign()
-		return nil
-	}
-	return fmt.Errorf("unknown Targeting unique edge %s", name)
+func (m *TelegramAccountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TargetingMutation) ResetEdge(name string) error {
-	switch name {
-	case targeting.EdgeCampaign:
-		m.ResetCampaign()
-		return nil
-	}
-	return fmt.Errorf("unknown Targeting edge %s", name)
+func (m *TelegramAccountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramAccount edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
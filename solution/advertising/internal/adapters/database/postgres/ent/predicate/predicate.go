@@ -6,17 +6,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // Advertiser is the predicate function for advertiser builders.
 type Advertiser func(*sql.Selector)
 
+// AuditEntry is the predicate function for auditentry builders.
+type AuditEntry func(*sql.Selector)
+
 // Campaign is the predicate function for campaign builders.
 type Campaign func(*sql.Selector)
 
+// CampaignDailySpend is the predicate function for campaigndailyspend builders.
+type CampaignDailySpend func(*sql.Selector)
+
+// ClientErasure is the predicate function for clienterasure builders.
+type ClientErasure func(*sql.Selector)
+
+// LedgerEntry is the predicate function for ledgerentry builders.
+type LedgerEntry func(*sql.Selector)
+
 // MlScore is the predicate function for mlscore builders.
 type MlScore func(*sql.Selector)
 
+// ModerationDecision is the predicate function for moderationdecision builders.
+type ModerationDecision func(*sql.Selector)
+
 // Targeting is the predicate function for targeting builders.
 type Targeting func(*sql.Selector)
 
+// TelegramAccount is the predicate function for telegramaccount builders.
+type TelegramAccount func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -4,9 +4,17 @@ package ent
 
 import (
 	"nlypage-final/internal/adapters/database/postgres/ent/advertiser"
+	"nlypage-final/internal/adapters/database/postgres/ent/apikey"
+	"nlypage-final/internal/adapters/database/postgres/ent/auditentry"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/ledgerentry"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
 	"nlypage-final/internal/adapters/database/postgres/ent/schema"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
 	"nlypage-final/internal/adapters/database/postgres/ent/user"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,16 +23,40 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[6].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
 	advertiserFields := schema.Advertiser{}.Fields()
 	_ = advertiserFields
 	// advertiserDescName is the schema descriptor for name field.
 	advertiserDescName := advertiserFields[1].Descriptor()
 	// advertiser.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	advertiser.NameValidator = advertiserDescName.Validators[0].(func(string) error)
+	// advertiserDescBalance is the schema descriptor for balance field.
+	advertiserDescBalance := advertiserFields[2].Descriptor()
+	// advertiser.DefaultBalance holds the default value on creation for the balance field.
+	advertiser.DefaultBalance = advertiserDescBalance.Default.(float64)
 	// advertiserDescID is the schema descriptor for id field.
 	advertiserDescID := advertiserFields[0].Descriptor()
 	// advertiser.DefaultID holds the default value on creation for the id field.
 	advertiser.DefaultID = advertiserDescID.Default.(func() uuid.UUID)
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryFields[7].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
 	campaignFields := schema.Campaign{}.Fields()
 	_ = campaignFields
 	// campaignDescImpressionsLimit is the schema descriptor for impressions_limit field.
@@ -35,26 +67,80 @@ func init() {
 	campaignDescClicksLimit := campaignFields[3].Descriptor()
 	// campaign.ClicksLimitValidator is a validator for the "clicks_limit" field. It is called by the builders before save.
 	campaign.ClicksLimitValidator = campaignDescClicksLimit.Validators[0].(func(int) error)
+	// campaignDescCostPerAction is the schema descriptor for cost_per_action field.
+	campaignDescCostPerAction := campaignFields[6].Descriptor()
+	// campaign.DefaultCostPerAction holds the default value on creation for the cost_per_action field.
+	campaign.DefaultCostPerAction = campaignDescCostPerAction.Default.(float64)
+	// campaignDescSpent is the schema descriptor for spent field.
+	campaignDescSpent := campaignFields[9].Descriptor()
+	// campaign.DefaultSpent holds the default value on creation for the spent field.
+	campaign.DefaultSpent = campaignDescSpent.Default.(float64)
 	// campaignDescAdTitle is the schema descriptor for ad_title field.
-	campaignDescAdTitle := campaignFields[6].Descriptor()
+	campaignDescAdTitle := campaignFields[10].Descriptor()
 	// campaign.AdTitleValidator is a validator for the "ad_title" field. It is called by the builders before save.
 	campaign.AdTitleValidator = campaignDescAdTitle.Validators[0].(func(string) error)
 	// campaignDescAdText is the schema descriptor for ad_text field.
-	campaignDescAdText := campaignFields[7].Descriptor()
+	campaignDescAdText := campaignFields[11].Descriptor()
 	// campaign.AdTextValidator is a validator for the "ad_text" field. It is called by the builders before save.
 	campaign.AdTextValidator = campaignDescAdText.Validators[0].(func(string) error)
 	// campaignDescStartDate is the schema descriptor for start_date field.
-	campaignDescStartDate := campaignFields[9].Descriptor()
+	campaignDescStartDate := campaignFields[14].Descriptor()
 	// campaign.StartDateValidator is a validator for the "start_date" field. It is called by the builders before save.
 	campaign.StartDateValidator = campaignDescStartDate.Validators[0].(func(int) error)
 	// campaignDescEndDate is the schema descriptor for end_date field.
-	campaignDescEndDate := campaignFields[10].Descriptor()
+	campaignDescEndDate := campaignFields[15].Descriptor()
 	// campaign.EndDateValidator is a validator for the "end_date" field. It is called by the builders before save.
 	campaign.EndDateValidator = campaignDescEndDate.Validators[0].(func(int) error)
+	// campaignDescAiReviewAttempts is the schema descriptor for ai_review_attempts field.
+	campaignDescAiReviewAttempts := campaignFields[28].Descriptor()
+	// campaign.DefaultAiReviewAttempts holds the default value on creation for the ai_review_attempts field.
+	campaign.DefaultAiReviewAttempts = campaignDescAiReviewAttempts.Default.(int)
+	// campaignDescPaused is the schema descriptor for paused field.
+	campaignDescPaused := campaignFields[30].Descriptor()
+	// campaign.DefaultPaused holds the default value on creation for the paused field.
+	campaign.DefaultPaused = campaignDescPaused.Default.(bool)
 	// campaignDescID is the schema descriptor for id field.
 	campaignDescID := campaignFields[0].Descriptor()
 	// campaign.DefaultID holds the default value on creation for the id field.
 	campaign.DefaultID = campaignDescID.Default.(func() uuid.UUID)
+	campaigndailyspendFields := schema.CampaignDailySpend{}.Fields()
+	_ = campaigndailyspendFields
+	// campaigndailyspendDescAmount is the schema descriptor for amount field.
+	campaigndailyspendDescAmount := campaigndailyspendFields[3].Descriptor()
+	// campaigndailyspend.DefaultAmount holds the default value on creation for the amount field.
+	campaigndailyspend.DefaultAmount = campaigndailyspendDescAmount.Default.(float64)
+	clienterasureFields := schema.ClientErasure{}.Fields()
+	_ = clienterasureFields
+	// clienterasureDescMlScores is the schema descriptor for ml_scores field.
+	clienterasureDescMlScores := clienterasureFields[1].Descriptor()
+	// clienterasure.DefaultMlScores holds the default value on creation for the ml_scores field.
+	clienterasure.DefaultMlScores = clienterasureDescMlScores.Default.(int)
+	// clienterasureDescCachedAds is the schema descriptor for cached_ads field.
+	clienterasureDescCachedAds := clienterasureFields[2].Descriptor()
+	// clienterasure.DefaultCachedAds holds the default value on creation for the cached_ads field.
+	clienterasure.DefaultCachedAds = clienterasureDescCachedAds.Default.(int)
+	// clienterasureDescRequestedAt is the schema descriptor for requested_at field.
+	clienterasureDescRequestedAt := clienterasureFields[3].Descriptor()
+	// clienterasure.DefaultRequestedAt holds the default value on creation for the requested_at field.
+	clienterasure.DefaultRequestedAt = clienterasureDescRequestedAt.Default.(func() time.Time)
+	ledgerentryFields := schema.LedgerEntry{}.Fields()
+	_ = ledgerentryFields
+	// ledgerentryDescCreatedAt is the schema descriptor for created_at field.
+	ledgerentryDescCreatedAt := ledgerentryFields[7].Descriptor()
+	// ledgerentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgerentry.DefaultCreatedAt = ledgerentryDescCreatedAt.Default.(func() time.Time)
+	moderationdecisionFields := schema.ModerationDecision{}.Fields()
+	_ = moderationdecisionFields
+	// moderationdecisionDescCreatedAt is the schema descriptor for created_at field.
+	moderationdecisionDescCreatedAt := moderationdecisionFields[7].Descriptor()
+	// moderationdecision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationdecision.DefaultCreatedAt = moderationdecisionDescCreatedAt.Default.(func() time.Time)
+	telegramaccountFields := schema.TelegramAccount{}.Fields()
+	_ = telegramaccountFields
+	// telegramaccountDescLinkedAt is the schema descriptor for linked_at field.
+	telegramaccountDescLinkedAt := telegramaccountFields[4].Descriptor()
+	// telegramaccount.DefaultLinkedAt holds the default value on creation for the linked_at field.
+	telegramaccount.DefaultLinkedAt = telegramaccountDescLinkedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescAge is the schema descriptor for age field.
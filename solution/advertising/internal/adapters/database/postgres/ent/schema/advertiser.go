@@ -19,6 +19,9 @@ func (Advertiser) Fields() []ent.Field {
 			Unique(),
 		field.String("name").
 			NotEmpty(),
+		// Предоплаченный баланс, из которого списываются показы, клики и целевые действия
+		field.Float("balance").
+			Default(0),
 	}
 }
 
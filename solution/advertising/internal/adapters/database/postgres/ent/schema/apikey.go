@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKeyRoles holds the roles an API key can be issued for.
+var APIKeyRoles = []string{
+	"ADMIN",
+	"MODERATOR",
+	"ADVERTISER",
+	"CLIENT",
+}
+
+// APIKey holds the schema definition for the APIKey entity.
+// Only the SHA-256 hash of the key is stored, the key itself is shown once on creation.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("name").
+			NotEmpty(),
+		field.String("key_hash").
+			Unique().
+			Sensitive().
+			Immutable(),
+		// Начало ключа, по которому его можно узнать в списке
+		field.String("prefix").
+			Immutable(),
+		field.Enum("role").
+			Values(APIKeyRoles...).
+			Immutable(),
+		// Рекламодатель, к ресурсам которого ограничен ключ с ролью ADVERTISER
+		field.UUID("advertiser_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("advertiser_id"),
+	}
+}
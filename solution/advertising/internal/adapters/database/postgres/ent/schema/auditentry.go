@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"nlypage-final/pkg/jsondiff"
+)
+
+// AuditEntry holds the schema definition for the AuditEntry entity.
+// It is an append-only log of mutating API operations.
+type AuditEntry struct {
+	ent.Schema
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		// Ключ, которым выполнен запрос. Пусто, если аутентификация выключена
+		field.UUID("actor_key_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("actor_name").
+			Optional().
+			Immutable(),
+		field.String("actor_role").
+			Optional().
+			Immutable(),
+		field.String("action").
+			Immutable(),
+		field.String("entity").
+			Immutable(),
+		field.String("entity_id").
+			Immutable(),
+		// Изменившиеся поля сущности со значениями до и после операции
+		field.JSON("diff", map[string]jsondiff.Change{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditEntry.
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity", "entity_id"),
+		index.Fields("actor_key_id"),
+		index.Fields("created_at"),
+	}
+}
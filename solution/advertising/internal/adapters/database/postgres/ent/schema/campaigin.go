@@ -26,20 +26,90 @@ func (Campaign) Fields() []ent.Field {
 			Positive(),
 		field.Float("cost_per_impression"),
 		field.Float("cost_per_click"),
+		field.Float("cost_per_action").
+			Default(0),
+		// Бюджеты в валюте; пустой бюджет не ограничивает кампанию
+		field.Float("daily_budget").
+			Optional().
+			Nillable(),
+		field.Float("total_budget").
+			Optional().
+			Nillable(),
+		// Сколько всего списано за кампанию
+		field.Float("spent").
+			Default(0),
 		field.String("ad_title").
 			NotEmpty(),
 		field.String("ad_text").
 			NotEmpty(),
 		field.String("image_url").
 			Optional(),
+		// Перцептивный хэш изображения для поиска дублей
+		field.Int64("image_hash").
+			Optional().
+			Nillable(),
 		field.Int("start_date").
 			NonNegative(),
 		field.Int("end_date").
 			NonNegative(),
 		field.Bool("moderated"),
+		field.Enum("moderation_status").
+			Values("PENDING", "APPROVED", "REJECTED").
+			Default("PENDING"),
+		field.Enum("rejection_reason").
+			Values(RejectionReasons...).
+			Optional().
+			Nillable(),
+		field.String("moderation_comment").
+			Optional().
+			Nillable(),
+		// Одобренная версия креатива, которая показывается, пока измененная версия на модерации
+		field.String("approved_ad_title").
+			Optional().
+			Nillable(),
+		field.String("approved_ad_text").
+			Optional().
+			Nillable(),
+		field.String("approved_image_url").
+			Optional().
+			Nillable(),
+		// Вердикт ассистента модерации; пустой, пока кампания не проверена
+		field.String("ai_category").
+			Optional().
+			Nillable(),
+		field.JSON("ai_violations", []AIViolation{}).
+			Optional(),
+		field.Float("ai_confidence").
+			Optional().
+			Nillable(),
+		field.Float("risk_score").
+			Optional().
+			Nillable(),
+		field.Time("ai_reviewed_at").
+			Optional().
+			Nillable(),
+		// Неудачные проверки ассистентом: после ошибки кампания проверяется снова не раньше ai_review_retry_at
+		field.Int("ai_review_attempts").
+			Default(0),
+		field.Time("ai_review_retry_at").
+			Optional().
+			Nillable(),
+		// Приостановленная рекламодателем кампания не показывается, пока ее не возобновят
+		field.Bool("paused").
+			Default(false),
+		// Время архивации: удаленная кампания не показывается, но ее статистика и начисления сохраняются
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 	}
 }
 
+// AIViolation — нарушение правил, найденное ассистентом модерации
+type AIViolation struct {
+	Policy      string `json:"policy"`
+	Description string `json:"description"`
+}
+
 // Edges of the Campaign.
 func (Campaign) Edges() []ent.Edge {
 	return []ent.Edge{
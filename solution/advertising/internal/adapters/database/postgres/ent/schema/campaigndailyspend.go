@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CampaignDailySpend holds the schema definition for the CampaignDailySpend entity.
+// It accumulates the amount charged for a campaign per service day to enforce daily budgets.
+type CampaignDailySpend struct {
+	ent.Schema
+}
+
+// Fields of the CampaignDailySpend.
+func (CampaignDailySpend) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("campaign_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("advertiser_id", uuid.UUID{}).
+			Immutable(),
+		field.Int("day").
+			Immutable(),
+		field.Float("amount").
+			Default(0),
+	}
+}
+
+// Edges of the CampaignDailySpend.
+func (CampaignDailySpend) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CampaignDailySpend.
+func (CampaignDailySpend) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("campaign_id", "day").
+			Unique(),
+		index.Fields("advertiser_id", "day"),
+	}
+}
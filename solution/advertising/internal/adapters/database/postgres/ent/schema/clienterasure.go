@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ClientErasure holds the schema definition for the ClientErasure entity.
+// It is a tombstone left after a client's personal data is erased.
+// client_id is not a foreign key because the user row is deleted.
+type ClientErasure struct {
+	ent.Schema
+}
+
+// Fields of the ClientErasure.
+func (ClientErasure) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("client_id", uuid.UUID{}).
+			Unique().
+			Immutable(),
+		// Количество удаленных записей, чтобы удаление можно было проверить.
+		// Если клиента снова загрузили и удалили, запись описывает последнее удаление
+		field.Int("ml_scores").
+			Default(0),
+		field.Int("cached_ads").
+			Default(0),
+		field.Time("requested_at").
+			Default(time.Now),
+		// Заполняется, когда данные удалены из всех хранилищ. Пока поле пустое, удаление можно повторить
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LedgerEntry holds the schema definition for the LedgerEntry entity.
+// It is an append-only billing ledger filled when the service day is closed.
+// campaign_id is not a foreign key so the ledger outlives deleted campaigns.
+type LedgerEntry struct {
+	ent.Schema
+}
+
+// Fields of the LedgerEntry.
+func (LedgerEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("advertiser_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("campaign_id", uuid.UUID{}).
+			Immutable(),
+		// Заголовок кампании на момент закрытия дня, чтобы счет оставался читаемым после ее удаления
+		field.String("campaign_title").
+			Optional().
+			Immutable(),
+		field.Int("day").
+			Immutable(),
+		field.Enum("kind").
+			Values("IMPRESSIONS", "CLICKS", "CONVERSIONS").
+			Immutable(),
+		field.Int64("quantity").
+			Immutable(),
+		field.Float("amount").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the LedgerEntry.
+func (LedgerEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// Повторное закрытие дня не дублирует записи
+		index.Fields("campaign_id", "day", "kind").
+			Unique(),
+		index.Fields("advertiser_id", "day"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RejectionReasons holds the reason codes a moderator can reject a campaign with.
+var RejectionReasons = []string{
+	"PROHIBITED_CONTENT",
+	"MISLEADING_CLAIMS",
+	"INAPPROPRIATE_LANGUAGE",
+	"LOW_QUALITY_CREATIVE",
+	"TARGETING_VIOLATION",
+	"OTHER",
+}
+
+// ModerationDecision holds the schema definition for the ModerationDecision entity.
+// It is an append-only history of moderation actions, campaign_id is not a foreign key
+// so the history outlives deleted campaigns.
+type ModerationDecision struct {
+	ent.Schema
+}
+
+// Fields of the ModerationDecision.
+func (ModerationDecision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("campaign_id", uuid.UUID{}).
+			Immutable(),
+		field.Enum("action").
+			Values("SUBMITTED", "APPROVED", "REJECTED", "RESUBMITTED").
+			Immutable(),
+		field.Enum("reason").
+			Values(RejectionReasons...).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("comment").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("moderator").
+			Optional().
+			Nillable().
+			Immutable(),
+		// Перцептивный хэш изображения отклоненной кампании, чтобы распознавать повторную загрузку
+		field.Int64("image_hash").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("day").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ModerationDecision.
+func (ModerationDecision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("campaign_id", "created_at"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TelegramAccount holds the schema definition for the TelegramAccount entity.
+// It links a Telegram user to the advertiser whose API key they confirmed in the bot.
+// The link stops working once that key is revoked.
+type TelegramAccount struct {
+	ent.Schema
+}
+
+// Fields of the TelegramAccount.
+func (TelegramAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("telegram_id").
+			Unique().
+			Immutable(),
+		// Чат для уведомлений; для личных сообщений совпадает с telegram_id
+		field.Int64("chat_id"),
+		field.UUID("advertiser_id", uuid.UUID{}),
+		field.UUID("api_key_id", uuid.UUID{}),
+		field.Time("linked_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the TelegramAccount.
+func (TelegramAccount) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TelegramAccount.
+func (TelegramAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("advertiser_id"),
+	}
+}
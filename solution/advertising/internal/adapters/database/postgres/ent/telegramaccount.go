@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TelegramAccount is the model entity for the TelegramAccount schema.
+type TelegramAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TelegramID holds the value of the "telegram_id" field.
+	TelegramID int64 `json:"telegram_id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID int64 `json:"chat_id,omitempty"`
+	// AdvertiserID holds the value of the "advertiser_id" field.
+	AdvertiserID uuid.UUID `json:"advertiser_id,omitempty"`
+	// APIKeyID holds the value of the "api_key_id" field.
+	APIKeyID uuid.UUID `json:"api_key_id,omitempty"`
+	// LinkedAt holds the value of the "linked_at" field.
+	LinkedAt     time.Time `json:"linked_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TelegramAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case telegramaccount.FieldID, telegramaccount.FieldTelegramID, telegramaccount.FieldChatID:
+			values[i] = new(sql.NullInt64)
+		case telegramaccount.FieldLinkedAt:
+			values[i] = new(sql.NullTime)
+		case telegramaccount.FieldAdvertiserID, telegramaccount.FieldAPIKeyID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TelegramAccount fields.
+func (ta *TelegramAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case telegramaccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ta.ID = int(value.Int64)
+		case telegramaccount.FieldTelegramID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field telegram_id", values[i])
+			} else if value.Valid {
+				ta.TelegramID = value.Int64
+			}
+		case telegramaccount.FieldChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				ta.ChatID = value.Int64
+			}
+		case telegramaccount.FieldAdvertiserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field advertiser_id", values[i])
+			} else if value != nil {
+				ta.AdvertiserID = *value
+			}
+		case telegramaccount.FieldAPIKeyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value != nil {
+				ta.APIKeyID = *value
+			}
+		case telegramaccount.FieldLinkedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field linked_at", values[i])
+			} else if value.Valid {
+				ta.LinkedAt = value.Time
+			}
+		default:
+			ta.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TelegramAccount.
+// This includes values selected through modifiers, order, etc.
+func (ta *TelegramAccount) Value(name string) (ent.Value, error) {
+	return ta.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TelegramAccount.
+// Note that you need to call TelegramAccount.Unwrap() before calling this method if this TelegramAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ta *TelegramAccount) Update() *TelegramAccountUpdateOne {
+	return NewTelegramAccountClient(ta.config).UpdateOne(ta)
+}
+
+// Unwrap unwraps the TelegramAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ta *TelegramAccount) Unwrap() *TelegramAccount {
+	_tx, ok := ta.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TelegramAccount is not a transactional entity")
+	}
+	ta.config.driver = _tx.drv
+	return ta
+}
+
+// String implements the fmt.Stringer.
+func (ta *TelegramAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("TelegramAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ta.ID))
+	builder.WriteString("telegram_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.TelegramID))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("advertiser_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.AdvertiserID))
+	builder.WriteString(", ")
+	builder.WriteString("api_key_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.APIKeyID))
+	builder.WriteString(", ")
+	builder.WriteString("linked_at=")
+	builder.WriteString(ta.LinkedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TelegramAccounts is a parsable slice of TelegramAccount.
+type TelegramAccounts []*TelegramAccount
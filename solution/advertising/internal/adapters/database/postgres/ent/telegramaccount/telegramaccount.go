@@ -0,0 +1,86 @@
+// Code generated by ent, DO NOT EDIT.
+
+package telegramaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the telegramaccount type in the database.
+	Label = "telegram_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTelegramID holds the string denoting the telegram_id field in the database.
+	FieldTelegramID = "telegram_id"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldAdvertiserID holds the string denoting the advertiser_id field in the database.
+	FieldAdvertiserID = "advertiser_id"
+	// FieldAPIKeyID holds the string denoting the api_key_id field in the database.
+	FieldAPIKeyID = "api_key_id"
+	// FieldLinkedAt holds the string denoting the linked_at field in the database.
+	FieldLinkedAt = "linked_at"
+	// Table holds the table name of the telegramaccount in the database.
+	Table = "telegram_accounts"
+)
+
+// Columns holds all SQL columns for telegramaccount fields.
+var Columns = []string{
+	FieldID,
+	FieldTelegramID,
+	FieldChatID,
+	FieldAdvertiserID,
+	FieldAPIKeyID,
+	FieldLinkedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultLinkedAt holds the default value on creation for the "linked_at" field.
+	DefaultLinkedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TelegramAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTelegramID orders the results by the telegram_id field.
+func ByTelegramID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTelegramID, opts...).ToFunc()
+}
+
+// ByChatID orders the results by the chat_id field.
+func ByChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatID, opts...).ToFunc()
+}
+
+// ByAdvertiserID orders the results by the advertiser_id field.
+func ByAdvertiserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdvertiserID, opts...).ToFunc()
+}
+
+// ByAPIKeyID orders the results by the api_key_id field.
+func ByAPIKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyID, opts...).ToFunc()
+}
+
+// ByLinkedAt orders the results by the linked_at field.
+func ByLinkedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkedAt, opts...).ToFunc()
+}
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package telegramaccount
+
+import (
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldID, id))
+}
+
+// TelegramID applies equality check predicate on the "telegram_id" field. It's identical to TelegramIDEQ.
+func TelegramID(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldTelegramID, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldChatID, v))
+}
+
+// AdvertiserID applies equality check predicate on the "advertiser_id" field. It's identical to AdvertiserIDEQ.
+func AdvertiserID(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAdvertiserID, v))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// LinkedAt applies equality check predicate on the "linked_at" field. It's identical to LinkedAtEQ.
+func LinkedAt(v time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldLinkedAt, v))
+}
+
+// TelegramIDEQ applies the EQ predicate on the "telegram_id" field.
+func TelegramIDEQ(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldTelegramID, v))
+}
+
+// TelegramIDNEQ applies the NEQ predicate on the "telegram_id" field.
+func TelegramIDNEQ(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldTelegramID, v))
+}
+
+// TelegramIDIn applies the In predicate on the "telegram_id" field.
+func TelegramIDIn(vs ...int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldTelegramID, vs...))
+}
+
+// TelegramIDNotIn applies the NotIn predicate on the "telegram_id" field.
+func TelegramIDNotIn(vs ...int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldTelegramID, vs...))
+}
+
+// TelegramIDGT applies the GT predicate on the "telegram_id" field.
+func TelegramIDGT(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldTelegramID, v))
+}
+
+// TelegramIDGTE applies the GTE predicate on the "telegram_id" field.
+func TelegramIDGTE(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldTelegramID, v))
+}
+
+// TelegramIDLT applies the LT predicate on the "telegram_id" field.
+func TelegramIDLT(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldTelegramID, v))
+}
+
+// TelegramIDLTE applies the LTE predicate on the "telegram_id" field.
+func TelegramIDLTE(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldTelegramID, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v int64) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldChatID, v))
+}
+
+// AdvertiserIDEQ applies the EQ predicate on the "advertiser_id" field.
+func AdvertiserIDEQ(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDNEQ applies the NEQ predicate on the "advertiser_id" field.
+func AdvertiserIDNEQ(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDIn applies the In predicate on the "advertiser_id" field.
+func AdvertiserIDIn(vs ...uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldAdvertiserID, vs...))
+}
+
+// AdvertiserIDNotIn applies the NotIn predicate on the "advertiser_id" field.
+func AdvertiserIDNotIn(vs ...uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldAdvertiserID, vs...))
+}
+
+// AdvertiserIDGT applies the GT predicate on the "advertiser_id" field.
+func AdvertiserIDGT(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDGTE applies the GTE predicate on the "advertiser_id" field.
+func AdvertiserIDGTE(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDLT applies the LT predicate on the "advertiser_id" field.
+func AdvertiserIDLT(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldAdvertiserID, v))
+}
+
+// AdvertiserIDLTE applies the LTE predicate on the "advertiser_id" field.
+func AdvertiserIDLTE(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldAdvertiserID, v))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDGT applies the GT predicate on the "api_key_id" field.
+func APIKeyIDGT(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDGTE applies the GTE predicate on the "api_key_id" field.
+func APIKeyIDGTE(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLT applies the LT predicate on the "api_key_id" field.
+func APIKeyIDLT(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLTE applies the LTE predicate on the "api_key_id" field.
+func APIKeyIDLTE(v uuid.UUID) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldAPIKeyID, v))
+}
+
+// LinkedAtEQ applies the EQ predicate on the "linked_at" field.
+func LinkedAtEQ(v time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldEQ(FieldLinkedAt, v))
+}
+
+// LinkedAtNEQ applies the NEQ predicate on the "linked_at" field.
+func LinkedAtNEQ(v time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNEQ(FieldLinkedAt, v))
+}
+
+// LinkedAtIn applies the In predicate on the "linked_at" field.
+func LinkedAtIn(vs ...time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldIn(FieldLinkedAt, vs...))
+}
+
+// LinkedAtNotIn applies the NotIn predicate on the "linked_at" field.
+func LinkedAtNotIn(vs ...time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldNotIn(FieldLinkedAt, vs...))
+}
+
+// LinkedAtGT applies the GT predicate on the "linked_at" field.
+func LinkedAtGT(v time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGT(FieldLinkedAt, v))
+}
+
+// LinkedAtGTE applies the GTE predicate on the "linked_at" field.
+func LinkedAtGTE(v time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldGTE(FieldLinkedAt, v))
+}
+
+// LinkedAtLT applies the LT predicate on the "linked_at" field.
+func LinkedAtLT(v time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLT(FieldLinkedAt, v))
+}
+
+// LinkedAtLTE applies the LTE predicate on the "linked_at" field.
+func LinkedAtLTE(v time.Time) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.FieldLTE(FieldLinkedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TelegramAccount) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TelegramAccount) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TelegramAccount) predicate.TelegramAccount {
+	return predicate.TelegramAccount(sql.NotPredicates(p))
+}
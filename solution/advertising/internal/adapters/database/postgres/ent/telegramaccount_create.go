@@ -0,0 +1,678 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TelegramAccountCreate is the builder for creating a TelegramAccount entity.
+type TelegramAccountCreate struct {
+	config
+	mutation *TelegramAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTelegramID sets the "telegram_id" field.
+func (tac *TelegramAccountCreate) SetTelegramID(i int64) *TelegramAccountCreate {
+	tac.mutation.SetTelegramID(i)
+	return tac
+}
+
+// SetChatID sets the "chat_id" field.
+func (tac *TelegramAccountCreate) SetChatID(i int64) *TelegramAccountCreate {
+	tac.mutation.SetChatID(i)
+	return tac
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (tac *TelegramAccountCreate) SetAdvertiserID(u uuid.UUID) *TelegramAccountCreate {
+	tac.mutation.SetAdvertiserID(u)
+	return tac
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (tac *TelegramAccountCreate) SetAPIKeyID(u uuid.UUID) *TelegramAccountCreate {
+	tac.mutation.SetAPIKeyID(u)
+	return tac
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (tac *TelegramAccountCreate) SetLinkedAt(t time.Time) *TelegramAccountCreate {
+	tac.mutation.SetLinkedAt(t)
+	return tac
+}
+
+// SetNillableLinkedAt sets the "linked_at" field if the given value is not nil.
+func (tac *TelegramAccountCreate) SetNillableLinkedAt(t *time.Time) *TelegramAccountCreate {
+	if t != nil {
+		tac.SetLinkedAt(*t)
+	}
+	return tac
+}
+
+// Mutation returns the TelegramAccountMutation object of the builder.
+func (tac *TelegramAccountCreate) Mutation() *TelegramAccountMutation {
+	return tac.mutation
+}
+
+// Save creates the TelegramAccount in the database.
+func (tac *TelegramAccountCreate) Save(ctx context.Context) (*TelegramAccount, error) {
+	tac.defaults()
+	return withHooks(ctx, tac.sqlSave, tac.mutation, tac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tac *TelegramAccountCreate) SaveX(ctx context.Context) *TelegramAccount {
+	v, err := tac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tac *TelegramAccountCreate) Exec(ctx context.Context) error {
+	_, err := tac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tac *TelegramAccountCreate) ExecX(ctx context.Context) {
+	if err := tac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tac *TelegramAccountCreate) defaults() {
+	if _, ok := tac.mutation.LinkedAt(); !ok {
+		v := telegramaccount.DefaultLinkedAt()
+		tac.mutation.SetLinkedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tac *TelegramAccountCreate) check() error {
+	if _, ok := tac.mutation.TelegramID(); !ok {
+		return &ValidationError{Name: "telegram_id", err: errors.New(`ent: missing required field "TelegramAccount.telegram_id"`)}
+	}
+	if _, ok := tac.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "TelegramAccount.chat_id"`)}
+	}
+	if _, ok := tac.mutation.AdvertiserID(); !ok {
+		return &ValidationError{Name: "advertiser_id", err: errors.New(`ent: missing required field "TelegramAccount.advertiser_id"`)}
+	}
+	if _, ok := tac.mutation.APIKeyID(); !ok {
+		return &ValidationError{Name: "api_key_id", err: errors.New(`ent: missing required field "TelegramAccount.api_key_id"`)}
+	}
+	if _, ok := tac.mutation.LinkedAt(); !ok {
+		return &ValidationError{Name: "linked_at", err: errors.New(`ent: missing required field "TelegramAccount.linked_at"`)}
+	}
+	return nil
+}
+
+func (tac *TelegramAccountCreate) sqlSave(ctx context.Context) (*TelegramAccount, error) {
+	if err := tac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tac.mutation.id = &_node.ID
+	tac.mutation.done = true
+	return _node, nil
+}
+
+func (tac *TelegramAccountCreate) createSpec() (*TelegramAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TelegramAccount{config: tac.config}
+		_spec = sqlgraph.NewCreateSpec(telegramaccount.Table, sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tac.conflict
+	if value, ok := tac.mutation.TelegramID(); ok {
+		_spec.SetField(telegramaccount.FieldTelegramID, field.TypeInt64, value)
+		_node.TelegramID = value
+	}
+	if value, ok := tac.mutation.ChatID(); ok {
+		_spec.SetField(telegramaccount.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := tac.mutation.AdvertiserID(); ok {
+		_spec.SetField(telegramaccount.FieldAdvertiserID, field.TypeUUID, value)
+		_node.AdvertiserID = value
+	}
+	if value, ok := tac.mutation.APIKeyID(); ok {
+		_spec.SetField(telegramaccount.FieldAPIKeyID, field.TypeUUID, value)
+		_node.APIKeyID = value
+	}
+	if value, ok := tac.mutation.LinkedAt(); ok {
+		_spec.SetField(telegramaccount.FieldLinkedAt, field.TypeTime, value)
+		_node.LinkedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TelegramAccount.Create().
+//		SetTelegramID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TelegramAccountUpsert) {
+//			SetTelegramID(v+v).
+//		}).
+//		Exec(ctx)
+func (tac *TelegramAccountCreate) OnConflict(opts ...sql.ConflictOption) *TelegramAccountUpsertOne {
+	tac.conflict = opts
+	return &TelegramAccountUpsertOne{
+		create: tac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TelegramAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tac *TelegramAccountCreate) OnConflictColumns(columns ...string) *TelegramAccountUpsertOne {
+	tac.conflict = append(tac.conflict, sql.ConflictColumns(columns...))
+	return &TelegramAccountUpsertOne{
+		create: tac,
+	}
+}
+
+type (
+	// TelegramAccountUpsertOne is the builder for "upsert"-ing
+	//  one TelegramAccount node.
+	TelegramAccountUpsertOne struct {
+		create *TelegramAccountCreate
+	}
+
+	// TelegramAccountUpsert is the "OnConflict" setter.
+	TelegramAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetChatID sets the "chat_id" field.
+func (u *TelegramAccountUpsert) SetChatID(v int64) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateChatID() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldChatID)
+	return u
+}
+
+// AddChatID adds v to the "chat_id" field.
+func (u *TelegramAccountUpsert) AddChatID(v int64) *TelegramAccountUpsert {
+	u.Add(telegramaccount.FieldChatID, v)
+	return u
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (u *TelegramAccountUpsert) SetAdvertiserID(v uuid.UUID) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldAdvertiserID, v)
+	return u
+}
+
+// UpdateAdvertiserID sets the "advertiser_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateAdvertiserID() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldAdvertiserID)
+	return u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *TelegramAccountUpsert) SetAPIKeyID(v uuid.UUID) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldAPIKeyID, v)
+	return u
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateAPIKeyID() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldAPIKeyID)
+	return u
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (u *TelegramAccountUpsert) SetLinkedAt(v time.Time) *TelegramAccountUpsert {
+	u.Set(telegramaccount.FieldLinkedAt, v)
+	return u
+}
+
+// UpdateLinkedAt sets the "linked_at" field to the value that was provided on create.
+func (u *TelegramAccountUpsert) UpdateLinkedAt() *TelegramAccountUpsert {
+	u.SetExcluded(telegramaccount.FieldLinkedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TelegramAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TelegramAccountUpsertOne) UpdateNewValues() *TelegramAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.TelegramID(); exists {
+			s.SetIgnore(telegramaccount.FieldTelegramID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TelegramAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TelegramAccountUpsertOne) Ignore() *TelegramAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TelegramAccountUpsertOne) DoNothing() *TelegramAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TelegramAccountCreate.OnConflict
+// documentation for more info.
+func (u *TelegramAccountUpsertOne) Update(set func(*TelegramAccountUpsert)) *TelegramAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TelegramAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *TelegramAccountUpsertOne) SetChatID(v int64) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// AddChatID adds v to the "chat_id" field.
+func (u *TelegramAccountUpsertOne) AddChatID(v int64) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.AddChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateChatID() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (u *TelegramAccountUpsertOne) SetAdvertiserID(v uuid.UUID) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAdvertiserID(v)
+	})
+}
+
+// UpdateAdvertiserID sets the "advertiser_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateAdvertiserID() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAdvertiserID()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *TelegramAccountUpsertOne) SetAPIKeyID(v uuid.UUID) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateAPIKeyID() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (u *TelegramAccountUpsertOne) SetLinkedAt(v time.Time) *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetLinkedAt(v)
+	})
+}
+
+// UpdateLinkedAt sets the "linked_at" field to the value that was provided on create.
+func (u *TelegramAccountUpsertOne) UpdateLinkedAt() *TelegramAccountUpsertOne {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateLinkedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TelegramAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TelegramAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TelegramAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TelegramAccountUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TelegramAccountUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TelegramAccountCreateBulk is the builder for creating many TelegramAccount entities in bulk.
+type TelegramAccountCreateBulk struct {
+	config
+	err      error
+	builders []*TelegramAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TelegramAccount entities in the database.
+func (tacb *TelegramAccountCreateBulk) Save(ctx context.Context) ([]*TelegramAccount, error) {
+	if tacb.err != nil {
+		return nil, tacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tacb.builders))
+	nodes := make([]*TelegramAccount, len(tacb.builders))
+	mutators := make([]Mutator, len(tacb.builders))
+	for i := range tacb.builders {
+		func(i int, root context.Context) {
+			builder := tacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TelegramAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tacb *TelegramAccountCreateBulk) SaveX(ctx context.Context) []*TelegramAccount {
+	v, err := tacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tacb *TelegramAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := tacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tacb *TelegramAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := tacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TelegramAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TelegramAccountUpsert) {
+//			SetTelegramID(v+v).
+//		}).
+//		Exec(ctx)
+func (tacb *TelegramAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *TelegramAccountUpsertBulk {
+	tacb.conflict = opts
+	return &TelegramAccountUpsertBulk{
+		create: tacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TelegramAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tacb *TelegramAccountCreateBulk) OnConflictColumns(columns ...string) *TelegramAccountUpsertBulk {
+	tacb.conflict = append(tacb.conflict, sql.ConflictColumns(columns...))
+	return &TelegramAccountUpsertBulk{
+		create: tacb,
+	}
+}
+
+// TelegramAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of TelegramAccount nodes.
+type TelegramAccountUpsertBulk struct {
+	create *TelegramAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TelegramAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TelegramAccountUpsertBulk) UpdateNewValues() *TelegramAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.TelegramID(); exists {
+				s.SetIgnore(telegramaccount.FieldTelegramID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TelegramAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TelegramAccountUpsertBulk) Ignore() *TelegramAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TelegramAccountUpsertBulk) DoNothing() *TelegramAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TelegramAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *TelegramAccountUpsertBulk) Update(set func(*TelegramAccountUpsert)) *TelegramAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TelegramAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *TelegramAccountUpsertBulk) SetChatID(v int64) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// AddChatID adds v to the "chat_id" field.
+func (u *TelegramAccountUpsertBulk) AddChatID(v int64) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.AddChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateChatID() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (u *TelegramAccountUpsertBulk) SetAdvertiserID(v uuid.UUID) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAdvertiserID(v)
+	})
+}
+
+// UpdateAdvertiserID sets the "advertiser_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateAdvertiserID() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAdvertiserID()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *TelegramAccountUpsertBulk) SetAPIKeyID(v uuid.UUID) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateAPIKeyID() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (u *TelegramAccountUpsertBulk) SetLinkedAt(v time.Time) *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.SetLinkedAt(v)
+	})
+}
+
+// UpdateLinkedAt sets the "linked_at" field to the value that was provided on create.
+func (u *TelegramAccountUpsertBulk) UpdateLinkedAt() *TelegramAccountUpsertBulk {
+	return u.Update(func(s *TelegramAccountUpsert) {
+		s.UpdateLinkedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TelegramAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TelegramAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TelegramAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TelegramAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nlypage-final/internal/adapters/database/postgres/ent/predicate"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TelegramAccountUpdate is the builder for updating TelegramAccount entities.
+type TelegramAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TelegramAccountMutation
+}
+
+// Where appends a list predicates to the TelegramAccountUpdate builder.
+func (tau *TelegramAccountUpdate) Where(ps ...predicate.TelegramAccount) *TelegramAccountUpdate {
+	tau.mutation.Where(ps...)
+	return tau
+}
+
+// SetChatID sets the "chat_id" field.
+func (tau *TelegramAccountUpdate) SetChatID(i int64) *TelegramAccountUpdate {
+	tau.mutation.ResetChatID()
+	tau.mutation.SetChatID(i)
+	return tau
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableChatID(i *int64) *TelegramAccountUpdate {
+	if i != nil {
+		tau.SetChatID(*i)
+	}
+	return tau
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (tau *TelegramAccountUpdate) AddChatID(i int64) *TelegramAccountUpdate {
+	tau.mutation.AddChatID(i)
+	return tau
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (tau *TelegramAccountUpdate) SetAdvertiserID(u uuid.UUID) *TelegramAccountUpdate {
+	tau.mutation.SetAdvertiserID(u)
+	return tau
+}
+
+// SetNillableAdvertiserID sets the "advertiser_id" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableAdvertiserID(u *uuid.UUID) *TelegramAccountUpdate {
+	if u != nil {
+		tau.SetAdvertiserID(*u)
+	}
+	return tau
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (tau *TelegramAccountUpdate) SetAPIKeyID(u uuid.UUID) *TelegramAccountUpdate {
+	tau.mutation.SetAPIKeyID(u)
+	return tau
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableAPIKeyID(u *uuid.UUID) *TelegramAccountUpdate {
+	if u != nil {
+		tau.SetAPIKeyID(*u)
+	}
+	return tau
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (tau *TelegramAccountUpdate) SetLinkedAt(t time.Time) *TelegramAccountUpdate {
+	tau.mutation.SetLinkedAt(t)
+	return tau
+}
+
+// SetNillableLinkedAt sets the "linked_at" field if the given value is not nil.
+func (tau *TelegramAccountUpdate) SetNillableLinkedAt(t *time.Time) *TelegramAccountUpdate {
+	if t != nil {
+		tau.SetLinkedAt(*t)
+	}
+	return tau
+}
+
+// Mutation returns the TelegramAccountMutation object of the builder.
+func (tau *TelegramAccountUpdate) Mutation() *TelegramAccountMutation {
+	return tau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tau *TelegramAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tau.sqlSave, tau.mutation, tau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tau *TelegramAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := tau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tau *TelegramAccountUpdate) Exec(ctx context.Context) error {
+	_, err := tau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tau *TelegramAccountUpdate) ExecX(ctx context.Context) {
+	if err := tau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tau *TelegramAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(telegramaccount.Table, telegramaccount.Columns, sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeInt))
+	if ps := tau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tau.mutation.ChatID(); ok {
+		_spec.SetField(telegramaccount.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := tau.mutation.AddedChatID(); ok {
+		_spec.AddField(telegramaccount.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := tau.mutation.AdvertiserID(); ok {
+		_spec.SetField(telegramaccount.FieldAdvertiserID, field.TypeUUID, value)
+	}
+	if value, ok := tau.mutation.APIKeyID(); ok {
+		_spec.SetField(telegramaccount.FieldAPIKeyID, field.TypeUUID, value)
+	}
+	if value, ok := tau.mutation.LinkedAt(); ok {
+		_spec.SetField(telegramaccount.FieldLinkedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegramaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tau.mutation.done = true
+	return n, nil
+}
+
+// TelegramAccountUpdateOne is the builder for updating a single TelegramAccount entity.
+type TelegramAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TelegramAccountMutation
+}
+
+// SetChatID sets the "chat_id" field.
+func (tauo *TelegramAccountUpdateOne) SetChatID(i int64) *TelegramAccountUpdateOne {
+	tauo.mutation.ResetChatID()
+	tauo.mutation.SetChatID(i)
+	return tauo
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableChatID(i *int64) *TelegramAccountUpdateOne {
+	if i != nil {
+		tauo.SetChatID(*i)
+	}
+	return tauo
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (tauo *TelegramAccountUpdateOne) AddChatID(i int64) *TelegramAccountUpdateOne {
+	tauo.mutation.AddChatID(i)
+	return tauo
+}
+
+// SetAdvertiserID sets the "advertiser_id" field.
+func (tauo *TelegramAccountUpdateOne) SetAdvertiserID(u uuid.UUID) *TelegramAccountUpdateOne {
+	tauo.mutation.SetAdvertiserID(u)
+	return tauo
+}
+
+// SetNillableAdvertiserID sets the "advertiser_id" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableAdvertiserID(u *uuid.UUID) *TelegramAccountUpdateOne {
+	if u != nil {
+		tauo.SetAdvertiserID(*u)
+	}
+	return tauo
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (tauo *TelegramAccountUpdateOne) SetAPIKeyID(u uuid.UUID) *TelegramAccountUpdateOne {
+	tauo.mutation.SetAPIKeyID(u)
+	return tauo
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableAPIKeyID(u *uuid.UUID) *TelegramAccountUpdateOne {
+	if u != nil {
+		tauo.SetAPIKeyID(*u)
+	}
+	return tauo
+}
+
+// SetLinkedAt sets the "linked_at" field.
+func (tauo *TelegramAccountUpdateOne) SetLinkedAt(t time.Time) *TelegramAccountUpdateOne {
+	tauo.mutation.SetLinkedAt(t)
+	return tauo
+}
+
+// SetNillableLinkedAt sets the "linked_at" field if the given value is not nil.
+func (tauo *TelegramAccountUpdateOne) SetNillableLinkedAt(t *time.Time) *TelegramAccountUpdateOne {
+	if t != nil {
+		tauo.SetLinkedAt(*t)
+	}
+	return tauo
+}
+
+// Mutation returns the TelegramAccountMutation object of the builder.
+func (tauo *TelegramAccountUpdateOne) Mutation() *TelegramAccountMutation {
+	return tauo.mutation
+}
+
+// Where appends a list predicates to the TelegramAccountUpdate builder.
+func (tauo *TelegramAccountUpdateOne) Where(ps ...predicate.TelegramAccount) *TelegramAccountUpdateOne {
+	tauo.mutation.Where(ps...)
+	return tauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tauo *TelegramAccountUpdateOne) Select(field string, fields ...string) *TelegramAccountUpdateOne {
+	tauo.fields = append([]string{field}, fields...)
+	return tauo
+}
+
+// Save executes the query and returns the updated TelegramAccount entity.
+func (tauo *TelegramAccountUpdateOne) Save(ctx context.Context) (*TelegramAccount, error) {
+	return withHooks(ctx, tauo.sqlSave, tauo.mutation, tauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tauo *TelegramAccountUpdateOne) SaveX(ctx context.Context) *TelegramAccount {
+	node, err := tauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tauo *TelegramAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := tauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tauo *TelegramAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := tauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tauo *TelegramAccountUpdateOne) sqlSave(ctx context.Context) (_node *TelegramAccount, err error) {
+	_spec := sqlgraph.NewUpdateSpec(telegramaccount.Table, telegramaccount.Columns, sqlgraph.NewFieldSpec(telegramaccount.FieldID, field.TypeInt))
+	id, ok := tauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TelegramAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, telegramaccount.FieldID)
+		for _, f := range fields {
+			if !telegramaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != telegramaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tauo.mutation.ChatID(); ok {
+		_spec.SetField(telegramaccount.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := tauo.mutation.AddedChatID(); ok {
+		_spec.AddField(telegramaccount.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := tauo.mutation.AdvertiserID(); ok {
+		_spec.SetField(telegramaccount.FieldAdvertiserID, field.TypeUUID, value)
+	}
+	if value, ok := tauo.mutation.APIKeyID(); ok {
+		_spec.SetField(telegramaccount.FieldAPIKeyID, field.TypeUUID, value)
+	}
+	if value, ok := tauo.mutation.LinkedAt(); ok {
+		_spec.SetField(telegramaccount.FieldLinkedAt, field.TypeTime, value)
+	}
+	_node = &TelegramAccount{config: tauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegramaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tauo.mutation.done = true
+	return _node, nil
+}
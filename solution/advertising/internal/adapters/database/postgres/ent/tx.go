@@ -12,14 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Advertiser is the client for interacting with the Advertiser builders.
 	Advertiser *AdvertiserClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
 	// Campaign is the client for interacting with the Campaign builders.
 	Campaign *CampaignClient
+	// CampaignDailySpend is the client for interacting with the CampaignDailySpend builders.
+	CampaignDailySpend *CampaignDailySpendClient
+	// ClientErasure is the client for interacting with the ClientErasure builders.
+	ClientErasure *ClientErasureClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
 	// MlScore is the client for interacting with the MlScore builders.
 	MlScore *MlScoreClient
+	// ModerationDecision is the client for interacting with the ModerationDecision builders.
+	ModerationDecision *ModerationDecisionClient
 	// Targeting is the client for interacting with the Targeting builders.
 	Targeting *TargetingClient
+	// TelegramAccount is the client for interacting with the TelegramAccount builders.
+	TelegramAccount *TelegramAccountClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -153,10 +167,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
 	tx.Advertiser = NewAdvertiserClient(tx.config)
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
 	tx.Campaign = NewCampaignClient(tx.config)
+	tx.CampaignDailySpend = NewCampaignDailySpendClient(tx.config)
+	tx.ClientErasure = NewClientErasureClient(tx.config)
+	tx.LedgerEntry = NewLedgerEntryClient(tx.config)
 	tx.MlScore = NewMlScoreClient(tx.config)
+	tx.ModerationDecision = NewModerationDecisionClient(tx.config)
 	tx.Targeting = NewTargetingClient(tx.config)
+	tx.TelegramAccount = NewTelegramAccountClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -167,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Advertiser.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
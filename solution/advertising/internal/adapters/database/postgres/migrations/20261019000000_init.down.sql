@@ -0,0 +1,10 @@
+-- reverse: create "targetings" table
+DROP TABLE "targetings";
+-- reverse: create "ml_scores" table
+DROP TABLE "ml_scores";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create "campaigns" table
+DROP TABLE "campaigns";
+-- reverse: create "advertisers" table
+DROP TABLE "advertisers";
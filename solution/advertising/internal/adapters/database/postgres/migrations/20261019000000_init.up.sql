@@ -0,0 +1,22 @@
+-- Базовая схема, совпадающая с той, что создавал ent auto-migrate.
+-- IF NOT EXISTS позволяет применить миграцию к базе, развернутой до перехода на версионированные миграции
+-- create "advertisers" table
+CREATE TABLE IF NOT EXISTS "advertisers" ("id" uuid NOT NULL, "name" character varying NOT NULL, PRIMARY KEY ("id"));
+-- create "campaigns" table
+CREATE TABLE IF NOT EXISTS "campaigns" ("id" uuid NOT NULL, "advertiser_id" uuid NOT NULL, "impressions_limit" bigint NOT NULL, "clicks_limit" bigint NOT NULL, "cost_per_impression" double precision NOT NULL, "cost_per_click" double precision NOT NULL, "ad_title" character varying NOT NULL, "ad_text" character varying NOT NULL, "image_url" character varying NULL, "start_date" bigint NOT NULL, "end_date" bigint NOT NULL, "moderated" boolean NOT NULL, PRIMARY KEY ("id"));
+-- create index "campaign_start_date_end_date" to table: "campaigns"
+CREATE INDEX IF NOT EXISTS "campaign_start_date_end_date" ON "campaigns" ("start_date", "end_date");
+-- create "users" table
+CREATE TABLE IF NOT EXISTS "users" ("id" uuid NOT NULL, "login" character varying NOT NULL, "age" bigint NULL, "location" character varying NULL, "gender" character varying NULL, PRIMARY KEY ("id"));
+-- create "ml_scores" table
+CREATE TABLE IF NOT EXISTS "ml_scores" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "score" bigint NOT NULL, "user_id" uuid NOT NULL, "advertiser_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "ml_scores_advertisers_advertiser" FOREIGN KEY ("advertiser_id") REFERENCES "advertisers" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "ml_scores_users_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "mlscore_user_id_advertiser_id" to table: "ml_scores"
+CREATE UNIQUE INDEX IF NOT EXISTS "mlscore_user_id_advertiser_id" ON "ml_scores" ("user_id", "advertiser_id");
+-- create "targetings" table
+CREATE TABLE IF NOT EXISTS "targetings" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "gender" character varying NULL, "age_from" bigint NULL, "age_to" bigint NULL, "location" character varying NULL, "campaign_targeting" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "targetings_campaigns_targeting" FOREIGN KEY ("campaign_targeting") REFERENCES "campaigns" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "targetings_campaign_targeting_key" to table: "targetings"
+CREATE UNIQUE INDEX IF NOT EXISTS "targetings_campaign_targeting_key" ON "targetings" ("campaign_targeting");
+-- create index "targeting_location_campaign_targeting" to table: "targetings"
+CREATE INDEX IF NOT EXISTS "targeting_location_campaign_targeting" ON "targetings" ("location", "campaign_targeting");
+-- create index "targeting_age_from_age_to_campaign_targeting" to table: "targetings"
+CREATE INDEX IF NOT EXISTS "targeting_age_from_age_to_campaign_targeting" ON "targetings" ("age_from", "age_to", "campaign_targeting");
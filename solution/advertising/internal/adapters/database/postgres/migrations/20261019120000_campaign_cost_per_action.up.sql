@@ -0,0 +1,2 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "cost_per_action" double precision NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- reverse: create index "moderationdecision_campaign_id_created_at" to table: "moderation_decisions"
+DROP INDEX "moderationdecision_campaign_id_created_at";
+-- reverse: create "moderation_decisions" table
+DROP TABLE "moderation_decisions";
+-- reverse: modify "campaigns" table
+ALTER TABLE "campaigns" DROP COLUMN "moderation_comment", DROP COLUMN "rejection_reason", DROP COLUMN "moderation_status";
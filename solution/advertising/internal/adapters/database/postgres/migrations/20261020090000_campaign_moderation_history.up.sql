@@ -0,0 +1,8 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "moderation_status" character varying NOT NULL DEFAULT 'PENDING', ADD COLUMN "rejection_reason" character varying NULL, ADD COLUMN "moderation_comment" character varying NULL;
+-- already moderated campaigns keep serving
+UPDATE "campaigns" SET "moderation_status" = 'APPROVED' WHERE "moderated";
+-- create "moderation_decisions" table
+CREATE TABLE "moderation_decisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "campaign_id" uuid NOT NULL, "action" character varying NOT NULL, "reason" character varying NULL, "comment" character varying NULL, "moderator" character varying NULL, "day" bigint NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "moderationdecision_campaign_id_created_at" to table: "moderation_decisions"
+CREATE INDEX "moderationdecision_campaign_id_created_at" ON "moderation_decisions" ("campaign_id", "created_at");
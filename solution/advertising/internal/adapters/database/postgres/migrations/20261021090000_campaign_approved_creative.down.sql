@@ -0,0 +1,2 @@
+-- reverse: modify "campaigns" table
+ALTER TABLE "campaigns" DROP COLUMN "approved_image_url", DROP COLUMN "approved_ad_text", DROP COLUMN "approved_ad_title";
@@ -0,0 +1,2 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "approved_ad_title" character varying NULL, ADD COLUMN "approved_ad_text" character varying NULL, ADD COLUMN "approved_image_url" character varying NULL;
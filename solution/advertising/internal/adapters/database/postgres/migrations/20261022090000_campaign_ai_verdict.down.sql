@@ -0,0 +1,2 @@
+-- reverse: modify "campaigns" table
+ALTER TABLE "campaigns" DROP COLUMN "ai_reviewed_at", DROP COLUMN "risk_score", DROP COLUMN "ai_confidence", DROP COLUMN "ai_violations", DROP COLUMN "ai_category";
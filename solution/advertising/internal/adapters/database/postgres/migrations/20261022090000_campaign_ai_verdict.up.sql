@@ -0,0 +1,2 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "ai_category" character varying NULL, ADD COLUMN "ai_violations" jsonb NULL, ADD COLUMN "ai_confidence" double precision NULL, ADD COLUMN "risk_score" double precision NULL, ADD COLUMN "ai_reviewed_at" timestamptz NULL;
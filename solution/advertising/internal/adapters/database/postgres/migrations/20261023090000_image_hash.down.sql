@@ -0,0 +1,4 @@
+-- reverse: modify "moderation_decisions" table
+ALTER TABLE "moderation_decisions" DROP COLUMN "image_hash";
+-- reverse: modify "campaigns" table
+ALTER TABLE "campaigns" DROP COLUMN "image_hash";
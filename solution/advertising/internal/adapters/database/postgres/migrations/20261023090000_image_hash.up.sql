@@ -0,0 +1,4 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "image_hash" bigint NULL;
+-- modify "moderation_decisions" table
+ALTER TABLE "moderation_decisions" ADD COLUMN "image_hash" bigint NULL;
@@ -0,0 +1,6 @@
+-- reverse: create index "apikey_advertiser_id" to table: "api_keys"
+DROP INDEX "apikey_advertiser_id";
+-- reverse: create index "api_keys_key_hash_key" to table: "api_keys"
+DROP INDEX "api_keys_key_hash_key";
+-- reverse: create "api_keys" table
+DROP TABLE "api_keys";
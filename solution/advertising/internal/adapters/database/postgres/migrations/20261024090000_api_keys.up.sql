@@ -0,0 +1,6 @@
+-- create "api_keys" table
+CREATE TABLE "api_keys" ("id" uuid NOT NULL, "name" character varying NOT NULL, "key_hash" character varying NOT NULL, "prefix" character varying NOT NULL, "role" character varying NOT NULL, "advertiser_id" uuid NULL, "created_at" timestamptz NOT NULL, "revoked_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "api_keys_key_hash_key" to table: "api_keys"
+CREATE UNIQUE INDEX "api_keys_key_hash_key" ON "api_keys" ("key_hash");
+-- create index "apikey_advertiser_id" to table: "api_keys"
+CREATE INDEX "apikey_advertiser_id" ON "api_keys" ("advertiser_id");
@@ -0,0 +1,10 @@
+-- reverse: create index "campaigndailyspend_advertiser_id_day" to table: "campaign_daily_spends"
+DROP INDEX "campaigndailyspend_advertiser_id_day";
+-- reverse: create index "campaigndailyspend_campaign_id_day" to table: "campaign_daily_spends"
+DROP INDEX "campaigndailyspend_campaign_id_day";
+-- reverse: create "campaign_daily_spends" table
+DROP TABLE "campaign_daily_spends";
+-- reverse: modify "campaigns" table
+ALTER TABLE "campaigns" DROP COLUMN "spent", DROP COLUMN "total_budget", DROP COLUMN "daily_budget";
+-- reverse: modify "advertisers" table
+ALTER TABLE "advertisers" DROP COLUMN "balance";
@@ -0,0 +1,10 @@
+-- modify "advertisers" table
+ALTER TABLE "advertisers" ADD COLUMN "balance" double precision NOT NULL DEFAULT 0;
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "daily_budget" double precision NULL, ADD COLUMN "total_budget" double precision NULL, ADD COLUMN "spent" double precision NOT NULL DEFAULT 0;
+-- create "campaign_daily_spends" table
+CREATE TABLE "campaign_daily_spends" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "campaign_id" uuid NOT NULL, "advertiser_id" uuid NOT NULL, "day" bigint NOT NULL, "amount" double precision NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- create index "campaigndailyspend_campaign_id_day" to table: "campaign_daily_spends"
+CREATE UNIQUE INDEX "campaigndailyspend_campaign_id_day" ON "campaign_daily_spends" ("campaign_id", "day");
+-- create index "campaigndailyspend_advertiser_id_day" to table: "campaign_daily_spends"
+CREATE INDEX "campaigndailyspend_advertiser_id_day" ON "campaign_daily_spends" ("advertiser_id", "day");
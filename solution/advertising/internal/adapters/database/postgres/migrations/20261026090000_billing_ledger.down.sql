@@ -0,0 +1,6 @@
+-- reverse: create index "ledgerentry_advertiser_id_day" to table: "ledger_entries"
+DROP INDEX "ledgerentry_advertiser_id_day";
+-- reverse: create index "ledgerentry_campaign_id_day_kind" to table: "ledger_entries"
+DROP INDEX "ledgerentry_campaign_id_day_kind";
+-- reverse: create "ledger_entries" table
+DROP TABLE "ledger_entries";
@@ -0,0 +1,6 @@
+-- create "ledger_entries" table
+CREATE TABLE "ledger_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "advertiser_id" uuid NOT NULL, "campaign_id" uuid NOT NULL, "campaign_title" character varying NULL, "day" bigint NOT NULL, "kind" character varying NOT NULL, "quantity" bigint NOT NULL, "amount" double precision NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "ledgerentry_campaign_id_day_kind" to table: "ledger_entries"
+CREATE UNIQUE INDEX "ledgerentry_campaign_id_day_kind" ON "ledger_entries" ("campaign_id", "day", "kind");
+-- create index "ledgerentry_advertiser_id_day" to table: "ledger_entries"
+CREATE INDEX "ledgerentry_advertiser_id_day" ON "ledger_entries" ("advertiser_id", "day");
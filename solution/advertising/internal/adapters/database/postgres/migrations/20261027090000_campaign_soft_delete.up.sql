@@ -0,0 +1,2 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "deleted_at" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- reverse: create index "client_erasures_client_id_key" to table: "client_erasures"
+DROP INDEX "client_erasures_client_id_key";
+-- reverse: create "client_erasures" table
+DROP TABLE "client_erasures";
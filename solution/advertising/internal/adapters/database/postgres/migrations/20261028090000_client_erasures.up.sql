@@ -0,0 +1,4 @@
+-- create "client_erasures" table
+CREATE TABLE "client_erasures" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "client_id" uuid NOT NULL, "ml_scores" bigint NOT NULL DEFAULT 0, "cached_ads" bigint NOT NULL DEFAULT 0, "requested_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "client_erasures_client_id_key" to table: "client_erasures"
+CREATE UNIQUE INDEX "client_erasures_client_id_key" ON "client_erasures" ("client_id");
@@ -0,0 +1,8 @@
+-- reverse: create index "auditentry_created_at" to table: "audit_entries"
+DROP INDEX "auditentry_created_at";
+-- reverse: create index "auditentry_actor_key_id" to table: "audit_entries"
+DROP INDEX "auditentry_actor_key_id";
+-- reverse: create index "auditentry_entity_entity_id" to table: "audit_entries"
+DROP INDEX "auditentry_entity_entity_id";
+-- reverse: create "audit_entries" table
+DROP TABLE "audit_entries";
@@ -0,0 +1,8 @@
+-- create "audit_entries" table
+CREATE TABLE "audit_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "actor_key_id" uuid NULL, "actor_name" character varying NULL, "actor_role" character varying NULL, "action" character varying NOT NULL, "entity" character varying NOT NULL, "entity_id" character varying NOT NULL, "diff" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditentry_entity_entity_id" to table: "audit_entries"
+CREATE INDEX "auditentry_entity_entity_id" ON "audit_entries" ("entity", "entity_id");
+-- create index "auditentry_actor_key_id" to table: "audit_entries"
+CREATE INDEX "auditentry_actor_key_id" ON "audit_entries" ("actor_key_id");
+-- create index "auditentry_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_created_at" ON "audit_entries" ("created_at");
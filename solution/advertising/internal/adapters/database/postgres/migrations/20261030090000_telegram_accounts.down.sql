@@ -0,0 +1,8 @@
+-- reverse: create index "telegramaccount_advertiser_id" to table: "telegram_accounts"
+DROP INDEX "telegramaccount_advertiser_id";
+-- reverse: create index "telegram_accounts_telegram_id_key" to table: "telegram_accounts"
+DROP INDEX "telegram_accounts_telegram_id_key";
+-- reverse: create "telegram_accounts" table
+DROP TABLE "telegram_accounts";
+-- reverse: modify "campaigns" table
+ALTER TABLE "campaigns" DROP COLUMN "paused";
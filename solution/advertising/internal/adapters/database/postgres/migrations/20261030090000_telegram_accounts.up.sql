@@ -0,0 +1,8 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "paused" boolean NOT NULL DEFAULT false;
+-- create "telegram_accounts" table
+CREATE TABLE "telegram_accounts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "telegram_id" bigint NOT NULL, "chat_id" bigint NOT NULL, "advertiser_id" uuid NOT NULL, "api_key_id" uuid NOT NULL, "linked_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "telegram_accounts_telegram_id_key" to table: "telegram_accounts"
+CREATE UNIQUE INDEX "telegram_accounts_telegram_id_key" ON "telegram_accounts" ("telegram_id");
+-- create index "telegramaccount_advertiser_id" to table: "telegram_accounts"
+CREATE INDEX "telegramaccount_advertiser_id" ON "telegram_accounts" ("advertiser_id");
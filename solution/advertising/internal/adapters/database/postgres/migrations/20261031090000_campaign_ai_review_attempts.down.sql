@@ -0,0 +1,2 @@
+-- reverse: modify "campaigns" table
+ALTER TABLE "campaigns" DROP COLUMN "ai_review_retry_at", DROP COLUMN "ai_review_attempts";
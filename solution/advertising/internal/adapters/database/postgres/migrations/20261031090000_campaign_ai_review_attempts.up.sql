@@ -0,0 +1,2 @@
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "ai_review_attempts" bigint NOT NULL DEFAULT 0, ADD COLUMN "ai_review_retry_at" timestamptz NULL;
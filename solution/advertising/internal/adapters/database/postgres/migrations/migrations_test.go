@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := load(files)
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	var prev uint64
+	for _, m := range migrations {
+		assert.Greater(t, m.Version, prev, "migration %s must have version greater than %d", m.Name, prev)
+		assert.NotEmpty(t, m.Down, "migration %d_%s has no down file", m.Version, m.Name)
+		prev = m.Version
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("sorted by version", func(t *testing.T) {
+		migrations, err := load(fstest.MapFS{
+			"2_second.up.sql":   {Data: []byte("up 2")},
+			"2_second.down.sql": {Data: []byte("down 2")},
+			"1_first.up.sql":    {Data: []byte("up 1")},
+			"atlas.sum":         {Data: []byte("h1:")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []Migration{
+			{Version: 1, Name: "first", Up: "up 1"},
+			{Version: 2, Name: "second", Up: "up 2", Down: "down 2"},
+		}, migrations)
+	})
+	t.Run("missing up file", func(t *testing.T) {
+		_, err := load(fstest.MapFS{"1_first.down.sql": {Data: []byte("down 1")}})
+		assert.Error(t, err)
+	})
+}
+
+func TestPendingAndRollbackMigrations(t *testing.T) {
+	all := []Migration{
+		{Version: 1, Name: "first"},
+		{Version: 2, Name: "second"},
+		{Version: 3, Name: "third"},
+	}
+	applied := map[uint64]time.Time{1: time.Now(), 2: time.Now()}
+
+	assert.Equal(t, []Migration{all[2]}, pendingMigrations(all, applied))
+	assert.Equal(t, []Migration{all[1]}, rollbackMigrations(all, applied, 1))
+	assert.Equal(t, []Migration{all[1], all[0]}, rollbackMigrations(all, applied, 5))
+	assert.Empty(t, rollbackMigrations(all, map[uint64]time.Time{}, 1))
+}
@@ -0,0 +1,217 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// MigrationStatus описывает состояние миграции на текущей базе
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// advisoryLockID — ключ pg_advisory_lock, не дающий двум процессам применять миграции одновременно
+const advisoryLockID = 7_262_019_028
+
+const createSchemaMigrationsTable = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name character varying NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)
+`
+
+// Migrator применяет миграции из этого пакета к базе Postgres
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewMigrator создает Migrator для встроенных в бинарник миграций
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Statuses возвращает состояние всех известных миграций
+func (m *Migrator) Statuses(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		appliedAt, ok := applied[migration.Version]
+		statuses = append(statuses, MigrationStatus{
+			Migration: migration,
+			Applied:   ok,
+			AppliedAt: appliedAt,
+		})
+	}
+
+	return statuses, nil
+}
+
+// Up применяет все неприменённые миграции по порядку и возвращает их список.
+// Каждая миграция выполняется в отдельной транзакции вместе с записью в schema_migrations.
+// В режиме dryRun запросы не выполняются, а только возвращается список миграций, которые были бы применены
+func (m *Migrator) Up(ctx context.Context, dryRun bool) ([]Migration, error) {
+	var pending []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		pending = pendingMigrations(m.migrations, applied)
+		if dryRun {
+			return nil
+		}
+
+		for _, migration := range pending {
+			if err := m.apply(ctx, conn, migration.Up,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`,
+				migration.Version, migration.Name,
+			); err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+		}
+
+		return nil
+	})
+
+	return pending, err
+}
+
+// Down откатывает последние steps примененных миграций в обратном порядке и возвращает их список.
+// В режиме dryRun запросы не выполняются
+func (m *Migrator) Down(ctx context.Context, steps int, dryRun bool) ([]Migration, error) {
+	var rollback []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		rollback = rollbackMigrations(m.migrations, applied, steps)
+		if dryRun {
+			return nil
+		}
+
+		for _, migration := range rollback {
+			if migration.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+			}
+			if err := m.apply(ctx, conn, migration.Down,
+				`DELETE FROM schema_migrations WHERE version = $1`,
+				migration.Version,
+			); err != nil {
+				return fmt.Errorf("failed to roll back migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+		}
+
+		return nil
+	})
+
+	return rollback, err
+}
+
+// apply выполняет скрипт миграции и запрос учета в schema_migrations в одной транзакции
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, script string, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// withLock выполняет fn, удерживая advisory lock на отдельном соединении
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, advisoryLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, advisoryLockID)
+	}()
+
+	return fn(conn)
+}
+
+// applied возвращает версии примененных миграций и время их применения
+func (m *Migrator) applied(ctx context.Context) (map[uint64]time.Time, error) {
+	if _, err := m.db.ExecContext(ctx, createSchemaMigrationsTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[uint64]time.Time)
+	for rows.Next() {
+		var (
+			version   uint64
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan applied migration: %w", err)
+		}
+		applied[version] = appliedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating applied migrations: %w", err)
+	}
+
+	return applied, nil
+}
+
+// pendingMigrations возвращает миграции, которые еще не были применены, в порядке применения
+func pendingMigrations(all []Migration, applied map[uint64]time.Time) []Migration {
+	var pending []Migration
+	for _, m := range all {
+		if _, ok := applied[m.Version]; !ok {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+// rollbackMigrations возвращает последние steps примененных миграций в порядке отката
+func rollbackMigrations(all []Migration, applied map[uint64]time.Time, steps int) []Migration {
+	var rollback []Migration
+	for i := len(all) - 1; i >= 0 && len(rollback) < steps; i-- {
+		if _, ok := applied[all[i].Version]; ok {
+			rollback = append(rollback, all[i])
+		}
+	}
+	return rollback
+}
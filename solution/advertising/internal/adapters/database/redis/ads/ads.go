@@ -30,6 +30,10 @@ type Storage interface {
 	Get(ctx context.Context, userID uuid.UUID) (Ad, error)
 	Close() error
 	GetMedianScore(ctx context.Context, userID uuid.UUID) (decimal.Decimal, error)
+	// List возвращает все подобранные пользователю объявления
+	List(ctx context.Context, userID uuid.UUID) ([]Ad, error)
+	// RemoveUser удаляет все объявления пользователя и возвращает их количество
+	RemoveUser(ctx context.Context, userID uuid.UUID) (int, error)
 }
 
 type storage struct {
@@ -245,6 +249,81 @@ func (s *storage) GetMedianScore(ctx context.Context, userID uuid.UUID) (decimal
 	return decimal.NewFromFloat(scoreValues[n/2]), nil
 }
 
+func (s *storage) List(ctx context.Context, userID uuid.UUID) ([]Ad, error) {
+	key := fmt.Sprintf("user:%s:ads", userID.String())
+
+	results, err := s.redis.ZRevRangeWithScores(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ads: %w", err)
+	}
+
+	ads := make([]Ad, 0, len(results))
+	for _, result := range results {
+		adID := result.Member.(string)
+		id, err := uuid.Parse(adID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ad id %q: %w", adID, err)
+		}
+		ad, err := s.adData(ctx, adID)
+		if err != nil {
+			return nil, err
+		}
+		// Данные объявления общие для всех пользователей и могли быть перезаписаны,
+		// поэтому идентификатор пользователя и score берутся из его набора
+		ad.UserID = userID
+		ad.AdID = id
+		ad.Score = decimal.NewFromFloat(result.Score)
+		ads = append(ads, ad)
+	}
+
+	return ads, nil
+}
+
+func (s *storage) RemoveUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	key := fmt.Sprintf("user:%s:ads", userID.String())
+
+	adIDs, err := s.redis.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ads: %w", err)
+	}
+
+	pipe := s.redis.Pipeline()
+	for _, adID := range adIDs {
+		ad, err := s.adData(ctx, adID)
+		if err != nil {
+			return 0, err
+		}
+		// Данные, перезаписанные для другого пользователя, ему и нужны
+		if ad.UserID == userID {
+			pipe.Del(ctx, fmt.Sprintf("ad:%s", adID))
+		}
+	}
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to remove user ads: %w", err)
+	}
+
+	return len(adIDs), nil
+}
+
+// adData возвращает данные объявления, пустое объявление если данных нет
+func (s *storage) adData(ctx context.Context, adID string) (Ad, error) {
+	data, err := s.redis.Get(ctx, fmt.Sprintf("ad:%s", adID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return Ad{}, nil
+		}
+		return Ad{}, fmt.Errorf("failed to get ad data: %w", err)
+	}
+
+	var ad Ad
+	if err := json.Unmarshal(data, &ad); err != nil {
+		return Ad{}, fmt.Errorf("failed to unmarshal ad data: %w", err)
+	}
+
+	return ad, nil
+}
+
 func (s *storage) Close() error {
 	return s.redis.Close()
 }
@@ -0,0 +1,105 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Limit задает корзину токенов: Rate токенов в секунду, не больше Burst накопленных
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result — результат попытки взять токен
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter — через сколько появится следующий токен, если запрос не разрешен
+	RetryAfter time.Duration
+}
+
+type Storage interface {
+	// Take списывает токен из корзины key, пополняя ее за прошедшее время
+	Take(ctx context.Context, key string, limit Limit) (*Result, error)
+	Close() error
+}
+
+// takeScript атомарно пополняет корзину и списывает токен.
+// Корзина удаляется, когда успевает полностью пополниться
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or burst
+local ts = tonumber(bucket[2]) or now
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, math.floor(tokens), retry}
+`)
+
+type storage struct {
+	redis *redis.Client
+}
+
+func NewStorage(client *redis.Client) Storage {
+	return &storage{redis: client}
+}
+
+func key(name string) string {
+	return fmt.Sprintf("ratelimit:%s", name)
+}
+
+func (s *storage) Take(ctx context.Context, name string, limit Limit) (*Result, error) {
+	raw, err := takeScript.Run(
+		ctx,
+		s.redis,
+		[]string{key(name)},
+		limit.Rate,
+		limit.Burst,
+		time.Now().UnixMilli(),
+	).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take token: %w", err)
+	}
+	values, _ := raw.([]interface{})
+
+	reply := make([]int64, 0, 3)
+	for _, value := range values {
+		n, ok := value.(int64)
+		if !ok {
+			break
+		}
+		reply = append(reply, n)
+	}
+	if len(reply) != 3 {
+		return nil, fmt.Errorf("unexpected token bucket reply: %v", values)
+	}
+
+	return &Result{
+		Allowed:    reply[0] == 1,
+		Remaining:  int(reply[1]),
+		RetryAfter: time.Duration(reply[2]) * time.Millisecond,
+	}, nil
+}
+
+func (s *storage) Close() error {
+	return s.redis.Close()
+}
@@ -0,0 +1,88 @@
+package buckets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorage(t *testing.T) (Storage, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	s := NewStorage(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	t.Cleanup(func() { _ = s.Close() })
+	return s, mr
+}
+
+func TestTakeDeniesAfterBurst(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStorage(t)
+	limit := Limit{Rate: 0.5, Burst: 3}
+
+	for i := 0; i < limit.Burst; i++ {
+		result, err := s.Take(ctx, "client", limit)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, limit.Burst-i-1, result.Remaining)
+		assert.Zero(t, result.RetryAfter)
+	}
+
+	result, err := s.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Zero(t, result.Remaining)
+	// Токен появляется раз в две секунды; часть интервала уже могла пройти
+	assert.Greater(t, result.RetryAfter, time.Duration(0))
+	assert.LessOrEqual(t, result.RetryAfter, 2*time.Second)
+
+	// Корзины разных ключей независимы
+	result, err = s.Take(ctx, "other-client", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+func TestTakeRefills(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStorage(t)
+	limit := Limit{Rate: 100, Burst: 1}
+
+	result, err := s.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	result, err = s.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+
+	time.Sleep(result.RetryAfter + 5*time.Millisecond)
+
+	result, err = s.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+func TestTakeExpiresBucket(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestStorage(t)
+
+	_, err := s.Take(ctx, "client", Limit{Rate: 1, Burst: 2})
+	require.NoError(t, err)
+
+	// Корзина живет, пока не пополнится полностью, и еще секунду
+	require.True(t, mr.Exists(key("client")))
+	assert.Equal(t, 3*time.Second, mr.TTL(key("client")))
+}
+
+func TestTakeRedisUnavailable(t *testing.T) {
+	s, mr := newTestStorage(t)
+	mr.Close()
+
+	_, err := s.Take(context.Background(), "client", Limit{Rate: 1, Burst: 1})
+	assert.Error(t, err)
+}
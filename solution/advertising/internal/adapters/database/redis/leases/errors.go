@@ -0,0 +1,8 @@
+package leases
+
+import "errors"
+
+var (
+	ErrLeaseHeld     = errors.New("campaign is claimed by another moderator")
+	ErrLeaseNotFound = errors.New("lease not found")
+)
@@ -0,0 +1,161 @@
+package leases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// Lease — захват кампании модератором на время проверки
+type Lease struct {
+	CampaignID uuid.UUID `json:"campaign_id"`
+	Moderator  string    `json:"moderator"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type Storage interface {
+	// Acquire захватывает кампанию или продлевает захват того же модератора.
+	// Если кампания захвачена другим модератором, возвращается текущий захват и ErrLeaseHeld
+	Acquire(ctx context.Context, campaignID uuid.UUID, moderator string, ttl time.Duration) (*Lease, error)
+	// Release снимает захват, только если он принадлежит модератору
+	Release(ctx context.Context, campaignID uuid.UUID, moderator string) error
+	Get(ctx context.Context, campaignID uuid.UUID) (*Lease, error)
+	// GetMany возвращает действующие захваты для кампаний; незахваченных кампаний в результате нет
+	GetMany(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]*Lease, error)
+	Close() error
+}
+
+// acquireScript атомарно создает захват, если ключа нет или он принадлежит тому же модератору.
+// Возвращает текущее значение ключа
+var acquireScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current then
+	local lease = cjson.decode(current)
+	if lease['moderator'] ~= ARGV[2] then
+		return current
+	end
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[3])
+return ARGV[1]
+`)
+
+// releaseScript удаляет ключ, только если захват принадлежит модератору
+var releaseScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current then
+	return 0
+end
+local lease = cjson.decode(current)
+if lease['moderator'] ~= ARGV[1] then
+	return -1
+end
+redis.call('DEL', KEYS[1])
+return 1
+`)
+
+type storage struct {
+	redis *redis.Client
+}
+
+func NewStorage(client *redis.Client) Storage {
+	return &storage{redis: client}
+}
+
+func key(campaignID uuid.UUID) string {
+	return fmt.Sprintf("moderation:lease:%s", campaignID.String())
+}
+
+func (s *storage) Acquire(ctx context.Context, campaignID uuid.UUID, moderator string, ttl time.Duration) (*Lease, error) {
+	data, err := json.Marshal(Lease{
+		CampaignID: campaignID,
+		Moderator:  moderator,
+		ExpiresAt:  time.Now().Add(ttl),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal lease: %w", err)
+	}
+
+	current, err := acquireScript.Run(ctx, s.redis, []string{key(campaignID)}, data, moderator, ttl.Milliseconds()).Text()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	var lease Lease
+	if err := json.Unmarshal([]byte(current), &lease); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lease: %w", err)
+	}
+	if lease.Moderator != moderator {
+		return &lease, ErrLeaseHeld
+	}
+	return &lease, nil
+}
+
+func (s *storage) Release(ctx context.Context, campaignID uuid.UUID, moderator string) error {
+	result, err := releaseScript.Run(ctx, s.redis, []string{key(campaignID)}, moderator).Int()
+	if err != nil {
+		return fmt.Errorf("failed to release lease: %w", err)
+	}
+
+	switch result {
+	case 0:
+		return ErrLeaseNotFound
+	case -1:
+		return ErrLeaseHeld
+	}
+	return nil
+}
+
+func (s *storage) Get(ctx context.Context, campaignID uuid.UUID) (*Lease, error) {
+	data, err := s.redis.Get(ctx, key(campaignID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrLeaseNotFound
+		}
+		return nil, fmt.Errorf("failed to get lease: %w", err)
+	}
+
+	var lease Lease
+	if err := json.Unmarshal(data, &lease); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lease: %w", err)
+	}
+	return &lease, nil
+}
+
+func (s *storage) GetMany(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]*Lease, error) {
+	result := make(map[uuid.UUID]*Lease)
+	if len(campaignIDs) == 0 {
+		return result, nil
+	}
+
+	keys := make([]string, 0, len(campaignIDs))
+	for _, campaignID := range campaignIDs {
+		keys = append(keys, key(campaignID))
+	}
+
+	values, err := s.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leases: %w", err)
+	}
+
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var lease Lease
+		if err := json.Unmarshal([]byte(data), &lease); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal lease: %w", err)
+		}
+		result[lease.CampaignID] = &lease
+	}
+	return result, nil
+}
+
+func (s *storage) Close() error {
+	return s.redis.Close()
+}
@@ -0,0 +1,92 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// retryInterval — как часто проверяется, не освободилась ли занятая блокировка
+const retryInterval = 500 * time.Millisecond
+
+type Storage interface {
+	// Acquire ждет, пока блокировка name освободится, и захватывает ее. Пока блокировка удерживается,
+	// ее срок продлевается на ttl, поэтому после падения процесса она снимется сама не позже чем через ttl.
+	// Возвращает функцию, снимающую блокировку
+	Acquire(ctx context.Context, name string, ttl time.Duration) (release func(), err error)
+	Close() error
+}
+
+// extendScript продлевает блокировку, только если она все еще принадлежит владельцу
+var extendScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript снимает блокировку, только если она принадлежит владельцу
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type storage struct {
+	redis *redis.Client
+}
+
+func NewStorage(client *redis.Client) Storage {
+	return &storage{redis: client}
+}
+
+func key(name string) string {
+	return fmt.Sprintf("lock:%s", name)
+}
+
+func (s *storage) Acquire(ctx context.Context, name string, ttl time.Duration) (func(), error) {
+	token := uuid.NewString()
+
+	for {
+		ok, err := s.redis.SetNX(ctx, key(name), token, ttl).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire lock %s: %w", name, err)
+		}
+		if ok {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to acquire lock %s: %w", name, ctx.Err())
+		case <-time.After(retryInterval):
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = extendScript.Run(context.Background(), s.redis, []string{key(name)}, token, ttl.Milliseconds()).Err()
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		_ = releaseScript.Run(context.Background(), s.redis, []string{key(name)}, token).Err()
+	}, nil
+}
+
+func (s *storage) Close() error {
+	return s.redis.Close()
+}
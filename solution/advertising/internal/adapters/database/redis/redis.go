@@ -5,15 +5,21 @@ import (
 	"fmt"
 	"github.com/go-redis/redis/v8"
 	"nlypage-final/internal/adapters/database/redis/ads"
+	"nlypage-final/internal/adapters/database/redis/buckets"
+	"nlypage-final/internal/adapters/database/redis/leases"
+	"nlypage-final/internal/adapters/database/redis/locks"
 	"nlypage-final/internal/adapters/database/redis/states"
 	"nlypage-final/internal/adapters/database/redis/time"
 )
 
 type Client struct {
-	Time   time.Storage
-	States states.Storage
-	Ads    ads.Storage
-	Cache  *redis.Client
+	Time    time.Storage
+	States  states.Storage
+	Ads     ads.Storage
+	Leases  leases.Storage
+	Buckets buckets.Storage
+	Locks   locks.Storage
+	Cache   *redis.Client
 }
 
 type Options struct {
@@ -59,11 +65,41 @@ func New(opts Options) (*Client, error) {
 		return nil, fmt.Errorf("failed to ping cache storage: %w", err)
 	}
 
+	leasesRedis := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%s", opts.Host, opts.Port),
+		Password: opts.Password,
+		DB:       4,
+	})
+	if err := leasesRedis.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("failed to ping leases storage: %w", err)
+	}
+
+	bucketsRedis := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%s", opts.Host, opts.Port),
+		Password: opts.Password,
+		DB:       5,
+	})
+	if err := bucketsRedis.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("failed to ping buckets storage: %w", err)
+	}
+
+	locksRedis := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%s", opts.Host, opts.Port),
+		Password: opts.Password,
+		DB:       6,
+	})
+	if err := locksRedis.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("failed to ping locks storage: %w", err)
+	}
+
 	return &Client{
-		Time:   time.NewStorage(timeRedis),
-		States: states.NewStorage(statesRedis),
-		Ads:    ads.NewStorage(adsRedis),
-		Cache:  cacheRedis,
+		Time:    time.NewStorage(timeRedis),
+		States:  states.NewStorage(statesRedis),
+		Ads:     ads.NewStorage(adsRedis),
+		Leases:  leases.NewStorage(leasesRedis),
+		Buckets: buckets.NewStorage(bucketsRedis),
+		Locks:   locks.NewStorage(locksRedis),
+		Cache:   cacheRedis,
 	}, nil
 }
 
@@ -72,5 +108,8 @@ func (c *Client) CloseAll() error {
 	_ = c.Time.Close()
 	_ = c.States.Close()
 	_ = c.Ads.Close()
+	_ = c.Leases.Close()
+	_ = c.Buckets.Close()
+	_ = c.Locks.Close()
 	return nil
 }
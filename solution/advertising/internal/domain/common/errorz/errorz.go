@@ -7,6 +7,14 @@ var (
 		Code:    echo.ErrNotFound.Code,
 		Message: "Not Found",
 	}
+	ErrUnauthorized = &echo.HTTPError{
+		Code:    echo.ErrUnauthorized.Code,
+		Message: "Unauthorized",
+	}
+	ErrForbidden = &echo.HTTPError{
+		Code:    echo.ErrForbidden.Code,
+		Message: "Forbidden",
+	}
 	ErrInternal = &echo.HTTPError{
 		Code:    echo.ErrInternalServerError.Code,
 		Message: "Internal Server Error",
@@ -18,4 +18,12 @@ type ClientAdGet struct {
 type ClientAdClick struct {
 	AdID     uuid.UUID `param:"adId" validate:"required"`
 	ClientID uuid.UUID `json:"client_id" validate:"required"`
+	IP       string    `json:"-"` // адрес, с которого пришел клик, для проверки недействительного трафика
+}
+
+// ClientAdConversion описывает целевое действие клиента после клика по рекламе
+type ClientAdConversion struct {
+	AdID     uuid.UUID `param:"adId" validate:"required"`
+	ClientID uuid.UUID `json:"client_id" validate:"required"`
+	Value    *float64  `json:"value" validate:"omitempty,gte=0"` // ценность действия для рекламодателя, например сумма покупки
 }
@@ -24,3 +24,17 @@ type MlScoreUpsert struct {
 	AdvertiserID uuid.UUID `json:"advertiser_id" validate:"required"`
 	Score        int64     `json:"score" validate:"gte=0"`
 }
+
+// AdvertiserBalance представляет баланс рекламодателя и его расходы
+type AdvertiserBalance struct {
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	Balance      float64   `json:"balance"`
+	SpentToday   float64   `json:"spent_today"`
+	SpentTotal   float64   `json:"spent_total"`
+}
+
+// AdvertiserTopUp представляет DTO для пополнения баланса рекламодателя
+type AdvertiserTopUp struct {
+	AdvertiserID uuid.UUID `param:"advertiserId" validate:"required"`
+	Amount       float64   `json:"amount" validate:"required,gt=0"`
+}
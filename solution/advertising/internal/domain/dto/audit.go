@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"nlypage-final/pkg/jsondiff"
+)
+
+// Действия, которые записываются в журнал аудита
+const (
+	AuditActionCreate   = "CREATE"
+	AuditActionUpdate   = "UPDATE"
+	AuditActionUpsert   = "UPSERT"
+	AuditActionDelete   = "DELETE"
+	AuditActionPurge    = "PURGE"
+	AuditActionApprove  = "APPROVE"
+	AuditActionReject   = "REJECT"
+	AuditActionTopUp    = "TOP_UP"
+	AuditActionRevoke   = "REVOKE"
+	AuditActionAdvance  = "ADVANCE"
+	AuditActionResubmit = "RESUBMIT"
+	AuditActionPause    = "PAUSE"
+	AuditActionResume   = "RESUME"
+)
+
+// Сущности журнала аудита
+const (
+	AuditEntityAdvertiser = "advertiser"
+	AuditEntityCampaign   = "campaign"
+	AuditEntityClient     = "client"
+	AuditEntityMlScore    = "ml_score"
+	AuditEntityAPIKey     = "api_key"
+	AuditEntityTime       = "time"
+	AuditEntityTelegram   = "telegram_account"
+)
+
+// AuditRecord описывает изменение сущности. Before и After — ее состояние до и после операции,
+// nil если сущности не было или она удалена. В журнал попадают только изменившиеся поля
+type AuditRecord struct {
+	Action   string
+	Entity   string
+	EntityID string
+	Before   any
+	After    any
+	// Redact сохраняет только список изменившихся полей без значений. Журнал неизменяем,
+	// поэтому персональные данные клиентов в него не попадают: иначе их нельзя было бы удалить по запросу
+	Redact bool
+}
+
+// AuditGet описывает фильтры журнала аудита
+type AuditGet struct {
+	Entity   string     `query:"entity"`
+	EntityID string     `query:"entity_id"`
+	Action   string     `query:"action"`
+	ActorID  *uuid.UUID `query:"actor_key_id"`
+	From     *time.Time `query:"from"`
+	To       *time.Time `query:"to"`
+	Size     int        `query:"size" validate:"gte=1,lte=100"`
+	Page     int        `query:"page" validate:"gte=1"`
+}
+
+// AuditEntry — запись журнала аудита
+type AuditEntry struct {
+	ID         int                        `json:"id"`
+	ActorKeyID *uuid.UUID                 `json:"actor_key_id,omitempty"`
+	ActorName  string                     `json:"actor_name,omitempty"`
+	ActorRole  string                     `json:"actor_role,omitempty"`
+	Action     string                     `json:"action"`
+	Entity     string                     `json:"entity"`
+	EntityID   string                     `json:"entity_id"`
+	Diff       map[string]jsondiff.Change `json:"diff"`
+	CreatedAt  time.Time                  `json:"created_at"`
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Роли ключей доступа к API
+const (
+	RoleAdmin      = "ADMIN"
+	RoleModerator  = "MODERATOR"
+	RoleAdvertiser = "ADVERTISER"
+	RoleClient     = "CLIENT"
+)
+
+// Principal — владелец ключа, которым аутентифицирован запрос
+type Principal struct {
+	KeyID uuid.UUID
+	Name  string
+	Role  string
+	// AdvertiserID задан для роли ADVERTISER
+	AdvertiserID *uuid.UUID
+}
+
+type principalContextKey struct{}
+
+// ContextWithPrincipal сохраняет владельца ключа в контексте запроса, чтобы сервисы знали, кто выполняет операцию
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext возвращает владельца ключа из контекста, nil если запрос не аутентифицирован
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalContextKey{}).(*Principal)
+	return principal
+}
+
+type APIKeyCreate struct {
+	Name         string     `json:"name" validate:"required"`
+	Role         string     `json:"role" validate:"required,oneof=ADMIN MODERATOR ADVERTISER CLIENT"`
+	AdvertiserID *uuid.UUID `json:"advertiser_id" validate:"required_if=Role ADVERTISER,excluded_unless=Role ADVERTISER"`
+}
+
+type APIKeyRevoke struct {
+	KeyID uuid.UUID `param:"keyId" validate:"required"`
+}
+
+// APIKey описывает выданный ключ без секрета
+type APIKey struct {
+	KeyID        uuid.UUID  `json:"key_id"`
+	Name         string     `json:"name"`
+	Prefix       string     `json:"prefix"`
+	Role         string     `json:"role"`
+	AdvertiserID *uuid.UUID `json:"advertiser_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+}
+
+// APIKeyCreated — новый ключ. Key возвращается только при создании
+type APIKeyCreated struct {
+	APIKey
+	Key string `json:"key"`
+}
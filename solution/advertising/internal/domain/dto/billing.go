@@ -0,0 +1,35 @@
+package dto
+
+import "github.com/google/uuid"
+
+// InvoiceGet описывает параметры счета рекламодателя. Дни from и to включаются в счет,
+// если граница не указана, период с этой стороны не ограничивается
+type InvoiceGet struct {
+	AdvertiserID uuid.UUID `param:"advertiserId" validate:"required"`
+	Format       string    `query:"format" validate:"omitempty,oneof=json pdf"`
+	From         *int      `query:"from" validate:"omitempty,gte=0"`
+	To           *int      `query:"to" validate:"omitempty,gte=0"`
+}
+
+// Invoice представляет счет рекламодателя за диапазон дней
+type Invoice struct {
+	AdvertiserID   uuid.UUID     `json:"advertiser_id"`
+	AdvertiserName string        `json:"advertiser_name"`
+	From           int           `json:"from"`
+	To             int           `json:"to"`
+	Lines          []InvoiceLine `json:"lines"`
+	Total          float64       `json:"total"`
+}
+
+// InvoiceLine — строка счета по одной кампании
+type InvoiceLine struct {
+	CampaignID        uuid.UUID `json:"campaign_id"`
+	CampaignTitle     string    `json:"campaign_title"`
+	Impressions       int64     `json:"impressions"`
+	ImpressionsAmount float64   `json:"impressions_amount"`
+	Clicks            int64     `json:"clicks"`
+	ClicksAmount      float64   `json:"clicks_amount"`
+	Conversions       int64     `json:"conversions"`
+	ConversionsAmount float64   `json:"conversions_amount"`
+	Total             float64   `json:"total"`
+}
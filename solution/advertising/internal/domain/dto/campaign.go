@@ -10,12 +10,20 @@ type Campaign struct {
 	ClicksLimit       int       `json:"clicks_limit" validate:"required,gt=0"`
 	CostPerImpression float64   `json:"cost_per_impression" validate:"gte=0"`
 	CostPerClick      float64   `json:"cost_per_click" validate:"gte=0"`
+	CostPerAction     float64   `json:"cost_per_action" validate:"gte=0"`
+	DailyBudget       *float64  `json:"daily_budget,omitempty"`
+	TotalBudget       *float64  `json:"total_budget,omitempty"`
+	Spent             float64   `json:"spent"`
 	AdTitle           string    `json:"ad_title" validate:"required"`
 	AdText            string    `json:"ad_text" validate:"required"`
 	ImageURL          string    `json:"image_url"`
 	StartDate         int       `json:"start_date" validate:"gte=0"`
 	EndDate           int       `json:"end_date" validate:"gte=0,gtefield=StartDate"`
 	Moderated         bool      `json:"moderated"`
+	ModerationStatus  string    `json:"moderation_status"`
+	RejectionReason   *string   `json:"rejection_reason,omitempty"`
+	ModerationComment *string   `json:"moderation_comment,omitempty"`
+	Paused            bool      `json:"paused"`
 	Targeting         Targeting `json:"targeting" validate:"required"`
 }
 
@@ -26,6 +34,9 @@ type CampaignCreate struct {
 	ClicksLimit       int        `json:"clicks_limit" validate:"required,gt=0"`
 	CostPerImpression float64    `json:"cost_per_impression" validate:"gte=0"`
 	CostPerClick      float64    `json:"cost_per_click" validate:"gte=0"`
+	CostPerAction     float64    `json:"cost_per_action" validate:"gte=0"`
+	DailyBudget       *float64   `json:"daily_budget" validate:"omitempty,gt=0"`
+	TotalBudget       *float64   `json:"total_budget" validate:"omitempty,gt=0"`
 	AdTitle           string     `json:"ad_title" validate:"required"`
 	AdText            string     `json:"ad_text" validate:"required"`
 	StartDate         int        `json:"start_date" validate:"gte=0"`
@@ -60,6 +71,9 @@ type CampaignUpdate struct {
 	ClicksLimit       int        `json:"clicks_limit" validate:"required,gt=0"`
 	CostPerImpression float64    `json:"cost_per_impression" validate:"gt=0"`
 	CostPerClick      float64    `json:"cost_per_click" validate:"gt=0"`
+	CostPerAction     float64    `json:"cost_per_action" validate:"gte=0"`
+	DailyBudget       *float64   `json:"daily_budget" validate:"omitempty,gt=0"`
+	TotalBudget       *float64   `json:"total_budget" validate:"omitempty,gt=0"`
 	AdTitle           string     `json:"ad_title" validate:"required"`
 	AdText            string     `json:"ad_text" validate:"required"`
 	StartDate         int        `json:"start_date" validate:"gte=0"`
@@ -72,6 +86,16 @@ type CampaignDelete struct {
 	CampaignID   uuid.UUID `param:"campaignId" validate:"required"`
 }
 
+// CampaignPause описывает приостановку или возобновление показов кампании рекламодателем
+type CampaignPause struct {
+	AdvertiserID uuid.UUID `param:"advertiserId" validate:"required"`
+	CampaignID   uuid.UUID `param:"campaignId" validate:"required"`
+}
+
+type CampaignPurge struct {
+	CampaignID uuid.UUID `param:"campaignId" validate:"required"`
+}
+
 type CampaignUploadImageRequest struct {
 	AdvertiserID uuid.UUID `param:"advertiserId" validate:"required"`
 	CampaignID   uuid.UUID `param:"campaignId" validate:"required"`
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClientErasure — запись об удалении персональных данных клиента
+type ClientErasure struct {
+	ClientID    uuid.UUID  `json:"client_id"`
+	MlScores    int        `json:"ml_scores"`
+	CachedAds   int        `json:"cached_ads"`
+	RequestedAt time.Time  `json:"requested_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// ClientExport содержит все данные, которые сервис хранит о клиенте
+type ClientExport struct {
+	Client        Client                `json:"client"`
+	MlScores      []ClientExportMlScore `json:"ml_scores"`
+	Impressions   []ClientExportView    `json:"impressions"`
+	Clicks        []ClientExportClick   `json:"clicks"`
+	InvalidClicks []ClientExportClick   `json:"invalid_clicks"`
+	Conversions   []ClientExportAction  `json:"conversions"`
+	// CachedAds — объявления, подобранные клиенту для следующих показов
+	CachedAds []ClientExportCachedAd `json:"cached_ads"`
+}
+
+type ClientExportMlScore struct {
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	Score        int64     `json:"score"`
+}
+
+type ClientExportView struct {
+	CampaignID   uuid.UUID `json:"campaign_id"`
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	Day          int       `json:"day"`
+	ViewCount    uint64    `json:"view_count"`
+	ShownAt      time.Time `json:"shown_at"`
+}
+
+type ClientExportClick struct {
+	CampaignID   uuid.UUID `json:"campaign_id"`
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	Day          int       `json:"day"`
+	IP           string    `json:"ip"`
+	Reason       string    `json:"reason,omitempty"`
+	ClickedAt    time.Time `json:"clicked_at"`
+}
+
+type ClientExportAction struct {
+	CampaignID   uuid.UUID `json:"campaign_id"`
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	Value        float64   `json:"value"`
+	ClickDay     int       `json:"click_day"`
+	Day          int       `json:"day"`
+}
+
+type ClientExportCachedAd struct {
+	AdID         uuid.UUID `json:"ad_id"`
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	AdTitle      string    `json:"ad_title"`
+	Score        float64   `json:"score"`
+}
@@ -2,12 +2,62 @@ package dto
 
 import "github.com/google/uuid"
 
+// Тон рекламного текста
+const (
+	AdToneNeutral  = "neutral"
+	AdToneFriendly = "friendly"
+	AdToneFormal   = "formal"
+	AdTonePlayful  = "playful"
+	AdToneUrgent   = "urgent"
+)
+
 type GenerateAdTextRequest struct {
 	AdvertiserID   uuid.UUID `param:"advertiserId" validate:"required"`
 	AdTitle        string    `json:"ad_title" validate:"required"`
 	AdditionalInfo string    `json:"additional_info"`
+	// N — количество вариантов текста, по умолчанию 1
+	N    int    `json:"n" validate:"omitempty,gte=1,lte=4"`
+	Tone string `json:"tone" validate:"omitempty,oneof=neutral friendly formal playful urgent"`
+	// Length — желаемая длина текста в символах
+	Length int `json:"length" validate:"omitempty,gte=20,lte=1000"`
+	// CampaignID — кампания, таргетинг которой используется как описание целевой аудитории
+	CampaignID *uuid.UUID `json:"campaign_id"`
 }
 
 type GenerateAdTextResponse struct {
-	AdText string `json:"ad_text" validate:"required"`
+	// AdText — лучший из вариантов
+	AdText   string          `json:"ad_text" validate:"required"`
+	Variants []AdTextVariant `json:"variants"`
+}
+
+// AdTextVariant — вариант рекламного текста. Варианты отсортированы по rank, лучший первый
+type AdTextVariant struct {
+	Rank       int    `json:"rank"`
+	AdText     string `json:"ad_text"`
+	Characters int    `json:"characters"`
+}
+
+// GenerateCreativeRequest описывает генерацию креатива кампании по краткому описанию продукта
+type GenerateCreativeRequest struct {
+	AdvertiserID uuid.UUID `param:"advertiserId" validate:"required"`
+	Brief        string    `json:"brief" validate:"required,max=2000"`
+	// Titles — количество вариантов заголовка, по умолчанию 3
+	Titles int    `json:"titles" validate:"omitempty,gte=1,lte=5"`
+	Tone   string `json:"tone" validate:"omitempty,oneof=neutral friendly formal playful urgent"`
+}
+
+// GenerateCreativeResponse — предложенный креатив. Campaign можно дополнить лимитами и датами
+// и отправить в создание кампании
+type GenerateCreativeResponse struct {
+	Titles    []string      `json:"titles"`
+	AdText    string        `json:"ad_text"`
+	Targeting Targeting     `json:"targeting"`
+	Campaign  CampaignDraft `json:"campaign"`
+}
+
+// CampaignDraft — часть тела запроса создания кампании, заполненная по креативу
+type CampaignDraft struct {
+	AdTitle   string    `json:"ad_title"`
+	AdText    string    `json:"ad_text"`
+	Targeting Targeting `json:"targeting"`
 }
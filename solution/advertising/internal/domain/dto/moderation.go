@@ -1,7 +1,114 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Статусы модерации рекламной кампании
+const (
+	ModerationStatusPending  = "PENDING"
+	ModerationStatusApproved = "APPROVED"
+	ModerationStatusRejected = "REJECTED"
+)
+
+// Сортировка очереди модерации
+const (
+	ModerationSortStartDate = "start_date"
+	ModerationSortRisk      = "risk"
+)
+
+// ModerationCampaignsGet описывает параметры получения очереди модерации
+type ModerationCampaignsGet struct {
+	Sort          string     `query:"sort" validate:"omitempty,oneof=start_date risk"`
+	AdvertiserID  *uuid.UUID `query:"advertiser_id"`
+	StartDateFrom *int       `query:"start_date_from" validate:"omitempty,gte=0"`
+	StartDateTo   *int       `query:"start_date_to" validate:"omitempty,gte=0"`
+	Size          int        `query:"size" validate:"gte=1,lte=100"`
+	Page          int        `query:"page" validate:"gte=1"`
+}
+
+// ModerationCampaign — кампания в очереди модерации вместе с вердиктом ассистента и захватом модератора
+type ModerationCampaign struct {
+	Campaign
+	// ApprovedCreative — одобренная ранее версия креатива, если кампания на повторной модерации
+	ApprovedCreative *ApprovedCreative `json:"approved_creative,omitempty"`
+	AIVerdict        *AIVerdict        `json:"ai_verdict,omitempty"`
+	Lease            *ModerationLease  `json:"lease,omitempty"`
+}
+
+type ApprovedCreative struct {
+	AdTitle  string `json:"ad_title"`
+	AdText   string `json:"ad_text"`
+	ImageURL string `json:"image_url"`
+}
+
+// ModerationLease — захват кампании модератором, пока он ее проверяет
+type ModerationLease struct {
+	CampaignID uuid.UUID `json:"campaign_id"`
+	Moderator  string    `json:"moderator"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// CampaignClaim описывает захват или продление захвата кампании модератором
+type CampaignClaim struct {
+	CampaignID uuid.UUID `param:"campaignId" validate:"required"`
+	Moderator  string    `json:"moderator" validate:"required"`
+}
+
+// CampaignRelease описывает досрочное снятие захвата кампании
+type CampaignRelease struct {
+	CampaignID uuid.UUID `param:"campaignId" validate:"required"`
+	Moderator  string    `json:"moderator" validate:"required"`
+}
+
+// AIVerdict — вердикт LLM-ассистента модерации по кампании
+type AIVerdict struct {
+	Category   string        `json:"category"`
+	Violations []AIViolation `json:"violations"`
+	Confidence float64       `json:"confidence"`
+	RiskScore  float64       `json:"risk_score"`
+	ReviewedAt time.Time     `json:"reviewed_at"`
+}
+
+type AIViolation struct {
+	Policy      string `json:"policy"`
+	Description string `json:"description"`
+}
 
 type CampaignApprove struct {
 	CampaignID uuid.UUID `param:"campaignId" validate:"required"`
+	Moderator  *string   `json:"moderator"`
+	Comment    *string   `json:"comment"`
+}
+
+// CampaignReject описывает отклонение кампании модератором с кодом причины
+type CampaignReject struct {
+	CampaignID uuid.UUID `param:"campaignId" validate:"required"`
+	Reason     string    `json:"reason" validate:"required,oneof=PROHIBITED_CONTENT MISLEADING_CLAIMS INAPPROPRIATE_LANGUAGE LOW_QUALITY_CREATIVE TARGETING_VIOLATION OTHER"`
+	Comment    *string   `json:"comment"`
+	Moderator  *string   `json:"moderator"`
+}
+
+type CampaignModerationHistoryGet struct {
+	CampaignID uuid.UUID `param:"campaignId" validate:"required"`
+}
+
+// CampaignResubmit описывает повторную отправку отклоненной кампании на модерацию после исправлений
+type CampaignResubmit struct {
+	AdvertiserID uuid.UUID `param:"advertiserId" validate:"required"`
+	CampaignID   uuid.UUID `param:"campaignId" validate:"required"`
+	Comment      *string   `json:"comment"`
+}
+
+// ModerationDecision представляет запись истории модерации кампании
+type ModerationDecision struct {
+	CampaignID uuid.UUID `json:"campaign_id"`
+	Action     string    `json:"action"`
+	Reason     *string   `json:"reason,omitempty"`
+	Comment    *string   `json:"comment,omitempty"`
+	Moderator  *string   `json:"moderator,omitempty"`
+	Day        int       `json:"day"`
+	CreatedAt  time.Time `json:"created_at"`
 }
@@ -2,13 +2,20 @@ package dto
 
 import "github.com/google/uuid"
 
-// Stats содержит агрегированную статистику для рекламной кампании или рекламодателя
+// Stats содержит агрегированную статистику для рекламной кампании или рекламодателя.
+// Conversion — это CTR (клики / показы * 100), конверсия в целевые действия считается в CVR
 type Stats struct {
 	ImpressionsCount int     `json:"impressions_count" validate:"required,gte=0"`
 	ClicksCount      int     `json:"clicks_count" validate:"required,gte=0"`
 	Conversion       float64 `json:"conversion" validate:"required,gte=0"`
+	ConversionsCount int     `json:"conversions_count" validate:"gte=0"`
+	ConversionsValue float64 `json:"conversions_value" validate:"gte=0"`
+	CVR              float64 `json:"cvr" validate:"gte=0"`  // конверсии / клики * 100
+	CPA              float64 `json:"cpa" validate:"gte=0"`  // потрачено всего / конверсии
+	ROAS             float64 `json:"roas" validate:"gte=0"` // ценность конверсий / потрачено всего
 	SpentImpressions float64 `json:"spent_impressions" validate:"required,gte=0"`
 	SpentClicks      float64 `json:"spent_clicks" validate:"required,gte=0"`
+	SpentActions     float64 `json:"spent_actions" validate:"gte=0"`
 	SpentTotal       float64 `json:"spent_total" validate:"required,gte=0"`
 }
 
@@ -33,3 +40,42 @@ type StatsDaily struct {
 	Stats
 	Date int `json:"date" validate:"required,gte=0"`
 }
+
+// StatsExport описывает параметры выгрузки ежедневной статистики.
+// Если from или to не указаны, выгрузка не ограничивается с соответствующей стороны
+type StatsExport struct {
+	Format string `query:"format" validate:"omitempty,oneof=csv parquet"`
+	From   *int   `query:"from" validate:"omitempty,gte=0"`
+	To     *int   `query:"to" validate:"omitempty,gte=0"`
+}
+
+type CampaignStatsExport struct {
+	StatsExport
+	CampaignID uuid.UUID `param:"campaignId" validate:"required"`
+}
+
+type AdvertiserStatsExport struct {
+	StatsExport
+	AdvertiserID uuid.UUID `param:"advertiserId" validate:"required"`
+}
+
+// InvalidTrafficReport описывает клики, признанные недействительными и исключенные из статистики и оплаты
+type InvalidTrafficReport struct {
+	ClicksCount int                    `json:"clicks_count"`
+	InvalidRate float64                `json:"invalid_rate"` // недействительные клики / все клики * 100
+	SavedSpend  float64                `json:"saved_spend"`  // сколько не списано с рекламодателя за недействительные клики
+	Reasons     []InvalidTrafficReason `json:"reasons"`
+	Daily       []InvalidTrafficDaily  `json:"daily"`
+}
+
+type InvalidTrafficReason struct {
+	Reason      string  `json:"reason"`
+	ClicksCount int     `json:"clicks_count"`
+	SavedSpend  float64 `json:"saved_spend"`
+}
+
+type InvalidTrafficDaily struct {
+	Date        int     `json:"date"`
+	ClicksCount int     `json:"clicks_count"`
+	SavedSpend  float64 `json:"saved_spend"`
+}
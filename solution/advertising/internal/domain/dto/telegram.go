@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramAccount — Telegram-аккаунт, привязанный к рекламодателю через его ключ API
+type TelegramAccount struct {
+	TelegramID   int64     `json:"telegram_id"`
+	ChatID       int64     `json:"chat_id"`
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	APIKeyID     uuid.UUID `json:"api_key_id"`
+	LinkedAt     time.Time `json:"linked_at"`
+}
+
+// Principal возвращает владельца ключа, от имени которого бот выполняет операции рекламодателя
+func (a *TelegramAccount) Principal() *Principal {
+	advertiserID := a.AdvertiserID
+	return &Principal{
+		KeyID:        a.APIKeyID,
+		Name:         fmt.Sprintf("telegram:%d", a.TelegramID),
+		Role:         RoleAdvertiser,
+		AdvertiserID: &advertiserID,
+	}
+}
+
+// TelegramLink описывает привязку Telegram-аккаунта по ключу API, присланному в бот
+type TelegramLink struct {
+	TelegramID int64
+	ChatID     int64
+	Key        string
+}
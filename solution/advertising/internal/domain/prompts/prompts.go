@@ -0,0 +1,87 @@
+// Package prompts хранит шаблоны промптов для языковых моделей.
+// Шаблоны встроены в бинарник, но их можно переопределить файлами из каталога без пересборки
+package prompts
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"nlypage-final/pkg/ai_moderation"
+)
+
+// Имена шаблонов
+const (
+	AdText     = "ad_text.tmpl"
+	Creative   = "creative.tmpl"
+	Moderation = "moderation.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// AdTextData — данные шаблона AdText
+type AdTextData struct {
+	Advertiser     string
+	Title          string
+	Audience       string
+	AdditionalInfo string
+	Length         int
+	Language       string
+	Tone           string
+}
+
+// CreativeData — данные шаблона Creative
+type CreativeData struct {
+	Advertiser string
+	Brief      string
+	Titles     int
+	Language   string
+	Tone       string
+}
+
+// ModerationData — данные шаблона Moderation
+type ModerationData = ai_moderation.Ad
+
+type Templates struct {
+	templates *template.Template
+}
+
+// Load загружает встроенные шаблоны. Если dir не пустой, шаблоны *.tmpl из него заменяют встроенные с тем же именем
+func Load(dir string) (*Templates, error) {
+	templates, err := template.New("").Option("missingkey=error").ParseFS(defaultTemplates, "templates/*.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse default prompts: %w", err)
+	}
+
+	if dir != "" {
+		files, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list prompts in %s: %w", dir, err)
+		}
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read prompt %s: %w", file, err)
+			}
+			if _, err := templates.New(filepath.Base(file)).Parse(string(content)); err != nil {
+				return nil, fmt.Errorf("failed to parse prompt %s: %w", file, err)
+			}
+		}
+	}
+
+	return &Templates{templates: templates}, nil
+}
+
+// Render заполняет шаблон данными
+func (t *Templates) Render(name string, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("failed to render prompt %s: %w", name, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,76 @@
+package prompts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderDefault(t *testing.T) {
+	templates, err := Load("")
+	require.NoError(t, err)
+
+	adText, err := templates.Render(AdText, AdTextData{
+		Advertiser: "Цветочная лавка",
+		Title:      "Розы со скидкой",
+		Audience:   "женщины, возраст 25–40 лет",
+		Length:     150,
+		Language:   "русский",
+		Tone:       "дружелюбный",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, adText, "Заголовок объявления: Розы со скидкой")
+	assert.Contains(t, adText, "Целевая аудитория: женщины, возраст 25–40 лет")
+	assert.Contains(t, adText, "длиной около 150 символов")
+	assert.NotContains(t, adText, "Дополнительная информация")
+
+	creative, err := templates.Render(Creative, CreativeData{
+		Advertiser: "Цветочная лавка",
+		Brief:      "Доставка цветов за 2 часа",
+		Titles:     3,
+		Language:   "русский",
+		Tone:       "нейтральный",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, creative, "Предложите 3 вариантов")
+	assert.Contains(t, creative, `{"titles": ["заголовок"]`)
+
+	moderation, err := templates.Render(Moderation, ModerationData{
+		Advertiser: "Кафе",
+		Title:      "Лучший кофе",
+		Text:       "Заходите каждый день",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, moderation, "Заголовок: Лучший кофе")
+	assert.Contains(t, moderation, "- MISLEADING_CLAIMS")
+}
+
+func TestLoadOverride(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, AdText), []byte("Текст для {{ .Title }}"), 0o644))
+
+	templates, err := Load(dir)
+	require.NoError(t, err)
+
+	adText, err := templates.Render(AdText, AdTextData{Title: "Розы"})
+	require.NoError(t, err)
+	assert.Equal(t, "Текст для Розы", adText)
+
+	// Шаблоны, которых нет в каталоге, остаются встроенными
+	_, err = templates.Render(Creative, CreativeData{Titles: 1})
+	assert.NoError(t, err)
+}
+
+func TestRenderUnknownField(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, AdText), []byte("{{ .Unknown }}"), 0o644))
+
+	templates, err := Load(dir)
+	require.NoError(t, err)
+
+	_, err = templates.Render(AdText, AdTextData{})
+	assert.Error(t, err)
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"nlypage-final/internal/adapters/database/clickhouse"
 	"nlypage-final/internal/adapters/database/postgres/ent"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
@@ -11,7 +13,9 @@ import (
 	"nlypage-final/internal/domain/common/errorz"
 	"nlypage-final/internal/domain/dto"
 	"nlypage-final/pkg/ad_scoring"
+	"nlypage-final/pkg/invalid_traffic"
 	"nlypage-final/pkg/logger"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -32,11 +36,21 @@ type CampaignStats struct {
 
 type adClickhouseRepository interface {
 	RecordImpression(ctx context.Context, show *clickhouse.AdImpression) error
+	ViewedOnDay(ctx context.Context, campaignID, clientID uuid.UUID, day int) (bool, error)
 	RecordClick(ctx context.Context, click *clickhouse.AdClick) error
+	RecordInvalidClick(ctx context.Context, click *clickhouse.InvalidClick) error
+	ClickSignals(ctx context.Context, click *clickhouse.AdClick, fromDay int, ipWindow time.Duration) (*clickhouse.ClickSignals, error)
+	LastClickDay(ctx context.Context, campaignID, clientID uuid.UUID) (int, error)
+	RecordConversion(ctx context.Context, conversion *clickhouse.AdConversion) error
 	UserCampaignsStats(ctx context.Context, campaignIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID]*clickhouse.UserCampaignStats, error)
 	GetCampaignsSortedByUserViews(ctx context.Context, campaignIDs []uuid.UUID, userID uuid.UUID) ([]clickhouse.ViewsGroup, error)
 }
 
+type adBudgetService interface {
+	Charge(ctx context.Context, camp *ent.Campaign, day int, amount float64) error
+	Available(ctx context.Context, campaigns []*ent.Campaign, day int) ([]*ent.Campaign, error)
+}
+
 type adsStorage interface {
 	Get(ctx context.Context, userID uuid.UUID) (ads.Ad, error)
 	Add(ctx context.Context, userID uuid.UUID, ad ads.Ad) error
@@ -46,6 +60,17 @@ type adsStorage interface {
 type AdService interface {
 	SelectAd(ctx context.Context, clientID dto.ClientAdGet) (*dto.Ad, error)
 	RecordClick(ctx context.Context, click dto.ClientAdClick) error
+	RecordConversion(ctx context.Context, conversion dto.ClientAdConversion) error
+}
+
+// InvalidTrafficOptions задает проверку кликов на недействительный трафик
+type InvalidTrafficOptions struct {
+	// Detector nil отключает проверку
+	Detector invalid_traffic.Detector
+	// IPWindow — за какое время считаются клики с IP-адреса
+	IPWindow time.Duration
+	// ClientDays — за сколько последних дней считаются показы и клики клиента
+	ClientDays int
 }
 
 type adService struct {
@@ -54,14 +79,22 @@ type adService struct {
 	adsStorage           adsStorage
 	clickhouseRepository adClickhouseRepository
 	timeService          adTimeService
+	budgetService        adBudgetService
+	attributionWindow    int
+	invalidTraffic       InvalidTrafficOptions
 }
 
+// NewAdService создает AdService. attributionWindow — количество дней после клика,
+// в течение которых целевое действие клиента засчитывается как конверсия
 func NewAdService(
 	db *ent.Client,
 	adScoring ad_scoring.Scorer,
 	adsStorage adsStorage,
 	clickhouseRepository adClickhouseRepository,
 	timeService adTimeService,
+	budgetService adBudgetService,
+	attributionWindow int,
+	invalidTraffic InvalidTrafficOptions,
 ) AdService {
 	return &adService{
 		db:                   db,
@@ -69,6 +102,9 @@ func NewAdService(
 		adsStorage:           adsStorage,
 		clickhouseRepository: clickhouseRepository,
 		timeService:          timeService,
+		budgetService:        budgetService,
+		attributionWindow:    attributionWindow,
+		invalidTraffic:       invalidTraffic,
 	}
 }
 
@@ -96,6 +132,8 @@ func (a *adService) SelectAd(ctx context.Context, clientID dto.ClientAdGet) (*dt
 				campaign.StartDateLTE(a.timeService.Now().CurrentDate),
 				campaign.EndDateGTE(a.timeService.Now().CurrentDate),
 				campaign.ModeratedEQ(true),
+				campaign.DeletedAtIsNil(),
+				campaign.PausedEQ(false),
 				campaign.HasTargetingWith(
 					targeting.And(
 						targeting.Or(
@@ -125,6 +163,13 @@ func (a *adService) SelectAd(ctx context.Context, clientID dto.ClientAdGet) (*dt
 		logger.Log.Errorf("failed to get campaigns: %v", err)
 		return nil, errorz.ErrInternal
 	}
+
+	// Кампании с исчерпанным бюджетом не показываются
+	campaigns, err = a.budgetService.Available(ctx, campaigns, a.timeService.Now().CurrentDate)
+	if err != nil {
+		logger.Log.Errorf("failed to check campaigns budget: %v", err)
+		return nil, errorz.ErrInternal
+	}
 	logger.Log.Debugw("Found campaigns",
 		"count", len(campaigns),
 	)
@@ -267,23 +312,37 @@ func (a *adService) SelectAd(ctx context.Context, clientID dto.ClientAdGet) (*dt
 				"view_count", group.ViewCount,
 			)
 
+			currentDate := a.timeService.Now().CurrentDate
+			// Оплачивается только первый показ клиенту за день
+			viewed, err := a.clickhouseRepository.ViewedOnDay(ctx, bestCampaign.ID, user.ID, currentDate)
+			if err != nil {
+				// Без истории показов не списываем, чтобы не взять оплату дважды
+				logger.Log.Warnw("Failed to check impressions",
+					"error", err,
+				)
+				viewed = true
+			}
+
 			if err := a.clickhouseRepository.RecordImpression(ctx, &clickhouse.AdImpression{
 				CampaignID:   bestCampaign.ID,
 				AdvertiserID: bestCampaign.AdvertiserID,
 				ClientID:     user.ID,
 				Income:       bestCampaign.CostPerImpression,
-				Day:          a.timeService.Now().CurrentDate,
+				Day:          currentDate,
 			}); err != nil {
 				logger.Log.Warnw("Failed to record impression",
 					"error", err,
 				)
+			} else if !viewed {
+				a.charge(ctx, bestCampaign, currentDate, bestCampaign.CostPerImpression)
 			}
 
+			adTitle, adText, imageURL := servedCreative(bestCampaign)
 			return &dto.Ad{
 				AdID:         bestCampaign.ID,
-				AdTitle:      bestCampaign.AdTitle,
-				AdText:       bestCampaign.AdText,
-				ImageURL:     bestCampaign.ImageURL,
+				AdTitle:      adTitle,
+				AdText:       adText,
+				ImageURL:     imageURL,
 				AdvertiserID: bestCampaign.AdvertiserID,
 			}, nil
 		}
@@ -298,7 +357,12 @@ func (a *adService) SelectAd(ctx context.Context, clientID dto.ClientAdGet) (*dt
 }
 
 func (a *adService) RecordClick(ctx context.Context, click dto.ClientAdClick) error {
-	camp, err := a.db.Campaign.Get(ctx, click.AdID)
+	camp, err := a.db.Campaign.Query().
+		Where(
+			campaign.ID(click.AdID),
+			campaign.DeletedAtIsNil(),
+		).
+		Only(ctx)
 	if err != nil {
 		return &echo.HTTPError{
 			Message: "campaign not found",
@@ -306,23 +370,158 @@ func (a *adService) RecordClick(ctx context.Context, click dto.ClientAdClick) er
 		}
 	}
 
-	if err := a.clickhouseRepository.RecordClick(ctx, &clickhouse.AdClick{
+	adClick := &clickhouse.AdClick{
 		CampaignID:   click.AdID,
 		AdvertiserID: camp.AdvertiserID,
 		ClientID:     click.ClientID,
+		IP:           click.IP,
 		Income:       camp.CostPerClick,
 		Day:          a.timeService.Now().CurrentDate,
-	}); err != nil {
+	}
+
+	// Недействительный клик принимается как обычный, чтобы не подсказывать ботам,
+	// но записывается отдельно и не оплачивается
+	if reason := a.checkInvalidClick(ctx, adClick); reason != "" {
+		if err := a.clickhouseRepository.RecordInvalidClick(ctx, &clickhouse.InvalidClick{
+			AdClick: *adClick,
+			Reason:  string(reason),
+		}); err != nil {
+			return &echo.HTTPError{
+				Message: err.Error(),
+				Code:    echo.ErrConflict.Code,
+			}
+		}
+		logger.Log.Infow("Invalid click",
+			"campaign_id", click.AdID,
+			"client_id", click.ClientID,
+			"ip", click.IP,
+			"reason", reason,
+		)
+		a.adsStorage.Remove(ctx, click.ClientID, click.AdID)
+		return nil
+	}
+
+	if err := a.clickhouseRepository.RecordClick(ctx, adClick); err != nil {
 		return &echo.HTTPError{
 			Message: err.Error(),
 			Code:    echo.ErrConflict.Code,
 		}
 	}
+	a.charge(ctx, camp, adClick.Day, camp.CostPerClick)
 	a.adsStorage.Remove(ctx, click.ClientID, click.AdID)
 
 	return nil
 }
 
+// charge списывает стоимость события. Событие уже записано в статистику,
+// поэтому ошибка списания не отменяет его, а только логируется
+func (a *adService) charge(ctx context.Context, camp *ent.Campaign, day int, amount float64) {
+	if err := a.budgetService.Charge(ctx, camp, day, amount); err != nil {
+		logger.Log.Errorw("Failed to charge campaign",
+			"campaign_id", camp.ID,
+			"advertiser_id", camp.AdvertiserID,
+			"amount", amount,
+			"error", err,
+		)
+	}
+}
+
+// checkInvalidClick возвращает причину, по которой клик недействителен.
+// Если поведение клиента не удалось получить, клик считается действительным
+func (a *adService) checkInvalidClick(ctx context.Context, click *clickhouse.AdClick) invalid_traffic.Reason {
+	if a.invalidTraffic.Detector == nil {
+		return ""
+	}
+
+	signals, err := a.clickhouseRepository.ClickSignals(
+		ctx,
+		click,
+		click.Day-a.invalidTraffic.ClientDays+1,
+		a.invalidTraffic.IPWindow,
+	)
+	if err != nil {
+		logger.Log.Warnw("Failed to get click signals",
+			"error", err,
+		)
+		return ""
+	}
+
+	var ipClicks int
+	if click.IP != "" {
+		ipClicks = int(signals.IPClicks) + 1
+	}
+
+	return a.invalidTraffic.Detector.Check(invalid_traffic.Signals{
+		SinceImpression:   signals.SinceImpression,
+		IPClicks:          ipClicks,
+		ClientImpressions: int(signals.ClientImpressions),
+		ClientClicks:      int(signals.ClientClicks) + 1,
+	})
+}
+
+func (a *adService) RecordConversion(ctx context.Context, conversion dto.ClientAdConversion) error {
+	camp, err := a.db.Campaign.Query().
+		Where(
+			campaign.ID(conversion.AdID),
+			campaign.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		return &echo.HTTPError{
+			Message: "campaign not found",
+			Code:    echo.ErrNotFound.Code,
+		}
+	}
+
+	// Конверсия относится к последнему клику клиента по этой рекламе
+	clickDay, err := a.clickhouseRepository.LastClickDay(ctx, conversion.AdID, conversion.ClientID)
+	if err != nil {
+		if errors.Is(err, clickhouse.ErrClickNotFound) {
+			return &echo.HTTPError{
+				Message: err.Error(),
+				Code:    echo.ErrConflict.Code,
+			}
+		}
+		logger.Log.Errorf("failed to get last click: %v", err)
+		return errorz.ErrInternal
+	}
+
+	currentDate := a.timeService.Now().CurrentDate
+	if currentDate-clickDay > a.attributionWindow {
+		return &echo.HTTPError{
+			Message: fmt.Sprintf("last click (day %d) is outside of attribution window (%d days)", clickDay, a.attributionWindow),
+			Code:    echo.ErrConflict.Code,
+		}
+	}
+
+	var value float64
+	if conversion.Value != nil {
+		value = *conversion.Value
+	}
+
+	if err := a.clickhouseRepository.RecordConversion(ctx, &clickhouse.AdConversion{
+		CampaignID:   camp.ID,
+		AdvertiserID: camp.AdvertiserID,
+		ClientID:     conversion.ClientID,
+		Value:        value,
+		Income:       camp.CostPerAction,
+		ClickDay:     clickDay,
+		Day:          currentDate,
+	}); err != nil {
+		if errors.Is(err, clickhouse.ErrConversionAlreadyExists) {
+			return &echo.HTTPError{
+				Message: err.Error(),
+				Code:    echo.ErrConflict.Code,
+			}
+		}
+		logger.Log.Errorf("failed to record conversion: %v", err)
+		return errorz.ErrInternal
+	}
+	a.charge(ctx, camp, currentDate, camp.CostPerAction)
+
+	return nil
+}
+
 // getPositionInList возвращает позицию элемента в списке (1-based)
 func getPositionInList(list []uuid.UUID, item uuid.UUID) int {
 	for i, v := range list {
@@ -143,6 +143,8 @@ func (s *adScoringService) Recalculate(ctx context.Context, userID uuid.UUID, ca
 				campaign.ModeratedEQ(true),
 				campaign.StartDateLTE(s.timeService.Now().CurrentDate),
 				campaign.EndDateGTE(s.timeService.Now().CurrentDate),
+				campaign.DeletedAtIsNil(),
+				campaign.PausedEQ(false),
 			),
 		).
 		WithTargeting().
@@ -214,12 +216,13 @@ func (s *adScoringService) Recalculate(ctx context.Context, userID uuid.UUID, ca
 	}
 
 	// Обновляем объявление
+	adTitle, adText, imageURL := servedCreative(c)
 	if err := s.adsStorage.Add(ctx, userID, ads.Ad{
 		UserID:            userID,
 		AdID:              c.ID,
-		AdTitle:           c.AdTitle,
-		AdText:            c.AdText,
-		ImageURL:          c.ImageURL,
+		AdTitle:           adTitle,
+		AdText:            adText,
+		ImageURL:          imageURL,
 		AdvertiserID:      c.AdvertiserID,
 		CostPerImpression: c.CostPerImpression,
 		Score:             cd.score,
@@ -270,6 +273,8 @@ func (s *adScoringService) updateScores(ctx context.Context) {
 				campaign.ModeratedEQ(true),
 				campaign.StartDateLTE(s.timeService.Now().CurrentDate),
 				campaign.EndDateGTE(s.timeService.Now().CurrentDate),
+				campaign.DeletedAtIsNil(),
+				campaign.PausedEQ(false),
 			),
 		).
 		WithTargeting().
@@ -338,12 +343,13 @@ func (s *adScoringService) updateScores(ctx context.Context) {
 		// Добавляем объявление в Redis только если количество потенциальных показов не превышает лимит
 		if addedAdsCountMap[a.ID] < a.ImpressionsLimit-a.impressionsCount {
 			//addedAdsCountMap[a.ID]++
+			adTitle, adText, imageURL := servedCreative(a.Campaign)
 			adsToAdd = append(adsToAdd, ads.Ad{
 				UserID:            a.userID,
 				AdID:              a.ID,
-				AdTitle:           a.AdTitle,
-				AdText:            a.AdText,
-				ImageURL:          a.ImageURL,
+				AdTitle:           adTitle,
+				AdText:            adText,
+				ImageURL:          imageURL,
 				AdvertiserID:      a.AdvertiserID,
 				CostPerImpression: a.CostPerImpression,
 				Score:             a.score,
@@ -4,9 +4,10 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"nlypage-final/internal/adapters/database/postgres/ent"
-	"nlypage-final/internal/adapters/database/postgres/ent/user"
+	"nlypage-final/internal/adapters/database/postgres/ent/advertiser"
 	"nlypage-final/internal/domain/common/errorz"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
 )
 
 type AdvertiserService interface {
@@ -15,12 +16,14 @@ type AdvertiserService interface {
 }
 
 type advertiserService struct {
-	db *ent.Client
+	db    *ent.Client
+	audit auditRecorder
 }
 
-func NewAdvertiserService(db *ent.Client) AdvertiserService {
+func NewAdvertiserService(db *ent.Client, audit auditRecorder) AdvertiserService {
 	return &advertiserService{
-		db: db,
+		db:    db,
+		audit: audit,
 	}
 }
 
@@ -40,12 +43,47 @@ func (s *advertiserService) GetByID(ctx context.Context, advertiserID uuid.UUID)
 }
 
 func (s *advertiserService) UpsertBulk(ctx context.Context, upsertAdvertisers []dto.AdvertiserUpsert) error {
-	err := s.db.Advertiser.MapCreateBulk(upsertAdvertisers, func(c *ent.AdvertiserCreate, i int) {
+	ids := make([]uuid.UUID, 0, len(upsertAdvertisers))
+	for _, upsert := range upsertAdvertisers {
+		ids = append(ids, upsert.AdvertiserID)
+	}
+	existing, err := s.db.Advertiser.Query().
+		Where(advertiser.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get advertisers: %v", err)
+		return errorz.ErrInternal
+	}
+	before := make(map[uuid.UUID]*dto.Advertiser, len(existing))
+	for _, adv := range existing {
+		before[adv.ID] = &dto.Advertiser{
+			AdvertiserID: adv.ID,
+			Name:         adv.Name,
+		}
+	}
+
+	err = s.db.Advertiser.MapCreateBulk(upsertAdvertisers, func(c *ent.AdvertiserCreate, i int) {
 		c.SetID(upsertAdvertisers[i].AdvertiserID).
 			SetName(upsertAdvertisers[i].Name)
-	}).OnConflictColumns(user.FieldID).UpdateNewValues().Exec(ctx)
+	}).OnConflictColumns(advertiser.FieldID).UpdateName().Exec(ctx)
 	if err != nil {
 		return errorz.ErrInternal
 	}
+
+	records := make([]dto.AuditRecord, 0, len(upsertAdvertisers))
+	for _, upsert := range upsertAdvertisers {
+		records = append(records, dto.AuditRecord{
+			Action:   dto.AuditActionUpsert,
+			Entity:   dto.AuditEntityAdvertiser,
+			EntityID: upsert.AdvertiserID.String(),
+			Before:   before[upsert.AdvertiserID],
+			After: &dto.Advertiser{
+				AdvertiserID: upsert.AdvertiserID,
+				Name:         upsert.Name,
+			},
+		})
+	}
+	s.audit.Record(ctx, records...)
+
 	return nil
 }
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/schema"
+	"nlypage-final/pkg/ai_moderation"
+	"nlypage-final/pkg/logger"
+)
+
+const (
+	// aiReviewMaxAttempts — после стольких неудачных проверок подряд кампания остается без вердикта
+	aiReviewMaxAttempts = 5
+	// aiReviewMaxBackoff ограничивает паузу перед повторной проверкой
+	aiReviewMaxBackoff = time.Hour
+)
+
+type aiModerationReviewer interface {
+	Review(ctx context.Context, ad ai_moderation.Ad) (*ai_moderation.Verdict, error)
+}
+
+// AIModerationService в фоне проверяет кампании, ожидающие модерации, с помощью LLM
+// и сохраняет вердикт рядом с кампанией, чтобы модераторы могли сортировать очередь по риску
+type AIModerationService interface {
+	Start(ctx context.Context) error
+	Stop() error
+	// ReviewPending проверяет очередную пачку еще не проверенных кампаний
+	ReviewPending(ctx context.Context) error
+}
+
+type aiModerationService struct {
+	db         *ent.Client
+	reviewer   aiModerationReviewer
+	logger     *logger.Logger
+	interval   time.Duration
+	batchSize  int
+	cancelFunc context.CancelFunc
+	mu         sync.Mutex
+}
+
+func NewAIModerationService(
+	db *ent.Client,
+	reviewer aiModerationReviewer,
+	logger *logger.Logger,
+	interval time.Duration,
+	batchSize int,
+) AIModerationService {
+	return &aiModerationService{
+		db:        db,
+		reviewer:  reviewer,
+		logger:    logger,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (s *aiModerationService) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.cancelFunc != nil {
+		s.mu.Unlock()
+		return fmt.Errorf("ai moderation service is already running")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancelFunc = cancel
+	s.mu.Unlock()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.ReviewPending(ctx); err != nil {
+				s.logger.Errorf("failed to review pending campaigns: %v", err)
+			}
+		}
+	}
+}
+
+func (s *aiModerationService) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancelFunc == nil {
+		return fmt.Errorf("ai moderation service is not running")
+	}
+
+	s.cancelFunc()
+	s.cancelFunc = nil
+	return nil
+}
+
+func (s *aiModerationService) ReviewPending(ctx context.Context) error {
+	// Кампании, которые не удалось проверить, ждут повторной попытки и идут после новых,
+	// чтобы постоянно падающие проверки не занимали всю пачку
+	campaigns, err := s.db.Campaign.Query().
+		Where(
+			campaign.ModerationStatusEQ(campaign.ModerationStatusPENDING),
+			campaign.AiReviewedAtIsNil(),
+			campaign.AiReviewAttemptsLT(aiReviewMaxAttempts),
+			campaign.Or(
+				campaign.AiReviewRetryAtIsNil(),
+				campaign.AiReviewRetryAtLTE(time.Now()),
+			),
+			campaign.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(campaign.FieldAiReviewAttempts), ent.Asc(campaign.FieldStartDate)).
+		Limit(s.batchSize).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get pending campaigns: %w", err)
+	}
+
+	for _, camp := range campaigns {
+		if err := s.review(ctx, camp); err != nil {
+			s.logger.Warnw("Failed to review campaign",
+				"campaign_id", camp.ID,
+				"attempt", camp.AiReviewAttempts+1,
+				"error", err,
+			)
+			s.reviewFailed(ctx, camp)
+		}
+	}
+	return nil
+}
+
+// reviewFailed откладывает повторную проверку кампании: пауза растет вдвое с каждой неудачей
+func (s *aiModerationService) reviewFailed(ctx context.Context, camp *ent.Campaign) {
+	backoff := s.interval << camp.AiReviewAttempts
+	if backoff <= 0 || backoff > aiReviewMaxBackoff {
+		backoff = aiReviewMaxBackoff
+	}
+
+	// Креатив могли изменить за время проверки, тогда счетчик уже сброшен
+	if _, err := s.db.Campaign.Update().
+		Where(
+			campaign.ID(camp.ID),
+			campaign.AdTitle(camp.AdTitle),
+			campaign.AdText(camp.AdText),
+			campaign.AiReviewedAtIsNil(),
+		).
+		AddAiReviewAttempts(1).
+		SetAiReviewRetryAt(time.Now().Add(backoff)).
+		Save(ctx); err != nil {
+		s.logger.Errorf("failed to record ai review attempt for campaign %s: %v", camp.ID, err)
+	}
+}
+
+func (s *aiModerationService) review(ctx context.Context, camp *ent.Campaign) error {
+	var advertiserName string
+	if advertiser, err := s.db.Advertiser.Get(ctx, camp.AdvertiserID); err == nil {
+		advertiserName = advertiser.Name
+	}
+
+	verdict, err := s.reviewer.Review(ctx, ai_moderation.Ad{
+		Advertiser: advertiserName,
+		Title:      camp.AdTitle,
+		Text:       camp.AdText,
+	})
+	if err != nil {
+		return err
+	}
+
+	violations := make([]schema.AIViolation, 0, len(verdict.Violations))
+	for _, violation := range verdict.Violations {
+		violations = append(violations, schema.AIViolation{
+			Policy:      violation.Policy,
+			Description: violation.Description,
+		})
+	}
+
+	// Вердикт сохраняется, только если креатив не изменился за время проверки
+	_, err = s.db.Campaign.Update().
+		Where(
+			campaign.ID(camp.ID),
+			campaign.AdTitle(camp.AdTitle),
+			campaign.AdText(camp.AdText),
+			campaign.AiReviewedAtIsNil(),
+		).
+		SetAiCategory(verdict.Category).
+		SetAiViolations(violations).
+		SetAiConfidence(verdict.Confidence).
+		SetRiskScore(verdict.RiskScore()).
+		SetAiReviewedAt(time.Now()).
+		Save(ctx)
+	return err
+}
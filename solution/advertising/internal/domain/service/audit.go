@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/auditentry"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/jsondiff"
+	"nlypage-final/pkg/logger"
+)
+
+const (
+	// auditBatchSize ограничивает количество записей аудита в одном INSERT
+	auditBatchSize = 1000
+	// auditRedacted заменяет значения полей в записях с dto.AuditRecord.Redact
+	auditRedacted = "[redacted]"
+)
+
+// auditRecorder записывает изменения в журнал аудита, его используют сервисы, изменяющие данные
+type auditRecorder interface {
+	Record(ctx context.Context, records ...dto.AuditRecord)
+}
+
+// AuditService ведет журнал изменений, выполненных через API
+type AuditService interface {
+	// Record записывает изменения от имени владельца ключа из контекста.
+	// Операция к этому моменту уже выполнена, поэтому ошибка записи только логируется
+	Record(ctx context.Context, records ...dto.AuditRecord)
+	List(ctx context.Context, get dto.AuditGet) ([]*dto.AuditEntry, error)
+}
+
+type auditService struct {
+	db *ent.Client
+}
+
+func NewAuditService(db *ent.Client) AuditService {
+	return &auditService{
+		db: db,
+	}
+}
+
+func (s *auditService) Record(ctx context.Context, records ...dto.AuditRecord) {
+	if len(records) == 0 {
+		return
+	}
+
+	principal := dto.PrincipalFromContext(ctx)
+	builders := make([]*ent.AuditEntryCreate, 0, len(records))
+	for _, record := range records {
+		diff, err := jsondiff.Diff(record.Before, record.After)
+		if err != nil {
+			logger.Log.Errorf("failed to diff audit record %s %s %s: %v", record.Action, record.Entity, record.EntityID, err)
+			continue
+		}
+		if record.Redact {
+			for field, change := range diff {
+				if change.Before != nil {
+					change.Before = auditRedacted
+				}
+				if change.After != nil {
+					change.After = auditRedacted
+				}
+				diff[field] = change
+			}
+		}
+
+		builder := s.db.AuditEntry.Create().
+			SetAction(record.Action).
+			SetEntity(record.Entity).
+			SetEntityID(record.EntityID).
+			SetDiff(diff)
+		if principal != nil {
+			builder.SetActorName(principal.Name).
+				SetActorRole(principal.Role)
+			// У ключа администратора из конфигурации нет идентификатора
+			if principal.KeyID != uuid.Nil {
+				builder.SetActorKeyID(principal.KeyID)
+			}
+		}
+		builders = append(builders, builder)
+	}
+
+	// Запись не должна прерываться вместе с запросом, который уже выполнил изменение
+	ctx = context.WithoutCancel(ctx)
+	for start := 0; start < len(builders); start += auditBatchSize {
+		end := min(start+auditBatchSize, len(builders))
+		if err := s.db.AuditEntry.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			logger.Log.Errorf("failed to write audit entries: %v", err)
+		}
+	}
+}
+
+func (s *auditService) List(ctx context.Context, get dto.AuditGet) ([]*dto.AuditEntry, error) {
+	query := s.db.AuditEntry.Query()
+	if get.Entity != "" {
+		query = query.Where(auditentry.Entity(get.Entity))
+	}
+	if get.EntityID != "" {
+		query = query.Where(auditentry.EntityID(get.EntityID))
+	}
+	if get.Action != "" {
+		query = query.Where(auditentry.Action(get.Action))
+	}
+	if get.ActorID != nil {
+		query = query.Where(auditentry.ActorKeyID(*get.ActorID))
+	}
+	if get.From != nil {
+		query = query.Where(auditentry.CreatedAtGTE(*get.From))
+	}
+	if get.To != nil {
+		query = query.Where(auditentry.CreatedAtLT(*get.To))
+	}
+
+	entries, err := query.
+		Order(ent.Desc(auditentry.FieldCreatedAt), ent.Desc(auditentry.FieldID)).
+		Offset((get.Page - 1) * get.Size).
+		Limit(get.Size).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get audit entries: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	result := make([]*dto.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, &dto.AuditEntry{
+			ID:         entry.ID,
+			ActorKeyID: entry.ActorKeyID,
+			ActorName:  entry.ActorName,
+			ActorRole:  entry.ActorRole,
+			Action:     entry.Action,
+			Entity:     entry.Entity,
+			EntityID:   entry.EntityID,
+			Diff:       entry.Diff,
+			CreatedAt:  entry.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
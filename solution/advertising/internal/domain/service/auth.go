@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/advertiser"
+	"nlypage-final/internal/adapters/database/postgres/ent/apikey"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+)
+
+const (
+	// apiKeyPrefix отличает ключи площадки от других секретов, например при поиске утечек
+	apiKeyPrefix = "nlp_"
+	// apiKeyVisiblePrefix — сколько символов ключа сохраняется открыто, чтобы его можно было узнать
+	apiKeyVisiblePrefix = 12
+)
+
+// AuthService выдает и проверяет ключи доступа к API
+type AuthService interface {
+	Authenticate(ctx context.Context, key string) (*dto.Principal, error)
+	// CampaignAdvertiser возвращает рекламодателя кампании для проверки доступа
+	CampaignAdvertiser(ctx context.Context, campaignID uuid.UUID) (uuid.UUID, error)
+	CreateKey(ctx context.Context, create dto.APIKeyCreate) (*dto.APIKeyCreated, error)
+	ListKeys(ctx context.Context) ([]*dto.APIKey, error)
+	RevokeKey(ctx context.Context, revoke dto.APIKeyRevoke) error
+}
+
+type authService struct {
+	db *ent.Client
+	// adminKey — ключ администратора из конфигурации, чтобы выдать первые ключи
+	adminKey string
+	audit    auditRecorder
+}
+
+func NewAuthService(db *ent.Client, adminKey string, audit auditRecorder) AuthService {
+	return &authService{
+		db:       db,
+		adminKey: adminKey,
+		audit:    audit,
+	}
+}
+
+func (s *authService) Authenticate(ctx context.Context, key string) (*dto.Principal, error) {
+	if key == "" {
+		return nil, errorz.ErrUnauthorized
+	}
+	if s.adminKey != "" && subtle.ConstantTimeCompare([]byte(key), []byte(s.adminKey)) == 1 {
+		return &dto.Principal{
+			Name: "config",
+			Role: dto.RoleAdmin,
+		}, nil
+	}
+
+	found, err := s.db.APIKey.Query().
+		Where(
+			apikey.KeyHash(hashAPIKey(key)),
+			apikey.RevokedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorz.ErrUnauthorized
+		}
+		logger.Log.Errorf("failed to get api key: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	return &dto.Principal{
+		KeyID:        found.ID,
+		Name:         found.Name,
+		Role:         found.Role.String(),
+		AdvertiserID: found.AdvertiserID,
+	}, nil
+}
+
+func (s *authService) CampaignAdvertiser(ctx context.Context, campaignID uuid.UUID) (uuid.UUID, error) {
+	camp, err := s.db.Campaign.Query().
+		Where(campaign.ID(campaignID)).
+		Select(campaign.FieldAdvertiserID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get campaign: %v", err)
+		return uuid.Nil, errorz.ErrInternal
+	}
+	return camp.AdvertiserID, nil
+}
+
+func (s *authService) CreateKey(ctx context.Context, create dto.APIKeyCreate) (*dto.APIKeyCreated, error) {
+	if create.AdvertiserID != nil {
+		exists, err := s.db.Advertiser.Query().
+			Where(advertiser.ID(*create.AdvertiserID)).
+			Exist(ctx)
+		if err != nil {
+			logger.Log.Errorf("failed to check advertiser: %v", err)
+			return nil, errorz.ErrInternal
+		}
+		if !exists {
+			return nil, &echo.HTTPError{
+				Message: "advertiser not found",
+				Code:    echo.ErrBadRequest.Code,
+			}
+		}
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		logger.Log.Errorf("failed to generate api key: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	created, err := s.db.APIKey.Create().
+		SetName(create.Name).
+		SetKeyHash(hashAPIKey(key)).
+		SetPrefix(key[:apiKeyVisiblePrefix]).
+		SetRole(apikey.Role(create.Role)).
+		SetNillableAdvertiserID(create.AdvertiserID).
+		Save(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to create api key: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionCreate,
+		Entity:   dto.AuditEntityAPIKey,
+		EntityID: created.ID.String(),
+		After:    toAPIKeyDTO(created),
+	})
+
+	return &dto.APIKeyCreated{
+		APIKey: *toAPIKeyDTO(created),
+		Key:    key,
+	}, nil
+}
+
+func (s *authService) ListKeys(ctx context.Context) ([]*dto.APIKey, error) {
+	keys, err := s.db.APIKey.Query().
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get api keys: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	result := make([]*dto.APIKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, toAPIKeyDTO(key))
+	}
+	return result, nil
+}
+
+func (s *authService) RevokeKey(ctx context.Context, revoke dto.APIKeyRevoke) error {
+	revokedAt := time.Now()
+	updated, err := s.db.APIKey.Update().
+		Where(
+			apikey.ID(revoke.KeyID),
+			apikey.RevokedAtIsNil(),
+		).
+		SetRevokedAt(revokedAt).
+		Save(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to revoke api key: %v", err)
+		return errorz.ErrInternal
+	}
+	if updated == 0 {
+		return errorz.ErrNotFound
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionRevoke,
+		Entity:   dto.AuditEntityAPIKey,
+		EntityID: revoke.KeyID.String(),
+		Before:   map[string]any{apikey.FieldRevokedAt: nil},
+		After:    map[string]any{apikey.FieldRevokedAt: revokedAt},
+	})
+
+	return nil
+}
+
+func generateAPIKey() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashAPIKey хэширует ключ. Ключ случайный и длинный, поэтому соль и медленный хэш не нужны
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func toAPIKeyDTO(key *ent.APIKey) *dto.APIKey {
+	return &dto.APIKey{
+		KeyID:        key.ID,
+		Name:         key.Name,
+		Prefix:       key.Prefix,
+		Role:         key.Role.String(),
+		AdvertiserID: key.AdvertiserID,
+		CreatedAt:    key.CreatedAt,
+		RevokedAt:    key.RevokedAt,
+	}
+}
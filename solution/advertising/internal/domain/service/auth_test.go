@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/internal/domain/dto"
+)
+
+func assertHTTPCode(t *testing.T, err error, code int) {
+	t.Helper()
+
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, code, httpErr.Code)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s := NewAuthService(db, "config-admin-key", nopAudit{})
+
+	adv := db.Advertiser.Create().SetName("Кафе").SaveX(ctx)
+
+	created, err := s.CreateKey(ctx, dto.APIKeyCreate{
+		Name:         "cafe",
+		Role:         dto.RoleAdvertiser,
+		AdvertiserID: &adv.ID,
+	})
+	require.NoError(t, err)
+
+	t.Run("issued key", func(t *testing.T) {
+		principal, err := s.Authenticate(ctx, created.Key)
+		require.NoError(t, err)
+		assert.Equal(t, created.KeyID, principal.KeyID)
+		assert.Equal(t, "cafe", principal.Name)
+		assert.Equal(t, dto.RoleAdvertiser, principal.Role)
+		assert.Equal(t, &adv.ID, principal.AdvertiserID)
+	})
+	t.Run("config admin key", func(t *testing.T) {
+		principal, err := s.Authenticate(ctx, "config-admin-key")
+		require.NoError(t, err)
+		assert.Equal(t, dto.RoleAdmin, principal.Role)
+	})
+	t.Run("empty and unknown keys", func(t *testing.T) {
+		_, err := s.Authenticate(ctx, "")
+		assertHTTPCode(t, err, http.StatusUnauthorized)
+
+		_, err = s.Authenticate(ctx, created.Key+"x")
+		assertHTTPCode(t, err, http.StatusUnauthorized)
+	})
+	t.Run("revoked key", func(t *testing.T) {
+		require.NoError(t, s.RevokeKey(ctx, dto.APIKeyRevoke{KeyID: created.KeyID}))
+
+		_, err := s.Authenticate(ctx, created.Key)
+		assertHTTPCode(t, err, http.StatusUnauthorized)
+
+		// Повторный отзыв не находит действующего ключа
+		assertHTTPCode(t, s.RevokeKey(ctx, dto.APIKeyRevoke{KeyID: created.KeyID}), http.StatusNotFound)
+	})
+}
+
+func TestAuthenticateWithoutAdminKey(t *testing.T) {
+	s := NewAuthService(newTestDB(t), "", nopAudit{})
+
+	// Пустой ключ администратора в конфигурации не совпадает с пустым ключом запроса
+	_, err := s.Authenticate(context.Background(), "")
+	assertHTTPCode(t, err, http.StatusUnauthorized)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"nlypage-final/internal/adapters/database/clickhouse"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/ledgerentry"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+)
+
+// ledgerBatchSize ограничивает количество записей журнала в одном INSERT
+const ledgerBatchSize = 1000
+
+type billingClickhouseRepository interface {
+	DailyIncome(ctx context.Context, from, to int) ([]*clickhouse.CampaignDayIncome, error)
+}
+
+// BillingService ведет биллинговый журнал и выставляет счета рекламодателям
+type BillingService interface {
+	// CloseDays переносит оплачиваемые события за дни from..to-1 из статистики в журнал.
+	// Повторное закрытие дня не дублирует записи
+	CloseDays(ctx context.Context, from, to int) error
+	Invoice(ctx context.Context, get dto.InvoiceGet) (*dto.Invoice, error)
+}
+
+type billingService struct {
+	db                   *ent.Client
+	clickhouseRepository billingClickhouseRepository
+}
+
+func NewBillingService(db *ent.Client, clickhouseRepository billingClickhouseRepository) BillingService {
+	return &billingService{
+		db:                   db,
+		clickhouseRepository: clickhouseRepository,
+	}
+}
+
+func (s *billingService) CloseDays(ctx context.Context, from, to int) error {
+	incomes, err := s.clickhouseRepository.DailyIncome(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	if len(incomes) == 0 {
+		return nil
+	}
+
+	campaignIDs := make([]uuid.UUID, 0, len(incomes))
+	for _, income := range incomes {
+		campaignIDs = append(campaignIDs, income.CampaignID)
+	}
+	campaigns, err := s.db.Campaign.Query().
+		Where(campaign.IDIn(campaignIDs...)).
+		Select(campaign.FieldID, campaign.FieldAdTitle).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get campaigns: %w", err)
+	}
+	titles := make(map[uuid.UUID]string, len(campaigns))
+	for _, camp := range campaigns {
+		titles[camp.ID] = camp.AdTitle
+	}
+
+	var builders []*ent.LedgerEntryCreate
+	entry := func(income *clickhouse.CampaignDayIncome, kind ledgerentry.Kind, quantity uint64, amount float64) {
+		if quantity == 0 {
+			return
+		}
+		builders = append(builders, s.db.LedgerEntry.Create().
+			SetAdvertiserID(income.AdvertiserID).
+			SetCampaignID(income.CampaignID).
+			SetCampaignTitle(titles[income.CampaignID]).
+			SetDay(int(income.Day)).
+			SetKind(kind).
+			SetQuantity(int64(quantity)).
+			SetAmount(amount),
+		)
+	}
+	for _, income := range incomes {
+		entry(income, ledgerentry.KindIMPRESSIONS, income.Impressions, income.ImpressionsIncome)
+		entry(income, ledgerentry.KindCLICKS, income.Clicks, income.ClicksIncome)
+		entry(income, ledgerentry.KindCONVERSIONS, income.Conversions, income.ConversionsIncome)
+	}
+
+	for start := 0; start < len(builders); start += ledgerBatchSize {
+		end := min(start+ledgerBatchSize, len(builders))
+		if err := s.db.LedgerEntry.CreateBulk(builders[start:end]...).
+			OnConflictColumns(ledgerentry.FieldCampaignID, ledgerentry.FieldDay, ledgerentry.FieldKind).
+			DoNothing().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to write ledger entries: %w", err)
+		}
+	}
+
+	logger.Log.Infow("Closed billing days",
+		"from", from,
+		"to", to-1,
+		"entries", len(builders),
+	)
+	return nil
+}
+
+func (s *billingService) Invoice(ctx context.Context, get dto.InvoiceGet) (*dto.Invoice, error) {
+	adv, err := s.db.Advertiser.Get(ctx, get.AdvertiserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get advertiser: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	if get.From != nil && get.To != nil && *get.To < *get.From {
+		return nil, &echo.HTTPError{
+			Message: "to must be gte than from",
+			Code:    echo.ErrBadRequest.Code,
+		}
+	}
+
+	query := s.db.LedgerEntry.Query().
+		Where(ledgerentry.AdvertiserID(get.AdvertiserID))
+	if get.From != nil {
+		query = query.Where(ledgerentry.DayGTE(*get.From))
+	}
+	if get.To != nil {
+		query = query.Where(ledgerentry.DayLTE(*get.To))
+	}
+	entries, err := query.
+		Order(ent.Asc(ledgerentry.FieldDay), ent.Asc(ledgerentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get ledger entries: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	invoice := &dto.Invoice{
+		AdvertiserID:   adv.ID,
+		AdvertiserName: adv.Name,
+		Lines:          []dto.InvoiceLine{},
+	}
+	// Неуказанная граница периода берется по записям журнала
+	if len(entries) > 0 {
+		invoice.From, invoice.To = entries[0].Day, entries[len(entries)-1].Day
+	}
+	if get.From != nil {
+		invoice.From = *get.From
+	}
+	if get.To != nil {
+		invoice.To = *get.To
+	}
+	lines := make(map[uuid.UUID]int)
+	for _, e := range entries {
+		i, ok := lines[e.CampaignID]
+		if !ok {
+			i = len(invoice.Lines)
+			lines[e.CampaignID] = i
+			invoice.Lines = append(invoice.Lines, dto.InvoiceLine{CampaignID: e.CampaignID})
+		}
+		line := &invoice.Lines[i]
+		// Заголовок берется из последней записи, если кампанию переименовали
+		if e.CampaignTitle != "" {
+			line.CampaignTitle = e.CampaignTitle
+		}
+
+		switch e.Kind {
+		case ledgerentry.KindIMPRESSIONS:
+			line.Impressions += e.Quantity
+			line.ImpressionsAmount += e.Amount
+		case ledgerentry.KindCLICKS:
+			line.Clicks += e.Quantity
+			line.ClicksAmount += e.Amount
+		case ledgerentry.KindCONVERSIONS:
+			line.Conversions += e.Quantity
+			line.ConversionsAmount += e.Amount
+		}
+		line.Total += e.Amount
+		invoice.Total += e.Amount
+	}
+
+	return invoice, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/internal/adapters/database/clickhouse"
+	"nlypage-final/internal/domain/dto"
+)
+
+// fakeIncomeRepository отдает начисления за дни [from, to) из заранее заданного списка
+type fakeIncomeRepository struct {
+	incomes []*clickhouse.CampaignDayIncome
+}
+
+func (r *fakeIncomeRepository) DailyIncome(_ context.Context, from, to int) ([]*clickhouse.CampaignDayIncome, error) {
+	var incomes []*clickhouse.CampaignDayIncome
+	for _, income := range r.incomes {
+		if int(income.Day) >= from && int(income.Day) < to {
+			incomes = append(incomes, income)
+		}
+	}
+	return incomes, nil
+}
+
+func TestCloseDaysIdempotent(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	adv := newTestAdvertiser(t, db, 0)
+	camp := newTestCampaign(t, db, adv, nil, nil)
+
+	repo := &fakeIncomeRepository{incomes: []*clickhouse.CampaignDayIncome{
+		{
+			CampaignID: camp.ID, AdvertiserID: adv.ID, Day: 0,
+			Impressions: 10, ImpressionsIncome: 10,
+			Clicks: 2, ClicksIncome: 10,
+		},
+		{
+			CampaignID: camp.ID, AdvertiserID: adv.ID, Day: 1,
+			Impressions: 5, ImpressionsIncome: 5,
+		},
+		{
+			CampaignID: camp.ID, AdvertiserID: adv.ID, Day: 2,
+			Conversions: 1, ConversionsIncome: 7,
+		},
+	}}
+	s := NewBillingService(db, repo)
+
+	require.NoError(t, s.CloseDays(ctx, 0, 2))
+	assert.Equal(t, 3, db.LedgerEntry.Query().CountX(ctx))
+
+	// Повторное закрытие тех же дней ничего не добавляет
+	require.NoError(t, s.CloseDays(ctx, 0, 2))
+	assert.Equal(t, 3, db.LedgerEntry.Query().CountX(ctx))
+
+	// Статистика закрытого дня изменилась, но журнал хранит начисления на момент закрытия.
+	// Пересекающийся диапазон добавляет только новый день
+	repo.incomes[1].Impressions, repo.incomes[1].ImpressionsIncome = 50, 50
+	require.NoError(t, s.CloseDays(ctx, 1, 3))
+	assert.Equal(t, 4, db.LedgerEntry.Query().CountX(ctx))
+
+	invoice, err := s.Invoice(ctx, dto.InvoiceGet{AdvertiserID: adv.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 0, invoice.From)
+	assert.Equal(t, 2, invoice.To)
+	assert.Equal(t, 32.0, invoice.Total)
+	require.Len(t, invoice.Lines, 1)
+
+	line := invoice.Lines[0]
+	assert.Equal(t, camp.ID, line.CampaignID)
+	assert.Equal(t, camp.AdTitle, line.CampaignTitle)
+	assert.Equal(t, int64(15), line.Impressions)
+	assert.Equal(t, 15.0, line.ImpressionsAmount)
+	assert.Equal(t, int64(2), line.Clicks)
+	assert.Equal(t, int64(1), line.Conversions)
+	assert.Equal(t, 32.0, line.Total)
+}
+
+func TestCloseDaysWithoutIncome(t *testing.T) {
+	s := NewBillingService(newTestDB(t), &fakeIncomeRepository{})
+
+	require.NoError(t, s.CloseDays(context.Background(), 0, 10))
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/advertiser"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaigndailyspend"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+)
+
+type budgetTimeService interface {
+	Now() *dto.CurrentDate
+}
+
+// BudgetService ведет баланс рекламодателей и расходы кампаний
+type BudgetService interface {
+	TopUp(ctx context.Context, topUp dto.AdvertiserTopUp) (*dto.AdvertiserBalance, error)
+	Balance(ctx context.Context, advertiserID uuid.UUID) (*dto.AdvertiserBalance, error)
+	// Charge списывает стоимость события с баланса рекламодателя и добавляет ее к расходам кампании
+	Charge(ctx context.Context, camp *ent.Campaign, day int, amount float64) error
+	// Available оставляет кампании, у которых не исчерпан бюджет
+	Available(ctx context.Context, campaigns []*ent.Campaign, day int) ([]*ent.Campaign, error)
+}
+
+type budgetService struct {
+	db          *ent.Client
+	timeService budgetTimeService
+	// requireBalance останавливает показы рекламодателей с неположительным балансом
+	requireBalance bool
+	audit          auditRecorder
+}
+
+func NewBudgetService(db *ent.Client, timeService budgetTimeService, requireBalance bool, audit auditRecorder) BudgetService {
+	return &budgetService{
+		db:             db,
+		timeService:    timeService,
+		requireBalance: requireBalance,
+		audit:          audit,
+	}
+}
+
+func (s *budgetService) TopUp(ctx context.Context, topUp dto.AdvertiserTopUp) (*dto.AdvertiserBalance, error) {
+	adv, err := s.db.Advertiser.UpdateOneID(topUp.AdvertiserID).
+		AddBalance(topUp.Amount).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to top up balance: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	logger.Log.Infow("Balance topped up",
+		"advertiser_id", topUp.AdvertiserID,
+		"amount", topUp.Amount,
+	)
+
+	// Баланс до пополнения восстанавливается по результату, чтобы не читать его отдельным запросом
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionTopUp,
+		Entity:   dto.AuditEntityAdvertiser,
+		EntityID: topUp.AdvertiserID.String(),
+		Before:   map[string]any{advertiser.FieldBalance: adv.Balance - topUp.Amount},
+		After:    map[string]any{advertiser.FieldBalance: adv.Balance},
+	})
+
+	return s.Balance(ctx, topUp.AdvertiserID)
+}
+
+func (s *budgetService) Balance(ctx context.Context, advertiserID uuid.UUID) (*dto.AdvertiserBalance, error) {
+	adv, err := s.db.Advertiser.Get(ctx, advertiserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get advertiser: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	spends, err := s.db.CampaignDailySpend.Query().
+		Where(campaigndailyspend.AdvertiserID(advertiserID)).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get daily spends: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	balance := &dto.AdvertiserBalance{
+		AdvertiserID: adv.ID,
+		Balance:      adv.Balance,
+	}
+	today := s.timeService.Now().CurrentDate
+	for _, spend := range spends {
+		balance.SpentTotal += spend.Amount
+		if spend.Day == today {
+			balance.SpentToday += spend.Amount
+		}
+	}
+
+	return balance, nil
+}
+
+func (s *budgetService) Charge(ctx context.Context, camp *ent.Campaign, day int, amount float64) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Баланс может уйти в минус: событие уже произошло и должно быть оплачено
+	if err := tx.Advertiser.UpdateOneID(camp.AdvertiserID).
+		AddBalance(-amount).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to charge advertiser: %w", err)
+	}
+
+	if err := tx.Campaign.UpdateOneID(camp.ID).
+		AddSpent(amount).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update campaign spend: %w", err)
+	}
+
+	if err := tx.CampaignDailySpend.Create().
+		SetCampaignID(camp.ID).
+		SetAdvertiserID(camp.AdvertiserID).
+		SetDay(day).
+		SetAmount(amount).
+		OnConflictColumns(campaigndailyspend.FieldCampaignID, campaigndailyspend.FieldDay).
+		AddAmount(amount).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update daily spend: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (s *budgetService) Available(ctx context.Context, campaigns []*ent.Campaign, day int) ([]*ent.Campaign, error) {
+	if len(campaigns) == 0 {
+		return campaigns, nil
+	}
+
+	var (
+		dailyLimited  []uuid.UUID
+		advertiserIDs []uuid.UUID
+	)
+	for _, camp := range campaigns {
+		if camp.DailyBudget != nil {
+			dailyLimited = append(dailyLimited, camp.ID)
+		}
+		advertiserIDs = append(advertiserIDs, camp.AdvertiserID)
+	}
+
+	dailySpent := make(map[uuid.UUID]float64)
+	if len(dailyLimited) > 0 {
+		spends, err := s.db.CampaignDailySpend.Query().
+			Where(
+				campaigndailyspend.CampaignIDIn(dailyLimited...),
+				campaigndailyspend.Day(day),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daily spends: %w", err)
+		}
+		for _, spend := range spends {
+			dailySpent[spend.CampaignID] = spend.Amount
+		}
+	}
+
+	var funded map[uuid.UUID]bool
+	if s.requireBalance {
+		ids, err := s.db.Advertiser.Query().
+			Where(
+				advertiser.IDIn(advertiserIDs...),
+				advertiser.BalanceGT(0),
+			).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get advertisers balance: %w", err)
+		}
+		funded = make(map[uuid.UUID]bool, len(ids))
+		for _, id := range ids {
+			funded[id] = true
+		}
+	}
+
+	available := make([]*ent.Campaign, 0, len(campaigns))
+	for _, camp := range campaigns {
+		switch {
+		case camp.TotalBudget != nil && camp.Spent >= *camp.TotalBudget:
+			continue
+		case camp.DailyBudget != nil && dailySpent[camp.ID] >= *camp.DailyBudget:
+			continue
+		case funded != nil && !funded[camp.AdvertiserID]:
+			continue
+		}
+		available = append(available, camp)
+	}
+
+	return available, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/domain/dto"
+)
+
+type fixedTime struct {
+	day int
+}
+
+func (t fixedTime) Now() *dto.CurrentDate {
+	return &dto.CurrentDate{CurrentDate: t.day}
+}
+
+func newTestAdvertiser(t *testing.T, db *ent.Client, balance float64) *ent.Advertiser {
+	t.Helper()
+
+	return db.Advertiser.Create().
+		SetName("Рекламодатель").
+		SetBalance(balance).
+		SaveX(context.Background())
+}
+
+func newTestCampaign(t *testing.T, db *ent.Client, adv *ent.Advertiser, daily, total *float64) *ent.Campaign {
+	t.Helper()
+
+	return db.Campaign.Create().
+		SetAdvertiserID(adv.ID).
+		SetImpressionsLimit(1000).
+		SetClicksLimit(100).
+		SetCostPerImpression(1).
+		SetCostPerClick(5).
+		SetAdTitle("Кофе").
+		SetAdText("Скидка на кофе").
+		SetStartDate(0).
+		SetEndDate(30).
+		SetModerated(true).
+		SetNillableDailyBudget(daily).
+		SetNillableTotalBudget(total).
+		SaveX(context.Background())
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
+
+func campaignIDs(campaigns []*ent.Campaign) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(campaigns))
+	for _, camp := range campaigns {
+		ids = append(ids, camp.ID)
+	}
+	return ids
+}
+
+func TestBudgetAvailable(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s := NewBudgetService(db, fixedTime{day: 1}, true, nopAudit{})
+
+	funded := newTestAdvertiser(t, db, 100)
+	broke := newTestAdvertiser(t, db, 0)
+
+	unlimited := newTestCampaign(t, db, funded, nil, nil)
+	daily := newTestCampaign(t, db, funded, ptr(10), nil)
+	total := newTestCampaign(t, db, funded, nil, ptr(20))
+	unfunded := newTestCampaign(t, db, broke, nil, nil)
+	campaigns := []*ent.Campaign{unlimited, daily, total, unfunded}
+
+	available, err := s.Available(ctx, campaigns, 1)
+	require.NoError(t, err)
+	assert.Equal(t, campaignIDs([]*ent.Campaign{unlimited, daily, total}), campaignIDs(available))
+
+	// Дневной бюджет исчерпан только в день списания
+	require.NoError(t, s.Charge(ctx, daily, 1, 6))
+	require.NoError(t, s.Charge(ctx, daily, 1, 4))
+	// Общий бюджет учитывает расходы за все дни
+	require.NoError(t, s.Charge(ctx, total, 0, 15))
+	require.NoError(t, s.Charge(ctx, total, 1, 5))
+
+	campaigns = reloadCampaigns(t, db, campaigns)
+
+	available, err = s.Available(ctx, campaigns, 1)
+	require.NoError(t, err)
+	assert.Equal(t, campaignIDs([]*ent.Campaign{unlimited}), campaignIDs(available))
+
+	available, err = s.Available(ctx, campaigns, 2)
+	require.NoError(t, err)
+	assert.Equal(t, campaignIDs([]*ent.Campaign{unlimited, daily}), campaignIDs(available))
+}
+
+func TestBudgetAvailableBalance(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	adv := newTestAdvertiser(t, db, 3)
+	camp := newTestCampaign(t, db, adv, nil, nil)
+
+	enforced := NewBudgetService(db, fixedTime{}, true, nopAudit{})
+	disabled := NewBudgetService(db, fixedTime{}, false, nopAudit{})
+
+	available, err := enforced.Available(ctx, []*ent.Campaign{camp}, 0)
+	require.NoError(t, err)
+	assert.Len(t, available, 1)
+
+	// Событие оплачивается целиком, даже если баланс уходит в минус
+	require.NoError(t, enforced.Charge(ctx, camp, 0, 5))
+	assert.Equal(t, -2.0, db.Advertiser.GetX(ctx, adv.ID).Balance)
+
+	available, err = enforced.Available(ctx, []*ent.Campaign{camp}, 0)
+	require.NoError(t, err)
+	assert.Empty(t, available)
+
+	available, err = disabled.Available(ctx, []*ent.Campaign{camp}, 0)
+	require.NoError(t, err)
+	assert.Len(t, available, 1)
+
+	_, err = enforced.TopUp(ctx, dto.AdvertiserTopUp{AdvertiserID: adv.ID, Amount: 10})
+	require.NoError(t, err)
+
+	available, err = enforced.Available(ctx, []*ent.Campaign{camp}, 0)
+	require.NoError(t, err)
+	assert.Len(t, available, 1)
+}
+
+func TestBudgetCharge(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s := NewBudgetService(db, fixedTime{day: 2}, true, nopAudit{})
+
+	adv := newTestAdvertiser(t, db, 100)
+	camp := newTestCampaign(t, db, adv, nil, nil)
+
+	require.NoError(t, s.Charge(ctx, camp, 1, 10))
+	require.NoError(t, s.Charge(ctx, camp, 2, 1.5))
+	require.NoError(t, s.Charge(ctx, camp, 2, 2.5))
+	// Бесплатные события ничего не списывают
+	require.NoError(t, s.Charge(ctx, camp, 2, 0))
+
+	assert.Equal(t, 14.0, db.Campaign.GetX(ctx, camp.ID).Spent)
+
+	balance, err := s.Balance(ctx, adv.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 86.0, balance.Balance)
+	assert.Equal(t, 14.0, balance.SpentTotal)
+	assert.Equal(t, 4.0, balance.SpentToday)
+}
+
+func reloadCampaigns(t *testing.T, db *ent.Client, campaigns []*ent.Campaign) []*ent.Campaign {
+	t.Helper()
+
+	reloaded := make([]*ent.Campaign, 0, len(campaigns))
+	for _, camp := range campaigns {
+		reloaded = append(reloaded, db.Campaign.GetX(context.Background(), camp.ID))
+	}
+	return reloaded
+}
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"entgo.io/ent/dialect/sql"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
@@ -10,12 +12,24 @@ import (
 	"nlypage-final/internal/adapters/database/minio"
 	"nlypage-final/internal/adapters/database/postgres/ent"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
 	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
 	"nlypage-final/internal/domain/common/errorz"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/image_validation"
 	"nlypage-final/pkg/logger"
+	"nlypage-final/pkg/premoderation"
+	"reflect"
+	"strings"
+	"time"
 )
 
+// preModerationModerator — имя модератора в истории для решений автоматической премодерации
+const preModerationModerator = "premoderation"
+
+// maxImageFlags — сколько похожих кампаний и отклоненных креативов попадает в комментарий модератору
+const maxImageFlags = 5
+
 type campaignTimeService interface {
 	Now() *dto.CurrentDate
 }
@@ -26,18 +40,38 @@ type campaignClickhouseRepository interface {
 
 type adImagesRepository interface {
 	UploadImage(ctx context.Context, campaignID string, imageData io.Reader) (string, error)
-	GetImage(ctx context.Context, campaignID string) (string, error)
-	DeleteImage(ctx context.Context, campaignID string) error
+	DeleteImage(ctx context.Context, imageURL string) error
+	DeleteCampaignObjects(ctx context.Context, campaignID string) error
+}
+
+type campaignPreModerator interface {
+	Check(title, text string) premoderation.Result
+}
+
+type campaignImageValidator interface {
+	Validate(r io.Reader) (*image_validation.Image, error)
+}
+
+// campaignModerationNotifier сообщает модераторам о кампаниях, которые ждут проверки
+type campaignModerationNotifier interface {
+	CampaignPending(ctx context.Context, campaign *dto.Campaign)
 }
 
 type CampaignService interface {
 	Create(ctx context.Context, campaign *dto.CampaignCreate) (*dto.Campaign, error)
 	GetByID(ctx context.Context, campaignID uuid.UUID, advertiserID uuid.UUID) (*dto.Campaign, error)
 	Get(ctx context.Context, advertiserID uuid.UUID, size, page int) ([]*dto.Campaign, error)
+	// Delete архивирует кампанию: она скрывается и не показывается, статистика и начисления сохраняются
 	Delete(ctx context.Context, campaignID uuid.UUID, advertiserID uuid.UUID) error
+	// Purge безвозвратно удаляет кампанию вместе со статистикой, историей модерации и изображением
+	Purge(ctx context.Context, campaignID uuid.UUID) error
 	Update(ctx context.Context, campaignUpdate *dto.CampaignUpdate) (*dto.Campaign, error)
 	UploadImage(ctx context.Context, uploadImageRequest *dto.CampaignUploadImageRequest, imageData io.Reader) (*dto.CampaignImageURL, error)
 	RemoveImage(ctx context.Context, removeImageRequest *dto.CampaignRemoveImageRequest) error
+	Resubmit(ctx context.Context, resubmit *dto.CampaignResubmit) (*dto.Campaign, error)
+	// Pause приостанавливает показы кампании, Resume возобновляет их. Повторный вызов ничего не меняет
+	Pause(ctx context.Context, pause *dto.CampaignPause) (*dto.Campaign, error)
+	Resume(ctx context.Context, resume *dto.CampaignPause) (*dto.Campaign, error)
 }
 
 type campaignService struct {
@@ -46,6 +80,17 @@ type campaignService struct {
 	clickhouseRepository campaignClickhouseRepository
 	adImagesRepository   adImagesRepository
 	moderation           bool
+	// serveApprovedCreative оставляет в показах одобренную версию креатива, пока измененная на модерации
+	serveApprovedCreative bool
+	// preModerator выполняет автоматическую премодерацию текста; nil, если она отключена
+	preModerator campaignPreModerator
+	// imageValidator проверяет загружаемые изображения и считает их перцептивный хэш
+	imageValidator campaignImageValidator
+	// imageDuplicateDistance — максимальное расстояние Хэмминга между хэшами похожих изображений
+	imageDuplicateDistance int
+	audit                  auditRecorder
+	// notifier может быть nil, если уведомления модераторов отключены
+	notifier campaignModerationNotifier
 }
 
 func NewCampaignService(
@@ -54,13 +99,25 @@ func NewCampaignService(
 	clickhouseRepository campaignClickhouseRepository,
 	adImagesRepository adImagesRepository,
 	moderation bool,
+	serveApprovedCreative bool,
+	preModerator campaignPreModerator,
+	imageValidator campaignImageValidator,
+	imageDuplicateDistance int,
+	audit auditRecorder,
+	notifier campaignModerationNotifier,
 ) CampaignService {
 	return &campaignService{
-		db:                   db,
-		timeService:          timeService,
-		clickhouseRepository: clickhouseRepository,
-		adImagesRepository:   adImagesRepository,
-		moderation:           moderation,
+		db:                     db,
+		timeService:            timeService,
+		clickhouseRepository:   clickhouseRepository,
+		adImagesRepository:     adImagesRepository,
+		moderation:             moderation,
+		serveApprovedCreative:  serveApprovedCreative,
+		preModerator:           preModerator,
+		imageValidator:         imageValidator,
+		imageDuplicateDistance: imageDuplicateDistance,
+		audit:                  audit,
+		notifier:               notifier,
 	}
 }
 
@@ -85,11 +142,15 @@ func (s *campaignService) Create(ctx context.Context, campaign *dto.CampaignCrea
 		SetClicksLimit(campaign.ClicksLimit).
 		SetCostPerImpression(campaign.CostPerImpression).
 		SetCostPerClick(campaign.CostPerClick).
+		SetCostPerAction(campaign.CostPerAction).
+		SetNillableDailyBudget(campaign.DailyBudget).
+		SetNillableTotalBudget(campaign.TotalBudget).
 		SetAdTitle(campaign.AdTitle).
 		SetAdText(campaign.AdText).
 		SetStartDate(campaign.StartDate).
 		SetEndDate(campaign.EndDate).
 		SetModerated(!s.moderation).
+		SetModerationStatus(s.initialModerationStatus()).
 		Save(ctx)
 	if err != nil {
 		if ent.IsValidationError(err) {
@@ -125,30 +186,29 @@ func (s *campaignService) Create(ctx context.Context, campaign *dto.CampaignCrea
 		return nil, errorz.ErrInternal
 	}
 
-	var gender *string
-	if createdTargeting.Gender != nil {
-		genderStr := createdTargeting.Gender.String()
-		gender = &genderStr
-	}
-	return &dto.Campaign{
-		CampaignID:        createdCampaign.ID,
-		AdvertiserID:      createdCampaign.AdvertiserID,
-		ImpressionsLimit:  createdCampaign.ImpressionsLimit,
-		ClicksLimit:       createdCampaign.ClicksLimit,
-		CostPerImpression: createdCampaign.CostPerImpression,
-		CostPerClick:      createdCampaign.CostPerClick,
-		AdTitle:           createdCampaign.AdTitle,
-		AdText:            createdCampaign.AdText,
-		StartDate:         createdCampaign.StartDate,
-		EndDate:           createdCampaign.EndDate,
-		Moderated:         createdCampaign.Moderated,
-		Targeting: dto.Targeting{
-			Gender:   gender,
-			AgeFrom:  createdTargeting.AgeFrom,
-			AgeTo:    createdTargeting.AgeTo,
-			Location: createdTargeting.Location,
-		},
-	}, nil
+	if s.moderation {
+		if err := recordModerationDecision(ctx, s.db, createdCampaign.ID, moderationdecision.ActionSUBMITTED,
+			nil, nil, nil, s.timeService.Now().CurrentDate); err != nil {
+			logger.Log.Errorf("failed to record moderation decision: %v", err)
+			return nil, errorz.ErrInternal
+		}
+
+		createdCampaign, err = s.preModerate(ctx, s.db, createdCampaign)
+		if err != nil {
+			logger.Log.Errorf("failed to pre-moderate campaign: %v", err)
+			return nil, errorz.ErrInternal
+		}
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionCreate,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: createdCampaign.ID.String(),
+		After:    campaignAuditState{createdCampaign, createdTargeting},
+	})
+	s.notifyPending(ctx, nil, createdCampaign)
+
+	return toCampaignDTO(createdCampaign, createdTargeting), nil
 }
 
 func (s *campaignService) GetByID(ctx context.Context, campaignID uuid.UUID, advertiserID uuid.UUID) (*dto.Campaign, error) {
@@ -157,6 +217,7 @@ func (s *campaignService) GetByID(ctx context.Context, campaignID uuid.UUID, adv
 			campaign.And(
 				campaign.ID(campaignID),
 				campaign.AdvertiserID(advertiserID),
+				campaign.DeletedAtIsNil(),
 			),
 		).Only(ctx)
 	if err != nil {
@@ -173,37 +234,15 @@ func (s *campaignService) GetByID(ctx context.Context, campaignID uuid.UUID, adv
 		return nil, errorz.ErrInternal
 	}
 
-	var gender *string
-	if target.Gender != nil {
-		genderStr := target.Gender.String()
-		gender = &genderStr
-	}
-
-	return &dto.Campaign{
-		CampaignID:        camp.ID,
-		AdvertiserID:      camp.AdvertiserID,
-		ImpressionsLimit:  camp.ImpressionsLimit,
-		ClicksLimit:       camp.ClicksLimit,
-		CostPerImpression: camp.CostPerImpression,
-		CostPerClick:      camp.CostPerClick,
-		AdTitle:           camp.AdTitle,
-		AdText:            camp.AdText,
-		ImageURL:          camp.ImageURL,
-		StartDate:         camp.StartDate,
-		EndDate:           camp.EndDate,
-		Moderated:         camp.Moderated,
-		Targeting: dto.Targeting{
-			Gender:   gender,
-			AgeFrom:  target.AgeFrom,
-			AgeTo:    target.AgeTo,
-			Location: target.Location,
-		},
-	}, nil
+	return toCampaignDTO(camp, target), nil
 }
 
 func (s *campaignService) Get(ctx context.Context, advertiserID uuid.UUID, size, page int) ([]*dto.Campaign, error) {
 	campaigns, err := s.db.Campaign.Query().
-		Where(campaign.AdvertiserID(advertiserID)).
+		Where(
+			campaign.AdvertiserID(advertiserID),
+			campaign.DeletedAtIsNil(),
+		).
 		Offset((page - 1) * size).
 		Limit(size).
 		Order(ent.Desc(campaign.FieldStartDate)).
@@ -221,59 +260,111 @@ func (s *campaignService) Get(ctx context.Context, advertiserID uuid.UUID, size,
 			return nil, errorz.ErrInternal
 		}
 
-		var gender *string
-		if target.Gender != nil {
-			genderStr := target.Gender.String()
-			gender = &genderStr
-		}
-
-		result = append(result, &dto.Campaign{
-			CampaignID:        camp.ID,
-			AdvertiserID:      camp.AdvertiserID,
-			ImpressionsLimit:  camp.ImpressionsLimit,
-			ClicksLimit:       camp.ClicksLimit,
-			CostPerImpression: camp.CostPerImpression,
-			CostPerClick:      camp.CostPerClick,
-			AdTitle:           camp.AdTitle,
-			AdText:            camp.AdText,
-			ImageURL:          camp.ImageURL,
-			StartDate:         camp.StartDate,
-			EndDate:           camp.EndDate,
-			Moderated:         camp.Moderated,
-			Targeting: dto.Targeting{
-				Gender:   gender,
-				AgeFrom:  target.AgeFrom,
-				AgeTo:    target.AgeTo,
-				Location: target.Location,
-			},
-		})
+		result = append(result, toCampaignDTO(camp, target))
 	}
 	return result, nil
 }
 
 func (s *campaignService) Delete(ctx context.Context, campaignID uuid.UUID, advertiserID uuid.UUID) error {
-	_, err := s.db.Campaign.Delete().
+	deletedAt := time.Now()
+	archived, err := s.db.Campaign.Update().
 		Where(
 			campaign.And(
 				campaign.ID(campaignID),
 				campaign.AdvertiserID(advertiserID),
+				campaign.DeletedAtIsNil(),
 			),
-		).Exec(ctx)
+		).
+		SetDeletedAt(deletedAt).
+		Save(ctx)
 	if err != nil {
-		logger.Log.Errorf("failed to delete campaign: %v", err)
+		logger.Log.Errorf("failed to archive campaign: %v", err)
 		return errorz.ErrInternal
 	}
+	if archived == 0 {
+		return errorz.ErrNotFound
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionDelete,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: campaignID.String(),
+		Before:   map[string]any{campaign.FieldDeletedAt: nil},
+		After:    map[string]any{campaign.FieldDeletedAt: deletedAt},
+	})
 
+	return nil
+}
+
+func (s *campaignService) Purge(ctx context.Context, campaignID uuid.UUID) error {
+	camp, err := s.db.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get campaign: %v", err)
+		return errorz.ErrInternal
+	}
+
+	// Статистика и изображения удаляются до строки кампании: если удаление в ClickHouse или MinIO упадет,
+	// кампания останется в базе и повторный запрос доведет удаление до конца, а не получит 404.
+	// Биллинговый журнал не удаляется: это бухгалтерские записи, в них нет данных клиентов
 	if err := s.clickhouseRepository.DeleteStatsByCampaignID(ctx, campaignID); err != nil {
 		logger.Log.Errorf("failed to delete campaign stats: %v", err)
 		return errorz.ErrInternal
 	}
 
-	if err := s.adImagesRepository.DeleteImage(ctx, campaignID.String()); err != nil {
-		logger.Log.Errorf("failed to delete campaign image: %v", err)
+	// Удаляется весь префикс кампании, включая одобренную версию изображения
+	if err := s.adImagesRepository.DeleteCampaignObjects(ctx, campaignID.String()); err != nil {
+		logger.Log.Errorf("failed to delete campaign images: %v", err)
+		return errorz.ErrInternal
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to start transaction: %v", err)
+		return errorz.ErrInternal
+	}
+
+	if _, err := tx.ModerationDecision.Delete().
+		Where(moderationdecision.CampaignID(campaignID)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to delete moderation decisions: %v", err)
+		return errorz.ErrInternal
+	}
+
+	// Внешний ключ таргетинга не каскадный, поэтому таргетинг удаляется первым
+	if _, err := tx.Targeting.Delete().
+		Where(targeting.HasCampaignWith(campaign.ID(campaignID))).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to delete targeting: %v", err)
+		return errorz.ErrInternal
+	}
+
+	if err := tx.Campaign.DeleteOneID(campaignID).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			// Кампанию удалил параллельный запрос
+			return errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to delete campaign: %v", err)
 		return errorz.ErrInternal
 	}
 
+	if err := tx.Commit(); err != nil {
+		logger.Log.Errorf("failed to commit transaction: %v", err)
+		return errorz.ErrInternal
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionPurge,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: campaignID.String(),
+		Before:   campaignAuditState{Campaign: camp},
+	})
+
 	return nil
 }
 
@@ -282,6 +373,7 @@ func (s *campaignService) Update(ctx context.Context, campaignUpdate *dto.Campai
 		campaign.And(
 			campaign.ID(campaignUpdate.CampaignID),
 			campaign.AdvertiserID(campaignUpdate.AdvertiserID),
+			campaign.DeletedAtIsNil(),
 		)).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -370,60 +462,80 @@ func (s *campaignService) Update(ctx context.Context, campaignUpdate *dto.Campai
 		return nil, errorz.ErrInternal
 	}
 
-	_, err = tx.Campaign.Update().
-		Where(
-			campaign.And(
-				campaign.ID(camp.ID),
-				campaign.AdvertiserID(camp.AdvertiserID),
-			),
-		).
+	creativeChanged := campaignUpdate.AdTitle != camp.AdTitle || campaignUpdate.AdText != camp.AdText
+	if creativeChanged {
+		if err := s.sendToRemoderation(ctx, tx, camp); err != nil {
+			_ = tx.Rollback()
+			logger.Log.Errorf("failed to send campaign to re-moderation: %v", err)
+			return nil, errorz.ErrInternal
+		}
+	}
+
+	campaignQuery := tx.Campaign.UpdateOne(camp).
 		SetImpressionsLimit(campaignUpdate.ImpressionsLimit).
 		SetClicksLimit(campaignUpdate.ClicksLimit).
 		SetCostPerImpression(campaignUpdate.CostPerImpression).
 		SetCostPerClick(campaignUpdate.CostPerClick).
+		SetCostPerAction(campaignUpdate.CostPerAction).
 		SetAdTitle(campaignUpdate.AdTitle).
 		SetAdText(campaignUpdate.AdText).
 		SetStartDate(campaignUpdate.StartDate).
-		SetEndDate(campaignUpdate.EndDate).
-		Save(ctx)
+		SetEndDate(campaignUpdate.EndDate)
+	// Бюджет, не переданный при обновлении, снимается
+	if campaignUpdate.DailyBudget != nil {
+		campaignQuery = campaignQuery.SetDailyBudget(*campaignUpdate.DailyBudget)
+	} else {
+		campaignQuery = campaignQuery.ClearDailyBudget()
+	}
+	if campaignUpdate.TotalBudget != nil {
+		campaignQuery = campaignQuery.SetTotalBudget(*campaignUpdate.TotalBudget)
+	} else {
+		campaignQuery = campaignQuery.ClearTotalBudget()
+	}
+	if creativeChanged {
+		// Вердикт ассистента модерации относится к прежнему тексту
+		campaignQuery = campaignQuery.
+			ClearAiCategory().
+			ClearAiViolations().
+			ClearAiConfidence().
+			ClearRiskScore().
+			ClearAiReviewedAt().
+			SetAiReviewAttempts(0).
+			ClearAiReviewRetryAt()
+	}
+
+	updatedCampaign, err := campaignQuery.Save(ctx)
 	if err != nil {
 		_ = tx.Rollback()
 		logger.Log.Errorf("failed to update campaign: %v", err)
 		return nil, errorz.ErrInternal
 	}
 
+	if creativeChanged {
+		updatedCampaign, err = s.preModerate(ctx, tx.Client(), updatedCampaign)
+		if err != nil {
+			_ = tx.Rollback()
+			logger.Log.Errorf("failed to pre-moderate campaign: %v", err)
+			return nil, errorz.ErrInternal
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		logger.Log.Errorf("failed to commit transaction: %v", err)
 		return nil, errorz.ErrInternal
 	}
 
-	var gender *string
-	if updatedTarget.Gender != nil {
-		genderStr := updatedTarget.Gender.String()
-		gender = &genderStr
-	}
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionUpdate,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: camp.ID.String(),
+		Before:   campaignAuditState{camp, target},
+		After:    campaignAuditState{updatedCampaign, updatedTarget},
+	})
+	s.notifyPending(ctx, camp, updatedCampaign)
 
-	return &dto.Campaign{
-		CampaignID:        campaignUpdate.CampaignID,
-		AdvertiserID:      campaignUpdate.AdvertiserID,
-		ImpressionsLimit:  campaignUpdate.ImpressionsLimit,
-		ClicksLimit:       campaignUpdate.ClicksLimit,
-		CostPerImpression: campaignUpdate.CostPerImpression,
-		CostPerClick:      campaignUpdate.CostPerClick,
-		AdTitle:           campaignUpdate.AdTitle,
-		AdText:            campaignUpdate.AdText,
-		ImageURL:          camp.ImageURL,
-		StartDate:         campaignUpdate.StartDate,
-		EndDate:           campaignUpdate.EndDate,
-		Moderated:         camp.Moderated,
-		Targeting: dto.Targeting{
-			Gender:   gender,
-			AgeFrom:  updatedTarget.AgeFrom,
-			AgeTo:    updatedTarget.AgeTo,
-			Location: updatedTarget.Location,
-		},
-	}, nil
+	return toCampaignDTO(updatedCampaign, updatedTarget), nil
 }
 
 func (s *campaignService) UploadImage(ctx context.Context, uploadImageRequest *dto.CampaignUploadImageRequest, imageData io.Reader) (*dto.CampaignImageURL, error) {
@@ -431,6 +543,7 @@ func (s *campaignService) UploadImage(ctx context.Context, uploadImageRequest *d
 		campaign.And(
 			campaign.ID(uploadImageRequest.CampaignID),
 			campaign.AdvertiserID(uploadImageRequest.AdvertiserID),
+			campaign.DeletedAtIsNil(),
 		)).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -440,7 +553,27 @@ func (s *campaignService) UploadImage(ctx context.Context, uploadImageRequest *d
 		return nil, errorz.ErrInternal
 	}
 
-	imageURL, err := s.adImagesRepository.UploadImage(ctx, camp.ID.String(), imageData)
+	validatedImage, err := s.imageValidator.Validate(imageData)
+	if err != nil {
+		if errors.Is(err, image_validation.ErrInvalidImage) {
+			return nil, &echo.HTTPError{
+				Message: err.Error(),
+				Code:    echo.ErrBadRequest.Code,
+			}
+		}
+		logger.Log.Errorf("failed to validate image: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	imageFlags, err := s.imageFlags(ctx, camp.ID, validatedImage.Hash)
+	if err != nil {
+		logger.Log.Errorf("failed to check image duplicates: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	// Изображение загружается под новым ключом до транзакции: показываемый объект не меняется,
+	// пока транзакция не переключит на новый ключ image_url
+	imageURL, err := s.adImagesRepository.UploadImage(ctx, camp.ID.String(), bytes.NewReader(validatedImage.Data))
 	if err != nil {
 		if errors.Is(minio.ErrFileNotImage, err) {
 			return nil, &echo.HTTPError{
@@ -452,15 +585,52 @@ func (s *campaignService) UploadImage(ctx context.Context, uploadImageRequest *d
 		return nil, errorz.ErrInternal
 	}
 
-	_, err = s.db.Campaign.Update().
-		Where(campaign.ID(camp.ID)).
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.removeUnusedImage(ctx, camp, imageURL)
+		logger.Log.Errorf("failed to start transaction: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	if err := s.sendToRemoderation(ctx, tx, camp); err != nil {
+		_ = tx.Rollback()
+		s.removeUnusedImage(ctx, camp, imageURL)
+		logger.Log.Errorf("failed to send campaign to re-moderation: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	campaignQuery := tx.Campaign.UpdateOneID(camp.ID).
 		SetImageURL(imageURL).
-		Save(ctx)
+		SetImageHash(int64(validatedImage.Hash))
+	if s.moderation && len(imageFlags) > 0 {
+		// Похожие изображения не блокируют загрузку, а попадают в комментарий для модератора
+		campaignQuery = campaignQuery.SetModerationComment(appendImageFlags(camp.ModerationComment, imageFlags))
+	}
+	updatedCampaign, err := campaignQuery.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
+		s.removeUnusedImage(ctx, camp, imageURL)
 		logger.Log.Errorf("failed to update campaign: %v", err)
 		return nil, errorz.ErrInternal
 	}
 
+	if err := tx.Commit(); err != nil {
+		s.removeUnusedImage(ctx, camp, imageURL)
+		logger.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, errorz.ErrInternal
+	}
+	updatedCampaign = updatedCampaign.Unwrap()
+	s.removeUnusedImage(ctx, updatedCampaign, camp.ImageURL)
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionUpdate,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: camp.ID.String(),
+		Before:   campaignAuditState{Campaign: camp},
+		After:    campaignAuditState{Campaign: updatedCampaign},
+	})
+	s.notifyPending(ctx, camp, updatedCampaign)
+
 	return &dto.CampaignImageURL{
 		AdvertiserID: camp.AdvertiserID,
 		CampaignID:   camp.ID,
@@ -469,22 +639,428 @@ func (s *campaignService) UploadImage(ctx context.Context, uploadImageRequest *d
 }
 
 func (s *campaignService) RemoveImage(ctx context.Context, removeImageRequest *dto.CampaignRemoveImageRequest) error {
-	if err := s.adImagesRepository.DeleteImage(ctx, removeImageRequest.CampaignID.String()); err != nil {
-		if errors.Is(minio.ErrImageNotFound, err) {
+	camp, err := s.db.Campaign.Query().Where(
+		campaign.And(
+			campaign.ID(removeImageRequest.CampaignID),
+			campaign.AdvertiserID(removeImageRequest.AdvertiserID),
+			campaign.DeletedAtIsNil(),
+		)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
 			return errorz.ErrNotFound
 		}
-		logger.Log.Errorf("failed to delete image: %v", err)
+		logger.Log.Errorf("failed to get campaign: %v", err)
+		return errorz.ErrInternal
+	}
+	if camp.ImageURL == "" {
+		return errorz.ErrNotFound
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to start transaction: %v", err)
 		return errorz.ErrInternal
 	}
 
-	_, err := s.db.Campaign.Update().
-		Where(campaign.ID(removeImageRequest.CampaignID)).
+	if err := s.sendToRemoderation(ctx, tx, camp); err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to send campaign to re-moderation: %v", err)
+		return errorz.ErrInternal
+	}
+
+	updatedCampaign, err := tx.Campaign.UpdateOneID(camp.ID).
 		ClearImageURL().
+		ClearImageHash().
 		Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
 		logger.Log.Errorf("failed to update campaign: %v", err)
 		return errorz.ErrInternal
 	}
 
+	if err := tx.Commit(); err != nil {
+		logger.Log.Errorf("failed to commit transaction: %v", err)
+		return errorz.ErrInternal
+	}
+	updatedCampaign = updatedCampaign.Unwrap()
+	// Объект удаляется после коммита: при откате кампания продолжила бы ссылаться на удаленное изображение
+	s.removeUnusedImage(ctx, updatedCampaign, camp.ImageURL)
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionUpdate,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: camp.ID.String(),
+		Before:   campaignAuditState{Campaign: camp},
+		After:    campaignAuditState{Campaign: updatedCampaign},
+	})
+	s.notifyPending(ctx, camp, updatedCampaign)
+
 	return nil
 }
+
+// Resubmit отправляет отклоненную кампанию на повторную модерацию после исправлений
+func (s *campaignService) Resubmit(ctx context.Context, resubmit *dto.CampaignResubmit) (*dto.Campaign, error) {
+	camp, err := s.db.Campaign.Query().Where(
+		campaign.And(
+			campaign.ID(resubmit.CampaignID),
+			campaign.AdvertiserID(resubmit.AdvertiserID),
+			campaign.DeletedAtIsNil(),
+		)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get campaign: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	if camp.ModerationStatus != campaign.ModerationStatusREJECTED {
+		return nil, &echo.HTTPError{
+			Message: "only rejected campaigns can be resubmitted",
+			Code:    echo.ErrConflict.Code,
+		}
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to start transaction: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	updatedCampaign, err := tx.Campaign.UpdateOne(camp).
+		SetModerationStatus(campaign.ModerationStatusPENDING).
+		ClearRejectionReason().
+		ClearModerationComment().
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to update campaign: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	if err := recordModerationDecision(ctx, tx.Client(), camp.ID, moderationdecision.ActionRESUBMITTED,
+		nil, resubmit.Comment, nil, s.timeService.Now().CurrentDate); err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to record moderation decision: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	updatedCampaign, err = s.preModerate(ctx, tx.Client(), updatedCampaign)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to pre-moderate campaign: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionResubmit,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: camp.ID.String(),
+		Before:   campaignAuditState{Campaign: camp},
+		After:    campaignAuditState{Campaign: updatedCampaign},
+	})
+	s.notifyPending(ctx, camp, updatedCampaign)
+
+	target, err := updatedCampaign.QueryTargeting().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get targeting: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	return toCampaignDTO(updatedCampaign, target), nil
+}
+
+// sendToRemoderation возвращает одобренную кампанию на модерацию перед изменением креатива.
+// При serveApprovedCreative текущий креатив сохраняется как одобренный и продолжает показываться до решения модератора
+func (s *campaignService) sendToRemoderation(ctx context.Context, tx *ent.Tx, camp *ent.Campaign) error {
+	if !s.moderation || camp.ModerationStatus != campaign.ModerationStatusAPPROVED {
+		return nil
+	}
+
+	update := tx.Campaign.UpdateOne(camp).
+		SetModerationStatus(campaign.ModerationStatusPENDING).
+		ClearModerationComment()
+	if s.serveApprovedCreative {
+		// Новое изображение загружается под другим ключом, поэтому одобренное остается по прежней ссылке
+		update.
+			SetApprovedAdTitle(camp.AdTitle).
+			SetApprovedAdText(camp.AdText).
+			SetApprovedImageURL(camp.ImageURL)
+	} else {
+		update.SetModerated(false)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return err
+	}
+
+	return recordModerationDecision(ctx, tx.Client(), camp.ID, moderationdecision.ActionSUBMITTED,
+		nil, nil, nil, s.timeService.Now().CurrentDate)
+}
+
+// removeUnusedImage удаляет объект изображения, если кампания на него больше не ссылается ни как на текущее,
+// ни как на одобренное изображение. Ошибка только логируется: оставшийся объект удалится вместе с кампанией
+func (s *campaignService) removeUnusedImage(ctx context.Context, camp *ent.Campaign, imageURL string) {
+	if imageURL == "" || imageURL == camp.ImageURL ||
+		(camp.ApprovedImageURL != nil && *camp.ApprovedImageURL == imageURL) {
+		return
+	}
+
+	if err := s.adImagesRepository.DeleteImage(ctx, imageURL); err != nil {
+		logger.Log.Warnw("Failed to delete unused campaign image",
+			"campaign_id", camp.ID,
+			"image_url", imageURL,
+			"error", err,
+		)
+	}
+}
+
+// notifyPending сообщает модераторам о кампании, которая ждет проверки: новой, вернувшейся на модерацию
+// или с измененным креативом. Иначе модераторы решали бы по устаревшему объявлению
+func (s *campaignService) notifyPending(ctx context.Context, before, after *ent.Campaign) {
+	if s.notifier == nil || after.ModerationStatus != campaign.ModerationStatusPENDING {
+		return
+	}
+	if before != nil && before.ModerationStatus == campaign.ModerationStatusPENDING &&
+		before.AdTitle == after.AdTitle &&
+		before.AdText == after.AdText &&
+		before.ImageURL == after.ImageURL &&
+		// Старые кампании хранят изображение по постоянной ссылке, поэтому сравнивается и хэш
+		reflect.DeepEqual(before.ImageHash, after.ImageHash) {
+		return
+	}
+	s.notifier.CampaignPending(ctx, toCampaignDTO(after, nil))
+}
+
+// preModerate проверяет текст кампании, ожидающей модерации, правилами премодерации.
+// Нарушения с вердиктом REJECT отклоняют кампанию, REVIEW оставляют ее модератору с комментарием о сработавших правилах
+func (s *campaignService) preModerate(ctx context.Context, client *ent.Client, camp *ent.Campaign) (*ent.Campaign, error) {
+	if s.preModerator == nil || camp.ModerationStatus != campaign.ModerationStatusPENDING {
+		return camp, nil
+	}
+
+	result := s.preModerator.Check(camp.AdTitle, camp.AdText)
+	day := s.timeService.Now().CurrentDate
+	moderator := preModerationModerator
+
+	switch result.Verdict {
+	case premoderation.VerdictReject:
+		reason := result.Reason()
+		if campaign.RejectionReasonValidator(campaign.RejectionReason(reason)) != nil {
+			reason = campaign.RejectionReasonOTHER.String()
+		}
+		comment := result.Reasons()
+		return rejectCampaign(ctx, client, camp, reason, &comment, &moderator, day)
+	case premoderation.VerdictReview:
+		return client.Campaign.UpdateOne(camp).
+			SetModerationComment(result.Reasons()).
+			Save(ctx)
+	default:
+		// Изображение правилами не проверяется, поэтому кампанию с изображением одобряет модератор
+		if camp.ImageURL != "" {
+			return camp, nil
+		}
+		return approveCampaign(ctx, client, camp, nil, &moderator, day)
+	}
+}
+
+// imageFlags ищет среди других кампаний и ранее отклоненных креативов изображения, похожие на загружаемое.
+// Расстояние между хэшами считает Postgres, а число найденных совпадений ограничено maxImageFlags
+func (s *campaignService) imageFlags(ctx context.Context, campaignID uuid.UUID, hash uint64) ([]string, error) {
+	campaigns, err := s.db.Campaign.Query().
+		Where(
+			campaign.IDNEQ(campaignID),
+			campaign.ImageHashNotNil(),
+			campaign.DeletedAtIsNil(),
+			func(sel *sql.Selector) {
+				sel.Where(imageHashWithin(sel.C(campaign.FieldImageHash), hash, s.imageDuplicateDistance))
+			},
+		).
+		Limit(maxImageFlags).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var flags []string
+	for _, id := range campaigns {
+		flags = append(flags, fmt.Sprintf("image duplicates campaign %s", id))
+	}
+
+	var rejected []struct {
+		CampaignID uuid.UUID `sql:"campaign_id"`
+	}
+	err = s.db.ModerationDecision.Query().
+		Where(
+			moderationdecision.ActionEQ(moderationdecision.ActionREJECTED),
+			moderationdecision.ImageHashNotNil(),
+			func(sel *sql.Selector) {
+				sel.Where(imageHashWithin(sel.C(moderationdecision.FieldImageHash), hash, s.imageDuplicateDistance))
+			},
+		).
+		Unique(true).
+		Limit(maxImageFlags).
+		Select(moderationdecision.FieldCampaignID).
+		Scan(ctx, &rejected)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, decision := range rejected {
+		flags = append(flags, fmt.Sprintf("image is similar to a rejected creative of campaign %s", decision.CampaignID))
+	}
+
+	return flags, nil
+}
+
+// imageHashWithin отбирает строки, где перцептивный хэш в column отличается от hash не больше чем на distance бит
+func imageHashWithin(column string, hash uint64, distance int) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString("bit_count((").
+			WriteString(column).
+			WriteString(" # ").
+			Arg(int64(hash)).
+			WriteString(")::bit(64)) <= ").
+			Arg(distance)
+	})
+}
+
+// appendImageFlags дописывает флаги похожих изображений к комментарию модерации, не затирая причины отклонения
+// и результаты премодерации. Флаги, которые уже есть в комментарии, повторно не добавляются
+func appendImageFlags(comment *string, flags []string) string {
+	var parts []string
+	if comment != nil && *comment != "" {
+		parts = append(parts, *comment)
+	}
+	for _, flag := range flags {
+		if comment != nil && strings.Contains(*comment, flag) {
+			continue
+		}
+		parts = append(parts, flag)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// servedCreative возвращает креатив, который видят пользователи: одобренную версию, если измененная еще на модерации
+func servedCreative(camp *ent.Campaign) (title, text, imageURL string) {
+	if camp.ApprovedAdTitle == nil || camp.ApprovedAdText == nil || camp.ApprovedImageURL == nil {
+		return camp.AdTitle, camp.AdText, camp.ImageURL
+	}
+	return *camp.ApprovedAdTitle, *camp.ApprovedAdText, *camp.ApprovedImageURL
+}
+
+// initialModerationStatus возвращает статус новой кампании: без модерации она сразу считается одобренной
+func (s *campaignService) initialModerationStatus() campaign.ModerationStatus {
+	if s.moderation {
+		return campaign.ModerationStatusPENDING
+	}
+	return campaign.ModerationStatusAPPROVED
+}
+
+// toCampaignDTO переводит кампанию и ее таргетинг в DTO. target может быть nil, если таргетинг не загружался
+func toCampaignDTO(camp *ent.Campaign, target *ent.Targeting) *dto.Campaign {
+	result := &dto.Campaign{
+		CampaignID:        camp.ID,
+		AdvertiserID:      camp.AdvertiserID,
+		ImpressionsLimit:  camp.ImpressionsLimit,
+		ClicksLimit:       camp.ClicksLimit,
+		CostPerImpression: camp.CostPerImpression,
+		CostPerClick:      camp.CostPerClick,
+		CostPerAction:     camp.CostPerAction,
+		DailyBudget:       camp.DailyBudget,
+		TotalBudget:       camp.TotalBudget,
+		Spent:             camp.Spent,
+		AdTitle:           camp.AdTitle,
+		AdText:            camp.AdText,
+		ImageURL:          camp.ImageURL,
+		StartDate:         camp.StartDate,
+		EndDate:           camp.EndDate,
+		Moderated:         camp.Moderated,
+		ModerationStatus:  camp.ModerationStatus.String(),
+		ModerationComment: camp.ModerationComment,
+		Paused:            camp.Paused,
+	}
+
+	if camp.RejectionReason != nil {
+		reason := camp.RejectionReason.String()
+		result.RejectionReason = &reason
+	}
+
+	if target != nil {
+		var gender *string
+		if target.Gender != nil {
+			genderStr := target.Gender.String()
+			gender = &genderStr
+		}
+		result.Targeting = dto.Targeting{
+			Gender:   gender,
+			AgeFrom:  target.AgeFrom,
+			AgeTo:    target.AgeTo,
+			Location: target.Location,
+		}
+	}
+
+	return result
+}
+
+func (s *campaignService) Pause(ctx context.Context, pause *dto.CampaignPause) (*dto.Campaign, error) {
+	return s.setPaused(ctx, pause, true)
+}
+
+func (s *campaignService) Resume(ctx context.Context, resume *dto.CampaignPause) (*dto.Campaign, error) {
+	return s.setPaused(ctx, resume, false)
+}
+
+func (s *campaignService) setPaused(ctx context.Context, pause *dto.CampaignPause, paused bool) (*dto.Campaign, error) {
+	camp, err := s.db.Campaign.Query().
+		Where(
+			campaign.ID(pause.CampaignID),
+			campaign.AdvertiserID(pause.AdvertiserID),
+			campaign.DeletedAtIsNil(),
+		).
+		WithTargeting().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get campaign: %v", err)
+		return nil, errorz.ErrInternal
+	}
+	if camp.Paused == paused {
+		return toCampaignDTO(camp, camp.Edges.Targeting), nil
+	}
+
+	updatedCampaign, err := s.db.Campaign.UpdateOne(camp).
+		SetPaused(paused).
+		Save(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to update campaign: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	action := dto.AuditActionResume
+	if paused {
+		action = dto.AuditActionPause
+	}
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   action,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: camp.ID.String(),
+		Before:   map[string]any{campaign.FieldPaused: camp.Paused},
+		After:    map[string]any{campaign.FieldPaused: updatedCampaign.Paused},
+	})
+
+	return toCampaignDTO(updatedCampaign, camp.Edges.Targeting), nil
+}
+
+// campaignAuditState — состояние кампании для журнала аудита. Таргетинг указывается, если операция его меняет
+type campaignAuditState struct {
+	*ent.Campaign
+	Targeting *ent.Targeting `json:"targeting,omitempty"`
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"nlypage-final/internal/domain/dto"
+)
+
+// fakeCampaignStats считает удаления статистики и может падать, как недоступный ClickHouse
+type fakeCampaignStats struct {
+	deleted int
+	err     error
+}
+
+func (r *fakeCampaignStats) DeleteStatsByCampaignID(context.Context, uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.deleted++
+	return nil
+}
+
+// fakeAdImages хранит объекты кампаний в памяти вместо MinIO, ключ — публичная ссылка на объект
+type fakeAdImages struct {
+	objects map[string]string
+	err     error
+}
+
+func newFakeAdImages() *fakeAdImages {
+	return &fakeAdImages{objects: make(map[string]string)}
+}
+
+func (r *fakeAdImages) UploadImage(_ context.Context, campaignID string, imageData io.Reader) (string, error) {
+	data, err := io.ReadAll(imageData)
+	if err != nil {
+		return "", err
+	}
+	imageURL := "http://minio/campaigns/" + campaignID + "/" + string(data)
+	r.objects[imageURL] = string(data)
+	return imageURL, nil
+}
+
+func (r *fakeAdImages) DeleteImage(_ context.Context, imageURL string) error {
+	delete(r.objects, imageURL)
+	return nil
+}
+
+func (r *fakeAdImages) DeleteCampaignObjects(_ context.Context, campaignID string) error {
+	if r.err != nil {
+		return r.err
+	}
+	for key := range r.objects {
+		if strings.HasPrefix(key, "http://minio/campaigns/"+campaignID+"/") {
+			delete(r.objects, key)
+		}
+	}
+	return nil
+}
+
+// add кладет изображение кампании и возвращает ссылку на него
+func (r *fakeAdImages) add(camp *ent.Campaign, name string) string {
+	imageURL := "http://minio/campaigns/" + camp.ID.String() + "/" + name
+	r.objects[imageURL] = name
+	return imageURL
+}
+
+func newTestCampaignService(
+	db *ent.Client,
+	stats campaignClickhouseRepository,
+	images adImagesRepository,
+	serveApprovedCreative bool,
+) CampaignService {
+	return NewCampaignService(db, fixedTime{}, stats, images, true, serveApprovedCreative,
+		nil, nil, 0, nopAudit{}, nil)
+}
+
+// newApprovedTestCampaign создает одобренную кампанию с таргетингом и изображением
+func newApprovedTestCampaign(t *testing.T, db *ent.Client, images *fakeAdImages) *ent.Campaign {
+	t.Helper()
+	ctx := context.Background()
+
+	camp := newTestCampaign(t, db, newTestAdvertiser(t, db, 0), nil, nil)
+	db.Targeting.Create().SetCampaign(camp).ExecX(ctx)
+	return camp.Update().
+		SetModerationStatus(campaign.ModerationStatusAPPROVED).
+		SetImageURL(images.add(camp, "image")).
+		SaveX(ctx)
+}
+
+// creativeUpdate меняет в кампании только заголовок и текст
+func creativeUpdate(camp *ent.Campaign, title, text string) *dto.CampaignUpdate {
+	return &dto.CampaignUpdate{
+		AdvertiserID:      camp.AdvertiserID,
+		CampaignID:        camp.ID,
+		ImpressionsLimit:  camp.ImpressionsLimit,
+		ClicksLimit:       camp.ClicksLimit,
+		CostPerImpression: camp.CostPerImpression,
+		CostPerClick:      camp.CostPerClick,
+		CostPerAction:     camp.CostPerAction,
+		AdTitle:           title,
+		AdText:            text,
+		StartDate:         camp.StartDate,
+		EndDate:           camp.EndDate,
+	}
+}
+
+func TestPurgeArchivedCampaign(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	stats := &fakeCampaignStats{}
+	images := newFakeAdImages()
+	s := newTestCampaignService(db, stats, images, false)
+
+	adv := newTestAdvertiser(t, db, 0)
+	camp := newTestCampaign(t, db, adv, nil, nil)
+	db.Targeting.Create().SetCampaign(camp).ExecX(ctx)
+	require.NoError(t, recordModerationDecision(ctx, db, camp.ID, moderationdecision.ActionSUBMITTED,
+		nil, nil, nil, 0))
+	images.add(camp, "image")
+	images.add(camp, "approved-image")
+
+	require.NoError(t, s.Delete(ctx, camp.ID, adv.ID))
+
+	// Удаление падает на ClickHouse: кампания остается, и запрос можно повторить
+	stats.err = errors.New("clickhouse is down")
+	assertHTTPCode(t, s.Purge(ctx, camp.ID), http.StatusInternalServerError)
+	assert.True(t, db.Campaign.Query().Where(campaign.ID(camp.ID)).ExistX(ctx))
+
+	// Затем падает MinIO
+	stats.err = nil
+	images.err = errors.New("minio is down")
+	assertHTTPCode(t, s.Purge(ctx, camp.ID), http.StatusInternalServerError)
+	assert.True(t, db.Campaign.Query().Where(campaign.ID(camp.ID)).ExistX(ctx))
+
+	images.err = nil
+	require.NoError(t, s.Purge(ctx, camp.ID))
+
+	assert.False(t, db.Campaign.Query().Where(campaign.ID(camp.ID)).ExistX(ctx))
+	assert.Zero(t, db.Targeting.Query().CountX(ctx))
+	assert.Zero(t, db.ModerationDecision.Query().Where(moderationdecision.CampaignID(camp.ID)).CountX(ctx))
+	assert.Equal(t, 2, stats.deleted)
+	assert.Empty(t, images.objects)
+
+	assertHTTPCode(t, s.Purge(ctx, camp.ID), http.StatusNotFound)
+}
+
+func TestRemoveImageKeepsApprovedVersion(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	images := newFakeAdImages()
+
+	camp := newApprovedTestCampaign(t, db, images)
+	imageURL := camp.ImageURL
+
+	// Одобренное изображение показывается до решения модератора, поэтому объект остается
+	s := newTestCampaignService(db, &fakeCampaignStats{}, images, true)
+	request := &dto.CampaignRemoveImageRequest{AdvertiserID: camp.AdvertiserID, CampaignID: camp.ID}
+	require.NoError(t, s.RemoveImage(ctx, request))
+
+	camp = db.Campaign.GetX(ctx, camp.ID)
+	assert.Empty(t, camp.ImageURL)
+	require.NotNil(t, camp.ApprovedImageURL)
+	assert.Equal(t, imageURL, *camp.ApprovedImageURL)
+	assert.Contains(t, images.objects, imageURL)
+
+	assertHTTPCode(t, s.RemoveImage(ctx, request), http.StatusNotFound)
+}
+
+func TestRemoveImageDeletesObject(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	images := newFakeAdImages()
+
+	camp := newApprovedTestCampaign(t, db, images)
+
+	s := newTestCampaignService(db, &fakeCampaignStats{}, images, false)
+	require.NoError(t, s.RemoveImage(ctx, &dto.CampaignRemoveImageRequest{AdvertiserID: camp.AdvertiserID, CampaignID: camp.ID}))
+
+	assert.Empty(t, db.Campaign.GetX(ctx, camp.ID).ImageURL)
+	assert.Empty(t, images.objects)
+}
+
+func TestUpdateSendsApprovedCampaignToRemoderation(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	images := newFakeAdImages()
+	s := newTestCampaignService(db, &fakeCampaignStats{}, images, false)
+
+	camp := newApprovedTestCampaign(t, db, images)
+	_, err := s.Update(ctx, creativeUpdate(camp, "Чай", "Скидка на чай"))
+	require.NoError(t, err)
+
+	// Без serve-approved-creative кампания не показывается до решения модератора
+	updated := db.Campaign.GetX(ctx, camp.ID)
+	assert.Equal(t, campaign.ModerationStatusPENDING, updated.ModerationStatus)
+	assert.False(t, updated.Moderated)
+	assert.Nil(t, updated.ApprovedAdTitle)
+	assert.Nil(t, updated.ApprovedAdText)
+	assert.Nil(t, updated.ApprovedImageURL)
+	assert.Equal(t, 1, decisionsCount(t, db, camp, moderationdecision.ActionSUBMITTED))
+
+	// Изменение без креатива не возвращает кампанию на модерацию повторно
+	_, err = s.Update(ctx, creativeUpdate(camp, "Чай", "Скидка на чай"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, decisionsCount(t, db, camp, moderationdecision.ActionSUBMITTED))
+}
+
+func TestRemoderationServesApprovedCreative(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	images := newFakeAdImages()
+	s := newTestCampaignService(db, &fakeCampaignStats{}, images, true)
+
+	camp := newApprovedTestCampaign(t, db, images)
+	_, err := s.Update(ctx, creativeUpdate(camp, "Чай", "Скидка на чай"))
+	require.NoError(t, err)
+
+	// До решения модератора показывается одобренная версия креатива
+	updated := db.Campaign.GetX(ctx, camp.ID)
+	assert.Equal(t, campaign.ModerationStatusPENDING, updated.ModerationStatus)
+	assert.True(t, updated.Moderated)
+	assert.Equal(t, "Чай", updated.AdTitle)
+
+	title, text, imageURL := servedCreative(updated)
+	assert.Equal(t, camp.AdTitle, title)
+	assert.Equal(t, camp.AdText, text)
+	assert.Equal(t, camp.ImageURL, imageURL)
+	assert.Contains(t, images.objects, camp.ImageURL)
+
+	// Отклонение измененной версии оставляет в показах одобренную
+	moderation, _ := newTestModerationService(t, db)
+	require.NoError(t, moderation.RejectCampaign(ctx, dto.CampaignReject{
+		CampaignID: camp.ID,
+		Reason:     campaign.RejectionReasonMISLEADING_CLAIMS.String(),
+		Moderator:  moderator("alice"),
+	}))
+
+	rejected := db.Campaign.GetX(ctx, camp.ID)
+	assert.Equal(t, campaign.ModerationStatusREJECTED, rejected.ModerationStatus)
+	assert.True(t, rejected.Moderated)
+
+	title, text, imageURL = servedCreative(rejected)
+	assert.Equal(t, camp.AdTitle, title)
+	assert.Equal(t, camp.AdText, text)
+	assert.Equal(t, camp.ImageURL, imageURL)
+}
@@ -2,26 +2,52 @@ package service
 
 import (
 	"context"
+	"time"
+
+	"nlypage-final/internal/adapters/database/clickhouse"
 	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/clienterasure"
+	"nlypage-final/internal/adapters/database/postgres/ent/mlscore"
 	"nlypage-final/internal/adapters/database/postgres/ent/user"
+	"nlypage-final/internal/adapters/database/redis/ads"
 	"nlypage-final/internal/domain/common/errorz"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
 
 	"github.com/google/uuid"
 )
 
+type clientClickhouseRepository interface {
+	ClientEvents(ctx context.Context, clientID uuid.UUID) (*clickhouse.ClientEvents, error)
+	DeleteClientEvents(ctx context.Context, clientID uuid.UUID) error
+}
+
+type clientAdsStorage interface {
+	List(ctx context.Context, userID uuid.UUID) ([]ads.Ad, error)
+	RemoveUser(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
 type ClientService interface {
 	GetByID(ctx context.Context, clientID uuid.UUID) (*dto.Client, error)
 	UpsertBulk(ctx context.Context, upsertClients []dto.ClientUpsert) error
+	// Delete удаляет персональные данные клиента из всех хранилищ и оставляет запись об удалении
+	Delete(ctx context.Context, clientID uuid.UUID) (*dto.ClientErasure, error)
+	Export(ctx context.Context, clientID uuid.UUID) (*dto.ClientExport, error)
 }
 
 type clientService struct {
-	db *ent.Client
+	db                   *ent.Client
+	clickhouseRepository clientClickhouseRepository
+	adsStorage           clientAdsStorage
+	audit                auditRecorder
 }
 
-func NewClientService(db *ent.Client) ClientService {
+func NewClientService(db *ent.Client, clickhouseRepository clientClickhouseRepository, adsStorage clientAdsStorage, audit auditRecorder) ClientService {
 	return &clientService{
-		db: db,
+		db:                   db,
+		clickhouseRepository: clickhouseRepository,
+		adsStorage:           adsStorage,
+		audit:                audit,
 	}
 }
 
@@ -34,17 +60,27 @@ func (s *clientService) GetByID(ctx context.Context, clientID uuid.UUID) (*dto.C
 		return nil, errorz.ErrInternal
 	}
 
-	return &dto.Client{
-		ClientID: client.ID,
-		Login:    client.Login,
-		Age:      client.Age,
-		Location: client.Location,
-		Gender:   string(client.Gender),
-	}, nil
+	return toClientDTO(client), nil
 }
 
 func (s *clientService) UpsertBulk(ctx context.Context, upsertClients []dto.ClientUpsert) error {
-	err := s.db.User.MapCreateBulk(upsertClients, func(c *ent.UserCreate, i int) {
+	ids := make([]uuid.UUID, 0, len(upsertClients))
+	for _, upsert := range upsertClients {
+		ids = append(ids, upsert.ClientID)
+	}
+	existing, err := s.db.User.Query().
+		Where(user.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get clients: %v", err)
+		return errorz.ErrInternal
+	}
+	before := make(map[uuid.UUID]*dto.Client, len(existing))
+	for _, client := range existing {
+		before[client.ID] = toClientDTO(client)
+	}
+
+	err = s.db.User.MapCreateBulk(upsertClients, func(c *ent.UserCreate, i int) {
 		c.SetID(upsertClients[i].ClientID).
 			SetLogin(upsertClients[i].Login).
 			SetAge(upsertClients[i].Age).
@@ -54,5 +90,243 @@ func (s *clientService) UpsertBulk(ctx context.Context, upsertClients []dto.Clie
 	if err != nil {
 		return errorz.ErrInternal
 	}
+
+	records := make([]dto.AuditRecord, 0, len(upsertClients))
+	for _, upsert := range upsertClients {
+		records = append(records, dto.AuditRecord{
+			Action:   dto.AuditActionUpsert,
+			Entity:   dto.AuditEntityClient,
+			EntityID: upsert.ClientID.String(),
+			Before:   before[upsert.ClientID],
+			After:    dto.Client(upsert),
+			Redact:   true,
+		})
+	}
+	s.audit.Record(ctx, records...)
+
 	return nil
 }
+
+func (s *clientService) Delete(ctx context.Context, clientID uuid.UUID) (*dto.ClientErasure, error) {
+	erasure, err := s.db.ClientErasure.Query().
+		Where(clienterasure.ClientID(clientID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Log.Errorf("failed to get client erasure: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	// Незавершенное удаление продолжается. После завершенного клиента могли снова загрузить через upsert:
+	// тогда удаление выполняется заново, а если клиента нет, отвечаем 404
+	if erasure == nil || erasure.CompletedAt != nil {
+		var client *dto.Client
+		erasure, client, err = s.deleteProfile(ctx, clientID, erasure)
+		if err != nil {
+			return nil, err
+		}
+		s.audit.Record(ctx, dto.AuditRecord{
+			Action:   dto.AuditActionDelete,
+			Entity:   dto.AuditEntityClient,
+			EntityID: clientID.String(),
+			Before:   client,
+			Redact:   true,
+		})
+	}
+
+	// ClickHouse и Redis не участвуют в транзакции, поэтому события и кэш удаляются после нее.
+	// Если удаление упадет, запись останется незавершенной и повторный запрос продолжит его
+	if err := s.clickhouseRepository.DeleteClientEvents(ctx, clientID); err != nil {
+		logger.Log.Errorf("failed to delete client events: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	cachedAds, err := s.adsStorage.RemoveUser(ctx, clientID)
+	if err != nil {
+		logger.Log.Errorf("failed to delete client ads: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	erasure, err = erasure.Update().
+		SetCachedAds(cachedAds).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to complete client erasure: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	logger.Log.Infow("Client data erased",
+		"client_id", clientID,
+		"ml_scores", erasure.MlScores,
+		"cached_ads", erasure.CachedAds,
+	)
+
+	return &dto.ClientErasure{
+		ClientID:    erasure.ClientID,
+		MlScores:    erasure.MlScores,
+		CachedAds:   erasure.CachedAds,
+		RequestedAt: erasure.RequestedAt,
+		CompletedAt: erasure.CompletedAt,
+	}, nil
+}
+
+// deleteProfile удаляет клиента и его ML-скоры и создает запись об удалении в одной транзакции.
+// Завершенная запись previous, если она есть, начинается заново. Возвращает запись и удаленный профиль
+func (s *clientService) deleteProfile(ctx context.Context, clientID uuid.UUID, previous *ent.ClientErasure) (*ent.ClientErasure, *dto.Client, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to start transaction: %v", err)
+		return nil, nil, errorz.ErrInternal
+	}
+
+	mlScores, err := tx.MlScore.Delete().
+		Where(mlscore.UserID(clientID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to delete ml scores: %v", err)
+		return nil, nil, errorz.ErrInternal
+	}
+
+	client, err := tx.User.Get(ctx, clientID)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get client: %v", err)
+		return nil, nil, errorz.ErrInternal
+	}
+
+	if err := tx.User.DeleteOne(client).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to delete client: %v", err)
+		return nil, nil, errorz.ErrInternal
+	}
+
+	var erasure *ent.ClientErasure
+	if previous == nil {
+		erasure, err = tx.ClientErasure.Create().
+			SetClientID(clientID).
+			SetMlScores(mlScores).
+			Save(ctx)
+	} else {
+		erasure, err = tx.ClientErasure.UpdateOne(previous).
+			SetMlScores(mlScores).
+			SetCachedAds(0).
+			SetRequestedAt(time.Now()).
+			ClearCompletedAt().
+			Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Log.Errorf("failed to save client erasure: %v", err)
+		return nil, nil, errorz.ErrInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, nil, errorz.ErrInternal
+	}
+
+	// Запись создана в транзакции: Unwrap отвязывает ее, чтобы завершить удаление после коммита
+	return erasure.Unwrap(), toClientDTO(client), nil
+}
+
+func (s *clientService) Export(ctx context.Context, clientID uuid.UUID) (*dto.ClientExport, error) {
+	client, err := s.GetByID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := s.db.MlScore.Query().
+		Where(mlscore.UserID(clientID)).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get ml scores: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	events, err := s.clickhouseRepository.ClientEvents(ctx, clientID)
+	if err != nil {
+		logger.Log.Errorf("failed to get client events: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	cachedAds, err := s.adsStorage.List(ctx, clientID)
+	if err != nil {
+		logger.Log.Errorf("failed to get client ads: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	export := &dto.ClientExport{
+		Client:        *client,
+		MlScores:      make([]dto.ClientExportMlScore, 0, len(scores)),
+		Impressions:   make([]dto.ClientExportView, 0, len(events.Impressions)),
+		Clicks:        make([]dto.ClientExportClick, 0, len(events.Clicks)),
+		InvalidClicks: make([]dto.ClientExportClick, 0, len(events.InvalidClicks)),
+		Conversions:   make([]dto.ClientExportAction, 0, len(events.Conversions)),
+		CachedAds:     make([]dto.ClientExportCachedAd, 0, len(cachedAds)),
+	}
+	for _, score := range scores {
+		export.MlScores = append(export.MlScores, dto.ClientExportMlScore{
+			AdvertiserID: score.AdvertiserID,
+			Score:        score.Score,
+		})
+	}
+	for _, impression := range events.Impressions {
+		export.Impressions = append(export.Impressions, dto.ClientExportView{
+			CampaignID:   impression.CampaignID,
+			AdvertiserID: impression.AdvertiserID,
+			Day:          int(impression.Day),
+			ViewCount:    impression.ViewCount,
+			ShownAt:      impression.ShownAt,
+		})
+	}
+	for _, click := range events.Clicks {
+		export.Clicks = append(export.Clicks, clientExportClick(click))
+	}
+	for _, click := range events.InvalidClicks {
+		export.InvalidClicks = append(export.InvalidClicks, clientExportClick(click))
+	}
+	for _, conversion := range events.Conversions {
+		export.Conversions = append(export.Conversions, dto.ClientExportAction{
+			CampaignID:   conversion.CampaignID,
+			AdvertiserID: conversion.AdvertiserID,
+			Value:        conversion.Value,
+			ClickDay:     int(conversion.ClickDay),
+			Day:          int(conversion.Day),
+		})
+	}
+	for _, ad := range cachedAds {
+		export.CachedAds = append(export.CachedAds, dto.ClientExportCachedAd{
+			AdID:         ad.AdID,
+			AdvertiserID: ad.AdvertiserID,
+			AdTitle:      ad.AdTitle,
+			Score:        ad.Score.InexactFloat64(),
+		})
+	}
+
+	return export, nil
+}
+
+func toClientDTO(client *ent.User) *dto.Client {
+	return &dto.Client{
+		ClientID: client.ID,
+		Login:    client.Login,
+		Age:      client.Age,
+		Location: client.Location,
+		Gender:   string(client.Gender),
+	}
+}
+
+func clientExportClick(click *clickhouse.ClientClick) dto.ClientExportClick {
+	return dto.ClientExportClick{
+		CampaignID:   click.CampaignID,
+		AdvertiserID: click.AdvertiserID,
+		Day:          int(click.Day),
+		IP:           click.IP,
+		Reason:       click.Reason,
+		ClickedAt:    click.ClickedAt,
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/internal/adapters/database/clickhouse"
+	"nlypage-final/internal/adapters/database/redis/ads"
+	"nlypage-final/internal/domain/dto"
+)
+
+// fakeClientEvents считает удаления событий клиента и может падать, как недоступный ClickHouse
+type fakeClientEvents struct {
+	deleted int
+	err     error
+}
+
+func (r *fakeClientEvents) ClientEvents(context.Context, uuid.UUID) (*clickhouse.ClientEvents, error) {
+	return &clickhouse.ClientEvents{}, nil
+}
+
+func (r *fakeClientEvents) DeleteClientEvents(context.Context, uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.deleted++
+	return nil
+}
+
+type fakeClientAds struct {
+	cached int
+}
+
+func (s *fakeClientAds) List(context.Context, uuid.UUID) ([]ads.Ad, error) {
+	return nil, nil
+}
+
+func (s *fakeClientAds) RemoveUser(context.Context, uuid.UUID) (int, error) {
+	return s.cached, nil
+}
+
+func TestClientDelete(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	events := &fakeClientEvents{}
+	s := NewClientService(db, events, &fakeClientAds{cached: 3}, nopAudit{})
+
+	clientID := uuid.New()
+	upsert := []dto.ClientUpsert{{ClientID: clientID, Login: "client", Age: 30, Location: "Moscow", Gender: "MALE"}}
+	require.NoError(t, s.UpsertBulk(ctx, upsert))
+
+	adv := newTestAdvertiser(t, db, 0)
+	db.MlScore.Create().SetUserID(clientID).SetAdvertiserID(adv.ID).SetScore(10).ExecX(ctx)
+
+	// Первое удаление падает на ClickHouse и остается незавершенным
+	events.err = errors.New("clickhouse is down")
+	_, err := s.Delete(ctx, clientID)
+	assertHTTPCode(t, err, http.StatusInternalServerError)
+
+	_, err = s.GetByID(ctx, clientID)
+	assertHTTPCode(t, err, http.StatusNotFound)
+
+	// Повторный запрос продолжает удаление
+	events.err = nil
+	erasure, err := s.Delete(ctx, clientID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, erasure.MlScores)
+	assert.Equal(t, 3, erasure.CachedAds)
+	require.NotNil(t, erasure.CompletedAt)
+
+	_, err = s.Delete(ctx, clientID)
+	assertHTTPCode(t, err, http.StatusNotFound)
+
+	// Клиента загрузили снова: удаление выполняется заново и обновляет запись
+	require.NoError(t, s.UpsertBulk(ctx, upsert))
+
+	again, err := s.Delete(ctx, clientID)
+	require.NoError(t, err)
+	assert.Zero(t, again.MlScores)
+	assert.Equal(t, 3, again.CachedAds)
+	require.NotNil(t, again.CompletedAt)
+	assert.True(t, again.RequestedAt.After(erasure.RequestedAt))
+	assert.Equal(t, 2, events.deleted)
+	assert.Equal(t, 1, db.ClientErasure.Query().CountX(ctx))
+
+	_, err = s.GetByID(ctx, clientID)
+	assertHTTPCode(t, err, http.StatusNotFound)
+}
+
+func TestClientDeleteAtOnce(t *testing.T) {
+	ctx := context.Background()
+	s := NewClientService(newTestDB(t), &fakeClientEvents{}, &fakeClientAds{cached: 1}, nopAudit{})
+
+	clientID := uuid.New()
+	require.NoError(t, s.UpsertBulk(ctx, []dto.ClientUpsert{
+		{ClientID: clientID, Login: "client", Age: 30, Location: "Moscow", Gender: "FEMALE"},
+	}))
+
+	erasure, err := s.Delete(ctx, clientID)
+	require.NoError(t, err)
+	assert.Equal(t, clientID, erasure.ClientID)
+	assert.Equal(t, 1, erasure.CachedAds)
+	assert.NotNil(t, erasure.CompletedAt)
+}
+
+func TestClientDeleteUnknown(t *testing.T) {
+	s := NewClientService(newTestDB(t), &fakeClientEvents{}, &fakeClientAds{}, nopAudit{})
+
+	_, err := s.Delete(context.Background(), uuid.New())
+	assertHTTPCode(t, err, http.StatusNotFound)
+}
@@ -2,89 +2,375 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"nlypage-final/internal/adapters/database/postgres/ent/targeting"
+	"nlypage-final/internal/domain/common/errorz"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/internal/domain/prompts"
 	"nlypage-final/internal/domain/utils"
-	"nlypage-final/pkg/gigachat"
+	"nlypage-final/pkg/llm"
+	"nlypage-final/pkg/logger"
 )
 
 type generateAdvertiserService interface {
 	GetByID(ctx context.Context, advertiserID uuid.UUID) (*dto.Advertiser, error)
 }
 
+type generateCampaignService interface {
+	GetByID(ctx context.Context, campaignID uuid.UUID, advertiserID uuid.UUID) (*dto.Campaign, error)
+}
+
+type generatePrompts interface {
+	Render(name string, data any) (string, error)
+}
+
 type GenerateService interface {
 	GenerateAdText(ctx context.Context, generateAdText *dto.GenerateAdTextRequest) (*dto.GenerateAdTextResponse, error)
+	// StreamAdText генерирует один вариант текста, передавая его в onDelta по частям
+	StreamAdText(ctx context.Context, generateAdText *dto.GenerateAdTextRequest, onDelta llm.DeltaFunc) (*dto.GenerateAdTextResponse, error)
+	GenerateCreative(ctx context.Context, generateCreative *dto.GenerateCreativeRequest) (*dto.GenerateCreativeResponse, error)
 }
 
 type generateService struct {
-	gigachatClient    *gigachat.Client
+	llm               llm.Provider
+	prompts           generatePrompts
 	advertiserService generateAdvertiserService
+	campaignService   generateCampaignService
 }
 
-func NewGenerateService(gigachatClient *gigachat.Client, advertiserService generateAdvertiserService) GenerateService {
+func NewGenerateService(
+	llmProvider llm.Provider,
+	prompts generatePrompts,
+	advertiserService generateAdvertiserService,
+	campaignService generateCampaignService,
+) GenerateService {
 	return &generateService{
-		gigachatClient:    gigachatClient,
+		llm:               llmProvider,
+		prompts:           prompts,
 		advertiserService: advertiserService,
+		campaignService:   campaignService,
 	}
 }
 
+// Температура генерации: для нескольких вариантов выше, чтобы они отличались друг от друга
+const (
+	singleVariantTemperature = 0.7
+	multiVariantTemperature  = 1.0
+)
+
+var adToneDescriptions = map[string]string{
+	dto.AdToneNeutral:  "нейтральный",
+	dto.AdToneFriendly: "дружелюбный, неформальный",
+	dto.AdToneFormal:   "деловой, официальный",
+	dto.AdTonePlayful:  "игривый, с юмором",
+	dto.AdToneUrgent:   "побуждающий действовать прямо сейчас",
+}
+
 func (s *generateService) GenerateAdText(ctx context.Context, generateAdText *dto.GenerateAdTextRequest) (*dto.GenerateAdTextResponse, error) {
-	advertiser, err := s.advertiserService.GetByID(ctx, generateAdText.AdvertiserID)
+	prompt, err := s.adTextPrompt(ctx, generateAdText)
+	if err != nil {
+		return nil, err
+	}
+
+	n := max(generateAdText.N, 1)
+	temperature := singleVariantTemperature
+	if n > 1 {
+		temperature = multiVariantTemperature
+	}
+
+	response, err := s.llm.Complete(ctx, llm.Request{
+		Messages:    llm.UserPrompt(prompt),
+		N:           n,
+		Temperature: &temperature,
+	})
 	if err != nil {
 		return nil, &echo.HTTPError{
+			Message: fmt.Errorf("failed to generate ad text: %w", err).Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	variants := rankAdTextVariants(response.Choices, generateAdText.Length)
+	if len(variants) == 0 {
+		return nil, &echo.HTTPError{
+			Message: "failed to generate ad text: empty response",
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	return &dto.GenerateAdTextResponse{
+		AdText:   variants[0].AdText,
+		Variants: variants,
+	}, nil
+}
+
+func (s *generateService) StreamAdText(
+	ctx context.Context,
+	generateAdText *dto.GenerateAdTextRequest,
+	onDelta llm.DeltaFunc,
+) (*dto.GenerateAdTextResponse, error) {
+	prompt, err := s.adTextPrompt(ctx, generateAdText)
+	if err != nil {
+		return nil, err
+	}
+
+	temperature := singleVariantTemperature
+	text, err := llm.Stream(ctx, s.llm, llm.Request{
+		Messages:    llm.UserPrompt(prompt),
+		Temperature: &temperature,
+	}, onDelta)
+	if err != nil {
+		return nil, &echo.HTTPError{
+			Message: fmt.Errorf("failed to generate ad text: %w", err).Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	variants := rankAdTextVariants([]string{text}, generateAdText.Length)
+	if len(variants) == 0 {
+		return nil, &echo.HTTPError{
+			Message: "failed to generate ad text: empty response",
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	return &dto.GenerateAdTextResponse{
+		AdText:   variants[0].AdText,
+		Variants: variants,
+	}, nil
+}
+
+// adTextPrompt собирает промпт генерации текста: рекламодатель, аудитория кампании, язык заголовка
+func (s *generateService) adTextPrompt(ctx context.Context, generateAdText *dto.GenerateAdTextRequest) (string, error) {
+	advertiser, err := s.advertiserService.GetByID(ctx, generateAdText.AdvertiserID)
+	if err != nil {
+		return "", &echo.HTTPError{
 			Message: fmt.Errorf("advertiser not found"),
 			Code:    echo.ErrBadRequest.Code,
 		}
 	}
 
-	prompt :=
-		`Создайте привлекательный рекламный текст для следующего объявления:
-Рекламодатель: %s
-Заголовок объявления: %s
-%s
-				
-Требования к тексту:
-1. Текст должен быть убедительным и привлекательным и при этом коротким
-2. Язык текста: %s
-3. Текст должен быть оптимизирован для целевой аудитории
-4. Не добавляй форматирование текста
-				
-Пожалуйста, создайте рекламный текст, который соответствует этим требованиям.`
+	var audience string
+	if generateAdText.CampaignID != nil {
+		camp, err := s.campaignService.GetByID(ctx, *generateAdText.CampaignID, generateAdText.AdvertiserID)
+		if err != nil {
+			return "", err
+		}
+		audience = describeAudience(camp.Targeting)
+	}
 
-	additionalInfo := ""
-	if generateAdText.AdditionalInfo != "" {
-		additionalInfo = "Дополнительная информация:\n" + generateAdText.AdditionalInfo
+	prompt, err := s.prompts.Render(prompts.AdText, prompts.AdTextData{
+		Advertiser:     advertiser.Name,
+		Title:          generateAdText.AdTitle,
+		Audience:       audience,
+		AdditionalInfo: generateAdText.AdditionalInfo,
+		Length:         generateAdText.Length,
+		Language:       utils.DetectLanguage(generateAdText.AdTitle),
+		Tone:           toneDescription(generateAdText.Tone),
+	})
+	if err != nil {
+		logger.Log.Errorf("failed to render ad text prompt: %v", err)
+		return "", errorz.ErrInternal
 	}
+	return prompt, nil
+}
 
-	formattedPrompt := fmt.Sprintf(prompt, advertiser.Name, generateAdText.AdTitle, additionalInfo, utils.DetectLanguage(generateAdText.AdTitle))
+const defaultCreativeTitles = 3
 
-	errAuth := s.gigachatClient.AuthWithContext(ctx)
-	if errAuth != nil {
+// creativeSuggestion — ответ модели при генерации креатива
+type creativeSuggestion struct {
+	Titles   []string `json:"titles"`
+	AdText   string   `json:"ad_text"`
+	Gender   string   `json:"gender"`
+	AgeFrom  *int     `json:"age_from"`
+	AgeTo    *int     `json:"age_to"`
+	Location string   `json:"location"`
+}
+
+func (s *generateService) GenerateCreative(ctx context.Context, generateCreative *dto.GenerateCreativeRequest) (*dto.GenerateCreativeResponse, error) {
+	advertiser, err := s.advertiserService.GetByID(ctx, generateCreative.AdvertiserID)
+	if err != nil {
 		return nil, &echo.HTTPError{
-			Message: fmt.Errorf("failed to generate ad text: %w", errAuth).Error(),
-			Code:    echo.ErrUnauthorized.Code,
+			Message: fmt.Errorf("advertiser not found"),
+			Code:    echo.ErrBadRequest.Code,
 		}
 	}
-	response, err := s.gigachatClient.ChatWithContext(ctx, &gigachat.ChatRequest{
-		Model: "GigaChat",
-		Messages: []gigachat.Message{
-			{
-				Role:    gigachat.UserRole,
-				Content: formattedPrompt,
-			},
-		},
+
+	titles := generateCreative.Titles
+	if titles == 0 {
+		titles = defaultCreativeTitles
+	}
+
+	prompt, err := s.prompts.Render(prompts.Creative, prompts.CreativeData{
+		Advertiser: advertiser.Name,
+		Brief:      generateCreative.Brief,
+		Titles:     titles,
+		Language:   utils.DetectLanguage(generateCreative.Brief),
+		Tone:       toneDescription(generateCreative.Tone),
 	})
+	if err != nil {
+		logger.Log.Errorf("failed to render creative prompt: %v", err)
+		return nil, errorz.ErrInternal
+	}
 
+	temperature := singleVariantTemperature
+	response, err := s.llm.Complete(ctx, llm.Request{
+		Messages:    llm.UserPrompt(prompt),
+		Temperature: &temperature,
+		JSON:        true,
+	})
 	if err != nil {
 		return nil, &echo.HTTPError{
-			Message: fmt.Errorf("failed to generate ad text: %w", err).Error(),
+			Message: fmt.Errorf("failed to generate creative: %w", err).Error(),
 			Code:    echo.ErrInternalServerError.Code,
 		}
 	}
 
-	return &dto.GenerateAdTextResponse{
-		AdText: response.Choices[0].Message.Content,
-	}, nil
+	suggestion, err := parseCreativeSuggestion(response.Choices[0])
+	if err != nil {
+		return nil, &echo.HTTPError{
+			Message: fmt.Errorf("failed to generate creative: %w", err).Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	return toGenerateCreativeResponse(suggestion, titles), nil
+}
+
+func toneDescription(tone string) string {
+	if description, ok := adToneDescriptions[tone]; ok {
+		return description
+	}
+	return adToneDescriptions[dto.AdToneNeutral]
+}
+
+// parseCreativeSuggestion достает JSON из ответа модели: модель иногда оборачивает его в markdown или добавляет текст
+func parseCreativeSuggestion(content string) (*creativeSuggestion, error) {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("no json object in model response")
+	}
+
+	var suggestion creativeSuggestion
+	if err := json.Unmarshal([]byte(content[start:end+1]), &suggestion); err != nil {
+		return nil, fmt.Errorf("invalid model response: %w", err)
+	}
+	return &suggestion, nil
+}
+
+// toGenerateCreativeResponse приводит ответ модели к значениям, которые примет создание кампании
+func toGenerateCreativeResponse(suggestion *creativeSuggestion, maxTitles int) *dto.GenerateCreativeResponse {
+	titles := make([]string, 0, len(suggestion.Titles))
+	for _, title := range suggestion.Titles {
+		title = strings.TrimSpace(title)
+		if title == "" || slices.Contains(titles, title) {
+			continue
+		}
+		titles = append(titles, title)
+	}
+	if len(titles) > maxTitles {
+		titles = titles[:maxTitles]
+	}
+
+	var target dto.Targeting
+	gender := strings.ToUpper(strings.TrimSpace(suggestion.Gender))
+	if targeting.GenderValidator(targeting.Gender(gender)) == nil {
+		target.Gender = &gender
+	}
+	if suggestion.AgeFrom != nil && *suggestion.AgeFrom >= 0 {
+		target.AgeFrom = suggestion.AgeFrom
+	}
+	if suggestion.AgeTo != nil && *suggestion.AgeTo >= 0 &&
+		(target.AgeFrom == nil || *suggestion.AgeTo >= *target.AgeFrom) {
+		target.AgeTo = suggestion.AgeTo
+	}
+	if location := strings.TrimSpace(suggestion.Location); location != "" {
+		target.Location = &location
+	}
+
+	adText := strings.TrimSpace(suggestion.AdText)
+	draft := dto.CampaignDraft{
+		AdText:    adText,
+		Targeting: target,
+	}
+	if len(titles) > 0 {
+		draft.AdTitle = titles[0]
+	}
+
+	return &dto.GenerateCreativeResponse{
+		Titles:    titles,
+		AdText:    adText,
+		Targeting: target,
+		Campaign:  draft,
+	}
+}
+
+// rankAdTextVariants убирает пустые и повторяющиеся варианты и сортирует их:
+// ближе к желаемой длине — выше, при равенстве сохраняется порядок модели
+func rankAdTextVariants(choices []string, targetLength int) []dto.AdTextVariant {
+	variants := make([]dto.AdTextVariant, 0, len(choices))
+	seen := make(map[string]bool, len(choices))
+	for _, choice := range choices {
+		text := strings.TrimSpace(choice)
+		if text == "" || seen[text] {
+			continue
+		}
+		seen[text] = true
+		variants = append(variants, dto.AdTextVariant{
+			AdText:     text,
+			Characters: utf8.RuneCountInString(text),
+		})
+	}
+
+	if targetLength > 0 {
+		slices.SortStableFunc(variants, func(a, b dto.AdTextVariant) int {
+			return lengthDeviation(a.Characters, targetLength) - lengthDeviation(b.Characters, targetLength)
+		})
+	}
+	for i := range variants {
+		variants[i].Rank = i + 1
+	}
+	return variants
+}
+
+func lengthDeviation(characters, target int) int {
+	if characters > target {
+		return characters - target
+	}
+	return target - characters
+}
+
+// describeAudience описывает таргетинг кампании для промпта. Пустая строка, если таргетинг не задан
+func describeAudience(target dto.Targeting) string {
+	var parts []string
+	if target.Gender != nil {
+		switch *target.Gender {
+		case targeting.GenderMALE.String():
+			parts = append(parts, "мужчины")
+		case targeting.GenderFEMALE.String():
+			parts = append(parts, "женщины")
+		}
+	}
+
+	switch {
+	case target.AgeFrom != nil && target.AgeTo != nil:
+		parts = append(parts, fmt.Sprintf("возраст %d–%d лет", *target.AgeFrom, *target.AgeTo))
+	case target.AgeFrom != nil:
+		parts = append(parts, fmt.Sprintf("возраст от %d лет", *target.AgeFrom))
+	case target.AgeTo != nil:
+		parts = append(parts, fmt.Sprintf("возраст до %d лет", *target.AgeTo))
+	}
+
+	if target.Location != nil && *target.Location != "" {
+		parts = append(parts, "локация: "+*target.Location)
+	}
+	return strings.Join(parts, ", ")
 }
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/enttest"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Log = &logger.Logger{SugaredLogger: zap.NewNop().Sugar()}
+	os.Exit(m.Run())
+}
+
+// newTestDB создает ent-клиент над SQLite в памяти со схемой из ent. Каждый тест получает свою базу
+func newTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// nopAudit не пишет журнал аудита
+type nopAudit struct{}
+
+func (nopAudit) Record(context.Context, ...dto.AuditRecord) {}
@@ -7,6 +7,7 @@ import (
 	"nlypage-final/internal/adapters/database/postgres/ent/mlscore"
 	"nlypage-final/internal/domain/common/errorz"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
 )
 
 type MlScoreService interface {
@@ -14,17 +15,38 @@ type MlScoreService interface {
 }
 
 type mlScoreService struct {
-	db *ent.Client
+	db    *ent.Client
+	audit auditRecorder
 }
 
-func NewMlScoreService(db *ent.Client) MlScoreService {
+func NewMlScoreService(db *ent.Client, audit auditRecorder) MlScoreService {
 	return &mlScoreService{
-		db: db,
+		db:    db,
+		audit: audit,
 	}
 }
 
 func (s *mlScoreService) Upsert(ctx context.Context, upsertMlScore dto.MlScoreUpsert) error {
-	err := s.db.MlScore.
+	var before *dto.MlScoreUpsert
+	existing, err := s.db.MlScore.Query().
+		Where(
+			mlscore.UserID(upsertMlScore.ClientID),
+			mlscore.AdvertiserID(upsertMlScore.AdvertiserID),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		before = &dto.MlScoreUpsert{
+			ClientID:     existing.UserID,
+			AdvertiserID: existing.AdvertiserID,
+			Score:        existing.Score,
+		}
+	case !ent.IsNotFound(err):
+		logger.Log.Errorf("failed to get ml score: %v", err)
+		return errorz.ErrInternal
+	}
+
+	err = s.db.MlScore.
 		Create().
 		SetAdvertiserID(upsertMlScore.AdvertiserID).
 		SetUserID(upsertMlScore.ClientID).
@@ -39,5 +61,14 @@ func (s *mlScoreService) Upsert(ctx context.Context, upsertMlScore dto.MlScoreUp
 		}
 		return errorz.ErrInternal
 	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionUpsert,
+		Entity:   dto.AuditEntityMlScore,
+		EntityID: upsertMlScore.ClientID.String() + ":" + upsertMlScore.AdvertiserID.String(),
+		Before:   before,
+		After:    upsertMlScore,
+	})
+
 	return nil
 }
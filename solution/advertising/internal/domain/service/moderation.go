@@ -2,33 +2,98 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"nlypage-final/internal/adapters/database/postgres/ent"
 	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"nlypage-final/internal/adapters/database/redis/leases"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
 )
 
+type moderationTimeService interface {
+	Now() *dto.CurrentDate
+}
+
+type moderationLeaseStorage interface {
+	Acquire(ctx context.Context, campaignID uuid.UUID, moderator string, ttl time.Duration) (*leases.Lease, error)
+	Release(ctx context.Context, campaignID uuid.UUID, moderator string) error
+	Get(ctx context.Context, campaignID uuid.UUID) (*leases.Lease, error)
+	GetMany(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]*leases.Lease, error)
+}
+
+// moderationNotifier сообщает рекламодателю о решении модератора
+type moderationNotifier interface {
+	CampaignModerated(ctx context.Context, campaign *dto.Campaign)
+}
+
 type ModerationService interface {
-	GetNotModeratedCampaigns(ctx context.Context) ([]*dto.Campaign, error)
-	ApproveCampaign(ctx context.Context, campaignID uuid.UUID) error
+	GetNotModeratedCampaigns(ctx context.Context, filter dto.ModerationCampaignsGet) ([]*dto.ModerationCampaign, error)
+	ClaimCampaign(ctx context.Context, claim dto.CampaignClaim) (*dto.ModerationLease, error)
+	ReleaseCampaign(ctx context.Context, release dto.CampaignRelease) error
+	ApproveCampaign(ctx context.Context, approve dto.CampaignApprove) error
+	RejectCampaign(ctx context.Context, reject dto.CampaignReject) error
+	History(ctx context.Context, campaignID uuid.UUID) ([]*dto.ModerationDecision, error)
 }
 
 type moderationService struct {
-	db *ent.Client
+	db          *ent.Client
+	timeService moderationTimeService
+	leases      moderationLeaseStorage
+	leaseTTL    time.Duration
+	audit       auditRecorder
+	// notifier может быть nil, если уведомления отключены
+	notifier moderationNotifier
 }
 
-func NewModerationService(db *ent.Client) ModerationService {
+func NewModerationService(
+	db *ent.Client,
+	timeService moderationTimeService,
+	leases moderationLeaseStorage,
+	leaseTTL time.Duration,
+	audit auditRecorder,
+	notifier moderationNotifier,
+) ModerationService {
 	return &moderationService{
-		db: db,
+		db:          db,
+		timeService: timeService,
+		leases:      leases,
+		leaseTTL:    leaseTTL,
+		audit:       audit,
+		notifier:    notifier,
 	}
 }
 
-func (s *moderationService) GetNotModeratedCampaigns(ctx context.Context) ([]*dto.Campaign, error) {
-	campaigns, err := s.db.Campaign.Query().
+func (s *moderationService) GetNotModeratedCampaigns(ctx context.Context, filter dto.ModerationCampaignsGet) ([]*dto.ModerationCampaign, error) {
+	query := s.db.Campaign.Query().
 		Where(
-			campaign.Moderated(false),
-		).Order(ent.Asc(campaign.FieldStartDate)).
+			campaign.ModerationStatusEQ(campaign.ModerationStatusPENDING),
+			campaign.DeletedAtIsNil(),
+		).
+		WithTargeting()
+	if filter.AdvertiserID != nil {
+		query = query.Where(campaign.AdvertiserID(*filter.AdvertiserID))
+	}
+	if filter.StartDateFrom != nil {
+		query = query.Where(campaign.StartDateGTE(*filter.StartDateFrom))
+	}
+	if filter.StartDateTo != nil {
+		query = query.Where(campaign.StartDateLTE(*filter.StartDateTo))
+	}
+	if filter.Sort == dto.ModerationSortRisk {
+		// Непроверенные ассистентом кампании идут после проверенных
+		query = query.Order(campaign.ByRiskScore(sql.OrderDesc(), sql.OrderNullsLast()))
+	}
+	campaigns, err := query.
+		Order(ent.Asc(campaign.FieldStartDate), ent.Asc(campaign.FieldID)).
+		Offset((filter.Page - 1) * filter.Size).
+		Limit(filter.Size).
 		All(ctx)
 	if err != nil {
 		return nil, &echo.HTTPError{
@@ -37,43 +102,406 @@ func (s *moderationService) GetNotModeratedCampaigns(ctx context.Context) ([]*dt
 		}
 	}
 
-	var result []*dto.Campaign
+	campaignIDs := make([]uuid.UUID, 0, len(campaigns))
+	for _, camp := range campaigns {
+		campaignIDs = append(campaignIDs, camp.ID)
+	}
+	activeLeases, err := s.leases.GetMany(ctx, campaignIDs)
+	if err != nil {
+		return nil, &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	result := make([]*dto.ModerationCampaign, 0, len(campaigns))
 	for _, camp := range campaigns {
-		result = append(result, &dto.Campaign{
-			CampaignID:        camp.ID,
-			AdvertiserID:      camp.AdvertiserID,
-			ImpressionsLimit:  camp.ImpressionsLimit,
-			ClicksLimit:       camp.ClicksLimit,
-			CostPerImpression: camp.CostPerImpression,
-			CostPerClick:      camp.CostPerClick,
-			AdTitle:           camp.AdTitle,
-			AdText:            camp.AdText,
-			StartDate:         camp.StartDate,
-			EndDate:           camp.EndDate,
-			Moderated:         camp.Moderated,
+		item := &dto.ModerationCampaign{
+			Campaign:  *toCampaignDTO(camp, camp.Edges.Targeting),
+			AIVerdict: toAIVerdictDTO(camp),
+		}
+		if camp.ApprovedAdTitle != nil && camp.ApprovedAdText != nil && camp.ApprovedImageURL != nil {
+			item.ApprovedCreative = &dto.ApprovedCreative{
+				AdTitle:  *camp.ApprovedAdTitle,
+				AdText:   *camp.ApprovedAdText,
+				ImageURL: *camp.ApprovedImageURL,
+			}
+		}
+		if lease, ok := activeLeases[camp.ID]; ok {
+			item.Lease = toModerationLeaseDTO(lease)
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (s *moderationService) ClaimCampaign(ctx context.Context, claim dto.CampaignClaim) (*dto.ModerationLease, error) {
+	camp, err := s.db.Campaign.Query().
+		Where(
+			campaign.ID(claim.CampaignID),
+			campaign.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &echo.HTTPError{
+				Message: "campaign not found",
+				Code:    echo.ErrNotFound.Code,
+			}
+		}
+		return nil, &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+	if camp.ModerationStatus != campaign.ModerationStatusPENDING {
+		return nil, notPendingError()
+	}
+
+	lease, err := s.leases.Acquire(ctx, claim.CampaignID, claim.Moderator, s.leaseTTL)
+	if err != nil {
+		if errors.Is(err, leases.ErrLeaseHeld) {
+			return nil, leaseHeldError(lease)
+		}
+		logger.Log.Errorf("failed to claim campaign: %v", err)
+		return nil, &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+	return toModerationLeaseDTO(lease), nil
+}
+
+func (s *moderationService) ReleaseCampaign(ctx context.Context, release dto.CampaignRelease) error {
+	err := s.leases.Release(ctx, release.CampaignID, release.Moderator)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, leases.ErrLeaseNotFound):
+		return &echo.HTTPError{
+			Message: "campaign is not claimed",
+			Code:    echo.ErrNotFound.Code,
+		}
+	case errors.Is(err, leases.ErrLeaseHeld):
+		return &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrConflict.Code,
+		}
+	default:
+		logger.Log.Errorf("failed to release campaign: %v", err)
+		return &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+}
+
+func (s *moderationService) ApproveCampaign(ctx context.Context, approve dto.CampaignApprove) error {
+	return s.decide(ctx, dto.AuditActionApprove, approve.CampaignID, approve.Moderator, func(tx *ent.Tx, camp *ent.Campaign) (*ent.Campaign, error) {
+		return approveCampaign(ctx, tx.Client(), camp, approve.Comment, approve.Moderator, s.timeService.Now().CurrentDate)
+	})
+}
+
+func (s *moderationService) RejectCampaign(ctx context.Context, reject dto.CampaignReject) error {
+	return s.decide(ctx, dto.AuditActionReject, reject.CampaignID, reject.Moderator, func(tx *ent.Tx, camp *ent.Campaign) (*ent.Campaign, error) {
+		return rejectCampaign(ctx, tx.Client(), camp, reject.Reason, reject.Comment, reject.Moderator, s.timeService.Now().CurrentDate)
+	})
+}
+
+func (s *moderationService) History(ctx context.Context, campaignID uuid.UUID) ([]*dto.ModerationDecision, error) {
+	decisions, err := s.db.ModerationDecision.Query().
+		Where(moderationdecision.CampaignID(campaignID)).
+		Order(ent.Asc(moderationdecision.FieldCreatedAt), ent.Asc(moderationdecision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	result := make([]*dto.ModerationDecision, 0, len(decisions))
+	for _, decision := range decisions {
+		var reason *string
+		if decision.Reason != nil {
+			reasonStr := decision.Reason.String()
+			reason = &reasonStr
+		}
+		result = append(result, &dto.ModerationDecision{
+			CampaignID: decision.CampaignID,
+			Action:     decision.Action.String(),
+			Reason:     reason,
+			Comment:    decision.Comment,
+			Moderator:  decision.Moderator,
+			Day:        decision.Day,
+			CreatedAt:  decision.CreatedAt,
 		})
 	}
 	return result, nil
 }
 
-func (s *moderationService) ApproveCampaign(ctx context.Context, campaignID uuid.UUID) error {
-	affected, err := s.db.Campaign.Update().
-		Where(campaign.ID(campaignID)).
-		SetModerated(true).
-		Save(ctx)
+// toAIVerdictDTO возвращает вердикт ассистента модерации или nil, если кампания еще не проверена
+func toAIVerdictDTO(camp *ent.Campaign) *dto.AIVerdict {
+	if camp.AiReviewedAt == nil {
+		return nil
+	}
+
+	verdict := &dto.AIVerdict{
+		Violations: make([]dto.AIViolation, 0, len(camp.AiViolations)),
+		ReviewedAt: *camp.AiReviewedAt,
+	}
+	if camp.AiCategory != nil {
+		verdict.Category = *camp.AiCategory
+	}
+	if camp.AiConfidence != nil {
+		verdict.Confidence = *camp.AiConfidence
+	}
+	if camp.RiskScore != nil {
+		verdict.RiskScore = *camp.RiskScore
+	}
+	for _, violation := range camp.AiViolations {
+		verdict.Violations = append(verdict.Violations, dto.AIViolation{
+			Policy:      violation.Policy,
+			Description: violation.Description,
+		})
+	}
+	return verdict
+}
+
+func toModerationLeaseDTO(lease *leases.Lease) *dto.ModerationLease {
+	return &dto.ModerationLease{
+		CampaignID: lease.CampaignID,
+		Moderator:  lease.Moderator,
+		ExpiresAt:  lease.ExpiresAt,
+	}
+}
+
+func leaseHeldError(lease *leases.Lease) *echo.HTTPError {
+	return &echo.HTTPError{
+		Message: fmt.Sprintf("campaign is claimed by %s until %s", lease.Moderator, lease.ExpiresAt.Format(time.RFC3339)),
+		Code:    echo.ErrConflict.Code,
+	}
+}
+
+func notPendingError() *echo.HTTPError {
+	return &echo.HTTPError{
+		Message: "campaign is not waiting for moderation",
+		Code:    echo.ErrConflict.Code,
+	}
+}
+
+// checkLease запрещает решение по кампании, захваченной другим модератором.
+// Захват необязательный: незахваченную кампанию может решить любой модератор, в том числе из Telegram-бота,
+// который кампании не захватывает. Два решения по одной кампании исключает проверка статуса в decide
+func (s *moderationService) checkLease(ctx context.Context, campaignID uuid.UUID, moderator *string) error {
+	lease, err := s.leases.Get(ctx, campaignID)
 	if err != nil {
+		if errors.Is(err, leases.ErrLeaseNotFound) {
+			return nil
+		}
+		logger.Log.Errorf("failed to get moderation lease: %v", err)
 		return &echo.HTTPError{
 			Message: err.Error(),
 			Code:    echo.ErrInternalServerError.Code,
 		}
 	}
+	if moderator == nil || *moderator != lease.Moderator {
+		return leaseHeldError(lease)
+	}
+	return nil
+}
 
-	if affected == 0 {
+// decide выполняет решение модератора по кампании в транзакции вместе с записью в историю.
+// После решения захват кампании снимается
+func (s *moderationService) decide(
+	ctx context.Context,
+	action string,
+	campaignID uuid.UUID,
+	moderator *string,
+	fn func(tx *ent.Tx, camp *ent.Campaign) (*ent.Campaign, error),
+) error {
+	if err := s.checkLease(ctx, campaignID, moderator); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
 		return &echo.HTTPError{
-			Message: "campaign not found",
-			Code:    echo.ErrNotFound.Code,
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	camp, err := tx.Campaign.Query().
+		Where(
+			campaign.ID(campaignID),
+			campaign.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return &echo.HTTPError{
+				Message: "campaign not found",
+				Code:    echo.ErrNotFound.Code,
+			}
+		}
+		return &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	// Решение принимается только по кампании в очереди: повторное нажатие кнопки в Telegram или устаревшая карточка
+	// не должны записывать второе решение и заново уведомлять рекламодателя
+	if camp.ModerationStatus != campaign.ModerationStatusPENDING {
+		_ = tx.Rollback()
+		return notPendingError()
+	}
+
+	decided, err := fn(tx, camp)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			// Кампанию решил параллельный запрос между чтением и обновлением
+			return notPendingError()
+		}
+		return &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &echo.HTTPError{
+			Message: err.Error(),
+			Code:    echo.ErrInternalServerError.Code,
+		}
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   action,
+		Entity:   dto.AuditEntityCampaign,
+		EntityID: campaignID.String(),
+		Before:   campaignAuditState{Campaign: camp},
+		After:    campaignAuditState{Campaign: decided},
+	})
+
+	if s.notifier != nil {
+		s.notifier.CampaignModerated(ctx, toCampaignDTO(decided, nil))
+	}
+
+	if moderator != nil {
+		// Захват истечет сам, поэтому ошибка снятия не влияет на решение
+		if err := s.leases.Release(ctx, campaignID, *moderator); err != nil && !errors.Is(err, leases.ErrLeaseNotFound) {
+			logger.Log.Warnw("Failed to release moderation lease",
+				"campaign_id", campaignID,
+				"error", err,
+			)
 		}
 	}
 
 	return nil
 }
+
+// approveCampaign одобряет кампанию и записывает решение в историю модерации.
+// Если кампания уже не ждет модерации, возвращается ошибка ent.NotFoundError
+func approveCampaign(
+	ctx context.Context,
+	client *ent.Client,
+	camp *ent.Campaign,
+	comment, moderator *string,
+	day int,
+) (*ent.Campaign, error) {
+	// Условие на статус не дает двум параллельным решениям обновить кампанию дважды
+	update := client.Campaign.UpdateOne(camp).
+		Where(campaign.ModerationStatusEQ(campaign.ModerationStatusPENDING)).
+		SetModerated(true).
+		SetModerationStatus(campaign.ModerationStatusAPPROVED).
+		ClearRejectionReason().
+		ClearApprovedAdTitle().
+		ClearApprovedAdText().
+		ClearApprovedImageURL()
+	if comment != nil {
+		update.SetModerationComment(*comment)
+	} else {
+		update.ClearModerationComment()
+	}
+	approved, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordModerationDecision(ctx, client, camp.ID, moderationdecision.ActionAPPROVED,
+		nil, comment, moderator, day); err != nil {
+		return nil, err
+	}
+	return approved, nil
+}
+
+// rejectCampaign отклоняет кампанию и записывает решение в историю модерации.
+// Ранее одобренная версия креатива продолжает показываться, если она сохранена.
+// Если кампания уже не ждет модерации, возвращается ошибка ent.NotFoundError
+func rejectCampaign(
+	ctx context.Context,
+	client *ent.Client,
+	camp *ent.Campaign,
+	reason string,
+	comment, moderator *string,
+	day int,
+) (*ent.Campaign, error) {
+	update := client.Campaign.UpdateOne(camp).
+		Where(campaign.ModerationStatusEQ(campaign.ModerationStatusPENDING)).
+		SetModerated(camp.ApprovedAdTitle != nil).
+		SetModerationStatus(campaign.ModerationStatusREJECTED).
+		SetRejectionReason(campaign.RejectionReason(reason))
+	if comment != nil {
+		update.SetModerationComment(*comment)
+	} else {
+		update.ClearModerationComment()
+	}
+	rejected, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = moderationDecisionCreate(client, camp.ID, moderationdecision.ActionREJECTED, &reason, comment, moderator, day).
+		SetNillableImageHash(camp.ImageHash).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rejected, nil
+}
+
+// recordModerationDecision добавляет запись в историю модерации кампании
+func recordModerationDecision(
+	ctx context.Context,
+	client *ent.Client,
+	campaignID uuid.UUID,
+	action moderationdecision.Action,
+	reason, comment, moderator *string,
+	day int,
+) error {
+	_, err := moderationDecisionCreate(client, campaignID, action, reason, comment, moderator, day).Save(ctx)
+	return err
+}
+
+func moderationDecisionCreate(
+	client *ent.Client,
+	campaignID uuid.UUID,
+	action moderationdecision.Action,
+	reason, comment, moderator *string,
+	day int,
+) *ent.ModerationDecisionCreate {
+	create := client.ModerationDecision.Create().
+		SetCampaignID(campaignID).
+		SetAction(action).
+		SetNillableComment(comment).
+		SetNillableModerator(moderator).
+		SetDay(day)
+	if reason != nil {
+		create.SetReason(moderationdecision.Reason(*reason))
+	}
+	return create
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/campaign"
+	"nlypage-final/internal/adapters/database/postgres/ent/moderationdecision"
+	"nlypage-final/internal/adapters/database/redis/leases"
+	"nlypage-final/internal/domain/dto"
+)
+
+// countingNotifier считает уведомления рекламодателю о решениях
+type countingNotifier struct {
+	moderated int
+}
+
+func (n *countingNotifier) CampaignModerated(context.Context, *dto.Campaign) {
+	n.moderated++
+}
+
+func newTestModerationService(t *testing.T, db *ent.Client) (ModerationService, *countingNotifier) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	storage := leases.NewStorage(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	t.Cleanup(func() { _ = storage.Close() })
+
+	notifier := &countingNotifier{}
+	return NewModerationService(db, fixedTime{}, storage, time.Minute, nopAudit{}, notifier), notifier
+}
+
+func moderator(name string) *string {
+	return &name
+}
+
+func decisionsCount(t *testing.T, db *ent.Client, camp *ent.Campaign, action moderationdecision.Action) int {
+	t.Helper()
+
+	return db.ModerationDecision.Query().
+		Where(
+			moderationdecision.CampaignID(camp.ID),
+			moderationdecision.ActionEQ(action),
+		).
+		CountX(context.Background())
+}
+
+func TestDecideOnlyPending(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s, notifier := newTestModerationService(t, db)
+
+	camp := newTestCampaign(t, db, newTestAdvertiser(t, db, 0), nil, nil)
+	require.Equal(t, campaign.ModerationStatusPENDING, camp.ModerationStatus)
+
+	approve := dto.CampaignApprove{CampaignID: camp.ID, Moderator: moderator("alice")}
+	require.NoError(t, s.ApproveCampaign(ctx, approve))
+
+	t.Run("approve twice", func(t *testing.T) {
+		assertHTTPCode(t, s.ApproveCampaign(ctx, approve), http.StatusConflict)
+	})
+	t.Run("reject after approve", func(t *testing.T) {
+		err := s.RejectCampaign(ctx, dto.CampaignReject{
+			CampaignID: camp.ID,
+			Reason:     campaign.RejectionReasonOTHER.String(),
+			Moderator:  moderator("bob"),
+		})
+		assertHTTPCode(t, err, http.StatusConflict)
+	})
+
+	// Повторные решения не меняют кампанию, историю и не уведомляют рекламодателя
+	approved := db.Campaign.GetX(ctx, camp.ID)
+	assert.Equal(t, campaign.ModerationStatusAPPROVED, approved.ModerationStatus)
+	assert.True(t, approved.Moderated)
+	assert.Nil(t, approved.RejectionReason)
+	assert.Equal(t, 1, decisionsCount(t, db, camp, moderationdecision.ActionAPPROVED))
+	assert.Zero(t, decisionsCount(t, db, camp, moderationdecision.ActionREJECTED))
+	assert.Equal(t, 1, notifier.moderated)
+}
+
+func TestRejectTwice(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s, notifier := newTestModerationService(t, db)
+
+	camp := newTestCampaign(t, db, newTestAdvertiser(t, db, 0), nil, nil)
+	reject := dto.CampaignReject{
+		CampaignID: camp.ID,
+		Reason:     campaign.RejectionReasonLOW_QUALITY_CREATIVE.String(),
+		Moderator:  moderator("alice"),
+	}
+
+	require.NoError(t, s.RejectCampaign(ctx, reject))
+	assertHTTPCode(t, s.RejectCampaign(ctx, reject), http.StatusConflict)
+	assertHTTPCode(t, s.ApproveCampaign(ctx, dto.CampaignApprove{CampaignID: camp.ID}), http.StatusConflict)
+
+	assert.Equal(t, campaign.ModerationStatusREJECTED, db.Campaign.GetX(ctx, camp.ID).ModerationStatus)
+	assert.Equal(t, 1, decisionsCount(t, db, camp, moderationdecision.ActionREJECTED))
+	assert.Equal(t, 1, notifier.moderated)
+}
+
+func TestClaimConflict(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s, _ := newTestModerationService(t, db)
+
+	camp := newTestCampaign(t, db, newTestAdvertiser(t, db, 0), nil, nil)
+	lease, err := s.ClaimCampaign(ctx, dto.CampaignClaim{CampaignID: camp.ID, Moderator: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", lease.Moderator)
+
+	_, err = s.ClaimCampaign(ctx, dto.CampaignClaim{CampaignID: camp.ID, Moderator: "bob"})
+	assertHTTPCode(t, err, http.StatusConflict)
+
+	// Владелец продлевает захват повторным вызовом
+	_, err = s.ClaimCampaign(ctx, dto.CampaignClaim{CampaignID: camp.ID, Moderator: "alice"})
+	require.NoError(t, err)
+}
+
+func TestReleaseByNonHolder(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s, _ := newTestModerationService(t, db)
+
+	camp := newTestCampaign(t, db, newTestAdvertiser(t, db, 0), nil, nil)
+	assertHTTPCode(t, s.ReleaseCampaign(ctx, dto.CampaignRelease{CampaignID: camp.ID, Moderator: "alice"}),
+		http.StatusNotFound)
+
+	_, err := s.ClaimCampaign(ctx, dto.CampaignClaim{CampaignID: camp.ID, Moderator: "alice"})
+	require.NoError(t, err)
+
+	assertHTTPCode(t, s.ReleaseCampaign(ctx, dto.CampaignRelease{CampaignID: camp.ID, Moderator: "bob"}),
+		http.StatusConflict)
+	_, err = s.ClaimCampaign(ctx, dto.CampaignClaim{CampaignID: camp.ID, Moderator: "bob"})
+	assertHTTPCode(t, err, http.StatusConflict)
+
+	// После снятия захвата владельцем кампанию может взять другой модератор
+	require.NoError(t, s.ReleaseCampaign(ctx, dto.CampaignRelease{CampaignID: camp.ID, Moderator: "alice"}))
+	_, err = s.ClaimCampaign(ctx, dto.CampaignClaim{CampaignID: camp.ID, Moderator: "bob"})
+	require.NoError(t, err)
+}
+
+func TestDecideWhileClaimed(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s, notifier := newTestModerationService(t, db)
+
+	camp := newTestCampaign(t, db, newTestAdvertiser(t, db, 0), nil, nil)
+	_, err := s.ClaimCampaign(ctx, dto.CampaignClaim{CampaignID: camp.ID, Moderator: "alice"})
+	require.NoError(t, err)
+
+	assertHTTPCode(t, s.ApproveCampaign(ctx, dto.CampaignApprove{CampaignID: camp.ID, Moderator: moderator("bob")}),
+		http.StatusConflict)
+	assertHTTPCode(t, s.RejectCampaign(ctx, dto.CampaignReject{
+		CampaignID: camp.ID,
+		Reason:     campaign.RejectionReasonOTHER.String(),
+		Moderator:  moderator("bob"),
+	}), http.StatusConflict)
+	// Решение без имени модератора тоже не проходит мимо чужого захвата
+	assertHTTPCode(t, s.ApproveCampaign(ctx, dto.CampaignApprove{CampaignID: camp.ID}), http.StatusConflict)
+
+	assert.Equal(t, campaign.ModerationStatusPENDING, db.Campaign.GetX(ctx, camp.ID).ModerationStatus)
+	assert.Zero(t, notifier.moderated)
+
+	// Владелец захвата решает, и захват снимается
+	require.NoError(t, s.ApproveCampaign(ctx, dto.CampaignApprove{CampaignID: camp.ID, Moderator: moderator("alice")}))
+	assert.Equal(t, campaign.ModerationStatusAPPROVED, db.Campaign.GetX(ctx, camp.ID).ModerationStatus)
+	assertHTTPCode(t, s.ReleaseCampaign(ctx, dto.CampaignRelease{CampaignID: camp.ID, Moderator: "alice"}),
+		http.StatusNotFound)
+}
+
+func TestDecideUnclaimed(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	s, _ := newTestModerationService(t, db)
+
+	// Захват необязательный: незахваченную кампанию решают сразу
+	camp := newTestCampaign(t, db, newTestAdvertiser(t, db, 0), nil, nil)
+	require.NoError(t, s.ApproveCampaign(ctx, dto.CampaignApprove{CampaignID: camp.ID, Moderator: moderator("bob")}))
+	assert.Equal(t, campaign.ModerationStatusAPPROVED, db.Campaign.GetX(ctx, camp.ID).ModerationStatus)
+}
@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"math"
 	"nlypage-final/internal/adapters/database/clickhouse"
 	"nlypage-final/internal/domain/dto"
+	"sort"
 )
 
 type statsTimeService interface {
@@ -16,6 +19,10 @@ type statsClickhouseRepository interface {
 	CampaignDailyStats(ctx context.Context, campaignID uuid.UUID) ([]*clickhouse.StatsDaily, error)
 	AdvertiserStats(ctx context.Context, advertiserID uuid.UUID) (*clickhouse.Stats, error)
 	AdvertiserDailyStats(ctx context.Context, advertiserID uuid.UUID) ([]*clickhouse.StatsDaily, error)
+	StreamCampaignDailyStats(ctx context.Context, campaignID uuid.UUID, from, to int, fn func(*clickhouse.StatsDaily) error) error
+	StreamAdvertiserDailyStats(ctx context.Context, advertiserID uuid.UUID, from, to int, fn func(*clickhouse.StatsDaily) error) error
+	CampaignInvalidClicks(ctx context.Context, campaignID uuid.UUID) ([]*clickhouse.InvalidClicksStats, error)
+	AdvertiserInvalidClicks(ctx context.Context, advertiserID uuid.UUID) ([]*clickhouse.InvalidClicksStats, error)
 }
 
 type StatsService interface {
@@ -23,6 +30,10 @@ type StatsService interface {
 	CampaignDaily(ctx context.Context, campaignID uuid.UUID) ([]*dto.StatsDaily, error)
 	Advertiser(ctx context.Context, advertiserID uuid.UUID) (*dto.Stats, error)
 	AdvertiserDaily(ctx context.Context, advertiserID uuid.UUID) ([]*dto.StatsDaily, error)
+	ExportCampaignDaily(ctx context.Context, campaignID uuid.UUID, export dto.StatsExport, fn func(*dto.StatsDaily) error) error
+	ExportAdvertiserDaily(ctx context.Context, advertiserID uuid.UUID, export dto.StatsExport, fn func(*dto.StatsDaily) error) error
+	CampaignInvalidTraffic(ctx context.Context, campaignID uuid.UUID) (*dto.InvalidTrafficReport, error)
+	AdvertiserInvalidTraffic(ctx context.Context, advertiserID uuid.UUID) (*dto.InvalidTrafficReport, error)
 }
 
 type statsService struct {
@@ -42,14 +53,8 @@ func (s *statsService) Campaign(ctx context.Context, campaignID uuid.UUID) (*dto
 	if err != nil {
 		return nil, err
 	}
-	return &dto.Stats{
-		ImpressionsCount: int(stats.ImpressionsCount),
-		ClicksCount:      int(stats.ClicksCount),
-		Conversion:       stats.Conversion,
-		SpentImpressions: stats.SpentImpressions,
-		SpentClicks:      stats.SpentClicks,
-		SpentTotal:       stats.SpentTotal,
-	}, nil
+	statsDTO := toStatsDTO(stats)
+	return &statsDTO, nil
 }
 
 func (s *statsService) CampaignDaily(ctx context.Context, campaignID uuid.UUID) ([]*dto.StatsDaily, error) {
@@ -61,15 +66,8 @@ func (s *statsService) CampaignDaily(ctx context.Context, campaignID uuid.UUID)
 	var statsDaily []*dto.StatsDaily
 	for _, stat := range stats {
 		statsDaily = append(statsDaily, &dto.StatsDaily{
-			Stats: dto.Stats{
-				ImpressionsCount: int(stat.ImpressionsCount),
-				ClicksCount:      int(stat.ClicksCount),
-				Conversion:       stat.Conversion,
-				SpentImpressions: stat.SpentImpressions,
-				SpentClicks:      stat.SpentClicks,
-				SpentTotal:       stat.SpentTotal,
-			},
-			Date: int(stat.Date),
+			Stats: toStatsDTO(&stat.Stats),
+			Date:  int(stat.Date),
 		})
 	}
 	return statsDaily, nil
@@ -80,14 +78,8 @@ func (s *statsService) Advertiser(ctx context.Context, advertiserID uuid.UUID) (
 	if err != nil {
 		return nil, err
 	}
-	return &dto.Stats{
-		ImpressionsCount: int(stats.ImpressionsCount),
-		ClicksCount:      int(stats.ClicksCount),
-		Conversion:       stats.Conversion,
-		SpentImpressions: stats.SpentImpressions,
-		SpentClicks:      stats.SpentClicks,
-		SpentTotal:       stats.SpentTotal,
-	}, nil
+	statsDTO := toStatsDTO(stats)
+	return &statsDTO, nil
 }
 
 func (s *statsService) AdvertiserDaily(ctx context.Context, advertiserID uuid.UUID) ([]*dto.StatsDaily, error) {
@@ -99,16 +91,147 @@ func (s *statsService) AdvertiserDaily(ctx context.Context, advertiserID uuid.UU
 	var statsDaily []*dto.StatsDaily
 	for _, stat := range stats {
 		statsDaily = append(statsDaily, &dto.StatsDaily{
-			Stats: dto.Stats{
-				ImpressionsCount: int(stat.ImpressionsCount),
-				ClicksCount:      int(stat.ClicksCount),
-				Conversion:       stat.Conversion,
-				SpentImpressions: stat.SpentImpressions,
-				SpentClicks:      stat.SpentClicks,
-				SpentTotal:       stat.SpentTotal,
-			},
-			Date: int(stat.Date),
+			Stats: toStatsDTO(&stat.Stats),
+			Date:  int(stat.Date),
 		})
 	}
 	return statsDaily, nil
 }
+
+func (s *statsService) ExportCampaignDaily(ctx context.Context, campaignID uuid.UUID, export dto.StatsExport, fn func(*dto.StatsDaily) error) error {
+	from, to, err := exportRange(export)
+	if err != nil {
+		return err
+	}
+	return s.clickhouseRepository.StreamCampaignDailyStats(ctx, campaignID, from, to, func(stat *clickhouse.StatsDaily) error {
+		return fn(&dto.StatsDaily{
+			Stats: toStatsDTO(&stat.Stats),
+			Date:  int(stat.Date),
+		})
+	})
+}
+
+func (s *statsService) ExportAdvertiserDaily(ctx context.Context, advertiserID uuid.UUID, export dto.StatsExport, fn func(*dto.StatsDaily) error) error {
+	from, to, err := exportRange(export)
+	if err != nil {
+		return err
+	}
+	return s.clickhouseRepository.StreamAdvertiserDailyStats(ctx, advertiserID, from, to, func(stat *clickhouse.StatsDaily) error {
+		return fn(&dto.StatsDaily{
+			Stats: toStatsDTO(&stat.Stats),
+			Date:  int(stat.Date),
+		})
+	})
+}
+
+func (s *statsService) CampaignInvalidTraffic(ctx context.Context, campaignID uuid.UUID) (*dto.InvalidTrafficReport, error) {
+	invalidClicks, err := s.clickhouseRepository.CampaignInvalidClicks(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.clickhouseRepository.CampaignStats(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	return toInvalidTrafficReport(invalidClicks, stats.ClicksCount), nil
+}
+
+func (s *statsService) AdvertiserInvalidTraffic(ctx context.Context, advertiserID uuid.UUID) (*dto.InvalidTrafficReport, error) {
+	invalidClicks, err := s.clickhouseRepository.AdvertiserInvalidClicks(ctx, advertiserID)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.clickhouseRepository.AdvertiserStats(ctx, advertiserID)
+	if err != nil {
+		return nil, err
+	}
+	return toInvalidTrafficReport(invalidClicks, stats.ClicksCount), nil
+}
+
+// toInvalidTrafficReport сворачивает недействительные клики по дням и причинам в отчет.
+// validClicks — число действительных кликов для расчета доли недействительных
+func toInvalidTrafficReport(invalidClicks []*clickhouse.InvalidClicksStats, validClicks uint64) *dto.InvalidTrafficReport {
+	report := &dto.InvalidTrafficReport{
+		Reasons: []dto.InvalidTrafficReason{},
+		Daily:   []dto.InvalidTrafficDaily{},
+	}
+
+	reasons := make(map[string]int)
+	for _, stat := range invalidClicks {
+		report.ClicksCount += int(stat.ClicksCount)
+		report.SavedSpend += stat.Income
+
+		// Строки отсортированы по дню, поэтому день добавляется, когда встречается впервые
+		if n := len(report.Daily); n == 0 || report.Daily[n-1].Date != int(stat.Day) {
+			report.Daily = append(report.Daily, dto.InvalidTrafficDaily{Date: int(stat.Day)})
+		}
+		daily := &report.Daily[len(report.Daily)-1]
+		daily.ClicksCount += int(stat.ClicksCount)
+		daily.SavedSpend += stat.Income
+
+		i, ok := reasons[stat.Reason]
+		if !ok {
+			i = len(report.Reasons)
+			reasons[stat.Reason] = i
+			report.Reasons = append(report.Reasons, dto.InvalidTrafficReason{Reason: stat.Reason})
+		}
+		report.Reasons[i].ClicksCount += int(stat.ClicksCount)
+		report.Reasons[i].SavedSpend += stat.Income
+	}
+
+	sort.Slice(report.Reasons, func(i, j int) bool {
+		return report.Reasons[i].ClicksCount > report.Reasons[j].ClicksCount
+	})
+	if total := report.ClicksCount + int(validClicks); total > 0 {
+		report.InvalidRate = float64(report.ClicksCount) / float64(total) * 100
+	}
+
+	return report
+}
+
+// exportRange возвращает границы выгрузки, подставляя вместо неуказанных весь диапазон дней
+func exportRange(export dto.StatsExport) (int, int, error) {
+	from, to := 0, math.MaxInt32
+	if export.From != nil {
+		from = *export.From
+	}
+	if export.To != nil {
+		to = *export.To
+	}
+
+	if to < from {
+		return 0, 0, &echo.HTTPError{
+			Message: "to must be gte than from",
+			Code:    echo.ErrBadRequest.Code,
+		}
+	}
+
+	return from, to, nil
+}
+
+// toStatsDTO переводит статистику из ClickHouse в DTO, рассчитывая производные метрики
+func toStatsDTO(stats *clickhouse.Stats) dto.Stats {
+	result := dto.Stats{
+		ImpressionsCount: int(stats.ImpressionsCount),
+		ClicksCount:      int(stats.ClicksCount),
+		Conversion:       stats.Conversion,
+		ConversionsCount: int(stats.ConversionsCount),
+		ConversionsValue: stats.ConversionsValue,
+		SpentImpressions: stats.SpentImpressions,
+		SpentClicks:      stats.SpentClicks,
+		SpentActions:     stats.SpentActions,
+		SpentTotal:       stats.SpentTotal,
+	}
+
+	if stats.ClicksCount > 0 {
+		result.CVR = float64(stats.ConversionsCount) / float64(stats.ClicksCount) * 100
+	}
+	if stats.ConversionsCount > 0 {
+		result.CPA = stats.SpentTotal / float64(stats.ConversionsCount)
+	}
+	if stats.SpentTotal > 0 {
+		result.ROAS = stats.ConversionsValue / stats.SpentTotal
+	}
+
+	return result
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"nlypage-final/internal/adapters/database/postgres/ent"
+	"nlypage-final/internal/adapters/database/postgres/ent/apikey"
+	"nlypage-final/internal/adapters/database/postgres/ent/telegramaccount"
+	"nlypage-final/internal/domain/common/errorz"
+	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
+)
+
+type telegramAuthService interface {
+	Authenticate(ctx context.Context, key string) (*dto.Principal, error)
+}
+
+// TelegramService привязывает Telegram-аккаунты к рекламодателям.
+// Привязка действует, пока не отозван ключ, по которому она сделана
+type TelegramService interface {
+	Link(ctx context.Context, link dto.TelegramLink) (*dto.TelegramAccount, error)
+	Account(ctx context.Context, telegramID int64) (*dto.TelegramAccount, error)
+	Unlink(ctx context.Context, telegramID int64) error
+	// ChatIDs возвращает чаты для уведомлений рекламодателя
+	ChatIDs(ctx context.Context, advertiserID uuid.UUID) ([]int64, error)
+}
+
+type telegramService struct {
+	db          *ent.Client
+	authService telegramAuthService
+	audit       auditRecorder
+}
+
+func NewTelegramService(db *ent.Client, authService telegramAuthService, audit auditRecorder) TelegramService {
+	return &telegramService{
+		db:          db,
+		authService: authService,
+		audit:       audit,
+	}
+}
+
+func (s *telegramService) Link(ctx context.Context, link dto.TelegramLink) (*dto.TelegramAccount, error) {
+	principal, err := s.authService.Authenticate(ctx, link.Key)
+	if err != nil {
+		return nil, err
+	}
+	if principal.Role != dto.RoleAdvertiser || principal.AdvertiserID == nil {
+		return nil, errorz.ErrForbidden
+	}
+
+	before, err := s.db.TelegramAccount.Query().
+		Where(telegramaccount.TelegramID(link.TelegramID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Log.Errorf("failed to get telegram account: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	if err := s.db.TelegramAccount.Create().
+		SetTelegramID(link.TelegramID).
+		SetChatID(link.ChatID).
+		SetAdvertiserID(*principal.AdvertiserID).
+		SetAPIKeyID(principal.KeyID).
+		OnConflictColumns(telegramaccount.FieldTelegramID).
+		UpdateNewValues().
+		Exec(ctx); err != nil {
+		logger.Log.Errorf("failed to link telegram account: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	account, err := s.db.TelegramAccount.Query().
+		Where(telegramaccount.TelegramID(link.TelegramID)).
+		Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get telegram account: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	action := dto.AuditActionCreate
+	if before != nil {
+		action = dto.AuditActionUpdate
+	}
+	s.audit.Record(dto.ContextWithPrincipal(ctx, principal), dto.AuditRecord{
+		Action:   action,
+		Entity:   dto.AuditEntityTelegram,
+		EntityID: strconv.FormatInt(link.TelegramID, 10),
+		Before:   before,
+		After:    account,
+	})
+
+	return toTelegramAccountDTO(account), nil
+}
+
+func (s *telegramService) Account(ctx context.Context, telegramID int64) (*dto.TelegramAccount, error) {
+	account, err := s.db.TelegramAccount.Query().
+		Where(telegramaccount.TelegramID(telegramID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get telegram account: %v", err)
+		return nil, errorz.ErrInternal
+	}
+
+	active, err := s.db.APIKey.Query().
+		Where(
+			apikey.ID(account.APIKeyID),
+			apikey.RevokedAtIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to check api key: %v", err)
+		return nil, errorz.ErrInternal
+	}
+	if !active {
+		return nil, errorz.ErrNotFound
+	}
+
+	return toTelegramAccountDTO(account), nil
+}
+
+func (s *telegramService) Unlink(ctx context.Context, telegramID int64) error {
+	account, err := s.db.TelegramAccount.Query().
+		Where(telegramaccount.TelegramID(telegramID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errorz.ErrNotFound
+		}
+		logger.Log.Errorf("failed to get telegram account: %v", err)
+		return errorz.ErrInternal
+	}
+
+	if err := s.db.TelegramAccount.DeleteOne(account).Exec(ctx); err != nil {
+		logger.Log.Errorf("failed to unlink telegram account: %v", err)
+		return errorz.ErrInternal
+	}
+
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionDelete,
+		Entity:   dto.AuditEntityTelegram,
+		EntityID: strconv.FormatInt(telegramID, 10),
+		Before:   account,
+	})
+
+	return nil
+}
+
+func (s *telegramService) ChatIDs(ctx context.Context, advertiserID uuid.UUID) ([]int64, error) {
+	accounts, err := s.db.TelegramAccount.Query().
+		Where(telegramaccount.AdvertiserID(advertiserID)).
+		All(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get telegram accounts: %v", err)
+		return nil, errorz.ErrInternal
+	}
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+
+	keyIDs := make([]uuid.UUID, 0, len(accounts))
+	for _, account := range accounts {
+		keyIDs = append(keyIDs, account.APIKeyID)
+	}
+	activeKeyIDs, err := s.db.APIKey.Query().
+		Where(
+			apikey.IDIn(keyIDs...),
+			apikey.RevokedAtIsNil(),
+		).
+		IDs(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed to get api keys: %v", err)
+		return nil, errorz.ErrInternal
+	}
+	active := make(map[uuid.UUID]struct{}, len(activeKeyIDs))
+	for _, id := range activeKeyIDs {
+		active[id] = struct{}{}
+	}
+
+	var chatIDs []int64
+	for _, account := range accounts {
+		if _, ok := active[account.APIKeyID]; ok {
+			chatIDs = append(chatIDs, account.ChatID)
+		}
+	}
+	return chatIDs, nil
+}
+
+func toTelegramAccountDTO(account *ent.TelegramAccount) *dto.TelegramAccount {
+	return &dto.TelegramAccount{
+		TelegramID:   account.TelegramID,
+		ChatID:       account.ChatID,
+		AdvertiserID: account.AdvertiserID,
+		APIKeyID:     account.APIKeyID,
+		LinkedAt:     account.LinkedAt,
+	}
+}
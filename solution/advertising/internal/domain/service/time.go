@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"nlypage-final/internal/domain/common/errorz"
 	"nlypage-final/internal/domain/dto"
+	"nlypage-final/pkg/logger"
 )
 
 type timeStorage interface {
@@ -10,17 +13,24 @@ type timeStorage interface {
 	Set(day int)
 }
 
+// timeLedger закрывает прошедшие дни в биллинговом журнале
+type timeLedger interface {
+	CloseDays(ctx context.Context, from, to int) error
+}
+
 type TimeService interface {
 	Now() *dto.CurrentDate
-	Set(day int) (*dto.CurrentDate, error)
+	Set(ctx context.Context, day int) (*dto.CurrentDate, error)
 }
 
 type timeService struct {
 	day         int
 	timeStorage timeStorage
+	ledger      timeLedger
+	audit       auditRecorder
 }
 
-func NewTimeService(timeStorage timeStorage) (TimeService, error) {
+func NewTimeService(timeStorage timeStorage, ledger timeLedger, audit auditRecorder) (TimeService, error) {
 	currentDay, err := timeStorage.Now()
 	if err != nil {
 		currentDay = 0
@@ -28,6 +38,8 @@ func NewTimeService(timeStorage timeStorage) (TimeService, error) {
 	return &timeService{
 		day:         currentDay,
 		timeStorage: timeStorage,
+		ledger:      ledger,
+		audit:       audit,
 	}, nil
 }
 
@@ -36,12 +48,28 @@ func (s *timeService) Now() *dto.CurrentDate {
 	return &dto.CurrentDate{CurrentDate: s.day}
 }
 
-func (s *timeService) Set(day int) (*dto.CurrentDate, error) {
+func (s *timeService) Set(ctx context.Context, day int) (*dto.CurrentDate, error) {
 	if day <= s.day {
 		return nil, errors.New("new day must be greater than current day")
 	}
+
+	// События пишутся только в текущий день, поэтому прошедшие дни окончательны.
+	// Если журнал не записан, день не переключается, чтобы повторный запрос закрыл те же дни
+	if err := s.ledger.CloseDays(ctx, s.day, day); err != nil {
+		logger.Log.Errorf("failed to close billing days: %v", err)
+		return nil, errorz.ErrInternal
+	}
+	previous := s.day
 	s.day = day
 	s.timeStorage.Set(day)
 
+	s.audit.Record(ctx, dto.AuditRecord{
+		Action:   dto.AuditActionAdvance,
+		Entity:   dto.AuditEntityTime,
+		EntityID: "current_date",
+		Before:   dto.CurrentDate{CurrentDate: previous},
+		After:    dto.CurrentDate{CurrentDate: day},
+	})
+
 	return &dto.CurrentDate{CurrentDate: day}, nil
 }
@@ -0,0 +1,145 @@
+package ai_moderation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"nlypage-final/pkg/llm"
+)
+
+var ErrInvalidResponse = errors.New("invalid model response")
+
+// Policies — правила площадки, нарушения которых ищет модель. Совпадают с кодами причин отклонения
+var Policies = []string{
+	"PROHIBITED_CONTENT",
+	"MISLEADING_CLAIMS",
+	"INAPPROPRIATE_LANGUAGE",
+	"LOW_QUALITY_CREATIVE",
+	"TARGETING_VIOLATION",
+	"OTHER",
+}
+
+const otherPolicy = "OTHER"
+
+// Ad — проверяемое объявление
+type Ad struct {
+	Advertiser string
+	Title      string
+	Text       string
+}
+
+type Violation struct {
+	Policy      string `json:"policy"`
+	Description string `json:"description"`
+}
+
+// Verdict — структурированный ответ модели по объявлению
+type Verdict struct {
+	Category   string      `json:"category"`
+	Violations []Violation `json:"violations"`
+	Confidence float64     `json:"confidence"`
+}
+
+// RiskScore переводит вердикт в оценку риска от 0 до 1: уверенно найденные нарушения дают 1,
+// уверенное отсутствие нарушений — 0, неуверенный ответ — около 0.5
+func (v Verdict) RiskScore() float64 {
+	if len(v.Violations) > 0 {
+		return 0.5 + v.Confidence/2
+	}
+	return (1 - v.Confidence) / 2
+}
+
+type Reviewer interface {
+	Review(ctx context.Context, ad Ad) (*Verdict, error)
+}
+
+// PromptRenderer заполняет шаблон промпта данными
+type PromptRenderer interface {
+	Render(name string, data any) (string, error)
+}
+
+type reviewer struct {
+	llm     llm.Provider
+	model   string
+	prompts PromptRenderer
+	// prompt — имя шаблона промпта проверки, данными шаблона служит Ad
+	prompt string
+}
+
+// NewReviewer создает проверку объявлений. Пустой model означает модель провайдера по умолчанию
+func NewReviewer(provider llm.Provider, model string, prompts PromptRenderer, prompt string) Reviewer {
+	return &reviewer{
+		llm:     provider,
+		model:   model,
+		prompts: prompts,
+		prompt:  prompt,
+	}
+}
+
+func (r *reviewer) Review(ctx context.Context, ad Ad) (*Verdict, error) {
+	prompt, err := r.prompts.Render(r.prompt, ad)
+	if err != nil {
+		return nil, err
+	}
+
+	temperature := 0.1
+	response, err := r.llm.Complete(ctx, llm.Request{
+		Model:       r.model,
+		Messages:    llm.UserPrompt(prompt),
+		Temperature: &temperature,
+		JSON:        true,
+	})
+	if err != nil {
+		if errors.Is(err, llm.ErrEmptyResponse) {
+			return nil, fmt.Errorf("%w: no choices", ErrInvalidResponse)
+		}
+		return nil, fmt.Errorf("failed to review ad: %w", err)
+	}
+
+	return parseVerdict(response.Choices[0])
+}
+
+// parseVerdict разбирает JSON из ответа модели. Модель может обернуть JSON в markdown или добавить текст вокруг,
+// поэтому берется подстрока от первой открывающей до последней закрывающей фигурной скобки
+func parseVerdict(content string) (*Verdict, error) {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("%w: no json object in %q", ErrInvalidResponse, content)
+	}
+
+	var verdict Verdict
+	if err := json.Unmarshal([]byte(content[start:end+1]), &verdict); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	verdict.Category = strings.ToLower(strings.TrimSpace(verdict.Category))
+	if verdict.Category == "" {
+		verdict.Category = "other"
+	}
+	verdict.Confidence = min(max(verdict.Confidence, 0), 1)
+
+	violations := make([]Violation, 0, len(verdict.Violations))
+	for _, violation := range verdict.Violations {
+		violation.Policy = strings.ToUpper(strings.TrimSpace(violation.Policy))
+		if !isPolicy(violation.Policy) {
+			violation.Policy = otherPolicy
+		}
+		violations = append(violations, violation)
+	}
+	verdict.Violations = violations
+
+	return &verdict, nil
+}
+
+func isPolicy(policy string) bool {
+	for _, p := range Policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package ai_moderation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/pkg/gigachat"
+	"nlypage-final/pkg/gigachat/gigachattest"
+	"nlypage-final/pkg/llm"
+)
+
+// testPrompts подставляет заголовок и текст объявления в промпт вместо шаблона
+type testPrompts struct{}
+
+func (testPrompts) Render(name string, data any) (string, error) {
+	ad := data.(Ad)
+	return name + ": " + ad.Title + "\n" + ad.Text, nil
+}
+
+func TestReview(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected *Verdict
+		risk     float64
+	}{
+		{
+			name:     "clean ad",
+			response: `{"category": "Food", "violations": [], "confidence": 0.9}`,
+			expected: &Verdict{Category: "food", Violations: []Violation{}, Confidence: 0.9},
+			risk:     0.05,
+		},
+		{
+			name: "violations in markdown",
+			response: "Вот результат:\n```json\n" +
+				`{"category": "gambling", "violations": [{"policy": "prohibited_content", "description": "казино"}, {"policy": "SPAM", "description": "спам"}], "confidence": 0.8}` +
+				"\n```",
+			expected: &Verdict{
+				Category: "gambling",
+				Violations: []Violation{
+					{Policy: "PROHIBITED_CONTENT", Description: "казино"},
+					{Policy: "OTHER", Description: "спам"},
+				},
+				Confidence: 0.8,
+			},
+			risk: 0.9,
+		},
+		{
+			name:     "confidence is clamped",
+			response: `{"category": "", "violations": [{"policy": "MISLEADING_CLAIMS", "description": "гарантия дохода"}], "confidence": 7}`,
+			expected: &Verdict{
+				Category:   "other",
+				Violations: []Violation{{Policy: "MISLEADING_CLAIMS", Description: "гарантия дохода"}},
+				Confidence: 1,
+			},
+			risk: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := gigachattest.NewServer(func(req *gigachat.ChatRequest) (string, error) {
+				return tt.response, nil
+			})
+			defer server.Close()
+
+			verdict, err := NewReviewer(llm.NewGigaChatProvider(server.Client(), ""), "GigaChat", testPrompts{}, "moderation").Review(context.Background(), Ad{
+				Advertiser: "Кафе",
+				Title:      "Лучший кофе",
+				Text:       "Заходите каждый день",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, verdict)
+			assert.InDelta(t, tt.risk, verdict.RiskScore(), 1e-9)
+
+			requests := server.Requests()
+			require.Len(t, requests, 1)
+			assert.Equal(t, "GigaChat", requests[0].Model)
+			assert.True(t, strings.Contains(requests[0].Messages[0].Content, "Лучший кофе"))
+		})
+	}
+}
+
+func TestReviewErrors(t *testing.T) {
+	t.Run("not json", func(t *testing.T) {
+		server := gigachattest.NewServer(func(req *gigachat.ChatRequest) (string, error) {
+			return "Объявление выглядит нормально", nil
+		})
+		defer server.Close()
+
+		_, err := NewReviewer(llm.NewGigaChatProvider(server.Client(), ""), "GigaChat", testPrompts{}, "moderation").Review(context.Background(), Ad{})
+		assert.ErrorIs(t, err, ErrInvalidResponse)
+	})
+
+	t.Run("backend error", func(t *testing.T) {
+		server := gigachattest.NewServer(func(req *gigachat.ChatRequest) (string, error) {
+			return "", errors.New("overloaded")
+		})
+		defer server.Close()
+
+		_, err := NewReviewer(llm.NewGigaChatProvider(server.Client(), ""), "GigaChat", testPrompts{}, "moderation").Review(context.Background(), Ad{})
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrInvalidResponse)
+	})
+}
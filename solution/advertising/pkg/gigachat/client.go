@@ -136,7 +136,9 @@ func (c *Client) AuthWithContext(ctx context.Context) error {
 func (c *Client) sendRequest(_ context.Context, req *http.Request) (*http.Response, error) {
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token.Get()))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
 
 	res, err := c.client.Do(req)
 	if err != nil {
@@ -0,0 +1,131 @@
+// Package gigachattest предоставляет поддельный backend GigaChat для тестов
+package gigachattest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"nlypage-final/pkg/gigachat"
+)
+
+// RespondFunc возвращает текст ответа модели на запрос. Ошибка превращается в ответ 500
+type RespondFunc func(req *gigachat.ChatRequest) (string, error)
+
+// Server эмулирует эндпоинты авторизации и чата GigaChat
+type Server struct {
+	*httptest.Server
+
+	respond  RespondFunc
+	mu       sync.Mutex
+	requests []gigachat.ChatRequest
+}
+
+func NewServer(respond RespondFunc) *Server {
+	s := &Server{respond: respond}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+gigachat.OAuthPath, s.oauth)
+	mux.HandleFunc("/"+gigachat.ChatPath, s.chat)
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Client возвращает клиент GigaChat, настроенный на этот сервер
+func (s *Server) Client() *gigachat.Client {
+	client, _ := gigachat.NewClientWithConfig(&gigachat.Config{
+		AuthUrl:  s.URL + "/",
+		BaseUrl:  s.URL + "/",
+		AuthKey:  "test",
+		Scope:    gigachat.ScopeApiIndividual,
+		Insecure: true,
+	})
+	return client
+}
+
+// Requests возвращает все полученные запросы к чату
+func (s *Server) Requests() []gigachat.ChatRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]gigachat.ChatRequest(nil), s.requests...)
+}
+
+func (s *Server) oauth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, gigachat.OAuthResponse{
+		AccessToken: "test",
+		ExpiresAt:   time.Now().Add(30 * time.Minute).UnixMilli(),
+	})
+}
+
+func (s *Server) chat(w http.ResponseWriter, r *http.Request) {
+	var req gigachat.ChatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+
+	content, err := s.respond(&req)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"message": err.Error()})
+		return
+	}
+
+	if req.Stream != nil && *req.Stream {
+		s.stream(w, req.Model, content)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, gigachat.ChatResponse{
+		Model:   req.Model,
+		Created: time.Now().Unix(),
+		Method:  "chat.completion",
+		Choices: []gigachat.Choice{{
+			FinishReason: "stop",
+			Message: gigachat.Message{
+				Role:    gigachat.AssistantRole,
+				Content: content,
+			},
+		}},
+	})
+}
+
+// stream отправляет ответ потоком SSE, по одному слову в событии
+func (s *Server) stream(w http.ResponseWriter, model, content string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+
+	for _, part := range strings.SplitAfter(content, " ") {
+		chunk, _ := json.Marshal(gigachat.ChatStreamChunk{
+			Model:   model,
+			Created: time.Now().Unix(),
+			Choices: []gigachat.ChatStreamChoice{{
+				Delta: gigachat.Message{
+					Role:    gigachat.AssistantRole,
+					Content: part,
+				},
+			}},
+		})
+		_, _ = fmt.Fprintf(w, "data: %s\n\n", chunk)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,91 @@
+package gigachat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"nlypage-final/pkg/sse"
+)
+
+// streamDone — данные последнего события потока
+const streamDone = "[DONE]"
+
+type ChatStreamChunk struct {
+	Model   string             `json:"model"`
+	Created int64              `json:"created"`
+	Choices []ChatStreamChoice `json:"choices"`
+	Usage   *Usage             `json:"usage,omitempty"`
+}
+
+type ChatStreamChoice struct {
+	Index        int64   `json:"index"`
+	FinishReason string  `json:"finish_reason"`
+	Delta        Message `json:"delta"`
+}
+
+// ChatStream — ответ модели, который приходит частями
+type ChatStream struct {
+	body   io.ReadCloser
+	reader *sse.Reader
+}
+
+// Recv возвращает следующую часть ответа. После последней части возвращается io.EOF
+func (s *ChatStream) Recv() (*ChatStreamChunk, error) {
+	event, err := s.reader.Next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("failed to read stream: %w", err)
+	}
+	if event.Data == streamDone {
+		return nil, io.EOF
+	}
+
+	var chunk ChatStreamChunk
+	if err := json.Unmarshal([]byte(event.Data), &chunk); err != nil {
+		return nil, fmt.Errorf("failed to decode stream chunk: %w", err)
+	}
+	return &chunk, nil
+}
+
+func (s *ChatStream) Close() error {
+	return s.body.Close()
+}
+
+func (c *Client) ChatStream(in *ChatRequest) (*ChatStream, error) {
+	return c.ChatStreamWithContext(context.Background(), in)
+}
+
+// ChatStreamWithContext запрашивает ответ потоком Server-Sent Events. Поток нужно закрыть
+func (c *Client) ChatStreamWithContext(ctx context.Context, in *ChatRequest) (*ChatStream, error) {
+	stream := true
+	request := *in
+	request.Stream = &stream
+
+	reqBytes, err := json.Marshal(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.BaseUrl+ChatPath, bytes.NewReader(reqBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", sse.ContentType)
+
+	res, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatStream{
+		body:   res.Body,
+		reader: sse.NewReader(res.Body),
+	}, nil
+}
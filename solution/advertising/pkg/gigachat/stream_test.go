@@ -0,0 +1,50 @@
+package gigachat_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/pkg/gigachat"
+	"nlypage-final/pkg/gigachat/gigachattest"
+)
+
+func TestChatStream(t *testing.T) {
+	server := gigachattest.NewServer(func(req *gigachat.ChatRequest) (string, error) {
+		return "Свежие цветы каждый день", nil
+	})
+	defer server.Close()
+
+	client := server.Client()
+	require.NoError(t, client.AuthWithContext(context.Background()))
+
+	stream, err := client.ChatStreamWithContext(context.Background(), &gigachat.ChatRequest{
+		Model:    "GigaChat",
+		Messages: []gigachat.Message{{Role: gigachat.UserRole, Content: "текст"}},
+	})
+	require.NoError(t, err)
+	defer stream.Close()
+
+	var parts []string
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.Len(t, chunk.Choices, 1)
+		parts = append(parts, chunk.Choices[0].Delta.Content)
+	}
+
+	assert.Len(t, parts, 4)
+	assert.Equal(t, "Свежие цветы каждый день", strings.Join(parts, ""))
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.NotNil(t, requests[0].Stream)
+	assert.True(t, *requests[0].Stream)
+}
@@ -0,0 +1,127 @@
+package image_validation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+var errMalformed = errors.New("malformed image data")
+
+// stripMetadata удаляет метаданные без перекодирования, чтобы не терять качество
+func stripMetadata(format string, data []byte) ([]byte, error) {
+	switch format {
+	case "jpeg":
+		return stripJPEG(data)
+	case "png":
+		return stripPNG(data)
+	default:
+		// GIF не содержит EXIF
+		return data, nil
+	}
+}
+
+// Сегменты JPEG с метаданными: APP1 (EXIF, XMP), APP13 (IPTC) и комментарий.
+// APP0 (JFIF), APP2 (ICC-профиль) и APP14 (Adobe) нужны для корректного отображения
+var jpegMetadataMarkers = map[byte]bool{
+	0xE1: true,
+	0xED: true,
+	0xFE: true,
+}
+
+func stripJPEG(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errMalformed
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:2])
+
+	pos := 2
+	for pos < len(data) {
+		if data[pos] != 0xFF {
+			return nil, errMalformed
+		}
+		// Между сегментами допускаются заполняющие байты 0xFF
+		for pos+1 < len(data) && data[pos+1] == 0xFF {
+			pos++
+		}
+		if pos+1 >= len(data) {
+			return nil, errMalformed
+		}
+		marker := data[pos+1]
+
+		switch {
+		case marker == 0xD9:
+			out.Write(data[pos : pos+2])
+			return out.Bytes(), nil
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			out.Write(data[pos : pos+2])
+			pos += 2
+			continue
+		}
+
+		if pos+4 > len(data) {
+			return nil, errMalformed
+		}
+		end := pos + 2 + int(binary.BigEndian.Uint16(data[pos+2:pos+4]))
+		if end > len(data) {
+			return nil, errMalformed
+		}
+
+		if marker == 0xDA {
+			// После начала скана идут сжатые данные до конца файла, метаданных там нет
+			out.Write(data[pos:])
+			return out.Bytes(), nil
+		}
+		if !jpegMetadataMarkers[marker] {
+			out.Write(data[pos:end])
+		}
+		pos = end
+	}
+	return out.Bytes(), nil
+}
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// Чанки PNG с метаданными: EXIF, текстовые блоки и время изменения
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+func stripPNG(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, errMalformed
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(pngSignature)
+
+	pos := len(pngSignature)
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, errMalformed
+		}
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		chunkType := string(data[pos+4 : pos+8])
+		// длина + тип + данные + CRC
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, errMalformed
+		}
+
+		if !pngMetadataChunks[chunkType] {
+			out.Write(data[pos:end])
+		}
+		pos = end
+
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,166 @@
+package image_validation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math/bits"
+	"slices"
+)
+
+// ErrInvalidImage оборачивает все ошибки проверки изображения; текст ошибки можно показывать пользователю
+var ErrInvalidImage = errors.New("invalid image")
+
+// Config задает ограничения для изображений. Нулевые значения отключают соответствующую проверку
+type Config struct {
+	// Formats — разрешенные форматы в терминах image.Decode: jpeg, png, gif
+	Formats        []string
+	MaxBytes       int64
+	MinWidth       int
+	MinHeight      int
+	MaxWidth       int
+	MaxHeight      int
+	MinAspectRatio float64
+	MaxAspectRatio float64
+}
+
+// Image — проверенное изображение
+type Image struct {
+	// Data — исходные байты изображения без метаданных (EXIF, XMP, текстовые блоки)
+	Data   []byte
+	Format string
+	Width  int
+	Height int
+	// Hash — перцептивный хэш (dHash), похожие изображения отличаются в небольшом числе бит
+	Hash uint64
+}
+
+type Validator interface {
+	Validate(r io.Reader) (*Image, error)
+}
+
+type validator struct {
+	config Config
+}
+
+func NewValidator(config Config) Validator {
+	return &validator{config: config}
+}
+
+func (v *validator) Validate(r io.Reader) (*Image, error) {
+	if v.config.MaxBytes > 0 {
+		r = io.LimitReader(r, v.config.MaxBytes+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if v.config.MaxBytes > 0 && int64(len(data)) > v.config.MaxBytes {
+		return nil, fmt.Errorf("%w: image is larger than %d bytes", ErrInvalidImage, v.config.MaxBytes)
+	}
+
+	// Размеры проверяются до полного декодирования, чтобы не распаковывать огромные изображения
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: file is not a supported image", ErrInvalidImage)
+	}
+	if len(v.config.Formats) > 0 && !slices.Contains(v.config.Formats, format) {
+		return nil, fmt.Errorf("%w: format %s is not allowed", ErrInvalidImage, format)
+	}
+	if err := v.checkDimensions(cfg.Width, cfg.Height); err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode %s image", ErrInvalidImage, format)
+	}
+
+	stripped, err := stripMetadata(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	return &Image{
+		Data:   stripped,
+		Format: format,
+		Width:  cfg.Width,
+		Height: cfg.Height,
+		Hash:   dHash(img),
+	}, nil
+}
+
+func (v *validator) checkDimensions(width, height int) error {
+	c := v.config
+	if width < c.MinWidth || height < c.MinHeight {
+		return fmt.Errorf("%w: image is %dx%d, minimum is %dx%d", ErrInvalidImage, width, height, c.MinWidth, c.MinHeight)
+	}
+	if (c.MaxWidth > 0 && width > c.MaxWidth) || (c.MaxHeight > 0 && height > c.MaxHeight) {
+		return fmt.Errorf("%w: image is %dx%d, maximum is %dx%d", ErrInvalidImage, width, height, c.MaxWidth, c.MaxHeight)
+	}
+	if height == 0 {
+		return fmt.Errorf("%w: image has zero height", ErrInvalidImage)
+	}
+
+	ratio := float64(width) / float64(height)
+	if (c.MinAspectRatio > 0 && ratio < c.MinAspectRatio) || (c.MaxAspectRatio > 0 && ratio > c.MaxAspectRatio) {
+		return fmt.Errorf("%w: aspect ratio %.2f is outside of [%.2f, %.2f]", ErrInvalidImage, ratio, c.MinAspectRatio, c.MaxAspectRatio)
+	}
+	return nil
+}
+
+// Distance возвращает расстояние Хэмминга между перцептивными хэшами
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// dHash считает разностный хэш: изображение уменьшается до 9x8 в оттенках серого,
+// каждый бит показывает, ярче ли пиксель соседа справа
+func dHash(img image.Image) uint64 {
+	const width, height = 9, 8
+
+	bounds := img.Bounds()
+	var gray [height][width]float64
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(bounds.Min.Y+(y+1)*bounds.Dy()/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(bounds.Min.X+(x+1)*bounds.Dx()/width, x0+1)
+			gray[y][x] = averageLuma(img, x0, y0, x1, y1)
+		}
+	}
+
+	var hash uint64
+	for y := 0; y < height; y++ {
+		for x := 0; x < width-1; x++ {
+			hash <<= 1
+			if gray[y][x] > gray[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// averageLuma усредняет яркость прямоугольника; для больших областей берется не более 16x16 точек
+func averageLuma(img image.Image, x0, y0, x1, y1 int) float64 {
+	stepX := max((x1-x0)/16, 1)
+	stepY := max((y1-y0)/16, 1)
+
+	var sum float64
+	var count int
+	for y := y0; y < y1; y += stepY {
+		for x := x0; x < x1; x += stepX {
+			r, g, b, _ := img.At(x, y).RGBA()
+			sum += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+			count++
+		}
+	}
+	return sum / float64(count)
+}
@@ -0,0 +1,192 @@
+package image_validation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	Formats:        []string{"jpeg", "png"},
+	MaxBytes:       1 << 20,
+	MinWidth:       32,
+	MinHeight:      32,
+	MaxWidth:       1024,
+	MaxHeight:      1024,
+	MinAspectRatio: 0.5,
+	MaxAspectRatio: 2,
+}
+
+// gradient рисует горизонтальный градиент; inverted меняет направление
+func gradient(width, height int, inverted bool) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := uint8(x * 255 / width)
+			if inverted {
+				v = 255 - v
+			}
+			img.Set(x, y, color.RGBA{R: v, G: v, B: v, A: 255})
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}))
+	return buf.Bytes()
+}
+
+// withJPEGExif добавляет сегмент APP1 с EXIF сразу после SOI
+func withJPEGExif(data []byte, payload string) []byte {
+	segment := append([]byte("Exif\x00\x00"), payload...)
+	header := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(header[2:], uint16(len(segment)+2))
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, header...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+// withPNGChunk добавляет чанк сразу после IHDR
+func withPNGChunk(data []byte, chunkType, payload string) []byte {
+	chunk := make([]byte, 8, 12+len(payload))
+	binary.BigEndian.PutUint32(chunk, uint32(len(payload)))
+	copy(chunk[4:], chunkType)
+	chunk = append(chunk, payload...)
+	crc := crc32.ChecksumIEEE(chunk[4:])
+	chunk = binary.BigEndian.AppendUint32(chunk, crc)
+
+	// сигнатура (8) + IHDR (4 + 4 + 13 + 4)
+	ihdrEnd := 8 + 25
+	out := append([]byte{}, data[:ihdrEnd]...)
+	out = append(out, chunk...)
+	return append(out, data[ihdrEnd:]...)
+}
+
+func TestValidate(t *testing.T) {
+	v := NewValidator(testConfig)
+
+	t.Run("valid png", func(t *testing.T) {
+		img, err := v.Validate(bytes.NewReader(encodePNG(t, gradient(100, 80, false))))
+		require.NoError(t, err)
+		assert.Equal(t, "png", img.Format)
+		assert.Equal(t, 100, img.Width)
+		assert.Equal(t, 80, img.Height)
+	})
+
+	tests := []struct {
+		name    string
+		data    func(t *testing.T) []byte
+		message string
+	}{
+		{
+			name:    "not an image",
+			data:    func(t *testing.T) []byte { return []byte("hello world") },
+			message: "not a supported image",
+		},
+		{
+			name: "format not allowed",
+			data: func(t *testing.T) []byte {
+				var buf bytes.Buffer
+				require.NoError(t, gif.Encode(&buf, gradient(64, 64, false), nil))
+				return buf.Bytes()
+			},
+			message: "format gif is not allowed",
+		},
+		{
+			name:    "too small",
+			data:    func(t *testing.T) []byte { return encodePNG(t, gradient(16, 16, false)) },
+			message: "minimum is 32x32",
+		},
+		{
+			name:    "too big",
+			data:    func(t *testing.T) []byte { return encodePNG(t, gradient(1200, 800, false)) },
+			message: "maximum is 1024x1024",
+		},
+		{
+			name:    "aspect ratio",
+			data:    func(t *testing.T) []byte { return encodePNG(t, gradient(300, 100, false)) },
+			message: "aspect ratio 3.00",
+		},
+		{
+			name: "too many bytes",
+			data: func(t *testing.T) []byte {
+				return append(encodePNG(t, gradient(64, 64, false)), make([]byte, 1<<20)...)
+			},
+			message: "larger than 1048576 bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.Validate(bytes.NewReader(tt.data(t)))
+			require.ErrorIs(t, err, ErrInvalidImage)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
+
+func TestStripMetadata(t *testing.T) {
+	v := NewValidator(testConfig)
+
+	t.Run("jpeg exif", func(t *testing.T) {
+		original := encodeJPEG(t, gradient(64, 64, false))
+		data := withJPEGExif(original, "GPS secret location")
+
+		img, err := v.Validate(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.NotContains(t, string(img.Data), "GPS secret location")
+		assert.Equal(t, original, img.Data)
+
+		_, err = jpeg.Decode(bytes.NewReader(img.Data))
+		assert.NoError(t, err)
+	})
+
+	t.Run("png text and exif chunks", func(t *testing.T) {
+		original := encodePNG(t, gradient(64, 64, false))
+		data := withPNGChunk(withPNGChunk(original, "tEXt", "Author\x00secret"), "eXIf", "MM\x00*exif")
+
+		img, err := v.Validate(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.NotContains(t, string(img.Data), "secret")
+		assert.Equal(t, original, img.Data)
+
+		_, err = png.Decode(bytes.NewReader(img.Data))
+		assert.NoError(t, err)
+	})
+}
+
+func TestHash(t *testing.T) {
+	v := NewValidator(testConfig)
+
+	pngImage, err := v.Validate(bytes.NewReader(encodePNG(t, gradient(200, 150, false))))
+	require.NoError(t, err)
+
+	// То же изображение в другом формате и размере
+	jpegImage, err := v.Validate(bytes.NewReader(encodeJPEG(t, gradient(400, 300, false))))
+	require.NoError(t, err)
+
+	invertedImage, err := v.Validate(bytes.NewReader(encodePNG(t, gradient(200, 150, true))))
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, Distance(pngImage.Hash, jpegImage.Hash), 5)
+	assert.Greater(t, Distance(pngImage.Hash, invertedImage.Hash), 32)
+}
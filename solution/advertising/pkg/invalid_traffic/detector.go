@@ -0,0 +1,83 @@
+package invalid_traffic
+
+import "time"
+
+// Reason — причина, по которой клик признан недействительным
+type Reason string
+
+const (
+	// ReasonTooFast — клик слишком быстро после показа, так не успевает среагировать человек
+	ReasonTooFast Reason = "TOO_FAST"
+	// ReasonIPBurst — всплеск кликов с одного IP-адреса
+	ReasonIPBurst Reason = "IP_BURST"
+	// ReasonClickEverything — клиент кликает по всем показанным ему объявлениям
+	ReasonClickEverything Reason = "CLICK_EVERYTHING"
+	// ReasonHighCTR — аномально высокий CTR клиента
+	ReasonHighCTR Reason = "HIGH_CTR"
+)
+
+// Reasons перечисляет причины в порядке проверки
+var Reasons = []Reason{ReasonTooFast, ReasonIPBurst, ReasonClickEverything, ReasonHighCTR}
+
+// Config задает пороги проверок. Нулевые значения отключают соответствующую проверку
+type Config struct {
+	// MinClickDelay — минимальное время между показом и кликом
+	MinClickDelay time.Duration
+	// MaxIPClicks — сколько кликов допускается с одного IP за окно Signals.IPClicks
+	MaxIPClicks int
+	// ClickEverythingMin — с какого числа кликов клиент, кликнувший по всем показам, считается ботом
+	ClickEverythingMin int
+	// MaxClientCTR — максимальная доля кликов от показов клиенту, от 0 до 1
+	MaxClientCTR float64
+	// MinClientImpressions — с какого числа показов проверяется CTR клиента
+	MinClientImpressions int
+}
+
+// Signals — поведение клиента на момент клика. Счетчики кликов включают проверяемый клик
+type Signals struct {
+	// SinceImpression — время от последнего показа объявления клиенту
+	SinceImpression time.Duration
+	// IPClicks — клики с IP-адреса клиента за последнее время
+	IPClicks int
+	// ClientImpressions — объявления, показанные клиенту за последние дни
+	ClientImpressions int
+	// ClientClicks — объявления, по которым клиент кликнул за те же дни
+	ClientClicks int
+}
+
+type Detector interface {
+	// Check возвращает причину, по которой клик недействителен, или пустую строку
+	Check(signals Signals) Reason
+}
+
+type detector struct {
+	config Config
+}
+
+func NewDetector(config Config) Detector {
+	return &detector{config: config}
+}
+
+func (d *detector) Check(signals Signals) Reason {
+	c := d.config
+
+	if c.MinClickDelay > 0 && signals.SinceImpression < c.MinClickDelay {
+		return ReasonTooFast
+	}
+	if c.MaxIPClicks > 0 && signals.IPClicks > c.MaxIPClicks {
+		return ReasonIPBurst
+	}
+	if c.ClickEverythingMin > 0 &&
+		signals.ClientClicks >= c.ClickEverythingMin &&
+		signals.ClientClicks >= signals.ClientImpressions {
+		return ReasonClickEverything
+	}
+	if c.MaxClientCTR > 0 &&
+		signals.ClientImpressions >= c.MinClientImpressions &&
+		signals.ClientImpressions > 0 &&
+		float64(signals.ClientClicks)/float64(signals.ClientImpressions) > c.MaxClientCTR {
+		return ReasonHighCTR
+	}
+
+	return ""
+}
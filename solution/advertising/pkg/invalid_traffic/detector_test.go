@@ -0,0 +1,117 @@
+package invalid_traffic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testConfig = Config{
+	MinClickDelay:        time.Second,
+	MaxIPClicks:          10,
+	ClickEverythingMin:   5,
+	MaxClientCTR:         0.5,
+	MinClientImpressions: 10,
+}
+
+func TestCheck(t *testing.T) {
+	d := NewDetector(testConfig)
+
+	normal := Signals{
+		SinceImpression:   5 * time.Second,
+		IPClicks:          1,
+		ClientImpressions: 20,
+		ClientClicks:      2,
+	}
+
+	tests := []struct {
+		name    string
+		signals func(s Signals) Signals
+		reason  Reason
+	}{
+		{
+			name:    "valid",
+			signals: func(s Signals) Signals { return s },
+			reason:  "",
+		},
+		{
+			name: "too fast",
+			signals: func(s Signals) Signals {
+				s.SinceImpression = 200 * time.Millisecond
+				return s
+			},
+			reason: ReasonTooFast,
+		},
+		{
+			name: "ip burst",
+			signals: func(s Signals) Signals {
+				s.IPClicks = 11
+				return s
+			},
+			reason: ReasonIPBurst,
+		},
+		{
+			name: "click everything",
+			signals: func(s Signals) Signals {
+				s.ClientImpressions = 6
+				s.ClientClicks = 6
+				return s
+			},
+			reason: ReasonClickEverything,
+		},
+		{
+			name: "few clicks on everything shown",
+			signals: func(s Signals) Signals {
+				s.ClientImpressions = 3
+				s.ClientClicks = 3
+				return s
+			},
+			reason: "",
+		},
+		{
+			name: "high ctr",
+			signals: func(s Signals) Signals {
+				s.ClientImpressions = 12
+				s.ClientClicks = 7
+				return s
+			},
+			reason: ReasonHighCTR,
+		},
+		{
+			name: "high ctr below min impressions",
+			signals: func(s Signals) Signals {
+				s.ClientImpressions = 4
+				s.ClientClicks = 3
+				return s
+			},
+			reason: "",
+		},
+		{
+			name: "first failed check wins",
+			signals: func(s Signals) Signals {
+				s.SinceImpression = 0
+				s.IPClicks = 100
+				return s
+			},
+			reason: ReasonTooFast,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.reason, d.Check(tt.signals(normal)))
+		})
+	}
+}
+
+func TestCheckDisabled(t *testing.T) {
+	d := NewDetector(Config{})
+
+	assert.Equal(t, Reason(""), d.Check(Signals{
+		SinceImpression:   0,
+		IPClicks:          1000,
+		ClientImpressions: 10,
+		ClientClicks:      10,
+	}))
+}
@@ -0,0 +1,67 @@
+package jsondiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Change — значения поля до и после изменения. Отсутствующее значение равно nil
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Diff сравнивает JSON-представления before и after по полям верхнего уровня
+// и возвращает только изменившиеся поля. nil означает, что объекта нет: при создании
+// before равен nil, при удалении — after. Значения, которые не являются JSON-объектами,
+// сравниваются целиком и попадают в поле "value"
+func Diff(before, after any) (map[string]Change, error) {
+	b, err := fields(before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode before: %w", err)
+	}
+	a, err := fields(after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode after: %w", err)
+	}
+
+	diff := make(map[string]Change)
+	for key, value := range b {
+		if other, ok := a[key]; !ok || !reflect.DeepEqual(value, other) {
+			diff[key] = Change{Before: value, After: a[key]}
+		}
+	}
+	for key, value := range a {
+		if _, ok := b[key]; !ok {
+			diff[key] = Change{After: value}
+		}
+	}
+
+	return diff, nil
+}
+
+func fields(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	switch decoded := decoded.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return decoded, nil
+	default:
+		return map[string]any{"value": decoded}, nil
+	}
+}
@@ -0,0 +1,91 @@
+package jsondiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type campaign struct {
+	Title  string   `json:"title"`
+	Limit  int      `json:"limit"`
+	Budget *float64 `json:"budget,omitempty"`
+	Tags   []string `json:"tags"`
+}
+
+func TestDiff(t *testing.T) {
+	budget := 10.0
+
+	tests := []struct {
+		name   string
+		before any
+		after  any
+		want   map[string]Change
+	}{
+		{
+			name:   "changed fields only",
+			before: campaign{Title: "old", Limit: 10, Tags: []string{"a"}},
+			after:  &campaign{Title: "new", Limit: 10, Tags: []string{"a"}},
+			want: map[string]Change{
+				"title": {Before: "old", After: "new"},
+			},
+		},
+		{
+			name:   "added and removed fields",
+			before: campaign{Title: "t", Budget: &budget},
+			after:  campaign{Title: "t", Tags: []string{"a", "b"}},
+			want: map[string]Change{
+				"budget": {Before: 10.0},
+				"tags":   {Before: nil, After: []any{"a", "b"}},
+			},
+		},
+		{
+			name:  "created",
+			after: map[string]int{"score": 5},
+			want: map[string]Change{
+				"score": {After: 5.0},
+			},
+		},
+		{
+			name:   "deleted",
+			before: map[string]string{"login": "user"},
+			want: map[string]Change{
+				"login": {Before: "user"},
+			},
+		},
+		{
+			name:   "scalar",
+			before: 1,
+			after:  2,
+			want: map[string]Change{
+				"value": {Before: 1.0, After: 2.0},
+			},
+		},
+		{
+			name:   "nil pointer",
+			before: (*campaign)(nil),
+			after:  nil,
+			want:   map[string]Change{},
+		},
+		{
+			name:   "equal",
+			before: campaign{Title: "t"},
+			after:  campaign{Title: "t"},
+			want:   map[string]Change{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := Diff(tt.before, tt.after)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, diff)
+		})
+	}
+}
+
+func TestDiffError(t *testing.T) {
+	_, err := Diff(make(chan int), nil)
+	assert.Error(t, err)
+}
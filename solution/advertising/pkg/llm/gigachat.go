@@ -0,0 +1,125 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"nlypage-final/pkg/gigachat"
+)
+
+const defaultGigaChatModel = "GigaChat"
+
+type gigaChatProvider struct {
+	client *gigachat.Client
+	model  string
+	// mu защищает токен клиента от одновременного обновления
+	mu sync.Mutex
+}
+
+// NewGigaChatProvider создает провайдер GigaChat. Токен обновляется только при истечении
+func NewGigaChatProvider(client *gigachat.Client, model string) Provider {
+	if model == "" {
+		model = defaultGigaChatModel
+	}
+	return &gigaChatProvider{
+		client: client,
+		model:  model,
+	}
+}
+
+func (p *gigaChatProvider) Complete(ctx context.Context, req Request) (*Response, error) {
+	if err := p.auth(ctx); err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.ChatWithContext(ctx, p.chatRequest(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete with gigachat: %w", err)
+	}
+	if len(response.Choices) == 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	result := &Response{Choices: make([]string, 0, len(response.Choices))}
+	for _, choice := range response.Choices {
+		result.Choices = append(result.Choices, choice.Message.Content)
+	}
+	return result, nil
+}
+
+func (p *gigaChatProvider) Stream(ctx context.Context, req Request, onDelta DeltaFunc) (string, error) {
+	if err := p.auth(ctx); err != nil {
+		return "", err
+	}
+
+	stream, err := p.client.ChatStreamWithContext(ctx, p.chatRequest(req))
+	if err != nil {
+		return "", fmt.Errorf("failed to stream from gigachat: %w", err)
+	}
+	defer stream.Close()
+
+	var text strings.Builder
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, choice := range chunk.Choices {
+			if choice.Index != 0 || choice.Delta.Content == "" {
+				continue
+			}
+			text.WriteString(choice.Delta.Content)
+			if err := onDelta(choice.Delta.Content); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if text.Len() == 0 {
+		return "", ErrEmptyResponse
+	}
+	return text.String(), nil
+}
+
+func (p *gigaChatProvider) auth(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.client.AuthWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to auth in gigachat: %w", err)
+	}
+	return nil
+}
+
+func (p *gigaChatProvider) chatRequest(req Request) *gigachat.ChatRequest {
+	chatRequest := &gigachat.ChatRequest{
+		Model:       p.model,
+		Messages:    make([]gigachat.Message, 0, len(req.Messages)),
+		Temperature: req.Temperature,
+	}
+	if req.Model != "" {
+		chatRequest.Model = req.Model
+	}
+	for _, message := range req.Messages {
+		chatRequest.Messages = append(chatRequest.Messages, gigachat.Message{
+			Role:    message.Role,
+			Content: message.Content,
+		})
+	}
+	if req.N > 1 {
+		n := int64(req.N)
+		chatRequest.N = &n
+	}
+	if req.MaxTokens > 0 {
+		maxTokens := int64(req.MaxTokens)
+		chatRequest.MaxTokens = &maxTokens
+	}
+	return chatRequest
+}
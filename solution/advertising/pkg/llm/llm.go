@@ -0,0 +1,74 @@
+// Package llm описывает общий интерфейс языковых моделей, чтобы сервисы не зависели от конкретного провайдера
+package llm
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// ErrEmptyResponse возвращается, если модель не вернула ни одного варианта
+var ErrEmptyResponse = errors.New("empty model response")
+
+type Message struct {
+	Role    string
+	Content string
+}
+
+type Request struct {
+	// Model переопределяет модель провайдера по умолчанию
+	Model    string
+	Messages []Message
+	// N — количество вариантов ответа, 0 означает один
+	N           int
+	Temperature *float64
+	MaxTokens   int
+	// JSON просит модель ответить JSON-объектом, если провайдер это поддерживает
+	JSON bool
+}
+
+type Response struct {
+	// Choices — тексты вариантов ответа в порядке модели
+	Choices []string
+}
+
+// Provider — языковая модель, которая дополняет диалог
+type Provider interface {
+	Complete(ctx context.Context, req Request) (*Response, error)
+}
+
+// UserPrompt — запрос из одного сообщения пользователя
+func UserPrompt(content string) []Message {
+	return []Message{{Role: RoleUser, Content: content}}
+}
+
+// DeltaFunc получает очередную часть ответа. Ошибка прерывает генерацию
+type DeltaFunc func(delta string) error
+
+// Streamer — провайдер, который умеет отдавать ответ по частям. Поток всегда содержит один вариант
+type Streamer interface {
+	Stream(ctx context.Context, req Request, onDelta DeltaFunc) (string, error)
+}
+
+// Stream генерирует ответ по частям и возвращает полный текст. Если провайдер не поддерживает потоковую генерацию,
+// ответ передается в onDelta одной частью
+func Stream(ctx context.Context, provider Provider, req Request, onDelta DeltaFunc) (string, error) {
+	req.N = 1
+	if streamer, ok := provider.(Streamer); ok {
+		return streamer.Stream(ctx, req, onDelta)
+	}
+
+	response, err := provider.Complete(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if err := onDelta(response.Choices[0]); err != nil {
+		return "", err
+	}
+	return response.Choices[0], nil
+}
@@ -0,0 +1,205 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nlypage-final/pkg/gigachat"
+	"nlypage-final/pkg/gigachat/gigachattest"
+)
+
+func TestGigaChatProvider(t *testing.T) {
+	server := gigachattest.NewServer(func(req *gigachat.ChatRequest) (string, error) {
+		return "ответ на " + req.Messages[0].Content, nil
+	})
+	defer server.Close()
+
+	provider := NewGigaChatProvider(server.Client(), "")
+	temperature := 0.5
+
+	response, err := provider.Complete(context.Background(), Request{
+		Messages:    UserPrompt("привет"),
+		N:           2,
+		Temperature: &temperature,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ответ на привет"}, response.Choices)
+
+	_, err = provider.Complete(context.Background(), Request{Model: "GigaChat-Pro", Messages: UserPrompt("еще")})
+	require.NoError(t, err)
+
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, defaultGigaChatModel, requests[0].Model)
+	require.NotNil(t, requests[0].N)
+	assert.EqualValues(t, 2, *requests[0].N)
+	assert.Equal(t, &temperature, requests[0].Temperature)
+	assert.Equal(t, "GigaChat-Pro", requests[1].Model)
+	assert.Nil(t, requests[1].N)
+}
+
+func TestOpenAIProvider(t *testing.T) {
+	var received openAIChatRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{
+				{"message": map[string]string{"role": "assistant", "content": "первый"}},
+				{"message": map[string]string{"role": "assistant", "content": "второй"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(OpenAIConfig{
+		BaseURL: server.URL + "/v1/",
+		APIKey:  "secret",
+		Model:   "gpt-4o-mini",
+	})
+
+	response, err := provider.Complete(context.Background(), Request{
+		Messages: []Message{
+			{Role: RoleSystem, Content: "ты копирайтер"},
+			{Role: RoleUser, Content: "напиши текст"},
+		},
+		N:    2,
+		JSON: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"первый", "второй"}, response.Choices)
+
+	assert.Equal(t, "gpt-4o-mini", received.Model)
+	assert.Equal(t, 2, received.N)
+	require.Len(t, received.Messages, 2)
+	assert.Equal(t, RoleSystem, received.Messages[0].Role)
+	require.NotNil(t, received.ResponseFormat)
+	assert.Equal(t, "json_object", received.ResponseFormat.Type)
+}
+
+func TestOpenAIProviderErrors(t *testing.T) {
+	t.Run("status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "rate limited", http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		_, err := NewOpenAIProvider(OpenAIConfig{BaseURL: server.URL}).Complete(context.Background(), Request{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "429")
+		assert.Contains(t, err.Error(), "rate limited")
+	})
+
+	t.Run("no choices", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"choices": []}`))
+		}))
+		defer server.Close()
+
+		_, err := NewOpenAIProvider(OpenAIConfig{BaseURL: server.URL}).Complete(context.Background(), Request{})
+		assert.ErrorIs(t, err, ErrEmptyResponse)
+	})
+}
+
+func TestStubProvider(t *testing.T) {
+	provider := NewStubProvider(nil)
+	req := Request{Messages: UserPrompt("напиши текст"), N: 3}
+
+	first, err := provider.Complete(context.Background(), req)
+	require.NoError(t, err)
+	second, err := provider.Complete(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Len(t, first.Choices, 3)
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first.Choices[0], first.Choices[1])
+
+	other, err := provider.Complete(context.Background(), Request{Messages: UserPrompt("другой текст")})
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Choices[0], other.Choices[0])
+
+	jsonResponse, err := provider.Complete(context.Background(), Request{JSON: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"{}"}, jsonResponse.Choices)
+
+	custom := NewStubProvider(func(req Request, choice int) string { return "fixed" })
+	response, err := custom.Complete(context.Background(), Request{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fixed"}, response.Choices)
+}
+
+func collect(t *testing.T, provider Provider, req Request) (string, []string) {
+	var deltas []string
+	text, err := Stream(context.Background(), provider, req, func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+	require.NoError(t, err)
+	return text, deltas
+}
+
+func TestStreamGigaChat(t *testing.T) {
+	server := gigachattest.NewServer(func(req *gigachat.ChatRequest) (string, error) {
+		return "Свежие цветы каждый день", nil
+	})
+	defer server.Close()
+
+	text, deltas := collect(t, NewGigaChatProvider(server.Client(), ""), Request{Messages: UserPrompt("текст"), N: 3})
+	assert.Equal(t, "Свежие цветы каждый день", text)
+	assert.Len(t, deltas, 4)
+
+	// Поток всегда содержит один вариант
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	assert.Nil(t, requests[0].N)
+}
+
+func TestStreamOpenAI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openAIChatRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.True(t, req.Stream)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, part := range []string{"Привет", ", ", "мир"} {
+			chunk, _ := json.Marshal(map[string]any{
+				"choices": []map[string]any{{"index": 0, "delta": map[string]string{"content": part}}},
+			})
+			_, _ = w.Write([]byte("data: " + string(chunk) + "\n\n"))
+		}
+		_, _ = w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	text, deltas := collect(t, NewOpenAIProvider(OpenAIConfig{BaseURL: server.URL}), Request{Messages: UserPrompt("текст")})
+	assert.Equal(t, "Привет, мир", text)
+	assert.Equal(t, []string{"Привет", ", ", "мир"}, deltas)
+}
+
+type completeOnly struct{}
+
+func (completeOnly) Complete(context.Context, Request) (*Response, error) {
+	return &Response{Choices: []string{"целиком"}}, nil
+}
+
+func TestStreamFallback(t *testing.T) {
+	text, deltas := collect(t, completeOnly{}, Request{})
+	assert.Equal(t, "целиком", text)
+	assert.Equal(t, []string{"целиком"}, deltas)
+}
+
+func TestStreamStopsOnError(t *testing.T) {
+	stop := errors.New("client disconnected")
+	_, err := Stream(context.Background(), NewStubProvider(nil), Request{Messages: UserPrompt("текст")}, func(string) error {
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+}
@@ -0,0 +1,192 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"nlypage-final/pkg/sse"
+)
+
+// OpenAIConfig — настройки провайдера с OpenAI-совместимым API (OpenAI, vLLM, Ollama, LM Studio и т.п.)
+type OpenAIConfig struct {
+	// BaseURL — адрес API вместе с версией, например https://api.openai.com/v1
+	BaseURL string
+	APIKey  string
+	Model   string
+	// HTTPClient по умолчанию http.DefaultClient
+	HTTPClient *http.Client
+}
+
+type openAIProvider struct {
+	config OpenAIConfig
+}
+
+func NewOpenAIProvider(config OpenAIConfig) Provider {
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	config.BaseURL = strings.TrimSuffix(config.BaseURL, "/")
+	return &openAIProvider{config: config}
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIResponseFormat struct {
+	Type string `json:"type"`
+}
+
+type openAIChatRequest struct {
+	Model          string                `json:"model"`
+	Messages       []openAIMessage       `json:"messages"`
+	N              int                   `json:"n,omitempty"`
+	Temperature    *float64              `json:"temperature,omitempty"`
+	MaxTokens      int                   `json:"max_tokens,omitempty"`
+	ResponseFormat *openAIResponseFormat `json:"response_format,omitempty"`
+	Stream         bool                  `json:"stream,omitempty"`
+}
+
+const openAIStreamDone = "[DONE]"
+
+type openAIStreamChunk struct {
+	Choices []struct {
+		Index int           `json:"index"`
+		Delta openAIMessage `json:"delta"`
+	} `json:"choices"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (p *openAIProvider) Complete(ctx context.Context, req Request) (*Response, error) {
+	httpResponse, err := p.send(ctx, p.chatRequest(req, false))
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	var chatResponse openAIChatResponse
+	if err := json.NewDecoder(httpResponse.Body).Decode(&chatResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(chatResponse.Choices) == 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	result := &Response{Choices: make([]string, 0, len(chatResponse.Choices))}
+	for _, choice := range chatResponse.Choices {
+		result.Choices = append(result.Choices, choice.Message.Content)
+	}
+	return result, nil
+}
+
+func (p *openAIProvider) Stream(ctx context.Context, req Request, onDelta DeltaFunc) (string, error) {
+	httpResponse, err := p.send(ctx, p.chatRequest(req, true))
+	if err != nil {
+		return "", err
+	}
+	defer httpResponse.Body.Close()
+
+	var text strings.Builder
+	reader := sse.NewReader(httpResponse.Body)
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read stream: %w", err)
+		}
+		if event.Data == openAIStreamDone {
+			break
+		}
+
+		var chunk openAIStreamChunk
+		if err := json.Unmarshal([]byte(event.Data), &chunk); err != nil {
+			return "", fmt.Errorf("failed to decode stream chunk: %w", err)
+		}
+		for _, choice := range chunk.Choices {
+			if choice.Index != 0 || choice.Delta.Content == "" {
+				continue
+			}
+			text.WriteString(choice.Delta.Content)
+			if err := onDelta(choice.Delta.Content); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if text.Len() == 0 {
+		return "", ErrEmptyResponse
+	}
+	return text.String(), nil
+}
+
+func (p *openAIProvider) chatRequest(req Request, stream bool) openAIChatRequest {
+	chatRequest := openAIChatRequest{
+		Model:       p.config.Model,
+		Messages:    make([]openAIMessage, 0, len(req.Messages)),
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+		Stream:      stream,
+	}
+	if req.Model != "" {
+		chatRequest.Model = req.Model
+	}
+	for _, message := range req.Messages {
+		chatRequest.Messages = append(chatRequest.Messages, openAIMessage{
+			Role:    message.Role,
+			Content: message.Content,
+		})
+	}
+	if req.N > 1 {
+		chatRequest.N = req.N
+	}
+	if req.JSON {
+		chatRequest.ResponseFormat = &openAIResponseFormat{Type: "json_object"}
+	}
+	return chatRequest
+}
+
+// send отправляет запрос и возвращает ответ со статусом 200. Тело ответа нужно закрыть
+func (p *openAIProvider) send(ctx context.Context, chatRequest openAIChatRequest) (*http.Response, error) {
+	body, err := json.Marshal(chatRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.BaseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	if chatRequest.Stream {
+		httpRequest.Header.Set("Accept", sse.ContentType)
+	}
+	if p.config.APIKey != "" {
+		httpRequest.Header.Set("Authorization", "Bearer "+p.config.APIKey)
+	}
+
+	httpResponse, err := p.config.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		defer httpResponse.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(httpResponse.Body, 1024))
+		return nil, fmt.Errorf("unexpected status %d: %s", httpResponse.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return httpResponse, nil
+}
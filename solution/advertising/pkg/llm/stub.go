@@ -0,0 +1,58 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// StubRespondFunc возвращает ответ заглушки на запрос
+type StubRespondFunc func(req Request, choice int) string
+
+type stubProvider struct {
+	respond StubRespondFunc
+}
+
+// NewStubProvider создает детерминированную заглушку для тестов и окружений без доступа к модели.
+// Если respond равен nil, на JSON-запросы возвращается пустой объект, а на остальные — текст,
+// зависящий только от запроса и номера варианта
+func NewStubProvider(respond StubRespondFunc) Provider {
+	if respond == nil {
+		respond = defaultStubResponse
+	}
+	return &stubProvider{respond: respond}
+}
+
+func (p *stubProvider) Complete(_ context.Context, req Request) (*Response, error) {
+	n := max(req.N, 1)
+	result := &Response{Choices: make([]string, 0, n)}
+	for i := 0; i < n; i++ {
+		result.Choices = append(result.Choices, p.respond(req, i))
+	}
+	return result, nil
+}
+
+// Stream отдает ответ по словам
+func (p *stubProvider) Stream(_ context.Context, req Request, onDelta DeltaFunc) (string, error) {
+	text := p.respond(req, 0)
+	for _, part := range strings.SplitAfter(text, " ") {
+		if err := onDelta(part); err != nil {
+			return "", err
+		}
+	}
+	return text, nil
+}
+
+func defaultStubResponse(req Request, choice int) string {
+	if req.JSON {
+		return "{}"
+	}
+
+	hash := fnv.New32a()
+	for _, message := range req.Messages {
+		_, _ = hash.Write([]byte(message.Role))
+		_, _ = hash.Write([]byte(message.Content))
+	}
+	return fmt.Sprintf("Stub response %d (%08x)", choice+1, hash.Sum32())
+}
@@ -0,0 +1,101 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gomono"
+)
+
+// Размеры страницы A4 и поля в пунктах
+const (
+	pageWidth  = 595
+	pageHeight = 842
+	margin     = 50
+)
+
+// fontFamily — встроенный моноширинный шрифт Go Mono. Он содержит кириллицу,
+// а одинаковая ширина символов позволяет выравнивать таблицы пробелами
+const fontFamily = "GoMono"
+
+// Document — текстовый PDF-документ из строк одного размера
+type Document struct {
+	fontSize float64
+	pages    [][]string
+	// compress сжимает потоки страниц, в тестах отключается, чтобы читать содержимое
+	compress bool
+}
+
+// NewDocument создает пустой документ с размером шрифта fontSize
+func NewDocument(fontSize float64) *Document {
+	return &Document{
+		fontSize: fontSize,
+		pages:    [][]string{{}},
+		compress: true,
+	}
+}
+
+func (d *Document) leading() float64 {
+	return d.fontSize * 1.2
+}
+
+func (d *Document) linesPerPage() int {
+	return int((pageHeight - 2*margin) / d.leading())
+}
+
+// Line добавляет строку, при заполнении страницы начинается новая
+func (d *Document) Line(text string) {
+	last := len(d.pages) - 1
+	if len(d.pages[last]) >= d.linesPerPage() {
+		d.pages = append(d.pages, nil)
+		last++
+	}
+	d.pages[last] = append(d.pages[last], text)
+}
+
+// Linef добавляет строку, отформатированную как fmt.Sprintf
+func (d *Document) Linef(format string, args ...any) {
+	d.Line(fmt.Sprintf(format, args...))
+}
+
+// PageBreak начинает новую страницу, если текущая не пуста
+func (d *Document) PageBreak() {
+	if len(d.pages[len(d.pages)-1]) > 0 {
+		d.pages = append(d.pages, nil)
+	}
+}
+
+// Pages возвращает количество страниц
+func (d *Document) Pages() int {
+	return len(d.pages)
+}
+
+// WriteTo записывает документ в формате PDF со встроенным шрифтом
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	doc := fpdf.NewCustom(&fpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "pt",
+		Size:           fpdf.SizeType{Wd: pageWidth, Ht: pageHeight},
+	})
+	doc.SetCompression(d.compress)
+	doc.SetMargins(margin, margin, margin)
+	doc.SetAutoPageBreak(false, margin)
+	doc.AddUTF8FontFromBytes(fontFamily, "", gomono.TTF)
+	doc.SetFont(fontFamily, "", d.fontSize)
+
+	for _, lines := range d.pages {
+		doc.AddPage()
+		for i, line := range lines {
+			doc.SetXY(margin, margin+float64(i)*d.leading())
+			doc.CellFormat(0, d.leading(), line, "", 0, "L", false, 0, "")
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Output(&buf); err != nil {
+		return 0, fmt.Errorf("failed to render pdf: %w", err)
+	}
+	return buf.WriteTo(w)
+}
@@ -0,0 +1,106 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, doc *Document) string {
+	t.Helper()
+
+	doc.compress = false
+	var buf bytes.Buffer
+	n, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	return buf.String()
+}
+
+// shown возвращает оператор вывода строки s: текст шрифта UTF-8 записывается в UTF-16BE
+func shown(s string) string {
+	var b strings.Builder
+	for _, r := range utf16.Encode([]rune(s)) {
+		for _, c := range []byte{byte(r >> 8), byte(r)} {
+			if c == '\\' || c == '(' || c == ')' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		}
+	}
+	return "(" + b.String() + ")Tj"
+}
+
+func TestDocument(t *testing.T) {
+	doc := NewDocument(10)
+	doc.Line("Invoice (draft)")
+	doc.Line(`C:\path`)
+	doc.Line("Кампания «Кофе»")
+
+	out := render(t, doc)
+	assert.True(t, strings.HasPrefix(out, "%PDF-"))
+	assert.True(t, strings.HasSuffix(strings.TrimSpace(out), "%%EOF"))
+	assert.Contains(t, out, shown("Invoice (draft)"))
+	assert.Contains(t, out, shown(`C:\path`))
+	assert.Contains(t, out, shown("Кампания «Кофе»"))
+	assert.Contains(t, out, "/Count 1")
+	// Шрифт встроен в документ, поэтому кириллица не зависит от шрифтов программы просмотра
+	assert.Contains(t, out, "/FontFile2")
+}
+
+func TestPages(t *testing.T) {
+	doc := NewDocument(10)
+	for i := 0; i < doc.linesPerPage()+1; i++ {
+		doc.Linef("line %d", i)
+	}
+	assert.Equal(t, 2, doc.Pages())
+
+	doc.PageBreak()
+	doc.PageBreak()
+	doc.Line("last")
+	assert.Equal(t, 3, doc.Pages())
+	assert.Contains(t, render(t, doc), "/Count 3")
+}
+
+func TestXref(t *testing.T) {
+	doc := NewDocument(12)
+	for i := 0; i < 100; i++ {
+		doc.Linef("строка %d", i)
+	}
+	out := render(t, doc)
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(out)
+	require.Len(t, startxref, 2)
+	xref, err := strconv.Atoi(startxref[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out[xref:], "xref\n"))
+
+	// Каждая запись таблицы xref указывает на начало своего объекта
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(out[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(entry[1])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[offset:], fmt.Sprintf("%d 0 obj\n", i+1)), "object %d", i+1)
+	}
+}
+
+func TestCompression(t *testing.T) {
+	doc := NewDocument(10)
+	for i := 0; i < 50; i++ {
+		doc.Linef("строка %d", i)
+	}
+
+	var compressed bytes.Buffer
+	_, err := doc.WriteTo(&compressed)
+	require.NoError(t, err)
+
+	assert.Less(t, compressed.Len(), len(render(t, doc)))
+}
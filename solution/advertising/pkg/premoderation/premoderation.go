@@ -0,0 +1,340 @@
+package premoderation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Verdict — итог проверки объявления
+type Verdict string
+
+const (
+	VerdictApprove Verdict = "APPROVE"
+	VerdictReject  Verdict = "REJECT"
+	VerdictReview  Verdict = "REVIEW"
+)
+
+const (
+	defaultReason            = "OTHER"
+	defaultMinFragmentLength = 20
+)
+
+// Violation — сработавшее правило
+type Violation struct {
+	Rule    string
+	Verdict Verdict
+	Reason  string
+	Message string
+}
+
+// Result — результат премодерации: вердикт и все сработавшие правила
+type Result struct {
+	Verdict    Verdict
+	Violations []Violation
+}
+
+// Reasons возвращает сообщения нарушений, влияющих на вердикт, через точку с запятой
+func (r Result) Reasons() string {
+	messages := make([]string, 0, len(r.Violations))
+	for _, v := range r.Violations {
+		if v.Verdict == r.Verdict {
+			messages = append(messages, v.Message)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Reason возвращает код причины первого нарушения, определившего вердикт
+func (r Result) Reason() string {
+	for _, v := range r.Violations {
+		if v.Verdict == r.Verdict {
+			return v.Reason
+		}
+	}
+	return defaultReason
+}
+
+type Checker interface {
+	Check(title, text string) Result
+}
+
+type compiledPattern struct {
+	Pattern
+	re *regexp.Regexp
+}
+
+type checker struct {
+	rules          Rules
+	wordLists      []WordList
+	patterns       []compiledPattern
+	detectLanguage func(text string) string
+}
+
+var urlRegexp = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+
+// NewChecker создает проверку по правилам. detectLanguage определяет язык фрагмента текста
+// и возвращает "unknown", если язык определить не удалось
+func NewChecker(rules Rules, detectLanguage func(text string) string) (Checker, error) {
+	c := &checker{
+		rules:          rules,
+		detectLanguage: detectLanguage,
+	}
+
+	for _, list := range rules.BannedWords {
+		verdict, err := violationVerdict(list.Verdict)
+		if err != nil {
+			return nil, fmt.Errorf("banned words %q: %w", list.Name, err)
+		}
+		list.Verdict = verdict
+		words := make([]string, 0, len(list.Words))
+		for _, word := range list.Words {
+			if normalized := normalize(word); normalized != "" {
+				words = append(words, normalized)
+			}
+		}
+		list.Words = words
+		c.wordLists = append(c.wordLists, list)
+	}
+
+	for _, pattern := range rules.Patterns {
+		verdict, err := violationVerdict(pattern.Verdict)
+		if err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", pattern.Name, err)
+		}
+		re, err := regexp.Compile(pattern.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", pattern.Name, err)
+		}
+		pattern.Verdict = verdict
+		c.patterns = append(c.patterns, compiledPattern{Pattern: pattern, re: re})
+	}
+
+	var err error
+	if c.rules.URLs.DenyVerdict, err = violationVerdict(rules.URLs.DenyVerdict); err != nil {
+		return nil, fmt.Errorf("urls: %w", err)
+	}
+	if rules.URLs.UnknownVerdict != "" {
+		if c.rules.URLs.UnknownVerdict, err = violationVerdict(rules.URLs.UnknownVerdict); err != nil {
+			return nil, fmt.Errorf("urls: %w", err)
+		}
+	}
+	if c.rules.Length.Verdict, err = violationVerdict(rules.Length.Verdict); err != nil {
+		return nil, fmt.Errorf("length: %w", err)
+	}
+	if rules.MixedLanguages.Verdict != "" {
+		if c.rules.MixedLanguages.Verdict, err = violationVerdict(rules.MixedLanguages.Verdict); err != nil {
+			return nil, fmt.Errorf("mixed languages: %w", err)
+		}
+		if c.rules.MixedLanguages.MinFragmentLength <= 0 {
+			c.rules.MixedLanguages.MinFragmentLength = defaultMinFragmentLength
+		}
+	}
+
+	return c, nil
+}
+
+// violationVerdict проверяет вердикт правила; по умолчанию нарушение отправляется модератору
+func violationVerdict(verdict Verdict) (Verdict, error) {
+	switch verdict {
+	case "":
+		return VerdictReview, nil
+	case VerdictReject, VerdictReview:
+		return verdict, nil
+	default:
+		return "", fmt.Errorf("unsupported verdict %q", verdict)
+	}
+}
+
+func (c *checker) Check(title, text string) Result {
+	var violations []Violation
+	violations = append(violations, c.checkBannedWords(title, text)...)
+	violations = append(violations, c.checkPatterns(title, text)...)
+	violations = append(violations, c.checkURLs(title, text)...)
+	violations = append(violations, c.checkLength(title, text)...)
+	violations = append(violations, c.checkMixedLanguages(title, text)...)
+
+	result := Result{Verdict: VerdictApprove, Violations: violations}
+	for _, v := range violations {
+		if v.Verdict == VerdictReject {
+			result.Verdict = VerdictReject
+			break
+		}
+		result.Verdict = VerdictReview
+	}
+	return result
+}
+
+func (c *checker) checkBannedWords(title, text string) []Violation {
+	// Пробелы по краям позволяют искать слова и фразы целиком
+	normalized := " " + normalize(title) + " " + normalize(text) + " "
+
+	var violations []Violation
+	for _, list := range c.wordLists {
+		for _, word := range list.Words {
+			if strings.Contains(normalized, " "+word+" ") {
+				violations = append(violations, Violation{
+					Rule:    "banned_words:" + list.Name,
+					Verdict: list.Verdict,
+					Reason:  reasonOrDefault(list.Reason),
+					Message: fmt.Sprintf("banned word %q", word),
+				})
+			}
+		}
+	}
+	return violations
+}
+
+func (c *checker) checkPatterns(title, text string) []Violation {
+	var violations []Violation
+	for _, pattern := range c.patterns {
+		if match := pattern.re.FindString(title + "\n" + text); match != "" {
+			violations = append(violations, Violation{
+				Rule:    "patterns:" + pattern.Name,
+				Verdict: pattern.Verdict,
+				Reason:  reasonOrDefault(pattern.Reason),
+				Message: fmt.Sprintf("matches %s: %q", pattern.Name, match),
+			})
+		}
+	}
+	return violations
+}
+
+func (c *checker) checkURLs(title, text string) []Violation {
+	rules := c.rules.URLs
+
+	var violations []Violation
+	for _, link := range urlRegexp.FindAllString(title+"\n"+text, -1) {
+		host := linkHost(link)
+		if host == "" {
+			continue
+		}
+
+		switch {
+		case matchesDomain(host, rules.Deny):
+			violations = append(violations, Violation{
+				Rule:    "urls:deny",
+				Verdict: rules.DenyVerdict,
+				Reason:  reasonOrDefault(rules.DenyReason),
+				Message: fmt.Sprintf("link to denied domain %s", host),
+			})
+		case rules.UnknownVerdict != "" && !matchesDomain(host, rules.Allow):
+			violations = append(violations, Violation{
+				Rule:    "urls:unknown",
+				Verdict: rules.UnknownVerdict,
+				Reason:  reasonOrDefault(rules.UnknownReason),
+				Message: fmt.Sprintf("link to unknown domain %s", host),
+			})
+		}
+	}
+	return violations
+}
+
+func (c *checker) checkLength(title, text string) []Violation {
+	rules := c.rules.Length
+
+	var violations []Violation
+	check := func(field, value string, bounds Bounds) {
+		length := utf8.RuneCountInString(strings.TrimSpace(value))
+		switch {
+		case bounds.Min > 0 && length < bounds.Min:
+			violations = append(violations, Violation{
+				Rule:    "length:" + field,
+				Verdict: rules.Verdict,
+				Reason:  reasonOrDefault(rules.Reason),
+				Message: fmt.Sprintf("%s is shorter than %d characters", field, bounds.Min),
+			})
+		case bounds.Max > 0 && length > bounds.Max:
+			violations = append(violations, Violation{
+				Rule:    "length:" + field,
+				Verdict: rules.Verdict,
+				Reason:  reasonOrDefault(rules.Reason),
+				Message: fmt.Sprintf("%s is longer than %d characters", field, bounds.Max),
+			})
+		}
+	}
+	check("title", title, rules.Title)
+	check("text", text, rules.Text)
+	return violations
+}
+
+func (c *checker) checkMixedLanguages(title, text string) []Violation {
+	rules := c.rules.MixedLanguages
+	if rules.Verdict == "" || c.detectLanguage == nil {
+		return nil
+	}
+
+	// Ссылки не учитываются: домены латиницей иначе считались бы фрагментом на другом языке
+	text = urlRegexp.ReplaceAllString(text, " ")
+	fragments := append([]string{urlRegexp.ReplaceAllString(title, " ")}, strings.FieldsFunc(text, func(r rune) bool {
+		return r == '.' || r == '!' || r == '?' || r == ';' || r == '\n'
+	})...)
+
+	detected := make(map[string]struct{})
+	for _, fragment := range fragments {
+		fragment = strings.TrimSpace(fragment)
+		if utf8.RuneCountInString(fragment) < rules.MinFragmentLength {
+			continue
+		}
+		if language := c.detectLanguage(fragment); language != "unknown" {
+			detected[language] = struct{}{}
+		}
+	}
+	if len(detected) < 2 {
+		return nil
+	}
+
+	languages := make([]string, 0, len(detected))
+	for language := range detected {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	return []Violation{{
+		Rule:    "mixed_languages",
+		Verdict: rules.Verdict,
+		Reason:  reasonOrDefault(rules.Reason),
+		Message: fmt.Sprintf("mixed languages: %s", strings.Join(languages, ", ")),
+	}}
+}
+
+// normalize приводит текст к нижнему регистру и оставляет слова, разделенные одним пробелом
+func normalize(text string) string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+	return strings.Join(strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func linkHost(link string) string {
+	link = strings.TrimRight(link, ".,;:!?)")
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
+func matchesDomain(host string, domains []string) bool {
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func reasonOrDefault(reason string) string {
+	if reason == "" {
+		return defaultReason
+	}
+	return reason
+}
@@ -0,0 +1,199 @@
+package premoderation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRules() Rules {
+	return Rules{
+		BannedWords: []WordList{
+			{Name: "prohibited", Verdict: VerdictReject, Reason: "PROHIBITED_CONTENT", Words: []string{"казино", "ставки на спорт"}},
+			{Name: "sensitive", Verdict: VerdictReview, Words: []string{"кредит"}},
+		},
+		Patterns: []Pattern{
+			{Name: "guaranteed_income", Verdict: VerdictReject, Reason: "MISLEADING_CLAIMS", Pattern: `(?i)гарантированн\pL*\s+доход`},
+		},
+		URLs: URLRules{
+			Allow:          []string{"example.com"},
+			Deny:           []string{"bit.ly"},
+			DenyVerdict:    VerdictReject,
+			DenyReason:     "MISLEADING_CLAIMS",
+			UnknownVerdict: VerdictReview,
+		},
+		Length: LengthRules{
+			Title:   Bounds{Min: 3, Max: 20},
+			Text:    Bounds{Min: 5},
+			Verdict: VerdictReject,
+			Reason:  "LOW_QUALITY_CREATIVE",
+		},
+		MixedLanguages: MixedLanguages{
+			Verdict:           VerdictReview,
+			MinFragmentLength: 5,
+		},
+	}
+}
+
+// fakeDetectLanguage считает фрагменты с латиницей английскими, остальные — русскими
+func fakeDetectLanguage(text string) string {
+	for _, r := range text {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return "English"
+		}
+	}
+	return "Russian"
+}
+
+func TestCheck(t *testing.T) {
+	checker, err := NewChecker(testRules(), fakeDetectLanguage)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		title   string
+		text    string
+		verdict Verdict
+		reason  string
+		rules   []string
+	}{
+		{
+			name:    "clean ad",
+			title:   "Кофейня",
+			text:    "Лучший кофе в городе, заходите на https://example.com или https://shop.example.com/menu",
+			verdict: VerdictApprove,
+		},
+		{
+			name:    "banned word case insensitive",
+			title:   "Новое КАЗИНО",
+			text:    "Играйте каждый день",
+			verdict: VerdictReject,
+			reason:  "PROHIBITED_CONTENT",
+			rules:   []string{"banned_words:prohibited"},
+		},
+		{
+			name:    "banned phrase",
+			title:   "Спорт",
+			text:    "Лучшие ставки  на спорт!",
+			verdict: VerdictReject,
+			reason:  "PROHIBITED_CONTENT",
+			rules:   []string{"banned_words:prohibited"},
+		},
+		{
+			name:    "banned word only as whole word",
+			title:   "Кредитка",
+			text:    "Кредитная карта без комиссии",
+			verdict: VerdictApprove,
+		},
+		{
+			name:    "review word",
+			title:   "Банк",
+			text:    "Выгодный кредит для всех",
+			verdict: VerdictReview,
+			reason:  "OTHER",
+			rules:   []string{"banned_words:sensitive"},
+		},
+		{
+			name:    "pattern",
+			title:   "Работа",
+			text:    "Гарантированный доход от первого дня",
+			verdict: VerdictReject,
+			reason:  "MISLEADING_CLAIMS",
+			rules:   []string{"patterns:guaranteed_income"},
+		},
+		{
+			name:    "denied url",
+			title:   "Скидки",
+			text:    "Подробнее по ссылке https://bit.ly/abc",
+			verdict: VerdictReject,
+			reason:  "MISLEADING_CLAIMS",
+			rules:   []string{"urls:deny"},
+		},
+		{
+			name:    "unknown url",
+			title:   "Скидки",
+			text:    "Подробнее на www.other.org.",
+			verdict: VerdictReview,
+			rules:   []string{"urls:unknown"},
+		},
+		{
+			name:    "too short title and text",
+			title:   "А",
+			text:    "Да",
+			verdict: VerdictReject,
+			reason:  "LOW_QUALITY_CREATIVE",
+			rules:   []string{"length:title", "length:text"},
+		},
+		{
+			name:    "mixed languages",
+			title:   "Кофейня",
+			text:    "Лучший кофе в городе. Best coffee in town",
+			verdict: VerdictReview,
+			rules:   []string{"mixed_languages"},
+		},
+		{
+			name:    "reject wins over review",
+			title:   "Казино",
+			text:    "Выгодный кредит для игры",
+			verdict: VerdictReject,
+			reason:  "PROHIBITED_CONTENT",
+			rules:   []string{"banned_words:prohibited", "banned_words:sensitive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := checker.Check(tt.title, tt.text)
+			assert.Equal(t, tt.verdict, result.Verdict)
+
+			var rules []string
+			for _, v := range result.Violations {
+				rules = append(rules, v.Rule)
+			}
+			assert.Equal(t, tt.rules, rules)
+
+			if tt.reason != "" {
+				assert.Equal(t, tt.reason, result.Reason())
+			}
+			if tt.verdict != VerdictApprove {
+				assert.NotEmpty(t, result.Reasons())
+			}
+		})
+	}
+}
+
+func TestNewCheckerInvalidRules(t *testing.T) {
+	_, err := NewChecker(Rules{Patterns: []Pattern{{Name: "broken", Pattern: "("}}}, nil)
+	assert.Error(t, err)
+
+	_, err = NewChecker(Rules{BannedWords: []WordList{{Name: "approve", Verdict: VerdictApprove}}}, nil)
+	assert.Error(t, err)
+}
+
+func TestLoadRules(t *testing.T) {
+	rules, err := LoadRules(filepath.Join("..", "..", "resources", "premoderation.yml"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, rules.BannedWords)
+	assert.NotEmpty(t, rules.Patterns)
+
+	_, err = NewChecker(rules, fakeDetectLanguage)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "rules.yml")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join([]string{
+		"banned_words:",
+		"  - name: test",
+		"    verdict: REJECT",
+		"    words: [spam]",
+	}, "\n")), 0o600))
+
+	rules, err = LoadRules(path)
+	require.NoError(t, err)
+	require.Len(t, rules.BannedWords, 1)
+	assert.Equal(t, VerdictReject, rules.BannedWords[0].Verdict)
+	assert.Equal(t, []string{"spam"}, rules.BannedWords[0].Words)
+}
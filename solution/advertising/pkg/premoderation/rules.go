@@ -0,0 +1,82 @@
+package premoderation
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Rules описывает правила автоматической премодерации рекламного текста
+type Rules struct {
+	BannedWords    []WordList     `yaml:"banned_words"`
+	Patterns       []Pattern      `yaml:"patterns"`
+	URLs           URLRules       `yaml:"urls"`
+	Length         LengthRules    `yaml:"length"`
+	MixedLanguages MixedLanguages `yaml:"mixed_languages"`
+}
+
+// WordList — список запрещенных слов и фраз с общим вердиктом
+type WordList struct {
+	Name    string   `yaml:"name"`
+	Verdict Verdict  `yaml:"verdict"`
+	Reason  string   `yaml:"reason"`
+	Words   []string `yaml:"words"`
+}
+
+// Pattern — регулярное выражение, совпадение с которым считается нарушением
+type Pattern struct {
+	Name    string  `yaml:"name"`
+	Verdict Verdict `yaml:"verdict"`
+	Reason  string  `yaml:"reason"`
+	Pattern string  `yaml:"pattern"`
+}
+
+// URLRules задает разрешенные и запрещенные домены ссылок в тексте.
+// Домен совпадает с правилом, если он равен ему или является его поддоменом
+type URLRules struct {
+	Allow []string `yaml:"allow"`
+	Deny  []string `yaml:"deny"`
+	// DenyVerdict применяется к ссылкам на запрещенные домены
+	DenyVerdict Verdict `yaml:"deny_verdict"`
+	DenyReason  string  `yaml:"deny_reason"`
+	// UnknownVerdict применяется к ссылкам на домены вне списка разрешенных; пустое значение отключает проверку
+	UnknownVerdict Verdict `yaml:"unknown_verdict"`
+	UnknownReason  string  `yaml:"unknown_reason"`
+}
+
+// LengthRules ограничивает длину заголовка и текста в символах; 0 отключает ограничение
+type LengthRules struct {
+	Title   Bounds  `yaml:"title"`
+	Text    Bounds  `yaml:"text"`
+	Verdict Verdict `yaml:"verdict"`
+	Reason  string  `yaml:"reason"`
+}
+
+type Bounds struct {
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
+}
+
+// MixedLanguages помечает объявления, в которых фрагменты написаны на разных языках
+type MixedLanguages struct {
+	Verdict Verdict `yaml:"verdict"`
+	Reason  string  `yaml:"reason"`
+	// MinFragmentLength — минимальная длина фрагмента в символах, для которой определяется язык
+	MinFragmentLength int `yaml:"min_fragment_length"`
+}
+
+// LoadRules читает правила премодерации из YAML-файла
+func LoadRules(path string) (Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Rules{}, fmt.Errorf("failed to read rules: %w", err)
+	}
+
+	var rules Rules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return Rules{}, fmt.Errorf("failed to parse rules: %w", err)
+	}
+
+	return rules, nil
+}
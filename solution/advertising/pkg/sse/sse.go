@@ -0,0 +1,116 @@
+// Package sse читает и пишет поток Server-Sent Events
+package sse
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const ContentType = "text/event-stream"
+
+// maxLineSize ограничивает длину строки события
+const maxLineSize = 1 << 20
+
+type Event struct {
+	Name string
+	Data string
+}
+
+type Reader struct {
+	scanner *bufio.Scanner
+}
+
+func NewReader(r io.Reader) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineSize)
+	return &Reader{scanner: scanner}
+}
+
+// Next возвращает следующее событие с данными. Комментарии и события без data пропускаются.
+// В конце потока возвращается io.EOF
+func (r *Reader) Next() (*Event, error) {
+	var event Event
+	var data []string
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		if line == "" {
+			if len(data) > 0 {
+				event.Data = strings.Join(data, "\n")
+				return &event, nil
+			}
+			event = Event{}
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event.Name = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Последнее событие может не заканчиваться пустой строкой
+	if len(data) > 0 {
+		event.Data = strings.Join(data, "\n")
+		return &event, nil
+	}
+	return nil, io.EOF
+}
+
+type Writer struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewWriter отправляет заголовки потока событий. После этого статус ответа изменить нельзя
+func NewWriter(w http.ResponseWriter) *Writer {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Отключает буферизацию в nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	writer := &Writer{w: w}
+	writer.flusher, _ = w.(http.Flusher)
+	writer.flush()
+	return writer
+}
+
+// Send отправляет событие. data сериализуется в JSON
+func (w *Writer) Send(name string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if name != "" {
+		if _, err := fmt.Fprintf(w.w, "event: %s\n", name); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w.w, "data: %s\n\n", payload); err != nil {
+		return err
+	}
+	w.flush()
+	return nil
+}
+
+func (w *Writer) flush() {
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+}
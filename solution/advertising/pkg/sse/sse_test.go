@@ -0,0 +1,57 @@
+package sse
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	stream := ": comment\n" +
+		"data: {\"a\": 1}\n\n" +
+		"event: delta\n" +
+		"data: first line\n" +
+		"data: second line\n\n" +
+		"event: ping\n\n" +
+		"data:no space\n\n" +
+		"data: [DONE]"
+
+	reader := NewReader(strings.NewReader(stream))
+
+	expected := []Event{
+		{Data: `{"a": 1}`},
+		{Name: "delta", Data: "first line\nsecond line"},
+		{Data: "no space"},
+		{Data: "[DONE]"},
+	}
+	for _, want := range expected {
+		event, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, want, *event)
+	}
+
+	_, err := reader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestWriter(t *testing.T) {
+	recorder := httptest.NewRecorder()
+
+	writer := NewWriter(recorder)
+	require.NoError(t, writer.Send("delta", map[string]string{"text": "привет"}))
+	require.NoError(t, writer.Send("", "done"))
+
+	assert.Equal(t, ContentType, recorder.Header().Get("Content-Type"))
+	assert.True(t, recorder.Flushed)
+	assert.Equal(t, "event: delta\ndata: {\"text\":\"привет\"}\n\ndata: \"done\"\n\n", recorder.Body.String())
+
+	// Записанный поток читается обратно
+	reader := NewReader(recorder.Body)
+	event, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "delta", event.Name)
+}